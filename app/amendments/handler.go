@@ -0,0 +1,56 @@
+package amendments
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for the amendments of requests
+type AmendmentHandler struct {
+	amendmentService interfaces.AmendmentService
+}
+
+// creates a new AmendmentHandler instance
+func NewAmendmentHandler(ctx context.Context, amendmentService interfaces.AmendmentService) *AmendmentHandler {
+	return &AmendmentHandler{amendmentService: amendmentService}
+}
+
+func (h *AmendmentHandler) GetAmendments(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleAmendmentError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	amendments, err := h.amendmentService.GetAmendments(ctx, role, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleAmendmentError(c, err)
+		return
+	}
+
+	response.Success(c, "amendments fetched successfully", amendments)
+}
+
+func handleAmendmentError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidID:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
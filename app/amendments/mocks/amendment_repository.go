@@ -0,0 +1,147 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// AmendmentRepository is an autogenerated mock type for the AmendmentRepository type
+type AmendmentRepository struct {
+	mock.Mock
+}
+
+type AmendmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AmendmentRepository) EXPECT() *AmendmentRepository_Expecter {
+	return &AmendmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tx, amendment
+func (_m *AmendmentRepository) Create(ctx context.Context, tx interfaces.Tx, amendment *models.RequestAmendment) error {
+	ret := _m.Called(ctx, tx, amendment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestAmendment) error); ok {
+		r0 = rf(ctx, tx, amendment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AmendmentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AmendmentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - amendment *models.RequestAmendment
+func (_e *AmendmentRepository_Expecter) Create(ctx interface{}, tx interface{}, amendment interface{}) *AmendmentRepository_Create_Call {
+	return &AmendmentRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, amendment)}
+}
+
+func (_c *AmendmentRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, amendment *models.RequestAmendment)) *AmendmentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RequestAmendment))
+	})
+	return _c
+}
+
+func (_c *AmendmentRepository_Create_Call) Return(_a0 error) *AmendmentRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmendmentRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RequestAmendment) error) *AmendmentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForRequest provides a mock function with given fields: ctx, requestType, requestID
+func (_m *AmendmentRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestAmendment, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForRequest")
+	}
+
+	var r0 []models.RequestAmendment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestAmendment, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestAmendment); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestAmendment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmendmentRepository_GetForRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForRequest'
+type AmendmentRepository_GetForRequest_Call struct {
+	*mock.Call
+}
+
+// GetForRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *AmendmentRepository_Expecter) GetForRequest(ctx interface{}, requestType interface{}, requestID interface{}) *AmendmentRepository_GetForRequest_Call {
+	return &AmendmentRepository_GetForRequest_Call{Call: _e.mock.On("GetForRequest", ctx, requestType, requestID)}
+}
+
+func (_c *AmendmentRepository_GetForRequest_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *AmendmentRepository_GetForRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *AmendmentRepository_GetForRequest_Call) Return(_a0 []models.RequestAmendment, _a1 error) *AmendmentRepository_GetForRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmendmentRepository_GetForRequest_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestAmendment, error)) *AmendmentRepository_GetForRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAmendmentRepository creates a new instance of AmendmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAmendmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AmendmentRepository {
+	mock := &AmendmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,37 @@
+package amendments
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// AmendmentService keeps what each amendment of a pending request changed, for its approvers
+type AmendmentService struct {
+	amendmentRepo interfaces.AmendmentRepository
+	historyReader interfaces.RequestHistoryReader
+}
+
+// NewAmendmentService creates a new instance of AmendmentService
+func NewAmendmentService(
+	ctx context.Context,
+	amendmentRepo interfaces.AmendmentRepository,
+	historyReader interfaces.RequestHistoryReader,
+) interfaces.AmendmentService {
+	return &AmendmentService{amendmentRepo: amendmentRepo, historyReader: historyReader}
+}
+
+// Record stores an amendment of a request
+func (s *AmendmentService) Record(ctx context.Context, tx interfaces.Tx, amendment *models.RequestAmendment) error {
+	return s.amendmentRepo.Create(ctx, tx, amendment)
+}
+
+// GetAmendments returns the amendments of a request with their before and after values
+func (s *AmendmentService) GetAmendments(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestAmendment, error) {
+	requestType, err := s.historyReader.Authorize(ctx, role, userID, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return s.amendmentRepo.GetForRequest(ctx, requestType, requestID)
+}
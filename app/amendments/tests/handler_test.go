@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/amendments"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/amendments/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAmendmentHandler_GetAmendments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(s *mocks.AmendmentService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			path: "/amendments/expense/10",
+			mockSetup: func(s *mocks.AmendmentService) {
+				s.EXPECT().GetAmendments(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return([]models.RequestAmendment{{ID: 4}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone Else's Request",
+			path: "/amendments/expense/10",
+			mockSetup: func(s *mocks.AmendmentService) {
+				s.EXPECT().GetAmendments(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return(nil, apperrors.ErrRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			path:           "/amendments/expense/abc",
+			mockSetup:      func(s *mocks.AmendmentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewAmendmentService(t)
+			tt.mockSetup(mockService)
+
+			handler := amendments.NewAmendmentHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/amendments/:type/:id", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+				c.Set("role", "EMPLOYEE")
+			}, handler.GetAmendments)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
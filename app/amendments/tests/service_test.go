@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/amendments"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/amendments/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmendmentService_Record(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewAmendmentRepository(t)
+	mockTx := mocks.NewTx(t)
+
+	amendment := &models.RequestAmendment{
+		RequestType: "EXPENSE",
+		RequestID:   10,
+		EmployeeID:  1,
+		Before:      map[string]interface{}{"amount": 500.0},
+		After:       map[string]interface{}{"amount": 50.0},
+		Status:      constants.StatusAutoApproved,
+	}
+	mockRepo.EXPECT().Create(ctx, mockTx, amendment).Return(nil)
+
+	service := amendments.NewAmendmentService(ctx, mockRepo, nil)
+	err := service.Record(ctx, mockTx, amendment)
+
+	assert.NoError(t, err)
+}
+
+func TestAmendmentService_GetAmendments(t *testing.T) {
+	ctx := context.Background()
+	history := []models.RequestAmendment{{ID: 4, RequestType: "EXPENSE", RequestID: 10, EmployeeID: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := mocks.NewAmendmentRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleManager, int64(2), "expense", int64(10)).Return("EXPENSE", nil)
+		mockRepo.EXPECT().GetForRequest(ctx, "EXPENSE", int64(10)).Return(history, nil)
+
+		service := amendments.NewAmendmentService(ctx, mockRepo, mockReader)
+		result, err := service.GetAmendments(ctx, constants.RoleManager, 2, "expense", 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, history, result)
+	})
+
+	t.Run("Not Allowed", func(t *testing.T) {
+		mockRepo := mocks.NewAmendmentRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleEmployee, int64(3), "expense", int64(10)).Return("", apperrors.ErrRequestNotFound)
+
+		service := amendments.NewAmendmentService(ctx, mockRepo, mockReader)
+		_, err := service.GetAmendments(ctx, constants.RoleEmployee, 3, "expense", 10)
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotFound)
+	})
+}
@@ -0,0 +1,139 @@
+package approval_chains
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for approval chains and the steps of requests going through them
+type ApprovalChainHandler struct {
+	chainService interfaces.ApprovalChainService
+}
+
+// creates a new ApprovalChainHandler instance
+func NewApprovalChainHandler(ctx context.Context, chainService interfaces.ApprovalChainService) *ApprovalChainHandler {
+	return &ApprovalChainHandler{chainService: chainService}
+}
+
+func (h *ApprovalChainHandler) CreateChain(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	var chain models.ApprovalChain
+	if err := c.ShouldBindJSON(&chain); err != nil {
+		handleChainError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	created, err := h.chainService.CreateChain(ctx, role, userID, chain)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Created(c, "approval chain created successfully", created)
+}
+
+func (h *ApprovalChainHandler) GetChains(c *gin.Context) {
+	role := c.GetString("role")
+	ctx := c.Request.Context()
+
+	chains, err := h.chainService.GetChains(ctx, role)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "approval chains fetched successfully", chains)
+}
+
+func (h *ApprovalChainHandler) DeleteChain(c *gin.Context) {
+	role := c.GetString("role")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleChainError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.chainService.DeleteChain(ctx, role, id); err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "approval chain deactivated successfully", nil)
+}
+
+// GetPendingSteps lists the chain steps waiting for the caller
+func (h *ApprovalChainHandler) GetPendingSteps(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+
+	steps, err := h.chainService.GetPendingSteps(ctx, role, userID)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "pending approval steps fetched successfully", steps)
+}
+
+// GetRequestSteps shows who decided each step of a request, and how
+func (h *ApprovalChainHandler) GetRequestSteps(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleChainError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	steps, err := h.chainService.GetRequestSteps(ctx, role, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleChainError(c, err)
+		return
+	}
+
+	response.Success(c, "approval steps fetched successfully", steps)
+}
+
+func handleChainError(c *gin.Context, err error) {
+	// invalid conditions and steps say which field is wrong
+	var detail interface{}
+	var condErrs utils.ConditionErrors
+	if errors.As(err, &condErrs) {
+		err, detail = apperrors.ErrInvalidCondition, condErrs
+	} else if errors.Is(err, apperrors.ErrInvalidChainStep) {
+		err, detail = apperrors.ErrInvalidChainStep, err.Error()
+	}
+
+	status := http.StatusInternalServerError
+	switch err {
+	case apperrors.ErrAdminOnly, apperrors.ErrEmployeeCannotApprove, apperrors.ErrUnauthorized:
+		status = http.StatusForbidden
+	case apperrors.ErrChainNotFound, apperrors.ErrRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID, apperrors.ErrChainNameRequired,
+		apperrors.ErrRequestTypeRequired, apperrors.ErrUnknownRequestType, apperrors.ErrInvalidPriority,
+		apperrors.ErrConditionRequired, apperrors.ErrInvalidCondition,
+		apperrors.ErrChainStepsRequired, apperrors.ErrInvalidChainStep:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), detail)
+}
@@ -0,0 +1,679 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// ApprovalChainRepository is an autogenerated mock type for the ApprovalChainRepository type
+type ApprovalChainRepository struct {
+	mock.Mock
+}
+
+type ApprovalChainRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ApprovalChainRepository) EXPECT() *ApprovalChainRepository_Expecter {
+	return &ApprovalChainRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, chain
+func (_m *ApprovalChainRepository) Create(ctx context.Context, chain *models.ApprovalChain) error {
+	ret := _m.Called(ctx, chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ApprovalChain) error); ok {
+		r0 = rf(ctx, chain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ApprovalChainRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chain *models.ApprovalChain
+func (_e *ApprovalChainRepository_Expecter) Create(ctx interface{}, chain interface{}) *ApprovalChainRepository_Create_Call {
+	return &ApprovalChainRepository_Create_Call{Call: _e.mock.On("Create", ctx, chain)}
+}
+
+func (_c *ApprovalChainRepository_Create_Call) Run(run func(ctx context.Context, chain *models.ApprovalChain)) *ApprovalChainRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ApprovalChain))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Create_Call) Return(_a0 error) *ApprovalChainRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Create_Call) RunAndReturn(run func(context.Context, *models.ApprovalChain) error) *ApprovalChainRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSteps provides a mock function with given fields: ctx, tx, steps
+func (_m *ApprovalChainRepository) CreateSteps(ctx context.Context, tx interfaces.Tx, steps []models.RequestApprovalStep) error {
+	ret := _m.Called(ctx, tx, steps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSteps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, []models.RequestApprovalStep) error); ok {
+		r0 = rf(ctx, tx, steps)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_CreateSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSteps'
+type ApprovalChainRepository_CreateSteps_Call struct {
+	*mock.Call
+}
+
+// CreateSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - steps []models.RequestApprovalStep
+func (_e *ApprovalChainRepository_Expecter) CreateSteps(ctx interface{}, tx interface{}, steps interface{}) *ApprovalChainRepository_CreateSteps_Call {
+	return &ApprovalChainRepository_CreateSteps_Call{Call: _e.mock.On("CreateSteps", ctx, tx, steps)}
+}
+
+func (_c *ApprovalChainRepository_CreateSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, steps []models.RequestApprovalStep)) *ApprovalChainRepository_CreateSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].([]models.RequestApprovalStep))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_CreateSteps_Call) Return(_a0 error) *ApprovalChainRepository_CreateSteps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_CreateSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, []models.RequestApprovalStep) error) *ApprovalChainRepository_CreateSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deactivate provides a mock function with given fields: ctx, chainID
+func (_m *ApprovalChainRepository) Deactivate(ctx context.Context, chainID int64) error {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deactivate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_Deactivate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deactivate'
+type ApprovalChainRepository_Deactivate_Call struct {
+	*mock.Call
+}
+
+// Deactivate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID int64
+func (_e *ApprovalChainRepository_Expecter) Deactivate(ctx interface{}, chainID interface{}) *ApprovalChainRepository_Deactivate_Call {
+	return &ApprovalChainRepository_Deactivate_Call{Call: _e.mock.On("Deactivate", ctx, chainID)}
+}
+
+func (_c *ApprovalChainRepository_Deactivate_Call) Run(run func(ctx context.Context, chainID int64)) *ApprovalChainRepository_Deactivate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Deactivate_Call) Return(_a0 error) *ApprovalChainRepository_Deactivate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_Deactivate_Call) RunAndReturn(run func(context.Context, int64) error) *ApprovalChainRepository_Deactivate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DecideStep provides a mock function with given fields: ctx, tx, stepID, status, approverID, onBehalfOf, comment
+func (_m *ApprovalChainRepository) DecideStep(ctx context.Context, tx interfaces.Tx, stepID int64, status string, approverID int64, onBehalfOf *int64, comment string) error {
+	ret := _m.Called(ctx, tx, stepID, status, approverID, onBehalfOf, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecideStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64, *int64, string) error); ok {
+		r0 = rf(ctx, tx, stepID, status, approverID, onBehalfOf, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_DecideStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecideStep'
+type ApprovalChainRepository_DecideStep_Call struct {
+	*mock.Call
+}
+
+// DecideStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - stepID int64
+//   - status string
+//   - approverID int64
+//   - onBehalfOf *int64
+//   - comment string
+func (_e *ApprovalChainRepository_Expecter) DecideStep(ctx interface{}, tx interface{}, stepID interface{}, status interface{}, approverID interface{}, onBehalfOf interface{}, comment interface{}) *ApprovalChainRepository_DecideStep_Call {
+	return &ApprovalChainRepository_DecideStep_Call{Call: _e.mock.On("DecideStep", ctx, tx, stepID, status, approverID, onBehalfOf, comment)}
+}
+
+func (_c *ApprovalChainRepository_DecideStep_Call) Run(run func(ctx context.Context, tx interfaces.Tx, stepID int64, status string, approverID int64, onBehalfOf *int64, comment string)) *ApprovalChainRepository_DecideStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(int64), args[5].(*int64), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_DecideStep_Call) Return(_a0 error) *ApprovalChainRepository_DecideStep_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_DecideStep_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, int64, *int64, string) error) *ApprovalChainRepository_DecideStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) DeleteSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSteps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_DeleteSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSteps'
+type ApprovalChainRepository_DeleteSteps_Call struct {
+	*mock.Call
+}
+
+// DeleteSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) DeleteSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_DeleteSteps_Call {
+	return &ApprovalChainRepository_DeleteSteps_Call{Call: _e.mock.On("DeleteSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_DeleteSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_DeleteSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_DeleteSteps_Call) Return(_a0 error) *ApprovalChainRepository_DeleteSteps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_DeleteSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) error) *ApprovalChainRepository_DeleteSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActive provides a mock function with given fields: ctx, requestType
+func (_m *ApprovalChainRepository) GetActive(ctx context.Context, requestType string) ([]models.ApprovalChain, error) {
+	ret := _m.Called(ctx, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActive")
+	}
+
+	var r0 []models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ApprovalChain, error)); ok {
+		return rf(ctx, requestType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ApprovalChain); ok {
+		r0 = rf(ctx, requestType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActive'
+type ApprovalChainRepository_GetActive_Call struct {
+	*mock.Call
+}
+
+// GetActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+func (_e *ApprovalChainRepository_Expecter) GetActive(ctx interface{}, requestType interface{}) *ApprovalChainRepository_GetActive_Call {
+	return &ApprovalChainRepository_GetActive_Call{Call: _e.mock.On("GetActive", ctx, requestType)}
+}
+
+func (_c *ApprovalChainRepository_GetActive_Call) Run(run func(ctx context.Context, requestType string)) *ApprovalChainRepository_GetActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetActive_Call) Return(_a0 []models.ApprovalChain, _a1 error) *ApprovalChainRepository_GetActive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetActive_Call) RunAndReturn(run func(context.Context, string) ([]models.ApprovalChain, error)) *ApprovalChainRepository_GetActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ApprovalChainRepository) GetAll(ctx context.Context) ([]models.ApprovalChain, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ApprovalChain, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ApprovalChain); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ApprovalChainRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ApprovalChainRepository_Expecter) GetAll(ctx interface{}) *ApprovalChainRepository_GetAll_Call {
+	return &ApprovalChainRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *ApprovalChainRepository_GetAll_Call) Run(run func(ctx context.Context)) *ApprovalChainRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetAll_Call) Return(_a0 []models.ApprovalChain, _a1 error) *ApprovalChainRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.ApprovalChain, error)) *ApprovalChainRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingStepsFor provides a mock function with given fields: ctx, approverID, role
+func (_m *ApprovalChainRepository) GetPendingStepsFor(ctx context.Context, approverID int64, role string) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, approverID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingStepsFor")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, approverID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, approverID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, approverID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetPendingStepsFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingStepsFor'
+type ApprovalChainRepository_GetPendingStepsFor_Call struct {
+	*mock.Call
+}
+
+// GetPendingStepsFor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - approverID int64
+//   - role string
+func (_e *ApprovalChainRepository_Expecter) GetPendingStepsFor(ctx interface{}, approverID interface{}, role interface{}) *ApprovalChainRepository_GetPendingStepsFor_Call {
+	return &ApprovalChainRepository_GetPendingStepsFor_Call{Call: _e.mock.On("GetPendingStepsFor", ctx, approverID, role)}
+}
+
+func (_c *ApprovalChainRepository_GetPendingStepsFor_Call) Run(run func(ctx context.Context, approverID int64, role string)) *ApprovalChainRepository_GetPendingStepsFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetPendingStepsFor_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainRepository_GetPendingStepsFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetPendingStepsFor_Call) RunAndReturn(run func(context.Context, int64, string) ([]models.RequestApprovalStep, error)) *ApprovalChainRepository_GetPendingStepsFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestSteps provides a mock function with given fields: ctx, requestType, requestID
+func (_m *ApprovalChainRepository) GetRequestSteps(ctx context.Context, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestSteps'
+type ApprovalChainRepository_GetRequestSteps_Call struct {
+	*mock.Call
+}
+
+// GetRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) GetRequestSteps(ctx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_GetRequestSteps_Call {
+	return &ApprovalChainRepository_GetRequestSteps_Call{Call: _e.mock.On("GetRequestSteps", ctx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_GetRequestSteps_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *ApprovalChainRepository_GetRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetRequestSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainRepository_GetRequestSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetRequestSteps_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainRepository_GetRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) GetSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainRepository_GetSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSteps'
+type ApprovalChainRepository_GetSteps_Call struct {
+	*mock.Call
+}
+
+// GetSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) GetSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_GetSteps_Call {
+	return &ApprovalChainRepository_GetSteps_Call{Call: _e.mock.On("GetSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_GetSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_GetSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainRepository_GetSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_GetSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainRepository_GetSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignStep provides a mock function with given fields: ctx, tx, stepID, assignedTo, role
+func (_m *ApprovalChainRepository) ReassignStep(ctx context.Context, tx interfaces.Tx, stepID int64, assignedTo *int64, role string) error {
+	ret := _m.Called(ctx, tx, stepID, assignedTo, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignStep")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, *int64, string) error); ok {
+		r0 = rf(ctx, tx, stepID, assignedTo, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_ReassignStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignStep'
+type ApprovalChainRepository_ReassignStep_Call struct {
+	*mock.Call
+}
+
+// ReassignStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - stepID int64
+//   - assignedTo *int64
+//   - role string
+func (_e *ApprovalChainRepository_Expecter) ReassignStep(ctx interface{}, tx interface{}, stepID interface{}, assignedTo interface{}, role interface{}) *ApprovalChainRepository_ReassignStep_Call {
+	return &ApprovalChainRepository_ReassignStep_Call{Call: _e.mock.On("ReassignStep", ctx, tx, stepID, assignedTo, role)}
+}
+
+func (_c *ApprovalChainRepository_ReassignStep_Call) Run(run func(ctx context.Context, tx interfaces.Tx, stepID int64, assignedTo *int64, role string)) *ApprovalChainRepository_ReassignStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(*int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_ReassignStep_Call) Return(_a0 error) *ApprovalChainRepository_ReassignStep_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_ReassignStep_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, *int64, string) error) *ApprovalChainRepository_ReassignStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SkipPendingSteps provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainRepository) SkipPendingSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipPendingSteps")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainRepository_SkipPendingSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SkipPendingSteps'
+type ApprovalChainRepository_SkipPendingSteps_Call struct {
+	*mock.Call
+}
+
+// SkipPendingSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainRepository_Expecter) SkipPendingSteps(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainRepository_SkipPendingSteps_Call {
+	return &ApprovalChainRepository_SkipPendingSteps_Call{Call: _e.mock.On("SkipPendingSteps", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainRepository_SkipPendingSteps_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainRepository_SkipPendingSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainRepository_SkipPendingSteps_Call) Return(_a0 error) *ApprovalChainRepository_SkipPendingSteps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainRepository_SkipPendingSteps_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) error) *ApprovalChainRepository_SkipPendingSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewApprovalChainRepository creates a new instance of ApprovalChainRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApprovalChainRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApprovalChainRepository {
+	mock := &ApprovalChainRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
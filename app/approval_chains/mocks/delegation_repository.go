@@ -0,0 +1,364 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+	time "time"
+)
+
+// DelegationRepository is an autogenerated mock type for the DelegationRepository type
+type DelegationRepository struct {
+	mock.Mock
+}
+
+type DelegationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DelegationRepository) EXPECT() *DelegationRepository_Expecter {
+	return &DelegationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tx, delegation
+func (_m *DelegationRepository) Create(ctx context.Context, tx interfaces.Tx, delegation *models.Delegation) error {
+	ret := _m.Called(ctx, tx, delegation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Delegation) error); ok {
+		r0 = rf(ctx, tx, delegation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type DelegationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - delegation *models.Delegation
+func (_e *DelegationRepository_Expecter) Create(ctx interface{}, tx interface{}, delegation interface{}) *DelegationRepository_Create_Call {
+	return &DelegationRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, delegation)}
+}
+
+func (_c *DelegationRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, delegation *models.Delegation)) *DelegationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Delegation))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_Create_Call) Return(_a0 error) *DelegationRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Delegation) error) *DelegationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveDelegators provides a mock function with given fields: ctx, delegateID, on
+func (_m *DelegationRepository) GetActiveDelegators(ctx context.Context, delegateID int64, on time.Time) ([]models.RuleSubject, error) {
+	ret := _m.Called(ctx, delegateID, on)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveDelegators")
+	}
+
+	var r0 []models.RuleSubject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) ([]models.RuleSubject, error)); ok {
+		return rf(ctx, delegateID, on)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) []models.RuleSubject); ok {
+		r0 = rf(ctx, delegateID, on)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleSubject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, delegateID, on)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetActiveDelegators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveDelegators'
+type DelegationRepository_GetActiveDelegators_Call struct {
+	*mock.Call
+}
+
+// GetActiveDelegators is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegateID int64
+//   - on time.Time
+func (_e *DelegationRepository_Expecter) GetActiveDelegators(ctx interface{}, delegateID interface{}, on interface{}) *DelegationRepository_GetActiveDelegators_Call {
+	return &DelegationRepository_GetActiveDelegators_Call{Call: _e.mock.On("GetActiveDelegators", ctx, delegateID, on)}
+}
+
+func (_c *DelegationRepository_GetActiveDelegators_Call) Run(run func(ctx context.Context, delegateID int64, on time.Time)) *DelegationRepository_GetActiveDelegators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetActiveDelegators_Call) Return(_a0 []models.RuleSubject, _a1 error) *DelegationRepository_GetActiveDelegators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetActiveDelegators_Call) RunAndReturn(run func(context.Context, int64, time.Time) ([]models.RuleSubject, error)) *DelegationRepository_GetActiveDelegators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUser provides a mock function with given fields: ctx, userID
+func (_m *DelegationRepository) GetForUser(ctx context.Context, userID int64) ([]models.Delegation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUser")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.Delegation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Delegation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_GetForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUser'
+type DelegationRepository_GetForUser_Call struct {
+	*mock.Call
+}
+
+// GetForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *DelegationRepository_Expecter) GetForUser(ctx interface{}, userID interface{}) *DelegationRepository_GetForUser_Call {
+	return &DelegationRepository_GetForUser_Call{Call: _e.mock.On("GetForUser", ctx, userID)}
+}
+
+func (_c *DelegationRepository_GetForUser_Call) Run(run func(ctx context.Context, userID int64)) *DelegationRepository_GetForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_GetForUser_Call) Return(_a0 []models.Delegation, _a1 error) *DelegationRepository_GetForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_GetForUser_Call) RunAndReturn(run func(context.Context, int64) ([]models.Delegation, error)) *DelegationRepository_GetForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasOverlap provides a mock function with given fields: ctx, tx, delegatorID, start, end
+func (_m *DelegationRepository) HasOverlap(ctx context.Context, tx interfaces.Tx, delegatorID int64, start time.Time, end time.Time) (bool, error) {
+	ret := _m.Called(ctx, tx, delegatorID, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasOverlap")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, tx, delegatorID, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, tx, delegatorID, start, end)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, tx, delegatorID, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationRepository_HasOverlap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasOverlap'
+type DelegationRepository_HasOverlap_Call struct {
+	*mock.Call
+}
+
+// HasOverlap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - delegatorID int64
+//   - start time.Time
+//   - end time.Time
+func (_e *DelegationRepository_Expecter) HasOverlap(ctx interface{}, tx interface{}, delegatorID interface{}, start interface{}, end interface{}) *DelegationRepository_HasOverlap_Call {
+	return &DelegationRepository_HasOverlap_Call{Call: _e.mock.On("HasOverlap", ctx, tx, delegatorID, start, end)}
+}
+
+func (_c *DelegationRepository_HasOverlap_Call) Run(run func(ctx context.Context, tx interfaces.Tx, delegatorID int64, start time.Time, end time.Time)) *DelegationRepository_HasOverlap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_HasOverlap_Call) Return(_a0 bool, _a1 error) *DelegationRepository_HasOverlap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationRepository_HasOverlap_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time, time.Time) (bool, error)) *DelegationRepository_HasOverlap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, delegationID, delegatorID
+func (_m *DelegationRepository) Revoke(ctx context.Context, delegationID int64, delegatorID int64) error {
+	ret := _m.Called(ctx, delegationID, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, delegationID, delegatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type DelegationRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegationID int64
+//   - delegatorID int64
+func (_e *DelegationRepository_Expecter) Revoke(ctx interface{}, delegationID interface{}, delegatorID interface{}) *DelegationRepository_Revoke_Call {
+	return &DelegationRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, delegationID, delegatorID)}
+}
+
+func (_c *DelegationRepository_Revoke_Call) Run(run func(ctx context.Context, delegationID int64, delegatorID int64)) *DelegationRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_Revoke_Call) Return(_a0 error) *DelegationRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationRepository_Revoke_Call) RunAndReturn(run func(context.Context, int64, int64) error) *DelegationRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeForLeave provides a mock function with given fields: ctx, tx, leaveRequestID
+func (_m *DelegationRepository) RevokeForLeave(ctx context.Context, tx interfaces.Tx, leaveRequestID int64) error {
+	ret := _m.Called(ctx, tx, leaveRequestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeForLeave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, leaveRequestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationRepository_RevokeForLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeForLeave'
+type DelegationRepository_RevokeForLeave_Call struct {
+	*mock.Call
+}
+
+// RevokeForLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - leaveRequestID int64
+func (_e *DelegationRepository_Expecter) RevokeForLeave(ctx interface{}, tx interface{}, leaveRequestID interface{}) *DelegationRepository_RevokeForLeave_Call {
+	return &DelegationRepository_RevokeForLeave_Call{Call: _e.mock.On("RevokeForLeave", ctx, tx, leaveRequestID)}
+}
+
+func (_c *DelegationRepository_RevokeForLeave_Call) Run(run func(ctx context.Context, tx interfaces.Tx, leaveRequestID int64)) *DelegationRepository_RevokeForLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationRepository_RevokeForLeave_Call) Return(_a0 error) *DelegationRepository_RevokeForLeave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationRepository_RevokeForLeave_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *DelegationRepository_RevokeForLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDelegationRepository creates a new instance of DelegationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDelegationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DelegationRepository {
+	mock := &DelegationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,456 @@
+package approval_chains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// ApprovalChainService manages approval chains and moves pending requests through their steps
+type ApprovalChainService struct {
+	chainRepo      interfaces.ApprovalChainRepository
+	userRepo       interfaces.UserRepository
+	delegationRepo interfaces.DelegationRepository
+	historyReader  interfaces.RequestHistoryReader
+}
+
+// NewApprovalChainService creates a new instance of ApprovalChainService
+func NewApprovalChainService(
+	ctx context.Context,
+	chainRepo interfaces.ApprovalChainRepository,
+	userRepo interfaces.UserRepository,
+	delegationRepo interfaces.DelegationRepository,
+	historyReader interfaces.RequestHistoryReader,
+) interfaces.ApprovalChainService {
+	return &ApprovalChainService{
+		chainRepo:      chainRepo,
+		userRepo:       userRepo,
+		delegationRepo: delegationRepo,
+		historyReader:  historyReader,
+	}
+}
+
+// CreateChain stores an active chain (admin only); it applies to requests filed from now on
+func (s *ApprovalChainService) CreateChain(ctx context.Context, role string, userID int64, chain models.ApprovalChain) (*models.ApprovalChain, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrAdminOnly
+	}
+
+	chain = normalizeChain(chain)
+	if err := validateChain(chain); err != nil {
+		return nil, err
+	}
+
+	chain.CreatedBy = userID
+	if err := s.chainRepo.Create(ctx, &chain); err != nil {
+		return nil, err
+	}
+	return &chain, nil
+}
+
+// GetChains lists every chain (admin only)
+func (s *ApprovalChainService) GetChains(ctx context.Context, role string) ([]models.ApprovalChain, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrAdminOnly
+	}
+	return s.chainRepo.GetAll(ctx)
+}
+
+// DeleteChain deactivates a chain (admin only); requests already on it finish their steps
+func (s *ApprovalChainService) DeleteChain(ctx context.Context, role string, chainID int64) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrAdminOnly
+	}
+	return s.chainRepo.Deactivate(ctx, chainID)
+}
+
+// GetRequestSteps returns the steps of a request with each approver's decision and comment;
+// only the requester and those who may approve the request see them
+func (s *ApprovalChainService) GetRequestSteps(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	requestType, err := s.historyReader.Authorize(ctx, role, userID, requestType, requestID)
+	if errors.Is(err, apperrors.ErrRequestNotFound) {
+		return nil, apperrors.ErrUnauthorized
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.chainRepo.GetRequestSteps(ctx, requestType, requestID)
+}
+
+// GetPendingSteps lists the steps waiting for the approver's decision, followed by those waiting
+// for anyone the approver stands in for today; those name the delegator in OnBehalfOf
+func (s *ApprovalChainService) GetPendingSteps(ctx context.Context, role string, approverID int64) ([]models.RequestApprovalStep, error) {
+	if role == constants.RoleEmployee {
+		return nil, apperrors.ErrEmployeeCannotApprove
+	}
+
+	steps, err := s.chainRepo.GetPendingStepsFor(ctx, approverID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	delegators, err := s.delegationRepo.GetActiveDelegators(ctx, approverID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int64]bool, len(steps))
+	for _, step := range steps {
+		seen[step.ID] = true
+	}
+
+	for _, delegator := range delegators {
+		delegated, err := s.chainRepo.GetPendingStepsFor(ctx, delegator.UserID, delegator.Role)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, step := range delegated {
+			// the approver's own requests stay with someone else
+			if seen[step.ID] || step.EmployeeID == approverID {
+				continue
+			}
+			seen[step.ID] = true
+			delegatorID := delegator.UserID
+			step.OnBehalfOf = &delegatorID
+			steps = append(steps, step)
+		}
+	}
+
+	return steps, nil
+}
+
+// Start puts a new pending request on the first active chain, by priority, whose condition holds.
+// Managers are resolved now; when the requester has no such manager, an admin decides the step.
+func (s *ApprovalChainService) Start(ctx context.Context, tx interfaces.Tx, requestType string, requestID, employeeID int64, facts utils.Facts) error {
+	chains, err := s.chainRepo.GetActive(ctx, requestType)
+	if err != nil {
+		return err
+	}
+
+	chain := matchChain(chains, facts)
+	if chain == nil {
+		return nil
+	}
+
+	subject, err := s.userRepo.GetRuleSubject(ctx, tx, employeeID)
+	if err != nil {
+		return err
+	}
+
+	steps := make([]models.RequestApprovalStep, len(chain.Steps))
+	for i, step := range chain.Steps {
+		steps[i] = models.RequestApprovalStep{
+			RequestType: requestType,
+			RequestID:   requestID,
+			EmployeeID:  employeeID,
+			ChainID:     chain.ID,
+			StepNo:      i + 1,
+			Approver:    step.Approver,
+		}
+
+		switch step.Approver {
+		case constants.StepManager:
+			steps[i].AssignedTo = subject.ManagerID
+		case constants.StepSkipManager:
+			if subject.ManagerID != nil {
+				manager, err := s.userRepo.GetRuleSubject(ctx, tx, *subject.ManagerID)
+				if err != nil {
+					return err
+				}
+				steps[i].AssignedTo = manager.ManagerID
+			}
+		case constants.StepDepartment:
+			steps[i].Department = step.Department
+			continue
+		case constants.StepRole:
+			steps[i].Role = step.Role
+			continue
+		}
+
+		if steps[i].AssignedTo == nil {
+			steps[i].Role = constants.RoleAdmin
+		}
+	}
+
+	return s.chainRepo.CreateSteps(ctx, tx, steps)
+}
+
+// Decide records the approver's decision on the current step of a request's chain. An approver who
+// may not decide the step still can while standing in for someone who may; the decision then names them.
+func (s *ApprovalChainService) Decide(
+	ctx context.Context,
+	tx interfaces.Tx,
+	requestType string,
+	requestID, approverID int64,
+	role, status, comment string,
+) (models.ChainDecision, error) {
+	steps, current, onBehalfOf, err := s.currentStep(ctx, tx, requestType, requestID, approverID, role)
+	if err != nil || current < 0 {
+		return models.ChainDecision{}, err
+	}
+
+	step := steps[current]
+	if err := s.chainRepo.DecideStep(ctx, tx, step.ID, status, approverID, onBehalfOf, comment); err != nil {
+		return models.ChainDecision{}, err
+	}
+
+	// a rejection ends the chain
+	if status == constants.StatusRejected {
+		if err := s.chainRepo.SkipPendingSteps(ctx, tx, requestType, requestID); err != nil {
+			return models.ChainDecision{}, err
+		}
+		return models.ChainDecision{Chained: true, Final: true, OnBehalfOf: onBehalfOf}, nil
+	}
+
+	return models.ChainDecision{Chained: true, Final: current == len(steps)-1, OnBehalfOf: onBehalfOf}, nil
+}
+
+// CheckApprover checks the approver may decide the current step of a request's chain, as Decide
+// would, without deciding it. Chained is false when the request is not on a chain.
+func (s *ApprovalChainService) CheckApprover(
+	ctx context.Context,
+	tx interfaces.Tx,
+	requestType string,
+	requestID, approverID int64,
+	role string,
+) (models.ChainDecision, error) {
+	_, current, onBehalfOf, err := s.currentStep(ctx, tx, requestType, requestID, approverID, role)
+	if err != nil || current < 0 {
+		return models.ChainDecision{}, err
+	}
+	return models.ChainDecision{Chained: true, OnBehalfOf: onBehalfOf}, nil
+}
+
+// currentStep returns a request's steps and the index of the one waiting, -1 when none is, once the
+// approver is found to be allowed to decide it; onBehalfOf names whom they stand in for to do so
+func (s *ApprovalChainService) currentStep(
+	ctx context.Context,
+	tx interfaces.Tx,
+	requestType string,
+	requestID, approverID int64,
+	role string,
+) ([]models.RequestApprovalStep, int, *int64, error) {
+	steps, err := s.chainRepo.GetSteps(ctx, tx, requestType, requestID)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+
+	current := -1
+	for i, step := range steps {
+		if step.DecidedBy != nil && *step.DecidedBy == approverID {
+			return nil, -1, nil, apperrors.ErrApproverAlreadyDecided
+		}
+		if current < 0 && step.Status == constants.StatusPending {
+			current = i
+		}
+	}
+	if current < 0 {
+		return steps, -1, nil, nil
+	}
+
+	step := steps[current]
+	allowed, err := s.canDecide(ctx, tx, step, approverID, role)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if allowed {
+		return steps, current, nil, nil
+	}
+
+	delegator, err := s.delegatorFor(ctx, step, approverID)
+	if err != nil {
+		return nil, -1, nil, err
+	}
+	if delegator == nil {
+		return nil, -1, nil, apperrors.ErrNotStepApprover
+	}
+	return steps, current, &delegator.UserID, nil
+}
+
+// Close skips the steps still waiting when a request is withdrawn or rejected outside its chain
+func (s *ApprovalChainService) Close(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	return s.chainRepo.SkipPendingSteps(ctx, tx, requestType, requestID)
+}
+
+// Restart sends an amended request through its approvals again: the steps decided on what it used
+// to say are dropped, and the chain its new facts select, if any, starts from the first step
+func (s *ApprovalChainService) Restart(ctx context.Context, tx interfaces.Tx, requestType string, requestID, employeeID int64, facts utils.Facts) error {
+	if err := s.chainRepo.DeleteSteps(ctx, tx, requestType, requestID); err != nil {
+		return err
+	}
+	return s.Start(ctx, tx, requestType, requestID, employeeID, facts)
+}
+
+// Escalate hands the current step of a request's chain to the manager of the person it is assigned
+// to, or to the admins when they have none. Department and role steps go to the admins too.
+func (s *ApprovalChainService) Escalate(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (models.ChainEscalation, error) {
+	steps, err := s.chainRepo.GetSteps(ctx, tx, requestType, requestID)
+	if err != nil {
+		return models.ChainEscalation{}, err
+	}
+
+	var step *models.RequestApprovalStep
+	for i := range steps {
+		if steps[i].Status == constants.StatusPending {
+			step = &steps[i]
+			break
+		}
+	}
+	if step == nil {
+		return models.ChainEscalation{}, nil
+	}
+
+	if step.AssignedTo == nil && step.Department == "" && step.Role == constants.RoleAdmin {
+		return models.ChainEscalation{Chained: true}, nil
+	}
+
+	var to *int64
+	if step.AssignedTo != nil {
+		approver, err := s.userRepo.GetRuleSubject(ctx, tx, *step.AssignedTo)
+		if err != nil {
+			return models.ChainEscalation{}, err
+		}
+		to = approver.ManagerID
+	}
+
+	role := ""
+	if to == nil {
+		role = constants.RoleAdmin
+	}
+	if err := s.chainRepo.ReassignStep(ctx, tx, step.ID, to, role); err != nil {
+		return models.ChainEscalation{}, err
+	}
+
+	return models.ChainEscalation{Chained: true, Escalated: true, From: step.AssignedTo, To: to}, nil
+}
+
+// canDecide looks up the approver's department only when the step needs it
+func (s *ApprovalChainService) canDecide(ctx context.Context, tx interfaces.Tx, step models.RequestApprovalStep, approverID int64, role string) (bool, error) {
+	approver := models.RuleSubject{UserID: approverID, Role: role}
+	if step.AssignedTo == nil && step.Department != "" && isApprover(role) {
+		subject, err := s.userRepo.GetRuleSubject(ctx, tx, approverID)
+		if err != nil {
+			return false, err
+		}
+		approver.Department = subject.Department
+	}
+	return decides(step, approver), nil
+}
+
+// the first person the delegate stands in for today who may decide the step, other than its requester
+func (s *ApprovalChainService) delegatorFor(ctx context.Context, step models.RequestApprovalStep, delegateID int64) (*models.RuleSubject, error) {
+	delegators, err := s.delegationRepo.GetActiveDelegators(ctx, delegateID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	for i, delegator := range delegators {
+		if delegator.UserID != step.EmployeeID && decides(step, delegator) {
+			return &delegators[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// a step resolved to a person is theirs alone; department steps go to that department's managers and admins
+func decides(step models.RequestApprovalStep, approver models.RuleSubject) bool {
+	switch {
+	case step.AssignedTo != nil:
+		return *step.AssignedTo == approver.UserID
+	case step.Department != "":
+		return isApprover(approver.Role) && strings.EqualFold(approver.Department, step.Department)
+	default:
+		return step.Role == approver.Role
+	}
+}
+
+func isApprover(role string) bool {
+	return role == constants.RoleManager || role == constants.RoleAdmin
+}
+
+// the first chain whose condition holds; a chain whose stored condition no longer parses never matches
+func matchChain(chains []models.ApprovalChain, facts utils.Facts) *models.ApprovalChain {
+	for i, chain := range chains {
+		cond, err := utils.ParseCondition(chain.Condition)
+		if err != nil {
+			continue
+		}
+		if cond.Evaluate(facts) {
+			return &chains[i]
+		}
+	}
+	return nil
+}
+
+func normalizeChain(chain models.ApprovalChain) models.ApprovalChain {
+	chain.Name = strings.TrimSpace(chain.Name)
+	chain.RequestType = strings.ToUpper(strings.TrimSpace(chain.RequestType))
+
+	steps := make([]models.ApprovalChainStep, len(chain.Steps))
+	for i, step := range chain.Steps {
+		steps[i] = models.ApprovalChainStep{Approver: strings.ToUpper(strings.TrimSpace(step.Approver))}
+		switch steps[i].Approver {
+		case constants.StepDepartment:
+			steps[i].Department = strings.TrimSpace(step.Department)
+		case constants.StepRole:
+			steps[i].Role = strings.ToUpper(strings.TrimSpace(step.Role))
+		}
+	}
+	chain.Steps = steps
+	return chain
+}
+
+func validateChain(chain models.ApprovalChain) error {
+	if chain.Name == "" {
+		return apperrors.ErrChainNameRequired
+	}
+
+	if chain.RequestType == "" {
+		return apperrors.ErrRequestTypeRequired
+	}
+
+	if chain.Priority < 0 {
+		return apperrors.ErrInvalidPriority
+	}
+
+	if err := utils.ValidateCondition(chain.RequestType, chain.Condition); err != nil {
+		return err
+	}
+
+	if len(chain.Steps) == 0 {
+		return apperrors.ErrChainStepsRequired
+	}
+
+	for i, step := range chain.Steps {
+		if err := validateStep(step); err != nil {
+			return fmt.Errorf("%w: steps[%d]: %s", apperrors.ErrInvalidChainStep, i, err)
+		}
+	}
+	return nil
+}
+
+func validateStep(step models.ApprovalChainStep) error {
+	switch step.Approver {
+	case constants.StepManager, constants.StepSkipManager:
+	case constants.StepDepartment:
+		if step.Department == "" {
+			return errors.New("department is required")
+		}
+	case constants.StepRole:
+		if step.Role != constants.RoleManager && step.Role != constants.RoleAdmin {
+			return errors.New("role must be MANAGER or ADMIN")
+		}
+	default:
+		return errors.New("approver must be MANAGER, SKIP_MANAGER, DEPARTMENT or ROLE")
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApprovalChainHandler_CreateChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.ApprovalChainService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			role:    "ADMIN",
+			reqBody: bigExpenseChain,
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				s.EXPECT().CreateChain(mock.Anything, "ADMIN", int64(1), mock.AnythingOfType("models.ApprovalChain")).Return(&bigExpenseChain, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:    "Invalid Step",
+			role:    "ADMIN",
+			reqBody: bigExpenseChain,
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				err := fmt.Errorf("%w: steps[1]: department is required", apperrors.ErrInvalidChainStep)
+				s.EXPECT().CreateChain(mock.Anything, "ADMIN", int64(1), mock.Anything).Return(nil, err)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Not Admin",
+			role:    "MANAGER",
+			reqBody: bigExpenseChain,
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				s.EXPECT().CreateChain(mock.Anything, "MANAGER", int64(1), mock.Anything).Return(nil, apperrors.ErrAdminOnly)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Malformed Body",
+			role:           "ADMIN",
+			reqBody:        "not a chain",
+			mockSetup:      func(s *mocks.ApprovalChainService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewApprovalChainService(t)
+			tt.mockSetup(mockS)
+
+			handler := approval_chains.NewApprovalChainHandler(context.Background(), mockS)
+			r := gin.New()
+			r.POST("/approval-chains", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				c.Set("user_id", int64(1))
+				handler.CreateChain(c)
+			})
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, "/approval-chains", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestApprovalChainHandler_DeleteChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		mockSetup      func(s *mocks.ApprovalChainService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			id:   "3",
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				s.EXPECT().DeleteChain(mock.Anything, "ADMIN", int64(3)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			id:   "99",
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				s.EXPECT().DeleteChain(mock.Anything, "ADMIN", int64(99)).Return(apperrors.ErrChainNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			mockSetup:      func(s *mocks.ApprovalChainService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewApprovalChainService(t)
+			tt.mockSetup(mockS)
+
+			handler := approval_chains.NewApprovalChainHandler(context.Background(), mockS)
+			r := gin.New()
+			r.DELETE("/approval-chains/:id", func(c *gin.Context) {
+				c.Set("role", "ADMIN")
+				c.Set("user_id", int64(1))
+				handler.DeleteChain(c)
+			})
+
+			req := httptest.NewRequest(http.MethodDelete, "/approval-chains/"+tt.id, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestApprovalChainHandler_GetRequestSteps(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		mockSetup      func(s *mocks.ApprovalChainService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				s.EXPECT().GetRequestSteps(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return([]models.RequestApprovalStep{{ID: 1}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone Else's Request",
+			mockSetup: func(s *mocks.ApprovalChainService) {
+				s.EXPECT().GetRequestSteps(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return(nil, apperrors.ErrRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewApprovalChainService(t)
+			tt.mockSetup(mockS)
+
+			handler := approval_chains.NewApprovalChainHandler(context.Background(), mockS)
+			r := gin.New()
+			r.GET("/approval-steps/:type/:id", func(c *gin.Context) {
+				c.Set("role", "EMPLOYEE")
+				c.Set("user_id", int64(1))
+				handler.GetRequestSteps(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/approval-steps/expense/10", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,623 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// expenses above 10000 need the manager, then the finance department, then an admin
+var bigExpenseChain = models.ApprovalChain{
+	ID:          3,
+	Name:        "Big expenses",
+	RequestType: "EXPENSE",
+	Condition:   map[string]interface{}{"attr": "amount", "op": ">", "value": 10000},
+	Steps: []models.ApprovalChainStep{
+		{Approver: constants.StepManager},
+		{Approver: constants.StepDepartment, Department: "Finance"},
+		{Approver: constants.StepRole, Role: constants.RoleAdmin},
+	},
+	Active: true,
+}
+
+func TestApprovalChainService_CreateChain(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		role          string
+		chain         models.ApprovalChain
+		mockSetup     func(r *mocks.ApprovalChainRepository)
+		expectedError error
+	}{
+		{
+			name: "Success - Normalized",
+			role: constants.RoleAdmin,
+			chain: models.ApprovalChain{
+				Name:        " Big expenses ",
+				RequestType: "expense",
+				Condition:   map[string]interface{}{"attr": "amount", "op": ">", "value": 10000},
+				Steps: []models.ApprovalChainStep{
+					{Approver: "manager"},
+					{Approver: "role", Role: "admin"},
+				},
+			},
+			mockSetup: func(r *mocks.ApprovalChainRepository) {
+				r.EXPECT().Create(ctx, mock.MatchedBy(func(c *models.ApprovalChain) bool {
+					return c.Name == "Big expenses" && c.RequestType == "EXPENSE" && c.CreatedBy == 1 &&
+						c.Steps[0].Approver == constants.StepManager && c.Steps[1].Role == constants.RoleAdmin
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "Not Admin",
+			role:          constants.RoleManager,
+			chain:         bigExpenseChain,
+			mockSetup:     func(r *mocks.ApprovalChainRepository) {},
+			expectedError: apperrors.ErrAdminOnly,
+		},
+		{
+			name:          "Missing Name",
+			role:          constants.RoleAdmin,
+			chain:         models.ApprovalChain{RequestType: "EXPENSE", Steps: bigExpenseChain.Steps},
+			mockSetup:     func(r *mocks.ApprovalChainRepository) {},
+			expectedError: apperrors.ErrChainNameRequired,
+		},
+		{
+			name: "No Steps",
+			role: constants.RoleAdmin,
+			chain: models.ApprovalChain{
+				Name:        "Empty",
+				RequestType: "EXPENSE",
+				Condition:   bigExpenseChain.Condition,
+			},
+			mockSetup:     func(r *mocks.ApprovalChainRepository) {},
+			expectedError: apperrors.ErrChainStepsRequired,
+		},
+		{
+			name: "Department Step Without Department",
+			role: constants.RoleAdmin,
+			chain: models.ApprovalChain{
+				Name:        "Finance",
+				RequestType: "EXPENSE",
+				Condition:   bigExpenseChain.Condition,
+				Steps:       []models.ApprovalChainStep{{Approver: constants.StepDepartment}},
+			},
+			mockSetup:     func(r *mocks.ApprovalChainRepository) {},
+			expectedError: apperrors.ErrInvalidChainStep,
+		},
+		{
+			name: "Role Step For Employees",
+			role: constants.RoleAdmin,
+			chain: models.ApprovalChain{
+				Name:        "Peers",
+				RequestType: "EXPENSE",
+				Condition:   bigExpenseChain.Condition,
+				Steps:       []models.ApprovalChainStep{{Approver: constants.StepRole, Role: constants.RoleEmployee}},
+			},
+			mockSetup:     func(r *mocks.ApprovalChainRepository) {},
+			expectedError: apperrors.ErrInvalidChainStep,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewApprovalChainRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+			created, err := service.CreateChain(ctx, tt.role, 1, tt.chain)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Big expenses", created.Name)
+		})
+	}
+
+	t.Run("Invalid Condition", func(t *testing.T) {
+		service := approval_chains.NewApprovalChainService(ctx, mocks.NewApprovalChainRepository(t), nil, nil, nil)
+		_, err := service.CreateChain(ctx, constants.RoleAdmin, 1, models.ApprovalChain{
+			Name:        "Bad",
+			RequestType: "EXPENSE",
+			Condition:   map[string]interface{}{"attr": "days", "op": ">", "value": 2},
+			Steps:       bigExpenseChain.Steps,
+		})
+
+		var condErrs utils.ConditionErrors
+		assert.ErrorAs(t, err, &condErrs)
+	})
+}
+
+func TestApprovalChainService_Start(t *testing.T) {
+	ctx := context.Background()
+	bigExpense := utils.Facts{utils.AttrAmount: 25000.0}
+
+	t.Run("No Chain Matches", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockRepo.EXPECT().GetActive(ctx, "EXPENSE").Return([]models.ApprovalChain{bigExpenseChain}, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+		err := service.Start(ctx, mockTx, "EXPENSE", 10, 1, utils.Facts{utils.AttrAmount: 500.0})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Resolves Steps In Order", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetActive(ctx, "EXPENSE").Return([]models.ApprovalChain{bigExpenseChain}, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, ManagerID: int64Ptr(2)}, nil)
+		mockRepo.EXPECT().CreateSteps(ctx, mockTx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+			return len(steps) == 3 &&
+				steps[0].StepNo == 1 && *steps[0].AssignedTo == 2 &&
+				steps[1].AssignedTo == nil && steps[1].Department == "Finance" &&
+				steps[2].AssignedTo == nil && steps[2].Role == constants.RoleAdmin &&
+				steps[2].RequestID == 10 && steps[2].ChainID == 3
+		})).Return(nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, nil, nil)
+		err := service.Start(ctx, mockTx, "EXPENSE", 10, 1, bigExpense)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Skip Manager Resolved Through Manager", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		chain := bigExpenseChain
+		chain.Steps = []models.ApprovalChainStep{{Approver: constants.StepSkipManager}}
+
+		mockRepo.EXPECT().GetActive(ctx, "EXPENSE").Return([]models.ApprovalChain{chain}, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, ManagerID: int64Ptr(2)}, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(2)).Return(&models.RuleSubject{UserID: 2, ManagerID: int64Ptr(7)}, nil)
+		mockRepo.EXPECT().CreateSteps(ctx, mockTx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+			return len(steps) == 1 && *steps[0].AssignedTo == 7
+		})).Return(nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, nil, nil)
+		err := service.Start(ctx, mockTx, "EXPENSE", 10, 1, bigExpense)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("No Manager Falls Back To Admins", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		chain := bigExpenseChain
+		chain.Steps = []models.ApprovalChainStep{{Approver: constants.StepManager}, {Approver: constants.StepSkipManager}}
+
+		mockRepo.EXPECT().GetActive(ctx, "EXPENSE").Return([]models.ApprovalChain{chain}, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1}, nil)
+		mockRepo.EXPECT().CreateSteps(ctx, mockTx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+			return len(steps) == 2 &&
+				steps[0].AssignedTo == nil && steps[0].Role == constants.RoleAdmin &&
+				steps[1].AssignedTo == nil && steps[1].Role == constants.RoleAdmin
+		})).Return(nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, nil, nil)
+		err := service.Start(ctx, mockTx, "EXPENSE", 10, 1, bigExpense)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestApprovalChainService_Restart(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Drops Old Steps And Starts Again", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().DeleteSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(nil)
+		mockRepo.EXPECT().GetActive(ctx, "EXPENSE").Return([]models.ApprovalChain{bigExpenseChain}, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, ManagerID: int64Ptr(2)}, nil)
+		mockRepo.EXPECT().CreateSteps(ctx, mockTx, mock.MatchedBy(func(steps []models.RequestApprovalStep) bool {
+			return len(steps) == 3 && steps[0].StepNo == 1 && *steps[0].AssignedTo == 2
+		})).Return(nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, nil, nil)
+		err := service.Restart(ctx, mockTx, "EXPENSE", 10, 1, utils.Facts{utils.AttrAmount: 25000.0})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("No Chain Matches After Amendment", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().DeleteSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(nil)
+		mockRepo.EXPECT().GetActive(ctx, "EXPENSE").Return([]models.ApprovalChain{bigExpenseChain}, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+		err := service.Restart(ctx, mockTx, "EXPENSE", 10, 1, utils.Facts{utils.AttrAmount: 500.0})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestApprovalChainService_Escalate(t *testing.T) {
+	ctx := context.Background()
+
+	pendingStep := func(step models.RequestApprovalStep) []models.RequestApprovalStep {
+		step.ID, step.StepNo, step.Status = 41, 2, constants.StatusPending
+		return []models.RequestApprovalStep{{ID: 40, StepNo: 1, Status: constants.StatusApproved}, step}
+	}
+
+	t.Run("Not Chained", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "LEAVE", int64(10)).Return(nil, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+		escalation, err := service.Escalate(ctx, mockTx, "LEAVE", 10)
+
+		assert.NoError(t, err)
+		assert.False(t, escalation.Chained)
+	})
+
+	t.Run("Assigned Step Goes To The Approver's Manager", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(pendingStep(models.RequestApprovalStep{AssignedTo: int64Ptr(2)}), nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(2)).Return(&models.RuleSubject{UserID: 2, ManagerID: int64Ptr(7)}, nil)
+		mockRepo.EXPECT().ReassignStep(ctx, mockTx, int64(41), int64Ptr(7), "").Return(nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, nil, nil)
+		escalation, err := service.Escalate(ctx, mockTx, "EXPENSE", 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ChainEscalation{Chained: true, Escalated: true, From: int64Ptr(2), To: int64Ptr(7)}, escalation)
+	})
+
+	t.Run("Department Step Goes To Admins", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(pendingStep(models.RequestApprovalStep{Department: "Finance"}), nil)
+		mockRepo.EXPECT().ReassignStep(ctx, mockTx, int64(41), (*int64)(nil), constants.RoleAdmin).Return(nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+		escalation, err := service.Escalate(ctx, mockTx, "EXPENSE", 10)
+
+		assert.NoError(t, err)
+		assert.True(t, escalation.Escalated)
+		assert.Nil(t, escalation.To)
+	})
+
+	t.Run("Admin Step Stays With Admins", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(pendingStep(models.RequestApprovalStep{Role: constants.RoleAdmin}), nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+		escalation, err := service.Escalate(ctx, mockTx, "EXPENSE", 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ChainEscalation{Chained: true}, escalation)
+	})
+}
+
+func TestApprovalChainService_Decide(t *testing.T) {
+	ctx := context.Background()
+
+	// step 1 is the manager's and already approved; step 2 waits for finance, step 3 for an admin
+	steps := func() []models.RequestApprovalStep {
+		return []models.RequestApprovalStep{
+			{ID: 1, StepNo: 1, AssignedTo: int64Ptr(2), Status: constants.StatusApproved, DecidedBy: int64Ptr(2)},
+			{ID: 2, StepNo: 2, Department: "Finance", Status: constants.StatusPending},
+			{ID: 3, StepNo: 3, Role: constants.RoleAdmin, Status: constants.StatusPending},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		approverID    int64
+		role          string
+		status        string
+		steps         []models.RequestApprovalStep
+		mockSetup     func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx)
+		expected      models.ChainDecision
+		expectedError error
+	}{
+		{
+			name:       "Not On A Chain",
+			approverID: 2,
+			role:       constants.RoleManager,
+			status:     constants.StatusApproved,
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+			},
+		},
+		{
+			name:       "Department Manager Approves Middle Step",
+			approverID: 5,
+			role:       constants.RoleManager,
+			status:     constants.StatusApproved,
+			steps:      steps(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(5)).Return(&models.RuleSubject{UserID: 5, Department: "finance"}, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(2), constants.StatusApproved, int64(5), (*int64)(nil), "ok").Return(nil)
+			},
+			expected: models.ChainDecision{Chained: true},
+		},
+		{
+			name:       "Manager Of Another Department",
+			approverID: 6,
+			role:       constants.RoleManager,
+			status:     constants.StatusApproved,
+			steps:      steps(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(6)).Return(&models.RuleSubject{UserID: 6, Department: "Sales"}, nil)
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return(nil, nil)
+			},
+			expectedError: apperrors.ErrNotStepApprover,
+		},
+		{
+			name:       "Delegate Of The Finance Manager",
+			approverID: 6,
+			role:       constants.RoleManager,
+			status:     constants.StatusApproved,
+			steps:      steps(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(6)).Return(&models.RuleSubject{UserID: 6, Department: "Sales"}, nil)
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{
+					{UserID: 4, Role: constants.RoleManager, Department: "Sales"},
+					{UserID: 5, Role: constants.RoleManager, Department: "Finance"},
+				}, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(2), constants.StatusApproved, int64(6), int64Ptr(5), "ok").Return(nil)
+			},
+			expected: models.ChainDecision{Chained: true, OnBehalfOf: int64Ptr(5)},
+		},
+		{
+			name:       "Delegator Cannot Decide Their Own Request",
+			approverID: 6,
+			role:       constants.RoleManager,
+			status:     constants.StatusApproved,
+			steps: func() []models.RequestApprovalStep {
+				s := steps()
+				for i := range s {
+					s[i].EmployeeID = 5
+				}
+				return s
+			}(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(6)).Return(&models.RuleSubject{UserID: 6, Department: "Sales"}, nil)
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{
+					{UserID: 5, Role: constants.RoleManager, Department: "Finance"},
+				}, nil)
+			},
+			expectedError: apperrors.ErrNotStepApprover,
+		},
+		{
+			name:       "Same Approver Twice",
+			approverID: 2,
+			role:       constants.RoleManager,
+			status:     constants.StatusApproved,
+			steps:      steps(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrApproverAlreadyDecided,
+		},
+		{
+			name:       "Rejection Skips Remaining Steps",
+			approverID: 5,
+			role:       constants.RoleManager,
+			status:     constants.StatusRejected,
+			steps:      steps(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(5)).Return(&models.RuleSubject{UserID: 5, Department: "Finance"}, nil)
+				r.EXPECT().DecideStep(ctx, tx, int64(2), constants.StatusRejected, int64(5), (*int64)(nil), "ok").Return(nil)
+				r.EXPECT().SkipPendingSteps(ctx, tx, "EXPENSE", int64(10)).Return(nil)
+			},
+			expected: models.ChainDecision{Chained: true, Final: true},
+		},
+		{
+			name:       "Admin Approves Last Step",
+			approverID: 9,
+			role:       constants.RoleAdmin,
+			status:     constants.StatusApproved,
+			steps: func() []models.RequestApprovalStep {
+				s := steps()
+				s[1].Status, s[1].DecidedBy = constants.StatusApproved, int64Ptr(5)
+				return s
+			}(),
+			mockSetup: func(r *mocks.ApprovalChainRepository, u *mocks.UserRepository, d *mocks.DelegationRepository, tx *mocks.Tx) {
+				r.EXPECT().DecideStep(ctx, tx, int64(3), constants.StatusApproved, int64(9), (*int64)(nil), "ok").Return(nil)
+			},
+			expected: models.ChainDecision{Chained: true, Final: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewApprovalChainRepository(t)
+			mockUser := mocks.NewUserRepository(t)
+			mockDelegation := mocks.NewDelegationRepository(t)
+			mockTx := mocks.NewTx(t)
+
+			mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(tt.steps, nil)
+			tt.mockSetup(mockRepo, mockUser, mockDelegation, mockTx)
+
+			service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, mockDelegation, nil)
+			decision, err := service.Decide(ctx, mockTx, "EXPENSE", 10, tt.approverID, tt.role, tt.status, "ok")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, decision)
+		})
+	}
+}
+
+func TestApprovalChainService_CheckApprover(t *testing.T) {
+	ctx := context.Background()
+
+	steps := []models.RequestApprovalStep{
+		{ID: 1, StepNo: 1, AssignedTo: int64Ptr(2), Status: constants.StatusApproved, DecidedBy: int64Ptr(2)},
+		{ID: 2, StepNo: 2, Department: "Finance", Status: constants.StatusPending},
+	}
+
+	t.Run("Current Step Approver", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(steps, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(5)).Return(&models.RuleSubject{UserID: 5, Department: "Finance"}, nil)
+
+		// nothing is decided
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, nil, nil)
+		decision, err := service.CheckApprover(ctx, mockTx, "EXPENSE", 10, 5, constants.RoleManager)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.ChainDecision{Chained: true}, decision)
+	})
+
+	t.Run("Not The Current Step Approver", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockUser := mocks.NewUserRepository(t)
+		mockDelegation := mocks.NewDelegationRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(steps, nil)
+		mockUser.EXPECT().GetRuleSubject(ctx, mockTx, int64(6)).Return(&models.RuleSubject{UserID: 6, Department: "Sales"}, nil)
+		mockDelegation.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return(nil, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, mockUser, mockDelegation, nil)
+		_, err := service.CheckApprover(ctx, mockTx, "EXPENSE", 10, 6, constants.RoleManager)
+
+		assert.ErrorIs(t, err, apperrors.ErrNotStepApprover)
+	})
+
+	t.Run("Not On A Chain", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetSteps(ctx, mockTx, "EXPENSE", int64(10)).Return(nil, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, nil)
+		decision, err := service.CheckApprover(ctx, mockTx, "EXPENSE", 10, 2, constants.RoleManager)
+
+		assert.NoError(t, err)
+		assert.False(t, decision.Chained)
+	})
+}
+
+func TestApprovalChainService_GetRequestSteps(t *testing.T) {
+	ctx := context.Background()
+	steps := []models.RequestApprovalStep{{ID: 1, RequestType: "LEAVE", RequestID: 4, EmployeeID: 1, StepNo: 1}}
+
+	t.Run("Requester Sees Own Steps", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleEmployee, int64(1), "leave", int64(4)).Return("LEAVE", nil)
+		mockRepo.EXPECT().GetRequestSteps(ctx, "LEAVE", int64(4)).Return(steps, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, mockReader)
+		result, err := service.GetRequestSteps(ctx, constants.RoleEmployee, 1, "leave", 4)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("Other Employees Do Not", func(t *testing.T) {
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleEmployee, int64(8), "LEAVE", int64(4)).Return("", apperrors.ErrRequestNotFound)
+
+		service := approval_chains.NewApprovalChainService(ctx, mocks.NewApprovalChainRepository(t), nil, nil, mockReader)
+		_, err := service.GetRequestSteps(ctx, constants.RoleEmployee, 8, "LEAVE", 4)
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+
+	t.Run("Uninvolved Manager Does Not", func(t *testing.T) {
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleManager, int64(9), "LEAVE", int64(4)).Return("", apperrors.ErrRequestNotFound)
+
+		service := approval_chains.NewApprovalChainService(ctx, mocks.NewApprovalChainRepository(t), nil, nil, mockReader)
+		_, err := service.GetRequestSteps(ctx, constants.RoleManager, 9, "LEAVE", 4)
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+
+	t.Run("Approver Sees The Steps", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleManager, int64(2), "LEAVE", int64(4)).Return("LEAVE", nil)
+		mockRepo.EXPECT().GetRequestSteps(ctx, "LEAVE", int64(4)).Return(steps, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, nil, mockReader)
+		result, err := service.GetRequestSteps(ctx, constants.RoleManager, 2, "LEAVE", 4)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestApprovalChainService_GetPendingSteps(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Employee", func(t *testing.T) {
+		service := approval_chains.NewApprovalChainService(ctx, nil, nil, nil, nil)
+		_, err := service.GetPendingSteps(ctx, constants.RoleEmployee, 1)
+
+		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
+	})
+
+	t.Run("Manager", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockDelegation := mocks.NewDelegationRepository(t)
+		mockRepo.EXPECT().GetPendingStepsFor(ctx, int64(2), constants.RoleManager).Return(nil, nil)
+		mockDelegation.EXPECT().GetActiveDelegators(ctx, int64(2), mock.Anything).Return(nil, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, mockDelegation, nil)
+		_, err := service.GetPendingSteps(ctx, constants.RoleManager, 2)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delegate Sees The Delegator's Steps", func(t *testing.T) {
+		mockRepo := mocks.NewApprovalChainRepository(t)
+		mockDelegation := mocks.NewDelegationRepository(t)
+		mockRepo.EXPECT().GetPendingStepsFor(ctx, int64(2), constants.RoleManager).Return([]models.RequestApprovalStep{{ID: 1, EmployeeID: 3}}, nil)
+		mockDelegation.EXPECT().GetActiveDelegators(ctx, int64(2), mock.Anything).Return([]models.RuleSubject{{UserID: 5, Role: constants.RoleManager}}, nil)
+		// step 1 is already the delegate's; step 8 is the delegate's own request
+		mockRepo.EXPECT().GetPendingStepsFor(ctx, int64(5), constants.RoleManager).Return([]models.RequestApprovalStep{
+			{ID: 1, EmployeeID: 3},
+			{ID: 7, EmployeeID: 4},
+			{ID: 8, EmployeeID: 2},
+		}, nil)
+
+		service := approval_chains.NewApprovalChainService(ctx, mockRepo, nil, mockDelegation, nil)
+		steps, err := service.GetPendingSteps(ctx, constants.RoleManager, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, steps, 2)
+		assert.Nil(t, steps[0].OnBehalfOf)
+		assert.Equal(t, int64(7), steps[1].ID)
+		assert.Equal(t, int64Ptr(5), steps[1].OnBehalfOf)
+	})
+}
@@ -0,0 +1,9 @@
+package auto_reject
+
+type ExpiryPolicyRequest struct {
+	GradeID      *int64 `json:"grade_id"`
+	Action       string `json:"action"`
+	ReminderDays *int   `json:"reminder_days"`
+	ExpiryDays   int    `json:"expiry_days"`
+	CalendarDays bool   `json:"calendar_days"`
+}
@@ -0,0 +1,665 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+	ruleengine "github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+)
+
+// ApprovalChainService is an autogenerated mock type for the ApprovalChainService type
+type ApprovalChainService struct {
+	mock.Mock
+}
+
+type ApprovalChainService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ApprovalChainService) EXPECT() *ApprovalChainService_Expecter {
+	return &ApprovalChainService_Expecter{mock: &_m.Mock}
+}
+
+// CheckApprover provides a mock function with given fields: ctx, tx, requestType, requestID, approverID, role
+func (_m *ApprovalChainService) CheckApprover(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, role string) (models.ChainDecision, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID, approverID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckApprover")
+	}
+
+	var r0 models.ChainDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, string) (models.ChainDecision, error)); ok {
+		return rf(ctx, tx, requestType, requestID, approverID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, string) models.ChainDecision); ok {
+		r0 = rf(ctx, tx, requestType, requestID, approverID, role)
+	} else {
+		r0 = ret.Get(0).(models.ChainDecision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64, int64, string) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID, approverID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_CheckApprover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckApprover'
+type ApprovalChainService_CheckApprover_Call struct {
+	*mock.Call
+}
+
+// CheckApprover is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - approverID int64
+//   - role string
+func (_e *ApprovalChainService_Expecter) CheckApprover(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, approverID interface{}, role interface{}) *ApprovalChainService_CheckApprover_Call {
+	return &ApprovalChainService_CheckApprover_Call{Call: _e.mock.On("CheckApprover", ctx, tx, requestType, requestID, approverID, role)}
+}
+
+func (_c *ApprovalChainService_CheckApprover_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, role string)) *ApprovalChainService_CheckApprover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_CheckApprover_Call) Return(_a0 models.ChainDecision, _a1 error) *ApprovalChainService_CheckApprover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_CheckApprover_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, string) (models.ChainDecision, error)) *ApprovalChainService_CheckApprover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainService) Close(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type ApprovalChainService_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainService_Expecter) Close(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainService_Close_Call {
+	return &ApprovalChainService_Close_Call{Call: _e.mock.On("Close", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainService_Close_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainService_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_Close_Call) Return(_a0 error) *ApprovalChainService_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_Close_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) error) *ApprovalChainService_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChain provides a mock function with given fields: ctx, role, userID, chain
+func (_m *ApprovalChainService) CreateChain(ctx context.Context, role string, userID int64, chain models.ApprovalChain) (*models.ApprovalChain, error) {
+	ret := _m.Called(ctx, role, userID, chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChain")
+	}
+
+	var r0 *models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.ApprovalChain) (*models.ApprovalChain, error)); ok {
+		return rf(ctx, role, userID, chain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.ApprovalChain) *models.ApprovalChain); ok {
+		r0 = rf(ctx, role, userID, chain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.ApprovalChain) error); ok {
+		r1 = rf(ctx, role, userID, chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_CreateChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChain'
+type ApprovalChainService_CreateChain_Call struct {
+	*mock.Call
+}
+
+// CreateChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - chain models.ApprovalChain
+func (_e *ApprovalChainService_Expecter) CreateChain(ctx interface{}, role interface{}, userID interface{}, chain interface{}) *ApprovalChainService_CreateChain_Call {
+	return &ApprovalChainService_CreateChain_Call{Call: _e.mock.On("CreateChain", ctx, role, userID, chain)}
+}
+
+func (_c *ApprovalChainService_CreateChain_Call) Run(run func(ctx context.Context, role string, userID int64, chain models.ApprovalChain)) *ApprovalChainService_CreateChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.ApprovalChain))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_CreateChain_Call) Return(_a0 *models.ApprovalChain, _a1 error) *ApprovalChainService_CreateChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_CreateChain_Call) RunAndReturn(run func(context.Context, string, int64, models.ApprovalChain) (*models.ApprovalChain, error)) *ApprovalChainService_CreateChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Decide provides a mock function with given fields: ctx, tx, requestType, requestID, approverID, role, status, comment
+func (_m *ApprovalChainService) Decide(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, role string, status string, comment string) (models.ChainDecision, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID, approverID, role, status, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decide")
+	}
+
+	var r0 models.ChainDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, string, string, string) (models.ChainDecision, error)); ok {
+		return rf(ctx, tx, requestType, requestID, approverID, role, status, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, string, string, string) models.ChainDecision); ok {
+		r0 = rf(ctx, tx, requestType, requestID, approverID, role, status, comment)
+	} else {
+		r0 = ret.Get(0).(models.ChainDecision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64, int64, string, string, string) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID, approverID, role, status, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_Decide_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decide'
+type ApprovalChainService_Decide_Call struct {
+	*mock.Call
+}
+
+// Decide is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - approverID int64
+//   - role string
+//   - status string
+//   - comment string
+func (_e *ApprovalChainService_Expecter) Decide(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, approverID interface{}, role interface{}, status interface{}, comment interface{}) *ApprovalChainService_Decide_Call {
+	return &ApprovalChainService_Decide_Call{Call: _e.mock.On("Decide", ctx, tx, requestType, requestID, approverID, role, status, comment)}
+}
+
+func (_c *ApprovalChainService_Decide_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, approverID int64, role string, status string, comment string)) *ApprovalChainService_Decide_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(string), args[6].(string), args[7].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_Decide_Call) Return(_a0 models.ChainDecision, _a1 error) *ApprovalChainService_Decide_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_Decide_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, string, string, string) (models.ChainDecision, error)) *ApprovalChainService_Decide_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChain provides a mock function with given fields: ctx, role, chainID
+func (_m *ApprovalChainService) DeleteChain(ctx context.Context, role string, chainID int64) error {
+	ret := _m.Called(ctx, role, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, role, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_DeleteChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChain'
+type ApprovalChainService_DeleteChain_Call struct {
+	*mock.Call
+}
+
+// DeleteChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - chainID int64
+func (_e *ApprovalChainService_Expecter) DeleteChain(ctx interface{}, role interface{}, chainID interface{}) *ApprovalChainService_DeleteChain_Call {
+	return &ApprovalChainService_DeleteChain_Call{Call: _e.mock.On("DeleteChain", ctx, role, chainID)}
+}
+
+func (_c *ApprovalChainService_DeleteChain_Call) Run(run func(ctx context.Context, role string, chainID int64)) *ApprovalChainService_DeleteChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_DeleteChain_Call) Return(_a0 error) *ApprovalChainService_DeleteChain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_DeleteChain_Call) RunAndReturn(run func(context.Context, string, int64) error) *ApprovalChainService_DeleteChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Escalate provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *ApprovalChainService) Escalate(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (models.ChainEscalation, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Escalate")
+	}
+
+	var r0 models.ChainEscalation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) (models.ChainEscalation, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) models.ChainEscalation); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Get(0).(models.ChainEscalation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_Escalate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Escalate'
+type ApprovalChainService_Escalate_Call struct {
+	*mock.Call
+}
+
+// Escalate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainService_Expecter) Escalate(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *ApprovalChainService_Escalate_Call {
+	return &ApprovalChainService_Escalate_Call{Call: _e.mock.On("Escalate", ctx, tx, requestType, requestID)}
+}
+
+func (_c *ApprovalChainService_Escalate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *ApprovalChainService_Escalate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_Escalate_Call) Return(_a0 models.ChainEscalation, _a1 error) *ApprovalChainService_Escalate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_Escalate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) (models.ChainEscalation, error)) *ApprovalChainService_Escalate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChains provides a mock function with given fields: ctx, role
+func (_m *ApprovalChainService) GetChains(ctx context.Context, role string) ([]models.ApprovalChain, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChains")
+	}
+
+	var r0 []models.ApprovalChain
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ApprovalChain, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ApprovalChain); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ApprovalChain)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_GetChains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChains'
+type ApprovalChainService_GetChains_Call struct {
+	*mock.Call
+}
+
+// GetChains is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *ApprovalChainService_Expecter) GetChains(ctx interface{}, role interface{}) *ApprovalChainService_GetChains_Call {
+	return &ApprovalChainService_GetChains_Call{Call: _e.mock.On("GetChains", ctx, role)}
+}
+
+func (_c *ApprovalChainService_GetChains_Call) Run(run func(ctx context.Context, role string)) *ApprovalChainService_GetChains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_GetChains_Call) Return(_a0 []models.ApprovalChain, _a1 error) *ApprovalChainService_GetChains_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_GetChains_Call) RunAndReturn(run func(context.Context, string) ([]models.ApprovalChain, error)) *ApprovalChainService_GetChains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingSteps provides a mock function with given fields: ctx, role, approverID
+func (_m *ApprovalChainService) GetPendingSteps(ctx context.Context, role string, approverID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, role, approverID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, role, approverID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, role, approverID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, role, approverID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_GetPendingSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingSteps'
+type ApprovalChainService_GetPendingSteps_Call struct {
+	*mock.Call
+}
+
+// GetPendingSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+func (_e *ApprovalChainService_Expecter) GetPendingSteps(ctx interface{}, role interface{}, approverID interface{}) *ApprovalChainService_GetPendingSteps_Call {
+	return &ApprovalChainService_GetPendingSteps_Call{Call: _e.mock.On("GetPendingSteps", ctx, role, approverID)}
+}
+
+func (_c *ApprovalChainService_GetPendingSteps_Call) Run(run func(ctx context.Context, role string, approverID int64)) *ApprovalChainService_GetPendingSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_GetPendingSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainService_GetPendingSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_GetPendingSteps_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainService_GetPendingSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestSteps provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *ApprovalChainService) GetRequestSteps(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestSteps")
+	}
+
+	var r0 []models.RequestApprovalStep
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.RequestApprovalStep, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.RequestApprovalStep); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestApprovalStep)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApprovalChainService_GetRequestSteps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestSteps'
+type ApprovalChainService_GetRequestSteps_Call struct {
+	*mock.Call
+}
+
+// GetRequestSteps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *ApprovalChainService_Expecter) GetRequestSteps(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *ApprovalChainService_GetRequestSteps_Call {
+	return &ApprovalChainService_GetRequestSteps_Call{Call: _e.mock.On("GetRequestSteps", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *ApprovalChainService_GetRequestSteps_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *ApprovalChainService_GetRequestSteps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_GetRequestSteps_Call) Return(_a0 []models.RequestApprovalStep, _a1 error) *ApprovalChainService_GetRequestSteps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ApprovalChainService_GetRequestSteps_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.RequestApprovalStep, error)) *ApprovalChainService_GetRequestSteps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restart provides a mock function with given fields: ctx, tx, requestType, requestID, employeeID, facts
+func (_m *ApprovalChainService) Restart(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, employeeID int64, facts ruleengine.Facts) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, employeeID, facts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, ruleengine.Facts) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, employeeID, facts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_Restart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restart'
+type ApprovalChainService_Restart_Call struct {
+	*mock.Call
+}
+
+// Restart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - employeeID int64
+//   - facts ruleengine.Facts
+func (_e *ApprovalChainService_Expecter) Restart(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, employeeID interface{}, facts interface{}) *ApprovalChainService_Restart_Call {
+	return &ApprovalChainService_Restart_Call{Call: _e.mock.On("Restart", ctx, tx, requestType, requestID, employeeID, facts)}
+}
+
+func (_c *ApprovalChainService_Restart_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, employeeID int64, facts ruleengine.Facts)) *ApprovalChainService_Restart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(ruleengine.Facts))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_Restart_Call) Return(_a0 error) *ApprovalChainService_Restart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_Restart_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, ruleengine.Facts) error) *ApprovalChainService_Restart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, tx, requestType, requestID, employeeID, facts
+func (_m *ApprovalChainService) Start(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, employeeID int64, facts ruleengine.Facts) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, employeeID, facts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, ruleengine.Facts) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, employeeID, facts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApprovalChainService_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type ApprovalChainService_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - employeeID int64
+//   - facts ruleengine.Facts
+func (_e *ApprovalChainService_Expecter) Start(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, employeeID interface{}, facts interface{}) *ApprovalChainService_Start_Call {
+	return &ApprovalChainService_Start_Call{Call: _e.mock.On("Start", ctx, tx, requestType, requestID, employeeID, facts)}
+}
+
+func (_c *ApprovalChainService_Start_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, employeeID int64, facts ruleengine.Facts)) *ApprovalChainService_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(ruleengine.Facts))
+	})
+	return _c
+}
+
+func (_c *ApprovalChainService_Start_Call) Return(_a0 error) *ApprovalChainService_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ApprovalChainService_Start_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, ruleengine.Facts) error) *ApprovalChainService_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewApprovalChainService creates a new instance of ApprovalChainService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewApprovalChainService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ApprovalChainService {
+	mock := &ApprovalChainService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,475 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// DelegationService is an autogenerated mock type for the DelegationService type
+type DelegationService struct {
+	mock.Mock
+}
+
+type DelegationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DelegationService) EXPECT() *DelegationService_Expecter {
+	return &DelegationService_Expecter{mock: &_m.Mock}
+}
+
+// AddDelegatedQueues provides a mock function with given fields: ctx, delegateID, queue, pending
+func (_m *DelegationService) AddDelegatedQueues(ctx context.Context, delegateID int64, queue []map[string]interface{}, pending interfaces.PendingQueue) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, delegateID, queue, pending)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDelegatedQueues")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []map[string]interface{}, interfaces.PendingQueue) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, delegateID, queue, pending)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []map[string]interface{}, interfaces.PendingQueue) []map[string]interface{}); ok {
+		r0 = rf(ctx, delegateID, queue, pending)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []map[string]interface{}, interfaces.PendingQueue) error); ok {
+		r1 = rf(ctx, delegateID, queue, pending)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_AddDelegatedQueues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDelegatedQueues'
+type DelegationService_AddDelegatedQueues_Call struct {
+	*mock.Call
+}
+
+// AddDelegatedQueues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegateID int64
+//   - queue []map[string]interface{}
+//   - pending interfaces.PendingQueue
+func (_e *DelegationService_Expecter) AddDelegatedQueues(ctx interface{}, delegateID interface{}, queue interface{}, pending interface{}) *DelegationService_AddDelegatedQueues_Call {
+	return &DelegationService_AddDelegatedQueues_Call{Call: _e.mock.On("AddDelegatedQueues", ctx, delegateID, queue, pending)}
+}
+
+func (_c *DelegationService_AddDelegatedQueues_Call) Run(run func(ctx context.Context, delegateID int64, queue []map[string]interface{}, pending interfaces.PendingQueue)) *DelegationService_AddDelegatedQueues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]map[string]interface{}), args[3].(interfaces.PendingQueue))
+	})
+	return _c
+}
+
+func (_c *DelegationService_AddDelegatedQueues_Call) Return(_a0 []map[string]interface{}, _a1 error) *DelegationService_AddDelegatedQueues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_AddDelegatedQueues_Call) RunAndReturn(run func(context.Context, int64, []map[string]interface{}, interfaces.PendingQueue) ([]map[string]interface{}, error)) *DelegationService_AddDelegatedQueues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDelegation provides a mock function with given fields: ctx, role, delegatorID, delegation
+func (_m *DelegationService) CreateDelegation(ctx context.Context, role string, delegatorID int64, delegation models.Delegation) (*models.Delegation, error) {
+	ret := _m.Called(ctx, role, delegatorID, delegation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDelegation")
+	}
+
+	var r0 *models.Delegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.Delegation) (*models.Delegation, error)); ok {
+		return rf(ctx, role, delegatorID, delegation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.Delegation) *models.Delegation); ok {
+		r0 = rf(ctx, role, delegatorID, delegation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Delegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.Delegation) error); ok {
+		r1 = rf(ctx, role, delegatorID, delegation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_CreateDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDelegation'
+type DelegationService_CreateDelegation_Call struct {
+	*mock.Call
+}
+
+// CreateDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - delegatorID int64
+//   - delegation models.Delegation
+func (_e *DelegationService_Expecter) CreateDelegation(ctx interface{}, role interface{}, delegatorID interface{}, delegation interface{}) *DelegationService_CreateDelegation_Call {
+	return &DelegationService_CreateDelegation_Call{Call: _e.mock.On("CreateDelegation", ctx, role, delegatorID, delegation)}
+}
+
+func (_c *DelegationService_CreateDelegation_Call) Run(run func(ctx context.Context, role string, delegatorID int64, delegation models.Delegation)) *DelegationService_CreateDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.Delegation))
+	})
+	return _c
+}
+
+func (_c *DelegationService_CreateDelegation_Call) Return(_a0 *models.Delegation, _a1 error) *DelegationService_CreateDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_CreateDelegation_Call) RunAndReturn(run func(context.Context, string, int64, models.Delegation) (*models.Delegation, error)) *DelegationService_CreateDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DelegateForLeave provides a mock function with given fields: ctx, tx, leave
+func (_m *DelegationService) DelegateForLeave(ctx context.Context, tx interfaces.Tx, leave *models.LeaveRequest) error {
+	ret := _m.Called(ctx, tx, leave)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DelegateForLeave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.LeaveRequest) error); ok {
+		r0 = rf(ctx, tx, leave)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationService_DelegateForLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DelegateForLeave'
+type DelegationService_DelegateForLeave_Call struct {
+	*mock.Call
+}
+
+// DelegateForLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - leave *models.LeaveRequest
+func (_e *DelegationService_Expecter) DelegateForLeave(ctx interface{}, tx interface{}, leave interface{}) *DelegationService_DelegateForLeave_Call {
+	return &DelegationService_DelegateForLeave_Call{Call: _e.mock.On("DelegateForLeave", ctx, tx, leave)}
+}
+
+func (_c *DelegationService_DelegateForLeave_Call) Run(run func(ctx context.Context, tx interfaces.Tx, leave *models.LeaveRequest)) *DelegationService_DelegateForLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.LeaveRequest))
+	})
+	return _c
+}
+
+func (_c *DelegationService_DelegateForLeave_Call) Return(_a0 error) *DelegationService_DelegateForLeave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationService_DelegateForLeave_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.LeaveRequest) error) *DelegationService_DelegateForLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelegations provides a mock function with given fields: ctx, userID
+func (_m *DelegationService) GetDelegations(ctx context.Context, userID int64) ([]models.Delegation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegations")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.Delegation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.Delegation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_GetDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelegations'
+type DelegationService_GetDelegations_Call struct {
+	*mock.Call
+}
+
+// GetDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+func (_e *DelegationService_Expecter) GetDelegations(ctx interface{}, userID interface{}) *DelegationService_GetDelegations_Call {
+	return &DelegationService_GetDelegations_Call{Call: _e.mock.On("GetDelegations", ctx, userID)}
+}
+
+func (_c *DelegationService_GetDelegations_Call) Run(run func(ctx context.Context, userID int64)) *DelegationService_GetDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_GetDelegations_Call) Return(_a0 []models.Delegation, _a1 error) *DelegationService_GetDelegations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_GetDelegations_Call) RunAndReturn(run func(context.Context, int64) ([]models.Delegation, error)) *DelegationService_GetDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveApprover provides a mock function with given fields: ctx, tx, approverID, role, requesterID
+func (_m *DelegationService) ResolveApprover(ctx context.Context, tx interfaces.Tx, approverID int64, role string, requesterID int64) (*models.Approver, error) {
+	ret := _m.Called(ctx, tx, approverID, role, requesterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveApprover")
+	}
+
+	var r0 *models.Approver
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64) (*models.Approver, error)); ok {
+		return rf(ctx, tx, approverID, role, requesterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64) *models.Approver); ok {
+		r0 = rf(ctx, tx, approverID, role, requesterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Approver)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, string, int64) error); ok {
+		r1 = rf(ctx, tx, approverID, role, requesterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelegationService_ResolveApprover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveApprover'
+type DelegationService_ResolveApprover_Call struct {
+	*mock.Call
+}
+
+// ResolveApprover is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - approverID int64
+//   - role string
+//   - requesterID int64
+func (_e *DelegationService_Expecter) ResolveApprover(ctx interface{}, tx interface{}, approverID interface{}, role interface{}, requesterID interface{}) *DelegationService_ResolveApprover_Call {
+	return &DelegationService_ResolveApprover_Call{Call: _e.mock.On("ResolveApprover", ctx, tx, approverID, role, requesterID)}
+}
+
+func (_c *DelegationService_ResolveApprover_Call) Run(run func(ctx context.Context, tx interfaces.Tx, approverID int64, role string, requesterID int64)) *DelegationService_ResolveApprover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_ResolveApprover_Call) Return(_a0 *models.Approver, _a1 error) *DelegationService_ResolveApprover_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DelegationService_ResolveApprover_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, int64) (*models.Approver, error)) *DelegationService_ResolveApprover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeDelegation provides a mock function with given fields: ctx, delegatorID, delegationID
+func (_m *DelegationService) RevokeDelegation(ctx context.Context, delegatorID int64, delegationID int64) error {
+	ret := _m.Called(ctx, delegatorID, delegationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeDelegation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, delegatorID, delegationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationService_RevokeDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeDelegation'
+type DelegationService_RevokeDelegation_Call struct {
+	*mock.Call
+}
+
+// RevokeDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegatorID int64
+//   - delegationID int64
+func (_e *DelegationService_Expecter) RevokeDelegation(ctx interface{}, delegatorID interface{}, delegationID interface{}) *DelegationService_RevokeDelegation_Call {
+	return &DelegationService_RevokeDelegation_Call{Call: _e.mock.On("RevokeDelegation", ctx, delegatorID, delegationID)}
+}
+
+func (_c *DelegationService_RevokeDelegation_Call) Run(run func(ctx context.Context, delegatorID int64, delegationID int64)) *DelegationService_RevokeDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_RevokeDelegation_Call) Return(_a0 error) *DelegationService_RevokeDelegation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationService_RevokeDelegation_Call) RunAndReturn(run func(context.Context, int64, int64) error) *DelegationService_RevokeDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeForLeave provides a mock function with given fields: ctx, tx, leaveRequestID
+func (_m *DelegationService) RevokeForLeave(ctx context.Context, tx interfaces.Tx, leaveRequestID int64) error {
+	ret := _m.Called(ctx, tx, leaveRequestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeForLeave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, leaveRequestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationService_RevokeForLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeForLeave'
+type DelegationService_RevokeForLeave_Call struct {
+	*mock.Call
+}
+
+// RevokeForLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - leaveRequestID int64
+func (_e *DelegationService_Expecter) RevokeForLeave(ctx interface{}, tx interface{}, leaveRequestID interface{}) *DelegationService_RevokeForLeave_Call {
+	return &DelegationService_RevokeForLeave_Call{Call: _e.mock.On("RevokeForLeave", ctx, tx, leaveRequestID)}
+}
+
+func (_c *DelegationService_RevokeForLeave_Call) Run(run func(ctx context.Context, tx interfaces.Tx, leaveRequestID int64)) *DelegationService_RevokeForLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_RevokeForLeave_Call) Return(_a0 error) *DelegationService_RevokeForLeave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationService_RevokeForLeave_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *DelegationService_RevokeForLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateDelegate provides a mock function with given fields: ctx, tx, delegatorID, delegateID
+func (_m *DelegationService) ValidateDelegate(ctx context.Context, tx interfaces.Tx, delegatorID int64, delegateID int64) error {
+	ret := _m.Called(ctx, tx, delegatorID, delegateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateDelegate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, delegatorID, delegateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DelegationService_ValidateDelegate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateDelegate'
+type DelegationService_ValidateDelegate_Call struct {
+	*mock.Call
+}
+
+// ValidateDelegate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - delegatorID int64
+//   - delegateID int64
+func (_e *DelegationService_Expecter) ValidateDelegate(ctx interface{}, tx interface{}, delegatorID interface{}, delegateID interface{}) *DelegationService_ValidateDelegate_Call {
+	return &DelegationService_ValidateDelegate_Call{Call: _e.mock.On("ValidateDelegate", ctx, tx, delegatorID, delegateID)}
+}
+
+func (_c *DelegationService_ValidateDelegate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, delegatorID int64, delegateID int64)) *DelegationService_ValidateDelegate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *DelegationService_ValidateDelegate_Call) Return(_a0 error) *DelegationService_ValidateDelegate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DelegationService_ValidateDelegate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) error) *DelegationService_ValidateDelegate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDelegationService creates a new instance of DelegationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDelegationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DelegationService {
+	mock := &DelegationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,8 +9,6 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
-
-	time "time"
 )
 
 // ExpenseRequestRepository is an autogenerated mock type for the ExpenseRequestRepository type
@@ -26,6 +24,54 @@ func (_m *ExpenseRequestRepository) EXPECT() *ExpenseRequestRepository_Expecter
 	return &ExpenseRequestRepository_Expecter{mock: &_m.Mock}
 }
 
+// Amend provides a mock function with given fields: ctx, tx, req
+func (_m *ExpenseRequestRepository) Amend(ctx context.Context, tx interfaces.Tx, req *models.ExpenseRequest) error {
+	ret := _m.Called(ctx, tx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Amend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.ExpenseRequest) error); ok {
+		r0 = rf(ctx, tx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseRequestRepository_Amend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Amend'
+type ExpenseRequestRepository_Amend_Call struct {
+	*mock.Call
+}
+
+// Amend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - req *models.ExpenseRequest
+func (_e *ExpenseRequestRepository_Expecter) Amend(ctx interface{}, tx interface{}, req interface{}) *ExpenseRequestRepository_Amend_Call {
+	return &ExpenseRequestRepository_Amend_Call{Call: _e.mock.On("Amend", ctx, tx, req)}
+}
+
+func (_c *ExpenseRequestRepository_Amend_Call) Run(run func(ctx context.Context, tx interfaces.Tx, req *models.ExpenseRequest)) *ExpenseRequestRepository_Amend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.ExpenseRequest))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Amend_Call) Return(_a0 error) *ExpenseRequestRepository_Amend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Amend_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.ExpenseRequest) error) *ExpenseRequestRepository_Amend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Cancel provides a mock function with given fields: ctx, tx, requestID
 func (_m *ExpenseRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx, requestID int64) error {
 	ret := _m.Called(ctx, tx, requestID)
@@ -122,6 +168,56 @@ func (_c *ExpenseRequestRepository_Create_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// Escalate provides a mock function with given fields: ctx, tx, requestID, level, escalatedTo
+func (_m *ExpenseRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	ret := _m.Called(ctx, tx, requestID, level, escalatedTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Escalate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int, *int64) error); ok {
+		r0 = rf(ctx, tx, requestID, level, escalatedTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseRequestRepository_Escalate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Escalate'
+type ExpenseRequestRepository_Escalate_Call struct {
+	*mock.Call
+}
+
+// Escalate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - level int
+//   - escalatedTo *int64
+func (_e *ExpenseRequestRepository_Expecter) Escalate(ctx interface{}, tx interface{}, requestID interface{}, level interface{}, escalatedTo interface{}) *ExpenseRequestRepository_Escalate_Call {
+	return &ExpenseRequestRepository_Escalate_Call{Call: _e.mock.On("Escalate", ctx, tx, requestID, level, escalatedTo)}
+}
+
+func (_c *ExpenseRequestRepository_Escalate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64)) *ExpenseRequestRepository_Escalate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int), args[4].(*int64))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Escalate_Call) Return(_a0 error) *ExpenseRequestRepository_Escalate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Escalate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int, *int64) error) *ExpenseRequestRepository_Escalate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, tx, requestID
 func (_m *ExpenseRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.ExpenseRequest, error) {
 	ret := _m.Called(ctx, tx, requestID)
@@ -300,38 +396,23 @@ func (_c *ExpenseRequestRepository_GetPendingForManager_Call) RunAndReturn(run f
 }
 
 // GetPendingRequests provides a mock function with given fields: ctx
-func (_m *ExpenseRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
+func (_m *ExpenseRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingRequests")
 	}
 
-	var r0 []struct {
-		ID        int64
-		CreatedAt time.Time
-	}
+	var r0 []models.PendingRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.PendingRequest, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []struct {
-		ID        int64
-		CreatedAt time.Time
-	}); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []models.PendingRequest); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]struct {
-				ID        int64
-				CreatedAt time.Time
-			})
+			r0 = ret.Get(0).([]models.PendingRequest)
 		}
 	}
 
@@ -362,18 +443,265 @@ func (_c *ExpenseRequestRepository_GetPendingRequests_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *ExpenseRequestRepository_GetPendingRequests_Call) Return(_a0 []struct {
-	ID        int64
-	CreatedAt time.Time
-}, _a1 error) *ExpenseRequestRepository_GetPendingRequests_Call {
+func (_c *ExpenseRequestRepository_GetPendingRequests_Call) Return(_a0 []models.PendingRequest, _a1 error) *ExpenseRequestRepository_GetPendingRequests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ExpenseRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error)) *ExpenseRequestRepository_GetPendingRequests_Call {
+func (_c *ExpenseRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]models.PendingRequest, error)) *ExpenseRequestRepository_GetPendingRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPending provides a mock function with given fields: ctx, tx, requestID
+func (_m *ExpenseRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPending")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (bool, error)); ok {
+		return rf(ctx, tx, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) bool); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpenseRequestRepository_LockPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPending'
+type ExpenseRequestRepository_LockPending_Call struct {
+	*mock.Call
+}
+
+// LockPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *ExpenseRequestRepository_Expecter) LockPending(ctx interface{}, tx interface{}, requestID interface{}) *ExpenseRequestRepository_LockPending_Call {
+	return &ExpenseRequestRepository_LockPending_Call{Call: _e.mock.On("LockPending", ctx, tx, requestID)}
+}
+
+func (_c *ExpenseRequestRepository_LockPending_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *ExpenseRequestRepository_LockPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_LockPending_Call) Return(_a0 bool, _a1 error) *ExpenseRequestRepository_LockPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_LockPending_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (bool, error)) *ExpenseRequestRepository_LockPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseForInfo provides a mock function with given fields: ctx, tx, requestID, question
+func (_m *ExpenseRequestRepository) PauseForInfo(ctx context.Context, tx interfaces.Tx, requestID int64, question string) error {
+	ret := _m.Called(ctx, tx, requestID, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseForInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseRequestRepository_PauseForInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseForInfo'
+type ExpenseRequestRepository_PauseForInfo_Call struct {
+	*mock.Call
+}
+
+// PauseForInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - question string
+func (_e *ExpenseRequestRepository_Expecter) PauseForInfo(ctx interface{}, tx interface{}, requestID interface{}, question interface{}) *ExpenseRequestRepository_PauseForInfo_Call {
+	return &ExpenseRequestRepository_PauseForInfo_Call{Call: _e.mock.On("PauseForInfo", ctx, tx, requestID, question)}
+}
+
+func (_c *ExpenseRequestRepository_PauseForInfo_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, question string)) *ExpenseRequestRepository_PauseForInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_PauseForInfo_Call) Return(_a0 error) *ExpenseRequestRepository_PauseForInfo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_PauseForInfo_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *ExpenseRequestRepository_PauseForInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resubmit provides a mock function with given fields: ctx, tx, requestID
+func (_m *ExpenseRequestRepository) Resubmit(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resubmit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseRequestRepository_Resubmit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resubmit'
+type ExpenseRequestRepository_Resubmit_Call struct {
+	*mock.Call
+}
+
+// Resubmit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *ExpenseRequestRepository_Expecter) Resubmit(ctx interface{}, tx interface{}, requestID interface{}) *ExpenseRequestRepository_Resubmit_Call {
+	return &ExpenseRequestRepository_Resubmit_Call{Call: _e.mock.On("Resubmit", ctx, tx, requestID)}
+}
+
+func (_c *ExpenseRequestRepository_Resubmit_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *ExpenseRequestRepository_Resubmit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Resubmit_Call) Return(_a0 error) *ExpenseRequestRepository_Resubmit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Resubmit_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *ExpenseRequestRepository_Resubmit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, tx, requestID, reason
+func (_m *ExpenseRequestRepository) Revoke(ctx context.Context, tx interfaces.Tx, requestID int64, reason string) error {
+	ret := _m.Called(ctx, tx, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseRequestRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type ExpenseRequestRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - reason string
+func (_e *ExpenseRequestRepository_Expecter) Revoke(ctx interface{}, tx interface{}, requestID interface{}, reason interface{}) *ExpenseRequestRepository_Revoke_Call {
+	return &ExpenseRequestRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, tx, requestID, reason)}
+}
+
+func (_c *ExpenseRequestRepository_Revoke_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, reason string)) *ExpenseRequestRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Revoke_Call) Return(_a0 error) *ExpenseRequestRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_Revoke_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *ExpenseRequestRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOnBehalfOf provides a mock function with given fields: ctx, tx, requestID, delegatorID
+func (_m *ExpenseRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64) error {
+	ret := _m.Called(ctx, tx, requestID, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOnBehalfOf")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, requestID, delegatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseRequestRepository_SetOnBehalfOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOnBehalfOf'
+type ExpenseRequestRepository_SetOnBehalfOf_Call struct {
+	*mock.Call
+}
+
+// SetOnBehalfOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - delegatorID int64
+func (_e *ExpenseRequestRepository_Expecter) SetOnBehalfOf(ctx interface{}, tx interface{}, requestID interface{}, delegatorID interface{}) *ExpenseRequestRepository_SetOnBehalfOf_Call {
+	return &ExpenseRequestRepository_SetOnBehalfOf_Call{Call: _e.mock.On("SetOnBehalfOf", ctx, tx, requestID, delegatorID)}
+}
+
+func (_c *ExpenseRequestRepository_SetOnBehalfOf_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64)) *ExpenseRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_SetOnBehalfOf_Call) Return(_a0 error) *ExpenseRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseRequestRepository_SetOnBehalfOf_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) error) *ExpenseRequestRepository_SetOnBehalfOf_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,124 @@
+package auto_reject
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for expiry policies and the escalations of requests
+type ExpiryPolicyHandler struct {
+	policyService interfaces.ExpiryPolicyService
+}
+
+func NewExpiryPolicyHandler(ctx context.Context, policyService interfaces.ExpiryPolicyService) *ExpiryPolicyHandler {
+	return &ExpiryPolicyHandler{policyService: policyService}
+}
+
+func (h *ExpiryPolicyHandler) GetPolicies(c *gin.Context) {
+	role := c.GetString("role")
+	ctx := c.Request.Context()
+
+	policies, err := h.policyService.GetPolicies(ctx, role)
+	if err != nil {
+		handleExpiryPolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "expiry policies fetched successfully", policies)
+}
+
+func (h *ExpiryPolicyHandler) SetPolicy(c *gin.Context) {
+	role := c.GetString("role")
+	adminID := c.GetInt64("user_id")
+
+	var req ExpiryPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleExpiryPolicyError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	policy, err := h.policyService.SetPolicy(ctx, role, adminID, models.ExpiryPolicy{
+		RequestType:  c.Param("type"),
+		GradeID:      req.GradeID,
+		Action:       req.Action,
+		ReminderDays: req.ReminderDays,
+		ExpiryDays:   req.ExpiryDays,
+		CalendarDays: req.CalendarDays,
+	})
+	if err != nil {
+		handleExpiryPolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "expiry policy saved successfully", policy)
+}
+
+// DeletePolicy removes the type-wide policy of a request type, or that of the grade_id query parameter
+func (h *ExpiryPolicyHandler) DeletePolicy(c *gin.Context) {
+	role := c.GetString("role")
+
+	var gradeID *int64
+	if raw := c.Query("grade_id"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			handleExpiryPolicyError(c, apperrors.ErrInvalidID)
+			return
+		}
+		gradeID = &id
+	}
+
+	ctx := c.Request.Context()
+	if err := h.policyService.DeletePolicy(ctx, role, c.Param("type"), gradeID); err != nil {
+		handleExpiryPolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "expiry policy removed successfully", nil)
+}
+
+// GetEscalations shows the reminders, escalation hops and expiry decisions of a request
+func (h *ExpiryPolicyHandler) GetEscalations(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleExpiryPolicyError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	escalations, err := h.policyService.GetEscalations(ctx, role, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleExpiryPolicyError(c, err)
+		return
+	}
+
+	response.Success(c, "escalations fetched successfully", escalations)
+}
+
+func handleExpiryPolicyError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrAdminOnly:
+		status = http.StatusForbidden
+	case apperrors.ErrExpiryPolicyNotFound, apperrors.ErrRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID, apperrors.ErrExpiryPolicyType,
+		apperrors.ErrInvalidExpiryAction, apperrors.ErrInvalidExpiryDays, apperrors.ErrInvalidReminderDays,
+		apperrors.ErrExpiryPolicyGrade:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
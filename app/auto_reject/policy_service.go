@@ -0,0 +1,238 @@
+package auto_reject
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// request types the expiry job looks after
+var expiringTypes = []string{"LEAVE", "EXPENSE", "DISCOUNT"}
+
+type ExpiryPolicyService struct {
+	policyRepo     interfaces.ExpiryPolicyRepository
+	escalationRepo interfaces.RequestEscalationRepository
+	holidayRepo    interfaces.HolidayRepository
+	historyReader  interfaces.RequestHistoryReader
+}
+
+func NewExpiryPolicyService(
+	ctx context.Context,
+	policyRepo interfaces.ExpiryPolicyRepository,
+	escalationRepo interfaces.RequestEscalationRepository,
+	holidayRepo interfaces.HolidayRepository,
+	historyReader interfaces.RequestHistoryReader,
+) interfaces.ExpiryPolicyService {
+	return &ExpiryPolicyService{
+		policyRepo:     policyRepo,
+		escalationRepo: escalationRepo,
+		holidayRepo:    holidayRepo,
+		historyReader:  historyReader,
+	}
+}
+
+// GetPolicies returns the type-wide policy in force for each request type, the default where none
+// is stored, followed by the stored policies of single grades
+func (s *ExpiryPolicyService) GetPolicies(ctx context.Context, role string) ([]models.ExpiryPolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrAdminOnly
+	}
+
+	stored, err := s.policyRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]models.ExpiryPolicy, 0, len(expiringTypes)+len(stored))
+	for _, requestType := range expiringTypes {
+		policies = append(policies, typePolicy(stored, requestType))
+	}
+	for _, p := range stored {
+		if p.GradeID != nil {
+			policies = append(policies, p)
+		}
+	}
+	return policies, nil
+}
+
+// SetPolicy stores the policy of a request type, or of its requests filed by one grade
+func (s *ExpiryPolicyService) SetPolicy(ctx context.Context, role string, adminID int64, policy models.ExpiryPolicy) (*models.ExpiryPolicy, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrAdminOnly
+	}
+
+	policy.RequestType = strings.ToUpper(strings.TrimSpace(policy.RequestType))
+	policy.Action = strings.ToUpper(strings.TrimSpace(policy.Action))
+	if err := validatePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	policy.UpdatedBy = &adminID
+	if err := s.policyRepo.Upsert(ctx, &policy); err != nil {
+		if errors.Is(err, apperrors.ErrForeignKeyViolation) {
+			return nil, apperrors.ErrExpiryPolicyGrade
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// DeletePolicy puts a request type back on the default policy, or a grade back on the type-wide one
+func (s *ExpiryPolicyService) DeletePolicy(ctx context.Context, role, requestType string, gradeID *int64) error {
+	if role != constants.RoleAdmin {
+		return apperrors.ErrAdminOnly
+	}
+
+	requestType = strings.ToUpper(strings.TrimSpace(requestType))
+	if !isExpiringType(requestType) {
+		return apperrors.ErrExpiryPolicyType
+	}
+	return s.policyRepo.Delete(ctx, requestType, gradeID)
+}
+
+// GetEscalations returns the reminders, escalations and expiry decisions recorded on a request
+func (s *ExpiryPolicyService) GetEscalations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	requestType, err := s.historyReader.Authorize(ctx, role, userID, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return s.escalationRepo.GetForRequest(ctx, requestType, requestID)
+}
+
+// AddDeadlines sets the deadline and expiry action of each row of a pending queue, and puts the
+// requests expiring soonest first. Rows carry the requester's grade_id and the time the request has
+// been waiting_since, as the pending queries of the request repositories return them.
+func (s *ExpiryPolicyService) AddDeadlines(ctx context.Context, requestType string, queue []map[string]interface{}) ([]map[string]interface{}, error) {
+	if len(queue) == 0 {
+		return queue, nil
+	}
+
+	policies, err := s.policyRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type pending struct {
+		row      map[string]interface{}
+		deadline time.Time
+	}
+	rows := make([]pending, len(queue))
+	earliest := time.Time{}
+	for i, row := range queue {
+		since, _ := row["waiting_since"].(time.Time)
+		if i == 0 || since.Before(earliest) {
+			earliest = since
+		}
+		rows[i] = pending{row: row}
+	}
+
+	cal, err := holidayCalendar(ctx, s.holidayRepo, earliest)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		row := rows[i].row
+		since, _ := row["waiting_since"].(time.Time)
+		gradeID, _ := row["grade_id"].(int64)
+		policy := policyFor(policies, requestType, gradeID)
+
+		rows[i].deadline = utils.ExpiryDeadline(since, policy.ExpiryDays, policy.CalendarDays, cal.IsHoliday)
+		row["waiting_since"] = since.Format(time.RFC3339)
+		row["deadline"] = rows[i].deadline.Format(time.RFC3339)
+		row["expiry_action"] = policy.Action
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].deadline.Before(rows[j].deadline)
+	})
+	for i := range rows {
+		queue[i] = rows[i].row
+	}
+	return queue, nil
+}
+
+// policyFor returns the policy of a request type for requests filed by a grade: the grade's own,
+// else the type-wide one, else the default
+func policyFor(policies []models.ExpiryPolicy, requestType string, gradeID int64) models.ExpiryPolicy {
+	for _, p := range policies {
+		if p.RequestType == requestType && p.GradeID != nil && *p.GradeID == gradeID {
+			return p
+		}
+	}
+	return typePolicy(policies, requestType)
+}
+
+// typePolicy returns the type-wide policy of a request type, or the default when it has none
+func typePolicy(policies []models.ExpiryPolicy, requestType string) models.ExpiryPolicy {
+	for _, p := range policies {
+		if p.RequestType == requestType && p.GradeID == nil {
+			return p
+		}
+	}
+	return models.ExpiryPolicy{
+		RequestType: requestType,
+		Action:      constants.DefaultExpiryAction,
+		ExpiryDays:  constants.DefaultExpiryDays,
+	}
+}
+
+func dayUnit(policy models.ExpiryPolicy) string {
+	if policy.CalendarDays {
+		return "calendar days"
+	}
+	return "working days"
+}
+
+// holidayCalendar loads the holidays the deadlines of requests waiting since from may cross;
+// deadlines more than a year away ignore holidays
+func holidayCalendar(ctx context.Context, holidayRepo interfaces.HolidayRepository, from time.Time) (utils.Calendar, error) {
+	holidays, err := holidayRepo.GetHolidayDates(ctx, utils.DateOf(from), time.Now().AddDate(1, 0, 0))
+	if err != nil {
+		return utils.Calendar{}, err
+	}
+	return utils.NewCalendar(holidays), nil
+}
+
+// isExpiringType reports whether the expiry job handles a request type. Registered generic types
+// are not expired, so a policy stored for one would never fire.
+func isExpiringType(requestType string) bool {
+	for _, t := range expiringTypes {
+		if requestType == t {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePolicy(policy models.ExpiryPolicy) error {
+	if !isExpiringType(policy.RequestType) {
+		return apperrors.ErrExpiryPolicyType
+	}
+
+	if policy.GradeID != nil && *policy.GradeID <= 0 {
+		return apperrors.ErrExpiryPolicyGrade
+	}
+
+	switch policy.Action {
+	case constants.ExpiryEscalate, constants.ExpiryAutoApprove, constants.ExpiryAutoReject:
+	default:
+		return apperrors.ErrInvalidExpiryAction
+	}
+
+	if policy.ExpiryDays <= 0 {
+		return apperrors.ErrInvalidExpiryDays
+	}
+	if policy.ReminderDays != nil && (*policy.ReminderDays <= 0 || *policy.ReminderDays >= policy.ExpiryDays) {
+		return apperrors.ErrInvalidReminderDays
+	}
+	return nil
+}
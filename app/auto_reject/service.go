@@ -2,18 +2,29 @@ package auto_reject
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 type AutoRejectService struct {
-	leaveRepo    interfaces.LeaveRequestRepository
-	expenseRepo  interfaces.ExpenseRequestRepository
-	discountRepo interfaces.DiscountRequestRepository
-	holidayRepo  interfaces.HolidayRepository
-	db           interfaces.DB
+	leaveRepo         interfaces.LeaveRequestRepository
+	expenseRepo       interfaces.ExpenseRequestRepository
+	discountRepo      interfaces.DiscountRequestRepository
+	holidayRepo       interfaces.HolidayRepository
+	policyRepo        interfaces.ExpiryPolicyRepository
+	escalationRepo    interfaces.RequestEscalationRepository
+	userRepo          interfaces.UserRepository
+	balanceRepo       interfaces.BalanceRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	db                interfaces.DB
 }
 
 func NewAutoRejectService(
@@ -22,128 +33,298 @@ func NewAutoRejectService(
 	expenseRepo interfaces.ExpenseRequestRepository,
 	discountRepo interfaces.DiscountRequestRepository,
 	holidayRepo interfaces.HolidayRepository,
+	policyRepo interfaces.ExpiryPolicyRepository,
+	escalationRepo interfaces.RequestEscalationRepository,
+	userRepo interfaces.UserRepository,
+	balanceRepo interfaces.BalanceRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
 	db interfaces.DB,
 ) interfaces.AutoRejectService {
 	return &AutoRejectService{
-		leaveRepo:    leaveRepo,
-		expenseRepo:  expenseRepo,
-		discountRepo: discountRepo,
-		holidayRepo:  holidayRepo,
-		db:           db,
+		leaveRepo:         leaveRepo,
+		expenseRepo:       expenseRepo,
+		discountRepo:      discountRepo,
+		holidayRepo:       holidayRepo,
+		policyRepo:        policyRepo,
+		escalationRepo:    escalationRepo,
+		userRepo:          userRepo,
+		balanceRepo:       balanceRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		db:                db,
 	}
 }
 
+// AutoRejectExpiredRequests applies each request type's expiry policy to its pending requests:
+// a reminder once they wait past the reminder days, then escalation, approval or rejection.
+// A type that fails does not stop the others; their errors are returned together.
 func (s *AutoRejectService) AutoRejectExpiredRequests(ctx context.Context) error {
-	if err := s.AutoRejectLeaveRequests(ctx); err != nil {
-		return err
-	}
-	if err := s.AutoRejectExpenseRequests(ctx); err != nil {
+	return errors.Join(
+		s.AutoRejectLeaveRequests(ctx),
+		s.AutoRejectExpenseRequests(ctx),
+		s.AutoRejectDiscountRequests(ctx),
+	)
+}
+
+func (s *AutoRejectService) AutoRejectLeaveRequests(ctx context.Context) error {
+	return s.expire(ctx, "LEAVE", s.leaveRepo, s.approveLeave)
+}
+
+func (s *AutoRejectService) AutoRejectExpenseRequests(ctx context.Context) error {
+	return s.expire(ctx, "EXPENSE", s.expenseRepo, s.approveExpense)
+}
+
+func (s *AutoRejectService) AutoRejectDiscountRequests(ctx context.Context) error {
+	return s.expire(ctx, "DISCOUNT", s.discountRepo, s.approveDiscount)
+}
+
+// approveFunc applies what approving a request does besides setting its status
+type approveFunc func(ctx context.Context, tx interfaces.Tx, requestID int64) error
+
+// expire handles each pending request of a type in its own transaction. A request that fails is
+// logged and skipped so it cannot hold up the rest; the failures are returned together at the end.
+func (s *AutoRejectService) expire(ctx context.Context, requestType string, repo interfaces.ExpiringRequestRepository, approve approveFunc) error {
+	policies, err := s.policyRepo.GetAll(ctx)
+	if err != nil {
 		return err
 	}
-	if err := s.AutoRejectDiscountRequests(ctx); err != nil {
+
+	requests, err := repo.GetPendingRequests(ctx)
+	if err != nil || len(requests) == 0 {
 		return err
 	}
-	return nil
-}
 
-func (s *AutoRejectService) AutoRejectLeaveRequests(ctx context.Context) error {
-	requests, err := s.leaveRepo.GetPendingRequests(ctx)
+	earliest := waitingSince(requests[0])
+	for _, req := range requests {
+		if since := waitingSince(req); since.Before(earliest) {
+			earliest = since
+		}
+	}
+	cal, err := holidayCalendar(ctx, s.holidayRepo, earliest)
 	if err != nil {
 		return err
 	}
 
 	now := time.Now()
 
+	var errs []error
 	for _, req := range requests {
-		id := req.ID
-		createdAt := req.CreatedAt
-
-		workingDays := utils.CountWorkingDays(createdAt, now, func(d time.Time) bool {
-			isHoliday, _ := s.holidayRepo.IsHoliday(ctx, d)
-			return isHoliday
-		})
-
-		if workingDays >= 7 {
-			tx, err := s.db.Begin(ctx)
-			if err != nil {
-				return err
-			}
-			err = s.leaveRepo.UpdateStatus(ctx, tx, id, "AUTO_REJECTED", 0, "Auto rejected after 7 working days")
-			if err != nil {
-				tx.Rollback(ctx)
-				return err
-			}
-			tx.Commit(ctx)
+		policy := policyFor(policies, requestType, req.GradeID)
+		since := waitingSince(req)
+
+		err = nil
+		switch {
+		case !now.Before(utils.ExpiryDeadline(since, policy.ExpiryDays, policy.CalendarDays, cal.IsHoliday)):
+			err = s.act(ctx, requestType, repo, approve, policy, req)
+		case policy.ReminderDays != nil && !now.Before(utils.ExpiryDeadline(since, *policy.ReminderDays, policy.CalendarDays, cal.IsHoliday)):
+			err = s.remind(ctx, requestType, req)
+		}
+		if err != nil {
+			log.Printf("expiry: %s request %d: %v", requestType, req.ID, err)
+			errs = append(errs, fmt.Errorf("%s request %d: %w", requestType, req.ID, err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func (s *AutoRejectService) AutoRejectExpenseRequests(ctx context.Context) error {
-	requests, err := s.expenseRepo.GetPendingRequests(ctx)
+// remind records a reminder to the request's current approver, once per escalation level
+func (s *AutoRejectService) remind(ctx context.Context, requestType string, req models.PendingRequest) error {
+	reminded, err := s.escalationRepo.HasEvent(ctx, requestType, req.ID, constants.EscalationReminded, req.EscalationLevel)
+	if err != nil || reminded {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback(ctx)
 
-	now := time.Now()
+	approverID := currentApprover(req)
+	err = s.escalationRepo.Create(ctx, tx, &models.RequestEscalation{
+		RequestType:    requestType,
+		RequestID:      req.ID,
+		EmployeeID:     req.EmployeeID,
+		Event:          constants.EscalationReminded,
+		Level:          req.EscalationLevel,
+		FromApproverID: approverID,
+	})
+	if err != nil {
+		return err
+	}
 
-	for _, req := range requests {
-		id := req.ID
-		createdAt := req.CreatedAt
-
-		workingDays := utils.CountWorkingDays(createdAt, now, func(d time.Time) bool {
-			isHoliday, _ := s.holidayRepo.IsHoliday(ctx, d)
-			return isHoliday
-		})
-
-		if workingDays >= 7 {
-			tx, err := s.db.Begin(ctx)
-			if err != nil {
-				return err
-			}
-			err = s.expenseRepo.UpdateStatus(ctx, tx, id, "AUTO_REJECTED", 0, "Auto rejected after 7 working days")
-			if err != nil {
-				tx.Rollback(ctx)
-				return err
-			}
-			tx.Commit(ctx)
+	if approverID != nil {
+		log.Printf("reminder: %s request %d is waiting for approver %d", requestType, req.ID, *approverID)
+	} else {
+		log.Printf("reminder: %s request %d is waiting for an admin", requestType, req.ID)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// act applies the policy's expiry action to a request. The request is locked first and left alone
+// if it was decided, cancelled or paused after the pending list was read.
+func (s *AutoRejectService) act(
+	ctx context.Context,
+	requestType string,
+	repo interfaces.ExpiringRequestRepository,
+	approve approveFunc,
+	policy models.ExpiryPolicy,
+	req models.PendingRequest,
+) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	pending, err := repo.LockPending(ctx, tx, req.ID)
+	if err != nil || !pending {
+		return err
+	}
+
+	event := &models.RequestEscalation{
+		RequestType:    requestType,
+		RequestID:      req.ID,
+		EmployeeID:     req.EmployeeID,
+		Level:          req.EscalationLevel,
+		FromApproverID: currentApprover(req),
+	}
+
+	switch policy.Action {
+	case constants.ExpiryEscalate:
+		escalated, err := s.escalate(ctx, tx, requestType, repo, req, event)
+		if err != nil || !escalated {
+			return err
+		}
+
+	case constants.ExpiryAutoApprove:
+		comment := fmt.Sprintf("Auto approved after %d %s", policy.ExpiryDays, dayUnit(policy))
+		if err := repo.UpdateStatus(ctx, tx, req.ID, constants.StatusAutoApproved, 0, comment); err != nil {
+			return err
+		}
+		if err := approve(ctx, tx, req.ID); err != nil {
+			return err
 		}
+		if err := s.chainService.Close(ctx, tx, requestType, req.ID); err != nil {
+			return err
+		}
+		event.Event = constants.EscalationAutoApproved
+
+	default:
+		comment := fmt.Sprintf("Auto rejected after %d %s", policy.ExpiryDays, dayUnit(policy))
+		if err := repo.UpdateStatus(ctx, tx, req.ID, constants.StatusAutoRejected, 0, comment); err != nil {
+			return err
+		}
+		if err := s.chainService.Close(ctx, tx, requestType, req.ID); err != nil {
+			return err
+		}
+		event.Event = constants.EscalationAutoRejected
 	}
 
+	if err := s.escalationRepo.Create(ctx, tx, event); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// escalate hands the request one level up: to its current approver's manager, or to the admins
+// when they have none. A request already waiting for the admins stays with them.
+func (s *AutoRejectService) escalate(
+	ctx context.Context,
+	tx interfaces.Tx,
+	requestType string,
+	repo interfaces.ExpiringRequestRepository,
+	req models.PendingRequest,
+	event *models.RequestEscalation,
+) (bool, error) {
+	// a request on an approval chain escalates its current step
+	chain, err := s.chainService.Escalate(ctx, tx, requestType, req.ID)
+	if err != nil {
+		return false, err
+	}
+
+	var to *int64
+	from := event.FromApproverID
+	switch {
+	case chain.Chained && !chain.Escalated:
+		return false, nil
+	case chain.Chained:
+		from, to = chain.From, chain.To
+	case from == nil:
+		return false, nil
+	default:
+		approver, err := s.userRepo.GetRuleSubject(ctx, tx, *from)
+		if err != nil {
+			return false, err
+		}
+		to = approver.ManagerID
+	}
+
+	level := req.EscalationLevel + 1
+	if err := repo.Escalate(ctx, tx, req.ID, level, to); err != nil {
+		return false, err
+	}
+
+	event.Event = constants.EscalationEscalated
+	event.Level = level
+	event.FromApproverID = from
+	event.ToApproverID = to
+	return true, nil
+}
+
+func (s *AutoRejectService) approveLeave(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	leaveReq, err := s.leaveRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	days := utils.CalculateLeaveDays(leaveReq.FromDate, leaveReq.ToDate)
+	if err := s.balanceRepo.DeductLeaveBalance(ctx, tx, leaveReq.EmployeeID, days); err != nil {
+		return err
+	}
+
+	if leaveReq.DelegateID != nil {
+		return s.delegationService.DelegateForLeave(ctx, tx, leaveReq)
+	}
 	return nil
 }
 
-func (s *AutoRejectService) AutoRejectDiscountRequests(ctx context.Context) error {
-	requests, err := s.discountRepo.GetPendingRequests(ctx)
+func (s *AutoRejectService) approveExpense(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	expenseReq, err := s.expenseRepo.GetByID(ctx, tx, requestID)
 	if err != nil {
 		return err
 	}
+	return s.balanceRepo.DeductExpenseBalance(ctx, tx, expenseReq.EmployeeID, expenseReq.Amount)
+}
 
-	now := time.Now()
+func (s *AutoRejectService) approveDiscount(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	discountReq, err := s.discountRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+	return s.balanceRepo.DeductDiscountBalance(ctx, tx, discountReq.EmployeeID, discountReq.DiscountPercentage)
+}
 
-	for _, req := range requests {
-		id := req.ID
-		createdAt := req.CreatedAt
-
-		workingDays := utils.CountWorkingDays(createdAt, now, func(d time.Time) bool {
-			isHoliday, _ := s.holidayRepo.IsHoliday(ctx, d)
-			return isHoliday
-		})
-
-		if workingDays >= 7 {
-			tx, err := s.db.Begin(ctx)
-			if err != nil {
-				return err
-			}
-			err = s.discountRepo.UpdateStatus(ctx, tx, id, "AUTO_REJECTED", 0, "Auto rejected after 7 working days")
-			if err != nil {
-				tx.Rollback(ctx)
-				return err
-			}
-			tx.Commit(ctx)
+// waitingSince is when the request reached its current approver; the clock restarts at every escalation
+// and whenever the requester resubmits it with the information asked for
+func waitingSince(req models.PendingRequest) time.Time {
+	since := req.CreatedAt
+	for _, t := range []*time.Time{req.EscalatedAt, req.ResubmittedAt} {
+		if t != nil && t.After(since) {
+			since = *t
 		}
 	}
+	return since
+}
 
-	return nil
+// currentApprover is who a pending request waits for; nil when it waits for the admins
+func currentApprover(req models.PendingRequest) *int64 {
+	if req.EscalationLevel == 0 {
+		return req.ManagerID
+	}
+	return req.EscalatedTo
 }
@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiryPolicyHandler_SetPolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.ExpiryPolicyService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			reqBody: map[string]interface{}{"action": "ESCALATE", "reminder_days": 3, "expiry_days": 5},
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().SetPolicy(mock.Anything, "ADMIN", int64(1), mock.MatchedBy(func(p models.ExpiryPolicy) bool {
+					return p.RequestType == "LEAVE" && p.Action == "ESCALATE" && *p.ReminderDays == 3 && p.ExpiryDays == 5
+				})).Return(&models.ExpiryPolicy{RequestType: "LEAVE"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Invalid Reminder",
+			reqBody: map[string]interface{}{"action": "ESCALATE", "reminder_days": 9, "expiry_days": 5},
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().SetPolicy(mock.Anything, "ADMIN", int64(1), mock.Anything).Return(nil, apperrors.ErrInvalidReminderDays)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Not Admin",
+			reqBody: map[string]interface{}{"action": "ESCALATE", "expiry_days": 5},
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().SetPolicy(mock.Anything, "ADMIN", int64(1), mock.Anything).Return(nil, apperrors.ErrAdminOnly)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Invalid Payload",
+			reqBody:        "not json",
+			mockSetup:      func(s *mocks.ExpiryPolicyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewExpiryPolicyService(t)
+			tt.mockSetup(mockS)
+
+			handler := auto_reject.NewExpiryPolicyHandler(context.Background(), mockS)
+			r := gin.New()
+			r.PUT("/expiry-policies/:type", func(c *gin.Context) {
+				c.Set("role", "ADMIN")
+				c.Set("user_id", int64(1))
+				handler.SetPolicy(c)
+			})
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPut, "/expiry-policies/LEAVE", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestExpiryPolicyHandler_DeletePolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(s *mocks.ExpiryPolicyService)
+		expectedStatus int
+	}{
+		{
+			name: "Type-wide Policy",
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().DeletePolicy(mock.Anything, "ADMIN", "LEAVE", (*int64)(nil)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Grade Policy",
+			query: "?grade_id=2",
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().DeletePolicy(mock.Anything, "ADMIN", "LEAVE", mock.MatchedBy(func(id *int64) bool {
+					return id != nil && *id == 2
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Not Found",
+			query: "?grade_id=3",
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().DeletePolicy(mock.Anything, "ADMIN", "LEAVE", mock.Anything).Return(apperrors.ErrExpiryPolicyNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid Grade",
+			query:          "?grade_id=abc",
+			mockSetup:      func(s *mocks.ExpiryPolicyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewExpiryPolicyService(t)
+			tt.mockSetup(mockS)
+
+			handler := auto_reject.NewExpiryPolicyHandler(context.Background(), mockS)
+			r := gin.New()
+			r.DELETE("/expiry-policies/:type", func(c *gin.Context) {
+				c.Set("role", "ADMIN")
+				handler.DeletePolicy(c)
+			})
+
+			req := httptest.NewRequest(http.MethodDelete, "/expiry-policies/LEAVE"+tt.query, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestExpiryPolicyHandler_GetEscalations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		mockSetup      func(s *mocks.ExpiryPolicyService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			id:   "10",
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().GetEscalations(mock.Anything, "EMPLOYEE", int64(5), "LEAVE", int64(10)).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone Else's Request",
+			id:   "11",
+			mockSetup: func(s *mocks.ExpiryPolicyService) {
+				s.EXPECT().GetEscalations(mock.Anything, "EMPLOYEE", int64(5), "LEAVE", int64(11)).Return(nil, apperrors.ErrRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			mockSetup:      func(s *mocks.ExpiryPolicyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewExpiryPolicyService(t)
+			tt.mockSetup(mockS)
+
+			handler := auto_reject.NewExpiryPolicyHandler(context.Background(), mockS)
+			r := gin.New()
+			r.GET("/escalations/:type/:id", func(c *gin.Context) {
+				c.Set("role", "EMPLOYEE")
+				c.Set("user_id", int64(5))
+				handler.GetEscalations(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/escalations/LEAVE/"+tt.id, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiryPolicyService_GetPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Admin Only", func(t *testing.T) {
+		service := auto_reject.NewExpiryPolicyService(ctx, nil, nil, nil, nil)
+		_, err := service.GetPolicies(ctx, "MANAGER")
+
+		assert.ErrorIs(t, err, apperrors.ErrAdminOnly)
+	})
+
+	t.Run("Types Without A Policy Get The Default", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return([]models.ExpiryPolicy{
+			{RequestType: "EXPENSE", Action: constants.ExpiryEscalate, ReminderDays: intPtr(2), ExpiryDays: 4},
+		}, nil)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, nil, nil)
+		policies, err := service.GetPolicies(ctx, "ADMIN")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.ExpiryPolicy{
+			{RequestType: "LEAVE", Action: constants.ExpiryAutoReject, ExpiryDays: 7},
+			{RequestType: "EXPENSE", Action: constants.ExpiryEscalate, ReminderDays: intPtr(2), ExpiryDays: 4},
+			{RequestType: "DISCOUNT", Action: constants.ExpiryAutoReject, ExpiryDays: 7},
+		}, policies)
+	})
+
+	t.Run("Grade Policies Follow The Type-wide Ones", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return([]models.ExpiryPolicy{
+			{RequestType: "LEAVE", Action: constants.ExpiryEscalate, ExpiryDays: 3},
+			{RequestType: "LEAVE", GradeID: int64Ptr(2), Action: constants.ExpiryAutoApprove, ExpiryDays: 2, CalendarDays: true},
+		}, nil)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, nil, nil)
+		policies, err := service.GetPolicies(ctx, "ADMIN")
+
+		assert.NoError(t, err)
+		assert.Len(t, policies, 4)
+		assert.Nil(t, policies[0].GradeID)
+		assert.Equal(t, constants.ExpiryEscalate, policies[0].Action)
+		assert.Equal(t, int64(2), *policies[3].GradeID)
+	})
+}
+
+func TestExpiryPolicyService_SetPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		role          string
+		policy        models.ExpiryPolicy
+		mockSetup     func(r *mocks.ExpiryPolicyRepository)
+		expectedError error
+	}{
+		{
+			name:   "Success - Normalized",
+			role:   "ADMIN",
+			policy: models.ExpiryPolicy{RequestType: "leave", Action: "escalate", ReminderDays: intPtr(3), ExpiryDays: 5},
+			mockSetup: func(r *mocks.ExpiryPolicyRepository) {
+				r.EXPECT().Upsert(ctx, mock.MatchedBy(func(p *models.ExpiryPolicy) bool {
+					return p.RequestType == "LEAVE" && p.Action == constants.ExpiryEscalate && *p.UpdatedBy == 1
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "Success - Grade Policy In Calendar Days",
+			role:   "ADMIN",
+			policy: models.ExpiryPolicy{RequestType: "EXPENSE", GradeID: int64Ptr(3), Action: "AUTO_APPROVE", ExpiryDays: 2, CalendarDays: true},
+			mockSetup: func(r *mocks.ExpiryPolicyRepository) {
+				r.EXPECT().Upsert(ctx, mock.MatchedBy(func(p *models.ExpiryPolicy) bool {
+					return *p.GradeID == 3 && p.CalendarDays
+				})).Return(nil)
+			},
+		},
+		{
+			name:   "Fail - Unknown Grade",
+			role:   "ADMIN",
+			policy: models.ExpiryPolicy{RequestType: "EXPENSE", GradeID: int64Ptr(99), Action: "AUTO_APPROVE", ExpiryDays: 2},
+			mockSetup: func(r *mocks.ExpiryPolicyRepository) {
+				r.EXPECT().Upsert(ctx, mock.Anything).Return(apperrors.ErrForeignKeyViolation)
+			},
+			expectedError: apperrors.ErrExpiryPolicyGrade,
+		},
+		{
+			name:          "Fail - Invalid Grade",
+			role:          "ADMIN",
+			policy:        models.ExpiryPolicy{RequestType: "EXPENSE", GradeID: int64Ptr(0), Action: "AUTO_APPROVE", ExpiryDays: 2},
+			expectedError: apperrors.ErrExpiryPolicyGrade,
+		},
+		{
+			name:          "Fail - Not Admin",
+			role:          "MANAGER",
+			policy:        models.ExpiryPolicy{RequestType: "LEAVE", Action: "ESCALATE", ExpiryDays: 5},
+			expectedError: apperrors.ErrAdminOnly,
+		},
+		{
+			name:          "Fail - Registered Request Type",
+			role:          "ADMIN",
+			policy:        models.ExpiryPolicy{RequestType: "TRAVEL", Action: "ESCALATE", ExpiryDays: 5},
+			expectedError: apperrors.ErrExpiryPolicyType,
+		},
+		{
+			name:          "Fail - Unknown Action",
+			role:          "ADMIN",
+			policy:        models.ExpiryPolicy{RequestType: "LEAVE", Action: "IGNORE", ExpiryDays: 5},
+			expectedError: apperrors.ErrInvalidExpiryAction,
+		},
+		{
+			name:          "Fail - No Expiry Days",
+			role:          "ADMIN",
+			policy:        models.ExpiryPolicy{RequestType: "LEAVE", Action: "AUTO_APPROVE"},
+			expectedError: apperrors.ErrInvalidExpiryDays,
+		},
+		{
+			name:          "Fail - Reminder Not Before Expiry",
+			role:          "ADMIN",
+			policy:        models.ExpiryPolicy{RequestType: "LEAVE", Action: "AUTO_REJECT", ReminderDays: intPtr(5), ExpiryDays: 5},
+			expectedError: apperrors.ErrInvalidReminderDays,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewExpiryPolicyRepository(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockRepo)
+			}
+
+			service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, nil, nil)
+			_, err := service.SetPolicy(ctx, tt.role, 1, tt.policy)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExpiryPolicyService_DeletePolicy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Type-Wide Policy", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+		mockRepo.EXPECT().Delete(ctx, "LEAVE", (*int64)(nil)).Return(nil)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, nil, nil)
+		assert.NoError(t, service.DeletePolicy(ctx, "ADMIN", "leave", nil))
+	})
+
+	t.Run("Fail - Registered Request Type", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, nil, nil)
+		err := service.DeletePolicy(ctx, "ADMIN", "TRAVEL", nil)
+
+		assert.ErrorIs(t, err, apperrors.ErrExpiryPolicyType)
+	})
+
+	t.Run("Fail - Not Admin", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, nil, nil)
+		err := service.DeletePolicy(ctx, "MANAGER", "LEAVE", nil)
+
+		assert.ErrorIs(t, err, apperrors.ErrAdminOnly)
+	})
+}
+
+func TestExpiryPolicyService_GetEscalations(t *testing.T) {
+	ctx := context.Background()
+	escalations := []models.RequestEscalation{{ID: 1, RequestType: "LEAVE", RequestID: 10, EmployeeID: 5, Event: constants.EscalationEscalated}}
+
+	t.Run("Requester Sees Their Escalations", func(t *testing.T) {
+		mockRepo := mocks.NewRequestEscalationRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, "EMPLOYEE", int64(5), "leave", int64(10)).Return("LEAVE", nil)
+		mockRepo.EXPECT().GetForRequest(ctx, "LEAVE", int64(10)).Return(escalations, nil)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, nil, mockRepo, nil, mockReader)
+		got, err := service.GetEscalations(ctx, "EMPLOYEE", 5, "leave", 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("Other Employees Do Not", func(t *testing.T) {
+		mockRepo := mocks.NewRequestEscalationRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, "EMPLOYEE", int64(6), "LEAVE", int64(10)).Return("", apperrors.ErrRequestNotFound)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, nil, mockRepo, nil, mockReader)
+		_, err := service.GetEscalations(ctx, "EMPLOYEE", 6, "LEAVE", 10)
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotFound)
+	})
+}
+
+func TestExpiryPolicyService_AddDeadlines(t *testing.T) {
+	ctx := context.Background()
+	monday := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Deadlines By Grade, Soonest First", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+		mockHoliday := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return([]models.ExpiryPolicy{
+			{RequestType: "LEAVE", Action: constants.ExpiryEscalate, ExpiryDays: 3},
+			{RequestType: "LEAVE", GradeID: int64Ptr(2), Action: constants.ExpiryAutoApprove, ExpiryDays: 2, CalendarDays: true},
+		}, nil)
+		// Tuesday is a holiday
+		mockHoliday.EXPECT().GetHolidayDates(ctx, utils.DateOf(monday), mock.Anything).Return([]time.Time{monday.AddDate(0, 0, 1)}, nil)
+
+		queue := []map[string]interface{}{
+			{"id": int64(1), "grade_id": int64(1), "waiting_since": monday},
+			{"id": int64(2), "grade_id": int64(2), "waiting_since": monday},
+		}
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, mockHoliday, nil)
+		result, err := service.AddDeadlines(ctx, "LEAVE", queue)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result[0]["id"])
+		assert.Equal(t, "2026-03-03T09:00:00Z", result[0]["deadline"])
+		assert.Equal(t, constants.ExpiryAutoApprove, result[0]["expiry_action"])
+		// Mon, (holiday), Wed, Thu
+		assert.Equal(t, int64(1), result[1]["id"])
+		assert.Equal(t, "2026-03-05T09:00:00Z", result[1]["deadline"])
+		assert.Equal(t, "2026-03-02T09:00:00Z", result[1]["waiting_since"])
+	})
+
+	t.Run("Empty Queue", func(t *testing.T) {
+		service := auto_reject.NewExpiryPolicyService(ctx, nil, nil, nil, nil)
+		result, err := service.AddDeadlines(ctx, "LEAVE", []map[string]interface{}{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Holiday Fetch Error", func(t *testing.T) {
+		mockRepo := mocks.NewExpiryPolicyRepository(t)
+		mockHoliday := mocks.NewHolidayRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return(nil, nil)
+		mockHoliday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+		service := auto_reject.NewExpiryPolicyService(ctx, mockRepo, nil, mockHoliday, nil)
+		_, err := service.AddDeadlines(ctx, "LEAVE", []map[string]interface{}{{"id": int64(1), "waiting_since": monday}})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
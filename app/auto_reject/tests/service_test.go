@@ -7,86 +7,283 @@ import (
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+func int64Ptr(v int64) *int64 { return &v }
+func intPtr(v int) *int       { return &v }
+
+// the dependencies of the expiry job
+type autoRejectMocks struct {
+	leave      *mocks.LeaveRequestRepository
+	expense    *mocks.ExpenseRequestRepository
+	discount   *mocks.DiscountRequestRepository
+	holiday    *mocks.HolidayRepository
+	policy     *mocks.ExpiryPolicyRepository
+	escalation *mocks.RequestEscalationRepository
+	user       *mocks.UserRepository
+	balance    *mocks.BalanceRepository
+	chain      *mocks.ApprovalChainService
+	delegation *mocks.DelegationService
+	db         *mocks.DB
+	tx         *mocks.Tx
+}
+
+func newAutoRejectMocks(t *testing.T) *autoRejectMocks {
+	return &autoRejectMocks{
+		leave:      mocks.NewLeaveRequestRepository(t),
+		expense:    mocks.NewExpenseRequestRepository(t),
+		discount:   mocks.NewDiscountRequestRepository(t),
+		holiday:    mocks.NewHolidayRepository(t),
+		policy:     mocks.NewExpiryPolicyRepository(t),
+		escalation: mocks.NewRequestEscalationRepository(t),
+		user:       mocks.NewUserRepository(t),
+		balance:    mocks.NewBalanceRepository(t),
+		chain:      mocks.NewApprovalChainService(t),
+		delegation: mocks.NewDelegationService(t),
+		db:         mocks.NewDB(t),
+		tx:         mocks.NewTx(t),
+	}
+}
+
+func (m *autoRejectMocks) service(ctx context.Context) *auto_reject.AutoRejectService {
+	return auto_reject.NewAutoRejectService(
+		ctx, m.leave, m.expense, m.discount, m.holiday, m.policy, m.escalation,
+		m.user, m.balance, m.chain, m.delegation, m.db,
+	).(*auto_reject.AutoRejectService)
+}
+
 func TestAutoRejectService_AutoRejectLeaveRequests(t *testing.T) {
 	ctx := context.Background()
 
 	tests := []struct {
 		name          string
-		mockSetup     func(l *mocks.LeaveRequestRepository, e *mocks.ExpenseRequestRepository, d *mocks.DiscountRequestRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx)
+		mockSetup     func(m *autoRejectMocks)
 		expectedError error
 	}{
 		{
 			name: "Success - Reject Expired Requests in all repos",
-			mockSetup: func(l *mocks.LeaveRequestRepository, e *mocks.ExpenseRequestRepository, d *mocks.DiscountRequestRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(m *autoRejectMocks) {
 				pastDate := time.Now().AddDate(0, 0, -10)
 
-				// Leave Repo
-				l.EXPECT().GetPendingRequests(ctx).Return([]struct {
-					ID        int64
-					CreatedAt time.Time
-				}{{ID: 1, CreatedAt: pastDate}}, nil)
-
-				// Expense Repo
-				e.EXPECT().GetPendingRequests(ctx).Return([]struct {
-					ID        int64
-					CreatedAt time.Time
-				}{{ID: 10, CreatedAt: pastDate}}, nil)
-
-				// Discount Repo
-				d.EXPECT().GetPendingRequests(ctx).Return([]struct {
-					ID        int64
-					CreatedAt time.Time
-				}{{ID: 20, CreatedAt: pastDate}}, nil)
+				// no policies stored: every type is auto rejected after 7 working days
+				m.policy.EXPECT().GetAll(ctx).Return(nil, nil).Times(3)
+				m.leave.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{{ID: 1, EmployeeID: 5, CreatedAt: pastDate}}, nil)
+				m.expense.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{{ID: 10, EmployeeID: 5, CreatedAt: pastDate}}, nil)
+				m.discount.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{{ID: 20, EmployeeID: 5, CreatedAt: pastDate}}, nil)
 
-				h.EXPECT().IsHoliday(ctx, mock.Anything).Return(false, nil).Maybe()
+				m.holiday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil).Times(3)
 
 				// Expectations for all three rejections
-				db.EXPECT().Begin(ctx).Return(tx, nil).Times(3)
-				l.EXPECT().UpdateStatus(ctx, tx, int64(1), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
-				e.EXPECT().UpdateStatus(ctx, tx, int64(10), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
-				d.EXPECT().UpdateStatus(ctx, tx, int64(20), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
-				tx.EXPECT().Commit(ctx).Return(nil).Times(3)
+				m.db.EXPECT().Begin(ctx).Return(m.tx, nil).Times(3)
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.leave.EXPECT().UpdateStatus(ctx, m.tx, int64(1), "AUTO_REJECTED", int64(0), "Auto rejected after 7 working days").Return(nil)
+				m.expense.EXPECT().LockPending(ctx, m.tx, int64(10)).Return(true, nil)
+				m.expense.EXPECT().UpdateStatus(ctx, m.tx, int64(10), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
+				m.discount.EXPECT().LockPending(ctx, m.tx, int64(20)).Return(true, nil)
+				m.discount.EXPECT().UpdateStatus(ctx, m.tx, int64(20), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
+				m.chain.EXPECT().Close(ctx, m.tx, "LEAVE", int64(1)).Return(nil)
+				m.chain.EXPECT().Close(ctx, m.tx, "EXPENSE", int64(10)).Return(nil)
+				m.chain.EXPECT().Close(ctx, m.tx, "DISCOUNT", int64(20)).Return(nil)
+				m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+					return e.Event == constants.EscalationAutoRejected && e.EmployeeID == 5
+				})).Return(nil).Times(3)
+				m.tx.EXPECT().Commit(ctx).Return(nil).Times(3)
+				m.tx.EXPECT().Rollback(ctx).Return(nil).Times(3)
 			},
 		},
 		{
-			name: "Fail - Leave Repo Fetch Error",
-			mockSetup: func(l *mocks.LeaveRequestRepository, e *mocks.ExpenseRequestRepository, d *mocks.DiscountRequestRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
-				l.EXPECT().GetPendingRequests(ctx).Return(nil, assert.AnError)
+			name: "Fail - Leave Repo Fetch Error Does Not Stop Other Types",
+			mockSetup: func(m *autoRejectMocks) {
+				m.policy.EXPECT().GetAll(ctx).Return(nil, nil).Times(3)
+				m.leave.EXPECT().GetPendingRequests(ctx).Return(nil, assert.AnError)
+				m.expense.EXPECT().GetPendingRequests(ctx).Return(nil, nil)
+				m.discount.EXPECT().GetPendingRequests(ctx).Return(nil, nil)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			name: "Fail - Policy Fetch Error",
+			mockSetup: func(m *autoRejectMocks) {
+				m.policy.EXPECT().GetAll(ctx).Return(nil, assert.AnError).Times(3)
 			},
 			expectedError: assert.AnError,
 		},
 		{
 			name: "Success - No Expired Requests",
-			mockSetup: func(l *mocks.LeaveRequestRepository, e *mocks.ExpenseRequestRepository, d *mocks.DiscountRequestRepository, h *mocks.HolidayRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(m *autoRejectMocks) {
 				now := time.Now()
-				l.EXPECT().GetPendingRequests(ctx).Return([]struct {
-					ID        int64
-					CreatedAt time.Time
-				}{{ID: 1, CreatedAt: now}}, nil)
-				e.EXPECT().GetPendingRequests(ctx).Return(nil, nil)
-				d.EXPECT().GetPendingRequests(ctx).Return(nil, nil)
-				h.EXPECT().IsHoliday(ctx, mock.Anything).Return(false, nil).Maybe()
+				m.policy.EXPECT().GetAll(ctx).Return(nil, nil).Times(3)
+				m.leave.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{{ID: 1, CreatedAt: now}}, nil)
+				m.expense.EXPECT().GetPendingRequests(ctx).Return(nil, nil)
+				m.discount.EXPECT().GetPendingRequests(ctx).Return(nil, nil)
+				m.holiday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockLeaveRepo := mocks.NewLeaveRequestRepository(t)
-			mockExpenseRepo := mocks.NewExpenseRequestRepository(t)
-			mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
-			mockHolidayRepo := mocks.NewHolidayRepository(t)
-			mockDB := mocks.NewDB(t)
-			mockTx := mocks.NewTx(t)
+			m := newAutoRejectMocks(t)
+			tt.mockSetup(m)
+
+			err := m.service(ctx).AutoRejectExpiredRequests(ctx)
 
-			tt.mockSetup(mockLeaveRepo, mockExpenseRepo, mockDiscountRepo, mockHolidayRepo, mockDB, mockTx)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
 
-			service := auto_reject.NewAutoRejectService(ctx, mockLeaveRepo, mockExpenseRepo, mockDiscountRepo, mockHolidayRepo, mockDB)
-			err := service.AutoRejectExpiredRequests(ctx)
+func TestAutoRejectService_ExpiryPolicies(t *testing.T) {
+	ctx := context.Background()
+	longAgo := time.Now().AddDate(0, 0, -30)
+
+	policy := func(action string, reminderDays *int, expiryDays int) []models.ExpiryPolicy {
+		return []models.ExpiryPolicy{{RequestType: "LEAVE", Action: action, ReminderDays: reminderDays, ExpiryDays: expiryDays}}
+	}
+
+	tests := []struct {
+		name          string
+		pending       models.PendingRequest
+		policies      []models.ExpiryPolicy
+		mockSetup     func(m *autoRejectMocks)
+		expectedError error
+	}{
+		{
+			name:     "Escalate - To The Manager's Manager",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: longAgo},
+			policies: policy(constants.ExpiryEscalate, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.chain.EXPECT().Escalate(ctx, m.tx, "LEAVE", int64(1)).Return(models.ChainEscalation{}, nil)
+				m.user.EXPECT().GetRuleSubject(ctx, m.tx, int64(2)).Return(&models.RuleSubject{UserID: 2, ManagerID: int64Ptr(7)}, nil)
+				m.leave.EXPECT().Escalate(ctx, m.tx, int64(1), 1, int64Ptr(7)).Return(nil)
+				m.escalation.EXPECT().Create(ctx, m.tx, &models.RequestEscalation{
+					RequestType: "LEAVE", RequestID: 1, EmployeeID: 5, Event: constants.EscalationEscalated,
+					Level: 1, FromApproverID: int64Ptr(2), ToApproverID: int64Ptr(7),
+				}).Return(nil)
+				m.tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:     "Escalate - Again From The Escalated Approver To Admins",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), EscalationLevel: 1, EscalatedTo: int64Ptr(7), EscalatedAt: &longAgo, CreatedAt: longAgo},
+			policies: policy(constants.ExpiryEscalate, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.chain.EXPECT().Escalate(ctx, m.tx, "LEAVE", int64(1)).Return(models.ChainEscalation{}, nil)
+				m.user.EXPECT().GetRuleSubject(ctx, m.tx, int64(7)).Return(&models.RuleSubject{UserID: 7}, nil)
+				m.leave.EXPECT().Escalate(ctx, m.tx, int64(1), 2, (*int64)(nil)).Return(nil)
+				m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+					return e.Level == 2 && *e.FromApproverID == 7 && e.ToApproverID == nil
+				})).Return(nil)
+				m.tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:     "Escalate - Already With Admins",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), EscalationLevel: 2, EscalatedAt: &longAgo, CreatedAt: longAgo},
+			policies: policy(constants.ExpiryEscalate, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.chain.EXPECT().Escalate(ctx, m.tx, "LEAVE", int64(1)).Return(models.ChainEscalation{}, nil)
+			},
+		},
+		{
+			name:     "Escalate - Chained Request Escalates Its Step",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: longAgo},
+			policies: policy(constants.ExpiryEscalate, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.chain.EXPECT().Escalate(ctx, m.tx, "LEAVE", int64(1)).Return(models.ChainEscalation{Chained: true, Escalated: true, From: int64Ptr(3), To: int64Ptr(8)}, nil)
+				m.leave.EXPECT().Escalate(ctx, m.tx, int64(1), 1, int64Ptr(8)).Return(nil)
+				m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+					return *e.FromApproverID == 3 && *e.ToApproverID == 8
+				})).Return(nil)
+				m.tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:     "Escalate - Fails",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: longAgo},
+			policies: policy(constants.ExpiryEscalate, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.chain.EXPECT().Escalate(ctx, m.tx, "LEAVE", int64(1)).Return(models.ChainEscalation{}, nil)
+				m.user.EXPECT().GetRuleSubject(ctx, m.tx, int64(2)).Return(&models.RuleSubject{UserID: 2, ManagerID: int64Ptr(7)}, nil)
+				m.leave.EXPECT().Escalate(ctx, m.tx, int64(1), 1, int64Ptr(7)).Return(assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			name:     "Auto Approve - Deducts Balance And Hands Over Approvals",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: longAgo},
+			policies: policy(constants.ExpiryAutoApprove, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+				leave := &models.LeaveRequest{ID: 1, EmployeeID: 5, FromDate: from, ToDate: from.AddDate(0, 0, 1), DelegateID: int64Ptr(9)}
+				m.leave.EXPECT().GetByID(ctx, m.tx, int64(1)).Return(leave, nil)
+				m.balance.EXPECT().DeductLeaveBalance(ctx, m.tx, int64(5), 2).Return(nil)
+				m.delegation.EXPECT().DelegateForLeave(ctx, m.tx, leave).Return(nil)
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.leave.EXPECT().UpdateStatus(ctx, m.tx, int64(1), "AUTO_APPROVED", int64(0), "Auto approved after 5 working days").Return(nil)
+				m.chain.EXPECT().Close(ctx, m.tx, "LEAVE", int64(1)).Return(nil)
+				m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+					return e.Event == constants.EscalationAutoApproved && *e.FromApproverID == 2
+				})).Return(nil)
+				m.tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:     "Auto Approve - Balance Error",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, CreatedAt: longAgo},
+			policies: policy(constants.ExpiryAutoApprove, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(true, nil)
+				m.leave.EXPECT().UpdateStatus(ctx, m.tx, int64(1), "AUTO_APPROVED", int64(0), mock.Anything).Return(nil)
+				m.leave.EXPECT().GetByID(ctx, m.tx, int64(1)).Return(&models.LeaveRequest{ID: 1, EmployeeID: 5, FromDate: longAgo, ToDate: longAgo}, nil)
+				m.balance.EXPECT().DeductLeaveBalance(ctx, m.tx, int64(5), mock.Anything).Return(assert.AnError)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			// approved by its manager after the pending list was read: no status change, balance or event
+			name:     "Auto Approve - Decided Since Listed",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: longAgo},
+			policies: policy(constants.ExpiryAutoApprove, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(false, nil)
+			},
+		},
+		{
+			name:     "Escalate - Decided Since Listed",
+			pending:  models.PendingRequest{ID: 1, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: longAgo},
+			policies: policy(constants.ExpiryEscalate, nil, 5),
+			mockSetup: func(m *autoRejectMocks) {
+				m.leave.EXPECT().LockPending(ctx, m.tx, int64(1)).Return(false, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newAutoRejectMocks(t)
+			m.policy.EXPECT().GetAll(ctx).Return(tt.policies, nil)
+			m.leave.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{tt.pending}, nil)
+			m.holiday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
+			m.db.EXPECT().Begin(ctx).Return(m.tx, nil)
+			m.tx.EXPECT().Rollback(ctx).Return(nil)
+			tt.mockSetup(m)
+
+			err := m.service(ctx).AutoRejectLeaveRequests(ctx)
 
 			if tt.expectedError != nil {
 				assert.ErrorIs(t, err, tt.expectedError)
@@ -96,3 +293,148 @@ func TestAutoRejectService_AutoRejectLeaveRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoRejectService_Reminders(t *testing.T) {
+	ctx := context.Background()
+	tenDaysAgo := time.Now().AddDate(0, 0, -10)
+	policies := []models.ExpiryPolicy{{RequestType: "EXPENSE", Action: constants.ExpiryEscalate, ReminderDays: intPtr(1), ExpiryDays: 20}}
+
+	t.Run("Reminds The Current Approver Once", func(t *testing.T) {
+		m := newAutoRejectMocks(t)
+		m.policy.EXPECT().GetAll(ctx).Return(policies, nil)
+		m.expense.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{
+			{ID: 10, EmployeeID: 5, ManagerID: int64Ptr(2), CreatedAt: tenDaysAgo},
+			{ID: 11, EmployeeID: 6, ManagerID: int64Ptr(2), CreatedAt: tenDaysAgo},
+		}, nil)
+		m.holiday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
+		m.escalation.EXPECT().HasEvent(ctx, "EXPENSE", int64(10), constants.EscalationReminded, 0).Return(false, nil)
+		m.escalation.EXPECT().HasEvent(ctx, "EXPENSE", int64(11), constants.EscalationReminded, 0).Return(true, nil)
+		m.db.EXPECT().Begin(ctx).Return(m.tx, nil)
+		m.escalation.EXPECT().Create(ctx, m.tx, &models.RequestEscalation{
+			RequestType: "EXPENSE", RequestID: 10, EmployeeID: 5, Event: constants.EscalationReminded, FromApproverID: int64Ptr(2),
+		}).Return(nil)
+		m.tx.EXPECT().Commit(ctx).Return(nil)
+		m.tx.EXPECT().Rollback(ctx).Return(nil)
+
+		err := m.service(ctx).AutoRejectExpenseRequests(ctx)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Escalation Restarts The Clock", func(t *testing.T) {
+		m := newAutoRejectMocks(t)
+		now := time.Now()
+		m.policy.EXPECT().GetAll(ctx).Return([]models.ExpiryPolicy{{RequestType: "EXPENSE", Action: constants.ExpiryEscalate, ExpiryDays: 5}}, nil)
+		m.expense.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{
+			{ID: 10, EmployeeID: 5, EscalationLevel: 1, EscalatedTo: int64Ptr(7), EscalatedAt: &now, CreatedAt: now.AddDate(0, 0, -60)},
+		}, nil)
+		m.holiday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
+
+		err := m.service(ctx).AutoRejectExpenseRequests(ctx)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestAutoRejectService_GradePolicies(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	threeDaysAgo := now.AddDate(0, 0, -3)
+	policies := []models.ExpiryPolicy{
+		{RequestType: "DISCOUNT", Action: constants.ExpiryAutoReject, ExpiryDays: 30},
+		{RequestType: "DISCOUNT", GradeID: int64Ptr(4), Action: constants.ExpiryAutoApprove, ExpiryDays: 2, CalendarDays: true},
+	}
+
+	m := newAutoRejectMocks(t)
+	m.policy.EXPECT().GetAll(ctx).Return(policies, nil)
+	m.discount.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{
+		{ID: 20, EmployeeID: 5, GradeID: 4, CreatedAt: threeDaysAgo},
+		{ID: 21, EmployeeID: 6, GradeID: 1, CreatedAt: threeDaysAgo},
+	}, nil)
+	m.holiday.EXPECT().GetHolidayDates(ctx, utils.DateOf(threeDaysAgo), mock.Anything).Return(nil, nil)
+
+	// only the grade with its own policy has expired
+	m.db.EXPECT().Begin(ctx).Return(m.tx, nil)
+	m.discount.EXPECT().GetByID(ctx, m.tx, int64(20)).Return(&models.DiscountRequest{ID: 20, EmployeeID: 5, DiscountPercentage: 10}, nil)
+	m.balance.EXPECT().DeductDiscountBalance(ctx, m.tx, int64(5), float64(10)).Return(nil)
+	m.discount.EXPECT().LockPending(ctx, m.tx, int64(20)).Return(true, nil)
+	m.discount.EXPECT().UpdateStatus(ctx, m.tx, int64(20), "AUTO_APPROVED", int64(0), "Auto approved after 2 calendar days").Return(nil)
+	m.chain.EXPECT().Close(ctx, m.tx, "DISCOUNT", int64(20)).Return(nil)
+	m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+		return e.RequestID == 20 && e.Event == constants.EscalationAutoApproved
+	})).Return(nil)
+	m.tx.EXPECT().Commit(ctx).Return(nil)
+	m.tx.EXPECT().Rollback(ctx).Return(nil)
+
+	err := m.service(ctx).AutoRejectDiscountRequests(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestAutoRejectService_ResubmittedRequests(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	tenDaysAgo := now.AddDate(0, 0, -10)
+	threeDaysAgo := now.AddDate(0, 0, -3)
+	policies := []models.ExpiryPolicy{
+		{RequestType: "EXPENSE", Action: constants.ExpiryAutoReject, ExpiryDays: 2, CalendarDays: true},
+	}
+
+	m := newAutoRejectMocks(t)
+	m.policy.EXPECT().GetAll(ctx).Return(policies, nil)
+	m.expense.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{
+		{ID: 10, EmployeeID: 5, CreatedAt: tenDaysAgo, ResubmittedAt: &now},
+		{ID: 11, EmployeeID: 6, CreatedAt: tenDaysAgo, ResubmittedAt: &threeDaysAgo},
+	}, nil)
+	m.holiday.EXPECT().GetHolidayDates(ctx, utils.DateOf(threeDaysAgo), mock.Anything).Return(nil, nil)
+
+	// the clock restarted when each was resubmitted; only the one resubmitted days ago has expired
+	m.db.EXPECT().Begin(ctx).Return(m.tx, nil)
+	m.expense.EXPECT().LockPending(ctx, m.tx, int64(11)).Return(true, nil)
+	m.expense.EXPECT().UpdateStatus(ctx, m.tx, int64(11), "AUTO_REJECTED", int64(0), "Auto rejected after 2 calendar days").Return(nil)
+	m.chain.EXPECT().Close(ctx, m.tx, "EXPENSE", int64(11)).Return(nil)
+	m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+		return e.RequestID == 11 && e.Event == constants.EscalationAutoRejected
+	})).Return(nil)
+	m.tx.EXPECT().Commit(ctx).Return(nil)
+	m.tx.EXPECT().Rollback(ctx).Return(nil)
+
+	err := m.service(ctx).AutoRejectExpenseRequests(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestAutoRejectService_FailedRequestDoesNotStopTheRest(t *testing.T) {
+	ctx := context.Background()
+	tenDaysAgo := time.Now().AddDate(0, 0, -10)
+
+	m := newAutoRejectMocks(t)
+	m.policy.EXPECT().GetAll(ctx).Return(nil, nil)
+	m.expense.EXPECT().GetPendingRequests(ctx).Return([]models.PendingRequest{
+		{ID: 10, EmployeeID: 5, CreatedAt: tenDaysAgo},
+		{ID: 11, EmployeeID: 6, CreatedAt: tenDaysAgo},
+		{ID: 12, EmployeeID: 7, CreatedAt: tenDaysAgo},
+	}, nil)
+	m.holiday.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
+
+	m.db.EXPECT().Begin(ctx).Return(m.tx, nil).Times(3)
+	m.expense.EXPECT().LockPending(ctx, m.tx, int64(10)).Return(true, nil)
+	m.expense.EXPECT().UpdateStatus(ctx, m.tx, int64(10), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
+	// the second request fails and is rolled back on its own
+	m.expense.EXPECT().LockPending(ctx, m.tx, int64(11)).Return(true, nil)
+	m.expense.EXPECT().UpdateStatus(ctx, m.tx, int64(11), "AUTO_REJECTED", int64(0), mock.Anything).Return(assert.AnError)
+	m.expense.EXPECT().LockPending(ctx, m.tx, int64(12)).Return(true, nil)
+	m.expense.EXPECT().UpdateStatus(ctx, m.tx, int64(12), "AUTO_REJECTED", int64(0), mock.Anything).Return(nil)
+	m.chain.EXPECT().Close(ctx, m.tx, "EXPENSE", int64(10)).Return(nil)
+	m.chain.EXPECT().Close(ctx, m.tx, "EXPENSE", int64(12)).Return(nil)
+	m.escalation.EXPECT().Create(ctx, m.tx, mock.MatchedBy(func(e *models.RequestEscalation) bool {
+		return e.RequestID != 11 && e.Event == constants.EscalationAutoRejected
+	})).Return(nil).Times(2)
+	m.tx.EXPECT().Commit(ctx).Return(nil).Times(2)
+	m.tx.EXPECT().Rollback(ctx).Return(nil).Times(3)
+
+	err := m.service(ctx).AutoRejectExpenseRequests(ctx)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "EXPENSE request 11")
+}
@@ -0,0 +1,8 @@
+package delegations
+
+type DelegationRequest struct {
+	DelegateID int64  `json:"delegate_id"`
+	StartDate  string `json:"start_date"`
+	EndDate    string `json:"end_date"`
+	Reason     string `json:"reason"`
+}
@@ -0,0 +1,111 @@
+package delegations
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for approval delegations
+type DelegationHandler struct {
+	delegationService interfaces.DelegationService
+}
+
+// creates a new DelegationHandler instance
+func NewDelegationHandler(ctx context.Context, delegationService interfaces.DelegationService) *DelegationHandler {
+	return &DelegationHandler{delegationService: delegationService}
+}
+
+func (h *DelegationHandler) CreateDelegation(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	var req DelegationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleDelegationError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		handleDelegationError(c, apperrors.ErrInvalidDateFormat)
+		return
+	}
+
+	end, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		handleDelegationError(c, apperrors.ErrInvalidDateFormat)
+		return
+	}
+
+	ctx := c.Request.Context()
+	created, err := h.delegationService.CreateDelegation(ctx, role, userID, models.Delegation{
+		DelegateID: req.DelegateID,
+		StartDate:  start,
+		EndDate:    end,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		handleDelegationError(c, err)
+		return
+	}
+
+	response.Created(c, "delegation created successfully", created)
+}
+
+func (h *DelegationHandler) GetDelegations(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	ctx := c.Request.Context()
+
+	delegations, err := h.delegationService.GetDelegations(ctx, userID)
+	if err != nil {
+		handleDelegationError(c, err)
+		return
+	}
+
+	response.Success(c, "delegations fetched successfully", delegations)
+}
+
+func (h *DelegationHandler) RevokeDelegation(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleDelegationError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.delegationService.RevokeDelegation(ctx, userID, id); err != nil {
+		handleDelegationError(c, err)
+		return
+	}
+
+	response.Success(c, "delegation revoked successfully", nil)
+}
+
+func handleDelegationError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrDelegatorNotApprover:
+		status = http.StatusForbidden
+	case apperrors.ErrDelegationNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrDelegationOverlap:
+		status = http.StatusConflict
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidID,
+		apperrors.ErrInvalidDelegate, apperrors.ErrInvalidDelegation:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
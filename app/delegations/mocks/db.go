@@ -0,0 +1,297 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	pgconn "github.com/jackc/pgx/v5/pgconn"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// DB is an autogenerated mock type for the DB type
+type DB struct {
+	mock.Mock
+}
+
+type DB_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DB) EXPECT() *DB_Expecter {
+	return &DB_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with given fields: ctx
+func (_m *DB) Begin(ctx context.Context) (interfaces.Tx, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 interfaces.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (interfaces.Tx, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) interfaces.Tx); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type DB_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DB_Expecter) Begin(ctx interface{}) *DB_Begin_Call {
+	return &DB_Begin_Call{Call: _e.mock.On("Begin", ctx)}
+}
+
+func (_c *DB_Begin_Call) Run(run func(ctx context.Context)) *DB_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DB_Begin_Call) Return(_a0 interfaces.Tx, _a1 error) *DB_Begin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_Begin_Call) RunAndReturn(run func(context.Context) (interfaces.Tx, error)) *DB_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exec provides a mock function with given fields: ctx, sql, args
+func (_m *DB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 pgconn.CommandTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)); ok {
+		return rf(ctx, sql, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgconn.CommandTag); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		r0 = ret.Get(0).(pgconn.CommandTag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+		r1 = rf(ctx, sql, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type DB_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *DB_Expecter) Exec(ctx interface{}, sql interface{}, args ...interface{}) *DB_Exec_Call {
+	return &DB_Exec_Call{Call: _e.mock.On("Exec",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *DB_Exec_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *DB_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DB_Exec_Call) Return(_a0 pgconn.CommandTag, _a1 error) *DB_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_Exec_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)) *DB_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: ctx, sql, args
+func (_m *DB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 pgx.Rows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgx.Rows, error)); ok {
+		return rf(ctx, sql, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgx.Rows); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Rows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+		r1 = rf(ctx, sql, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type DB_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *DB_Expecter) Query(ctx interface{}, sql interface{}, args ...interface{}) *DB_Query_Call {
+	return &DB_Query_Call{Call: _e.mock.On("Query",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *DB_Query_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *DB_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DB_Query_Call) Return(_a0 pgx.Rows, _a1 error) *DB_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_Query_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (pgx.Rows, error)) *DB_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryRow provides a mock function with given fields: ctx, sql, args
+func (_m *DB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryRow")
+	}
+
+	var r0 pgx.Row
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgx.Row); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Row)
+		}
+	}
+
+	return r0
+}
+
+// DB_QueryRow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryRow'
+type DB_QueryRow_Call struct {
+	*mock.Call
+}
+
+// QueryRow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *DB_Expecter) QueryRow(ctx interface{}, sql interface{}, args ...interface{}) *DB_QueryRow_Call {
+	return &DB_QueryRow_Call{Call: _e.mock.On("QueryRow",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *DB_QueryRow_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *DB_QueryRow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *DB_QueryRow_Call) Return(_a0 pgx.Row) *DB_QueryRow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DB_QueryRow_Call) RunAndReturn(run func(context.Context, string, ...interface{}) pgx.Row) *DB_QueryRow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDB creates a new instance of DB. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDB(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DB {
+	mock := &DB{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PendingQueue is an autogenerated mock type for the PendingQueue type
+type PendingQueue struct {
+	mock.Mock
+}
+
+type PendingQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PendingQueue) EXPECT() *PendingQueue_Expecter {
+	return &PendingQueue_Expecter{mock: &_m.Mock}
+}
+
+// GetPendingForAdmin provides a mock function with given fields: ctx
+func (_m *PendingQueue) GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingForAdmin")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]map[string]interface{}, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []map[string]interface{}); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PendingQueue_GetPendingForAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForAdmin'
+type PendingQueue_GetPendingForAdmin_Call struct {
+	*mock.Call
+}
+
+// GetPendingForAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PendingQueue_Expecter) GetPendingForAdmin(ctx interface{}) *PendingQueue_GetPendingForAdmin_Call {
+	return &PendingQueue_GetPendingForAdmin_Call{Call: _e.mock.On("GetPendingForAdmin", ctx)}
+}
+
+func (_c *PendingQueue_GetPendingForAdmin_Call) Run(run func(ctx context.Context)) *PendingQueue_GetPendingForAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PendingQueue_GetPendingForAdmin_Call) Return(_a0 []map[string]interface{}, _a1 error) *PendingQueue_GetPendingForAdmin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PendingQueue_GetPendingForAdmin_Call) RunAndReturn(run func(context.Context) ([]map[string]interface{}, error)) *PendingQueue_GetPendingForAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingForManager provides a mock function with given fields: ctx, managerID
+func (_m *PendingQueue) GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, managerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingForManager")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, managerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []map[string]interface{}); ok {
+		r0 = rf(ctx, managerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, managerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PendingQueue_GetPendingForManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForManager'
+type PendingQueue_GetPendingForManager_Call struct {
+	*mock.Call
+}
+
+// GetPendingForManager is a helper method to define mock.On call
+//   - ctx context.Context
+//   - managerID int64
+func (_e *PendingQueue_Expecter) GetPendingForManager(ctx interface{}, managerID interface{}) *PendingQueue_GetPendingForManager_Call {
+	return &PendingQueue_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, managerID)}
+}
+
+func (_c *PendingQueue_GetPendingForManager_Call) Run(run func(ctx context.Context, managerID int64)) *PendingQueue_GetPendingForManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *PendingQueue_GetPendingForManager_Call) Return(_a0 []map[string]interface{}, _a1 error) *PendingQueue_GetPendingForManager_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PendingQueue_GetPendingForManager_Call) RunAndReturn(run func(context.Context, int64) ([]map[string]interface{}, error)) *PendingQueue_GetPendingForManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPendingQueue creates a new instance of PendingQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPendingQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PendingQueue {
+	mock := &PendingQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
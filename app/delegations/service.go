@@ -0,0 +1,233 @@
+package delegations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+// DelegationService lets approvers hand their queue to a delegate while they are away
+type DelegationService struct {
+	delegationRepo interfaces.DelegationRepository
+	userRepo       interfaces.UserRepository
+	db             interfaces.DB
+}
+
+// NewDelegationService creates a new instance of DelegationService
+func NewDelegationService(
+	ctx context.Context,
+	delegationRepo interfaces.DelegationRepository,
+	userRepo interfaces.UserRepository,
+	db interfaces.DB,
+) interfaces.DelegationService {
+	return &DelegationService{
+		delegationRepo: delegationRepo,
+		userRepo:       userRepo,
+		db:             db,
+	}
+}
+
+// CreateDelegation hands the delegator's approvals to another manager or admin for a date range
+func (s *DelegationService) CreateDelegation(ctx context.Context, role string, delegatorID int64, delegation models.Delegation) (*models.Delegation, error) {
+	if role != constants.RoleManager && role != constants.RoleAdmin {
+		return nil, apperrors.ErrDelegatorNotApprover
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	if delegation.EndDate.Before(delegation.StartDate) || delegation.EndDate.Before(today) {
+		return nil, apperrors.ErrInvalidDelegation
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.ValidateDelegate(ctx, tx, delegatorID, delegation.DelegateID); err != nil {
+		return nil, err
+	}
+
+	overlap, err := s.delegationRepo.HasOverlap(ctx, tx, delegatorID, delegation.StartDate, delegation.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if overlap {
+		return nil, apperrors.ErrDelegationOverlap
+	}
+
+	delegation.DelegatorID = delegatorID
+	delegation.LeaveRequestID = nil
+	if err := s.delegationRepo.Create(ctx, tx, &delegation); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+	return &delegation, nil
+}
+
+// GetDelegations lists the delegations the user gave or received
+func (s *DelegationService) GetDelegations(ctx context.Context, userID int64) ([]models.Delegation, error) {
+	return s.delegationRepo.GetForUser(ctx, userID)
+}
+
+// RevokeDelegation ends one of the delegator's delegations early
+func (s *DelegationService) RevokeDelegation(ctx context.Context, delegatorID, delegationID int64) error {
+	return s.delegationRepo.Revoke(ctx, delegationID, delegatorID)
+}
+
+// ValidateDelegate checks that the delegator approves requests and the delegate is another approver
+func (s *DelegationService) ValidateDelegate(ctx context.Context, tx interfaces.Tx, delegatorID, delegateID int64) error {
+	delegator, err := s.userRepo.GetRuleSubject(ctx, tx, delegatorID)
+	if err != nil {
+		return err
+	}
+	if !isApprover(delegator.Role) {
+		return apperrors.ErrDelegatorNotApprover
+	}
+
+	if delegateID <= 0 || delegateID == delegatorID {
+		return apperrors.ErrInvalidDelegate
+	}
+
+	delegate, err := s.userRepo.GetRuleSubject(ctx, tx, delegateID)
+	if errors.Is(err, apperrors.ErrUserNotFound) {
+		return apperrors.ErrInvalidDelegate
+	}
+	if err != nil {
+		return err
+	}
+	if !isApprover(delegate.Role) {
+		return apperrors.ErrInvalidDelegate
+	}
+	return nil
+}
+
+// DelegateForLeave hands the dates of an approved leave to the delegate the applicant named.
+// A delegation the applicant already set up for any of those dates is left to cover them.
+func (s *DelegationService) DelegateForLeave(ctx context.Context, tx interfaces.Tx, leave *models.LeaveRequest) error {
+	if leave.DelegateID == nil {
+		return nil
+	}
+
+	overlap, err := s.delegationRepo.HasOverlap(ctx, tx, leave.EmployeeID, leave.FromDate, leave.ToDate)
+	if err != nil || overlap {
+		return err
+	}
+
+	leaveID := leave.ID
+	return s.delegationRepo.Create(ctx, tx, &models.Delegation{
+		DelegatorID:    leave.EmployeeID,
+		DelegateID:     *leave.DelegateID,
+		StartDate:      leave.FromDate,
+		EndDate:        leave.ToDate,
+		Reason:         fmt.Sprintf("leave request %d", leave.ID),
+		LeaveRequestID: &leaveID,
+	})
+}
+
+// RevokeForLeave ends the delegation an approved leave created once the leave is cancelled
+func (s *DelegationService) RevokeForLeave(ctx context.Context, tx interfaces.Tx, leaveRequestID int64) error {
+	return s.delegationRepo.RevokeForLeave(ctx, tx, leaveRequestID)
+}
+
+// ResolveApprover works out whose authority an approver decides a request with. A delegate stands in
+// for the requester's manager if that manager delegated to them, otherwise for an admin who did;
+// anyone else, and anyone deciding for their own team, decides as themselves.
+func (s *DelegationService) ResolveApprover(ctx context.Context, tx interfaces.Tx, approverID int64, role string, requesterID int64) (*models.Approver, error) {
+	self := &models.Approver{ID: approverID, Role: role}
+	if role == constants.RoleAdmin {
+		return self, nil
+	}
+
+	delegators, err := s.delegationRepo.GetActiveDelegators(ctx, approverID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(delegators) == 0 {
+		return self, nil
+	}
+
+	requester, err := s.userRepo.GetRuleSubject(ctx, tx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if requester.ManagerID != nil && *requester.ManagerID == approverID {
+		return self, nil
+	}
+
+	var admin *models.RuleSubject
+	for i, delegator := range delegators {
+		// nobody approves their own request through a delegate
+		if delegator.UserID == requesterID {
+			continue
+		}
+		if requester.ManagerID != nil && delegator.UserID == *requester.ManagerID {
+			return onBehalfOf(approverID, delegator), nil
+		}
+		if admin == nil && delegator.Role == constants.RoleAdmin {
+			admin = &delegators[i]
+		}
+	}
+
+	if admin != nil {
+		return onBehalfOf(approverID, *admin), nil
+	}
+	return self, nil
+}
+
+// AddDelegatedQueues appends the pending requests of everyone the delegate stands in for today
+// to the delegate's own queue, leaving out the delegate's own requests; each added row names the
+// delegator in "on_behalf_of"
+func (s *DelegationService) AddDelegatedQueues(ctx context.Context, delegateID int64, queue []map[string]interface{}, pending interfaces.PendingQueue) ([]map[string]interface{}, error) {
+	delegators, err := s.delegationRepo.GetActiveDelegators(ctx, delegateID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[interface{}]bool, len(queue))
+	for _, row := range queue {
+		seen[row["id"]] = true
+	}
+
+	for _, delegator := range delegators {
+		var rows []map[string]interface{}
+		if delegator.Role == constants.RoleAdmin {
+			rows, err = pending.GetPendingForAdmin(ctx)
+		} else {
+			rows, err = pending.GetPendingForManager(ctx, delegator.UserID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			// nobody approves their own request through a delegate
+			if seen[row["id"]] || row["employee_id"] == delegateID {
+				continue
+			}
+			seen[row["id"]] = true
+			row["on_behalf_of"] = delegator.UserID
+			queue = append(queue, row)
+		}
+	}
+
+	return queue, nil
+}
+
+func onBehalfOf(delegateID int64, delegator models.RuleSubject) *models.Approver {
+	delegatorID := delegator.UserID
+	return &models.Approver{ID: delegateID, Role: delegator.Role, OnBehalfOf: &delegatorID}
+}
+
+func isApprover(role string) bool {
+	return role == constants.RoleManager || role == constants.RoleAdmin
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelegationHandler_CreateDelegation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validBody := map[string]interface{}{"delegate_id": 5, "start_date": "2099-03-01", "end_date": "2099-03-10", "reason": "Conference"}
+
+	tests := []struct {
+		name           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.DelegationService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			reqBody: validBody,
+			mockSetup: func(s *mocks.DelegationService) {
+				s.EXPECT().CreateDelegation(mock.Anything, "MANAGER", int64(2), mock.MatchedBy(func(d models.Delegation) bool {
+					return d.DelegateID == 5 && d.StartDate.Day() == 1 && d.EndDate.Day() == 10
+				})).Return(&models.Delegation{ID: 1}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:    "Overlap",
+			reqBody: validBody,
+			mockSetup: func(s *mocks.DelegationService) {
+				s.EXPECT().CreateDelegation(mock.Anything, "MANAGER", int64(2), mock.Anything).Return(nil, apperrors.ErrDelegationOverlap)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:    "Invalid Delegate",
+			reqBody: validBody,
+			mockSetup: func(s *mocks.DelegationService) {
+				s.EXPECT().CreateDelegation(mock.Anything, "MANAGER", int64(2), mock.Anything).Return(nil, apperrors.ErrInvalidDelegate)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Date",
+			reqBody:        map[string]interface{}{"delegate_id": 5, "start_date": "01-03-2099", "end_date": "2099-03-10"},
+			mockSetup:      func(s *mocks.DelegationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewDelegationService(t)
+			tt.mockSetup(mockS)
+
+			handler := delegations.NewDelegationHandler(context.Background(), mockS)
+			r := gin.New()
+			r.POST("/delegations", func(c *gin.Context) {
+				c.Set("role", "MANAGER")
+				c.Set("user_id", int64(2))
+				handler.CreateDelegation(c)
+			})
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, "/delegations", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestDelegationHandler_RevokeDelegation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		mockSetup      func(s *mocks.DelegationService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			id:   "3",
+			mockSetup: func(s *mocks.DelegationService) {
+				s.EXPECT().RevokeDelegation(mock.Anything, int64(2), int64(3)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone Else's Delegation",
+			id:   "4",
+			mockSetup: func(s *mocks.DelegationService) {
+				s.EXPECT().RevokeDelegation(mock.Anything, int64(2), int64(4)).Return(apperrors.ErrDelegationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			mockSetup:      func(s *mocks.DelegationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewDelegationService(t)
+			tt.mockSetup(mockS)
+
+			handler := delegations.NewDelegationHandler(context.Background(), mockS)
+			r := gin.New()
+			r.DELETE("/delegations/:id", func(c *gin.Context) {
+				c.Set("role", "MANAGER")
+				c.Set("user_id", int64(2))
+				handler.RevokeDelegation(c)
+			})
+
+			req := httptest.NewRequest(http.MethodDelete, "/delegations/"+tt.id, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func int64Ptr(v int64) *int64 { return &v }
+
+func TestDelegationService_CreateDelegation(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now().Truncate(24 * time.Hour)
+	nextWeek := today.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name          string
+		role          string
+		delegation    models.Delegation
+		mockSetup     func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:       "Success",
+			role:       constants.RoleManager,
+			delegation: models.Delegation{DelegateID: 5, StartDate: today, EndDate: nextWeek, Reason: "Conference"},
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(2)).Return(&models.RuleSubject{UserID: 2, Role: constants.RoleManager}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(5)).Return(&models.RuleSubject{UserID: 5, Role: constants.RoleManager}, nil)
+				d.EXPECT().HasOverlap(ctx, tx, int64(2), today, nextWeek).Return(false, nil)
+				d.EXPECT().Create(ctx, tx, mock.MatchedBy(func(del *models.Delegation) bool {
+					return del.DelegatorID == 2 && del.DelegateID == 5 && del.LeaveRequestID == nil
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+		},
+		{
+			name:          "Employees Have Nothing To Delegate",
+			role:          constants.RoleEmployee,
+			delegation:    models.Delegation{DelegateID: 5, StartDate: today, EndDate: nextWeek},
+			mockSetup:     func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrDelegatorNotApprover,
+		},
+		{
+			name:          "Ends Before It Starts",
+			role:          constants.RoleManager,
+			delegation:    models.Delegation{DelegateID: 5, StartDate: nextWeek, EndDate: today},
+			mockSetup:     func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidDelegation,
+		},
+		{
+			name:          "Already Over",
+			role:          constants.RoleManager,
+			delegation:    models.Delegation{DelegateID: 5, StartDate: today.AddDate(0, 0, -7), EndDate: today.AddDate(0, 0, -1)},
+			mockSetup:     func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidDelegation,
+		},
+		{
+			name:       "Delegate To Self",
+			role:       constants.RoleManager,
+			delegation: models.Delegation{DelegateID: 2, StartDate: today, EndDate: nextWeek},
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(2)).Return(&models.RuleSubject{UserID: 2, Role: constants.RoleManager}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrInvalidDelegate,
+		},
+		{
+			name:       "Delegate Is An Employee",
+			role:       constants.RoleManager,
+			delegation: models.Delegation{DelegateID: 3, StartDate: today, EndDate: nextWeek},
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(2)).Return(&models.RuleSubject{UserID: 2, Role: constants.RoleManager}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(3)).Return(&models.RuleSubject{UserID: 3, Role: constants.RoleEmployee}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrInvalidDelegate,
+		},
+		{
+			name:       "Unknown Delegate",
+			role:       constants.RoleManager,
+			delegation: models.Delegation{DelegateID: 99, StartDate: today, EndDate: nextWeek},
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(2)).Return(&models.RuleSubject{UserID: 2, Role: constants.RoleManager}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(99)).Return(nil, apperrors.ErrUserNotFound)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrInvalidDelegate,
+		},
+		{
+			name:       "Overlaps Another Delegation",
+			role:       constants.RoleAdmin,
+			delegation: models.Delegation{DelegateID: 5, StartDate: today, EndDate: nextWeek},
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(2)).Return(&models.RuleSubject{UserID: 2, Role: constants.RoleAdmin}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(5)).Return(&models.RuleSubject{UserID: 5, Role: constants.RoleManager}, nil)
+				d.EXPECT().HasOverlap(ctx, tx, int64(2), today, nextWeek).Return(true, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrDelegationOverlap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockD := mocks.NewDelegationRepository(t)
+			mockU := mocks.NewUserRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockD, mockU, mockDB, mockTx)
+
+			service := delegations.NewDelegationService(ctx, mockD, mockU, mockDB)
+			created, err := service.CreateDelegation(ctx, tt.role, 2, tt.delegation)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), created.DelegatorID)
+		})
+	}
+}
+
+func TestDelegationService_DelegateForLeave(t *testing.T) {
+	ctx := context.Background()
+	from := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	to := from.AddDate(0, 0, 4)
+
+	t.Run("Creates A Delegation For The Leave", func(t *testing.T) {
+		mockD := mocks.NewDelegationRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockD.EXPECT().HasOverlap(ctx, mockTx, int64(2), from, to).Return(false, nil)
+		mockD.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(del *models.Delegation) bool {
+			return del.DelegatorID == 2 && del.DelegateID == 5 && *del.LeaveRequestID == 10 &&
+				del.StartDate.Equal(from) && del.EndDate.Equal(to)
+		})).Return(nil)
+
+		service := delegations.NewDelegationService(ctx, mockD, nil, nil)
+		err := service.DelegateForLeave(ctx, mockTx, &models.LeaveRequest{ID: 10, EmployeeID: 2, FromDate: from, ToDate: to, DelegateID: int64Ptr(5)})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Existing Delegation Is Kept", func(t *testing.T) {
+		mockD := mocks.NewDelegationRepository(t)
+		mockTx := mocks.NewTx(t)
+		mockD.EXPECT().HasOverlap(ctx, mockTx, int64(2), from, to).Return(true, nil)
+
+		service := delegations.NewDelegationService(ctx, mockD, nil, nil)
+		err := service.DelegateForLeave(ctx, mockTx, &models.LeaveRequest{ID: 10, EmployeeID: 2, FromDate: from, ToDate: to, DelegateID: int64Ptr(5)})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDelegationService_ResolveApprover(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		role        string
+		requesterID int64
+		mockSetup   func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx)
+		expected    models.Approver
+	}{
+		{
+			name:        "Admins Decide As Themselves",
+			role:        constants.RoleAdmin,
+			requesterID: 1,
+			mockSetup:   func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {},
+			expected:    models.Approver{ID: 6, Role: constants.RoleAdmin},
+		},
+		{
+			name:        "No Delegations",
+			role:        constants.RoleManager,
+			requesterID: 1,
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return(nil, nil)
+			},
+			expected: models.Approver{ID: 6, Role: constants.RoleManager},
+		},
+		{
+			name:        "Own Team Member",
+			role:        constants.RoleManager,
+			requesterID: 1,
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{{UserID: 2, Role: constants.RoleManager}}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, ManagerID: int64Ptr(6)}, nil)
+			},
+			expected: models.Approver{ID: 6, Role: constants.RoleManager},
+		},
+		{
+			name:        "Stands In For The Requester's Manager",
+			role:        constants.RoleManager,
+			requesterID: 1,
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{
+					{UserID: 9, Role: constants.RoleAdmin},
+					{UserID: 2, Role: constants.RoleManager},
+				}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, ManagerID: int64Ptr(2)}, nil)
+			},
+			expected: models.Approver{ID: 6, Role: constants.RoleManager, OnBehalfOf: int64Ptr(2)},
+		},
+		{
+			name:        "Stands In For An Admin",
+			role:        constants.RoleManager,
+			requesterID: 4,
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{{UserID: 9, Role: constants.RoleAdmin}}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(4)).Return(&models.RuleSubject{UserID: 4, Role: constants.RoleManager}, nil)
+			},
+			expected: models.Approver{ID: 6, Role: constants.RoleAdmin, OnBehalfOf: int64Ptr(9)},
+		},
+		{
+			name:        "Not For The Delegator's Own Request",
+			role:        constants.RoleManager,
+			requesterID: 9,
+			mockSetup: func(d *mocks.DelegationRepository, u *mocks.UserRepository, tx *mocks.Tx) {
+				d.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{{UserID: 9, Role: constants.RoleAdmin}}, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(9)).Return(&models.RuleSubject{UserID: 9, Role: constants.RoleAdmin}, nil)
+			},
+			expected: models.Approver{ID: 6, Role: constants.RoleManager},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockD := mocks.NewDelegationRepository(t)
+			mockU := mocks.NewUserRepository(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockD, mockU, mockTx)
+
+			service := delegations.NewDelegationService(ctx, mockD, mockU, nil)
+			approver, err := service.ResolveApprover(ctx, mockTx, 6, tt.role, tt.requesterID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, *approver)
+		})
+	}
+}
+
+func TestDelegationService_AddDelegatedQueues(t *testing.T) {
+	ctx := context.Background()
+
+	mockD := mocks.NewDelegationRepository(t)
+	mockQ := mocks.NewPendingQueue(t)
+	mockD.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{
+		{UserID: 2, Role: constants.RoleManager},
+		{UserID: 9, Role: constants.RoleAdmin},
+	}, nil)
+	mockQ.EXPECT().GetPendingForManager(ctx, int64(2)).Return([]map[string]interface{}{{"id": int64(11)}}, nil)
+	// the admin's queue repeats requests already listed
+	mockQ.EXPECT().GetPendingForAdmin(ctx).Return([]map[string]interface{}{{"id": int64(10)}, {"id": int64(11)}, {"id": int64(12)}}, nil)
+
+	service := delegations.NewDelegationService(ctx, mockD, nil, nil)
+	queue, err := service.AddDelegatedQueues(ctx, 6, []map[string]interface{}{{"id": int64(10)}}, mockQ)
+
+	assert.NoError(t, err)
+	assert.Len(t, queue, 3)
+	assert.Nil(t, queue[0]["on_behalf_of"])
+	assert.Equal(t, int64(2), queue[1]["on_behalf_of"])
+	assert.Equal(t, int64(12), queue[2]["id"])
+	assert.Equal(t, int64(9), queue[2]["on_behalf_of"])
+}
+
+func TestDelegationService_AddDelegatedQueues_SkipsOwnRequests(t *testing.T) {
+	ctx := context.Background()
+
+	mockD := mocks.NewDelegationRepository(t)
+	mockQ := mocks.NewPendingQueue(t)
+	mockD.EXPECT().GetActiveDelegators(ctx, int64(6), mock.Anything).Return([]models.RuleSubject{
+		{UserID: 2, Role: constants.RoleManager},
+	}, nil)
+	// the delegator manages the delegate, so the delegate's own request is in their queue
+	mockQ.EXPECT().GetPendingForManager(ctx, int64(2)).Return([]map[string]interface{}{
+		{"id": int64(11), "employee_id": int64(6)},
+		{"id": int64(12), "employee_id": int64(7)},
+	}, nil)
+
+	service := delegations.NewDelegationService(ctx, mockD, nil, nil)
+	queue, err := service.AddDelegatedQueues(ctx, 6, nil, mockQ)
+
+	assert.NoError(t, err)
+	assert.Len(t, queue, 1)
+	assert.Equal(t, int64(12), queue[0]["id"])
+}
@@ -4,3 +4,24 @@ type DiscountApplyRequest struct {
 	DiscountPercentage float64 `json:"discount_percentage"`
 	Reason             string  `json:"reason"`
 }
+
+// BulkDecisionRequest lists the requests a bulk approval or rejection decides, with one comment for all
+type BulkDecisionRequest struct {
+	RequestIDs []int64 `json:"request_ids"`
+	Comment    string  `json:"comment"`
+}
+
+// InfoQuestionRequest carries the question an approver pauses a request with
+type InfoQuestionRequest struct {
+	Question string `json:"question"`
+}
+
+// RevokeRequest carries the reason an approver or admin revokes an approved request with
+type RevokeRequest struct {
+	Reason string `json:"reason"`
+}
+
+// InfoAnswerRequest carries the requester's answer when they resubmit a paused request
+type InfoAnswerRequest struct {
+	Answer string `json:"answer"`
+}
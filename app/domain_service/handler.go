@@ -8,6 +8,7 @@ import (
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -109,6 +110,47 @@ func handleCancelDiscountError(c *gin.Context, err error) {
 	response.Error(c, status, err.Error(), nil)
 }
 
+// ResubmitDiscount answers the approver's question on a discount request and sends it back for approval
+func (h *DiscountHandler) ResubmitDiscount(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleResubmitDiscountError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req InfoAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleResubmitDiscountError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.discountService.ResubmitDiscount(ctx, userID, requestID, req.Answer)
+	if err != nil {
+		handleResubmitDiscountError(c, err)
+		return
+	}
+
+	response.Success(c, "discount request resubmitted successfully", nil)
+}
+
+func handleResubmitDiscountError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrDiscountRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrRequestNotAwaitingInfo, apperrors.ErrInfoRequestNotFound:
+		status = http.StatusConflict
+	case apperrors.ErrAnswerRequired, apperrors.ErrInvalidID, apperrors.ErrInvalidRequestPayload:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
+
 type DiscountApprovalHandler struct {
 	discountApprovalService interfaces.DiscountApprovalService
 }
@@ -191,20 +233,127 @@ func (h *DiscountApprovalHandler) RejectDiscount(c *gin.Context) {
 	response.Success(c, "discount request rejected successfully", nil)
 }
 
-func handleApproveRejectDiscountError(c *gin.Context, err error) {
+// BulkApproveDiscounts approves the listed discount requests one by one and reports each outcome
+func (h *DiscountApprovalHandler) BulkApproveDiscounts(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApproveRejectDiscountError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.discountApprovalService.BulkApproveDiscounts(ctx, role, approverID, req.RequestIDs, req.Comment)
+	if err != nil {
+		handleApproveRejectDiscountError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, approveRejectDiscountErrorStatus)
+	response.Success(c, "bulk approval processed", result)
+}
+
+// BulkRejectDiscounts rejects the listed discount requests one by one and reports each outcome
+func (h *DiscountApprovalHandler) BulkRejectDiscounts(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApproveRejectDiscountError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	if req.Comment == "" {
+		handleApproveRejectDiscountError(c, apperrors.ErrCommentMissing)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.discountApprovalService.BulkRejectDiscounts(ctx, role, approverID, req.RequestIDs, req.Comment)
+	if err != nil {
+		handleApproveRejectDiscountError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, approveRejectDiscountErrorStatus)
+	response.Success(c, "bulk rejection processed", result)
+}
+
+// RequestDiscountInfo pauses a pending discount request with a question for the requester
+func (h *DiscountApprovalHandler) RequestDiscountInfo(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleApproveRejectDiscountError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req InfoQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApproveRejectDiscountError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.discountApprovalService.RequestDiscountInfo(ctx, role, approverID, requestID, req.Question)
+	if err != nil {
+		handleApproveRejectDiscountError(c, err)
+		return
+	}
+
+	response.Success(c, "more information requested successfully", nil)
+}
+
+// RevokeDiscount withdraws an approved discount request; a reason is required
+func (h *DiscountApprovalHandler) RevokeDiscount(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleApproveRejectDiscountError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req RevokeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApproveRejectDiscountError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.discountApprovalService.RevokeDiscount(ctx, role, approverID, requestID, req.Reason)
+	if err != nil {
+		handleApproveRejectDiscountError(c, err)
+		return
+	}
+
+	response.Success(c, "discount request revoked successfully", nil)
+}
+
+func approveRejectDiscountErrorStatus(err error) int {
 	status := http.StatusInternalServerError
 
 	switch err {
-	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
-		apperrors.ErrSelfApprovalNotAllowed:
+	case apperrors.ErrEmployeeCannotApprove, apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
+		apperrors.ErrSelfApprovalNotAllowed, apperrors.ErrNotStepApprover, apperrors.ErrApproverAlreadyDecided:
 		status = http.StatusForbidden
 	case apperrors.ErrDiscountRequestNotFound:
 		status = http.StatusNotFound
 	case apperrors.ErrDiscountRequestNotPending, apperrors.ErrCommentRequired,
-		apperrors.ErrCommentMissing, apperrors.ErrInvalidID,
-		apperrors.ErrInvalidRequestPayload:
+		apperrors.ErrCommentMissing, apperrors.ErrQuestionRequired, apperrors.ErrInvalidID,
+		apperrors.ErrRevokeReasonRequired, apperrors.ErrRequestNotApproved,
+		apperrors.ErrInvalidRequestPayload, apperrors.ErrBulkRequestIDsMissing, apperrors.ErrBulkTooManyRequests:
 		status = http.StatusBadRequest
 	}
+	return status
+}
 
-	response.Error(c, status, err.Error(), nil)
+func handleApproveRejectDiscountError(c *gin.Context, err error) {
+	response.Error(c, approveRejectDiscountErrorStatus(err), err.Error(), nil)
 }
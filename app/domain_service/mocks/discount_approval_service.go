@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 )
 
 // DiscountApprovalService is an autogenerated mock type for the DiscountApprovalService type
@@ -71,6 +73,130 @@ func (_c *DiscountApprovalService_ApproveDiscount_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// BulkApproveDiscounts provides a mock function with given fields: ctx, role, approverID, requestIDs, comment
+func (_m *DiscountApprovalService) BulkApproveDiscounts(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkApproveDiscounts")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscountApprovalService_BulkApproveDiscounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkApproveDiscounts'
+type DiscountApprovalService_BulkApproveDiscounts_Call struct {
+	*mock.Call
+}
+
+// BulkApproveDiscounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestIDs []int64
+//   - comment string
+func (_e *DiscountApprovalService_Expecter) BulkApproveDiscounts(ctx interface{}, role interface{}, approverID interface{}, requestIDs interface{}, comment interface{}) *DiscountApprovalService_BulkApproveDiscounts_Call {
+	return &DiscountApprovalService_BulkApproveDiscounts_Call{Call: _e.mock.On("BulkApproveDiscounts", ctx, role, approverID, requestIDs, comment)}
+}
+
+func (_c *DiscountApprovalService_BulkApproveDiscounts_Call) Run(run func(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string)) *DiscountApprovalService_BulkApproveDiscounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountApprovalService_BulkApproveDiscounts_Call) Return(_a0 *models.BulkDecision, _a1 error) *DiscountApprovalService_BulkApproveDiscounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscountApprovalService_BulkApproveDiscounts_Call) RunAndReturn(run func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)) *DiscountApprovalService_BulkApproveDiscounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkRejectDiscounts provides a mock function with given fields: ctx, role, approverID, requestIDs, comment
+func (_m *DiscountApprovalService) BulkRejectDiscounts(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRejectDiscounts")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscountApprovalService_BulkRejectDiscounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRejectDiscounts'
+type DiscountApprovalService_BulkRejectDiscounts_Call struct {
+	*mock.Call
+}
+
+// BulkRejectDiscounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestIDs []int64
+//   - comment string
+func (_e *DiscountApprovalService_Expecter) BulkRejectDiscounts(ctx interface{}, role interface{}, approverID interface{}, requestIDs interface{}, comment interface{}) *DiscountApprovalService_BulkRejectDiscounts_Call {
+	return &DiscountApprovalService_BulkRejectDiscounts_Call{Call: _e.mock.On("BulkRejectDiscounts", ctx, role, approverID, requestIDs, comment)}
+}
+
+func (_c *DiscountApprovalService_BulkRejectDiscounts_Call) Run(run func(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string)) *DiscountApprovalService_BulkRejectDiscounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountApprovalService_BulkRejectDiscounts_Call) Return(_a0 *models.BulkDecision, _a1 error) *DiscountApprovalService_BulkRejectDiscounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscountApprovalService_BulkRejectDiscounts_Call) RunAndReturn(run func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)) *DiscountApprovalService_BulkRejectDiscounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPendingRequests provides a mock function with given fields: ctx, role, approverID
 func (_m *DiscountApprovalService) GetPendingRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error) {
 	ret := _m.Called(ctx, role, approverID)
@@ -181,6 +307,106 @@ func (_c *DiscountApprovalService_RejectDiscount_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RequestDiscountInfo provides a mock function with given fields: ctx, role, approverID, requestID, question
+func (_m *DiscountApprovalService) RequestDiscountInfo(ctx context.Context, role string, approverID int64, requestID int64, question string) error {
+	ret := _m.Called(ctx, role, approverID, requestID, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestDiscountInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestID, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountApprovalService_RequestDiscountInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestDiscountInfo'
+type DiscountApprovalService_RequestDiscountInfo_Call struct {
+	*mock.Call
+}
+
+// RequestDiscountInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestID int64
+//   - question string
+func (_e *DiscountApprovalService_Expecter) RequestDiscountInfo(ctx interface{}, role interface{}, approverID interface{}, requestID interface{}, question interface{}) *DiscountApprovalService_RequestDiscountInfo_Call {
+	return &DiscountApprovalService_RequestDiscountInfo_Call{Call: _e.mock.On("RequestDiscountInfo", ctx, role, approverID, requestID, question)}
+}
+
+func (_c *DiscountApprovalService_RequestDiscountInfo_Call) Run(run func(ctx context.Context, role string, approverID int64, requestID int64, question string)) *DiscountApprovalService_RequestDiscountInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountApprovalService_RequestDiscountInfo_Call) Return(_a0 error) *DiscountApprovalService_RequestDiscountInfo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountApprovalService_RequestDiscountInfo_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) error) *DiscountApprovalService_RequestDiscountInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeDiscount provides a mock function with given fields: ctx, role, approverID, requestID, reason
+func (_m *DiscountApprovalService) RevokeDiscount(ctx context.Context, role string, approverID int64, requestID int64, reason string) error {
+	ret := _m.Called(ctx, role, approverID, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeDiscount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountApprovalService_RevokeDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeDiscount'
+type DiscountApprovalService_RevokeDiscount_Call struct {
+	*mock.Call
+}
+
+// RevokeDiscount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestID int64
+//   - reason string
+func (_e *DiscountApprovalService_Expecter) RevokeDiscount(ctx interface{}, role interface{}, approverID interface{}, requestID interface{}, reason interface{}) *DiscountApprovalService_RevokeDiscount_Call {
+	return &DiscountApprovalService_RevokeDiscount_Call{Call: _e.mock.On("RevokeDiscount", ctx, role, approverID, requestID, reason)}
+}
+
+func (_c *DiscountApprovalService_RevokeDiscount_Call) Run(run func(ctx context.Context, role string, approverID int64, requestID int64, reason string)) *DiscountApprovalService_RevokeDiscount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountApprovalService_RevokeDiscount_Call) Return(_a0 error) *DiscountApprovalService_RevokeDiscount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountApprovalService_RevokeDiscount_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) error) *DiscountApprovalService_RevokeDiscount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDiscountApprovalService creates a new instance of DiscountApprovalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDiscountApprovalService(t interface {
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 // DiscountService is an autogenerated mock type for the DiscountService type
@@ -135,6 +137,115 @@ func (_c *DiscountService_CancelDiscount_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ResubmitDiscount provides a mock function with given fields: ctx, userID, requestID, answer
+func (_m *DiscountService) ResubmitDiscount(ctx context.Context, userID int64, requestID int64, answer string) error {
+	ret := _m.Called(ctx, userID, requestID, answer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResubmitDiscount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) error); ok {
+		r0 = rf(ctx, userID, requestID, answer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountService_ResubmitDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResubmitDiscount'
+type DiscountService_ResubmitDiscount_Call struct {
+	*mock.Call
+}
+
+// ResubmitDiscount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestID int64
+//   - answer string
+func (_e *DiscountService_Expecter) ResubmitDiscount(ctx interface{}, userID interface{}, requestID interface{}, answer interface{}) *DiscountService_ResubmitDiscount_Call {
+	return &DiscountService_ResubmitDiscount_Call{Call: _e.mock.On("ResubmitDiscount", ctx, userID, requestID, answer)}
+}
+
+func (_c *DiscountService_ResubmitDiscount_Call) Run(run func(ctx context.Context, userID int64, requestID int64, answer string)) *DiscountService_ResubmitDiscount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountService_ResubmitDiscount_Call) Return(_a0 error) *DiscountService_ResubmitDiscount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountService_ResubmitDiscount_Call) RunAndReturn(run func(context.Context, int64, int64, string) error) *DiscountService_ResubmitDiscount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateDiscount provides a mock function with given fields: ctx, userID, percent
+func (_m *DiscountService) SimulateDiscount(ctx context.Context, userID int64, percent float64) (*utils.Evaluation, error) {
+	ret := _m.Called(ctx, userID, percent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateDiscount")
+	}
+
+	var r0 *utils.Evaluation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, float64) (*utils.Evaluation, error)); ok {
+		return rf(ctx, userID, percent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, float64) *utils.Evaluation); ok {
+		r0 = rf(ctx, userID, percent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.Evaluation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, float64) error); ok {
+		r1 = rf(ctx, userID, percent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscountService_SimulateDiscount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateDiscount'
+type DiscountService_SimulateDiscount_Call struct {
+	*mock.Call
+}
+
+// SimulateDiscount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - percent float64
+func (_e *DiscountService_Expecter) SimulateDiscount(ctx interface{}, userID interface{}, percent interface{}) *DiscountService_SimulateDiscount_Call {
+	return &DiscountService_SimulateDiscount_Call{Call: _e.mock.On("SimulateDiscount", ctx, userID, percent)}
+}
+
+func (_c *DiscountService_SimulateDiscount_Call) Run(run func(ctx context.Context, userID int64, percent float64)) *DiscountService_SimulateDiscount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *DiscountService_SimulateDiscount_Call) Return(_a0 *utils.Evaluation, _a1 error) *DiscountService_SimulateDiscount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscountService_SimulateDiscount_Call) RunAndReturn(run func(context.Context, int64, float64) (*utils.Evaluation, error)) *DiscountService_SimulateDiscount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDiscountService creates a new instance of DiscountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDiscountService(t interface {
@@ -0,0 +1,217 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// UsageRepository is an autogenerated mock type for the UsageRepository type
+type UsageRepository struct {
+	mock.Mock
+}
+
+type UsageRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UsageRepository) EXPECT() *UsageRepository_Expecter {
+	return &UsageRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetApprovedDiscountTotal provides a mock function with given fields: ctx, tx, userID, since
+func (_m *UsageRepository) GetApprovedDiscountTotal(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time) (float64, error) {
+	ret := _m.Called(ctx, tx, userID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApprovedDiscountTotal")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time) (float64, error)); ok {
+		return rf(ctx, tx, userID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time) float64); ok {
+		r0 = rf(ctx, tx, userID, since)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, time.Time) error); ok {
+		r1 = rf(ctx, tx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsageRepository_GetApprovedDiscountTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApprovedDiscountTotal'
+type UsageRepository_GetApprovedDiscountTotal_Call struct {
+	*mock.Call
+}
+
+// GetApprovedDiscountTotal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - since time.Time
+func (_e *UsageRepository_Expecter) GetApprovedDiscountTotal(ctx interface{}, tx interface{}, userID interface{}, since interface{}) *UsageRepository_GetApprovedDiscountTotal_Call {
+	return &UsageRepository_GetApprovedDiscountTotal_Call{Call: _e.mock.On("GetApprovedDiscountTotal", ctx, tx, userID, since)}
+}
+
+func (_c *UsageRepository_GetApprovedDiscountTotal_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time)) *UsageRepository_GetApprovedDiscountTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UsageRepository_GetApprovedDiscountTotal_Call) Return(_a0 float64, _a1 error) *UsageRepository_GetApprovedDiscountTotal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsageRepository_GetApprovedDiscountTotal_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time) (float64, error)) *UsageRepository_GetApprovedDiscountTotal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetApprovedExpenseTotal provides a mock function with given fields: ctx, tx, userID, since
+func (_m *UsageRepository) GetApprovedExpenseTotal(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time) (float64, error) {
+	ret := _m.Called(ctx, tx, userID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApprovedExpenseTotal")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time) (float64, error)); ok {
+		return rf(ctx, tx, userID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time) float64); ok {
+		r0 = rf(ctx, tx, userID, since)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, time.Time) error); ok {
+		r1 = rf(ctx, tx, userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsageRepository_GetApprovedExpenseTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApprovedExpenseTotal'
+type UsageRepository_GetApprovedExpenseTotal_Call struct {
+	*mock.Call
+}
+
+// GetApprovedExpenseTotal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - since time.Time
+func (_e *UsageRepository_Expecter) GetApprovedExpenseTotal(ctx interface{}, tx interface{}, userID interface{}, since interface{}) *UsageRepository_GetApprovedExpenseTotal_Call {
+	return &UsageRepository_GetApprovedExpenseTotal_Call{Call: _e.mock.On("GetApprovedExpenseTotal", ctx, tx, userID, since)}
+}
+
+func (_c *UsageRepository_GetApprovedExpenseTotal_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time)) *UsageRepository_GetApprovedExpenseTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *UsageRepository_GetApprovedExpenseTotal_Call) Return(_a0 float64, _a1 error) *UsageRepository_GetApprovedExpenseTotal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsageRepository_GetApprovedExpenseTotal_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time) (float64, error)) *UsageRepository_GetApprovedExpenseTotal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaveRequestCount provides a mock function with given fields: ctx, tx, userID, since, excludeID
+func (_m *UsageRepository) GetLeaveRequestCount(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time, excludeID int64) (int, error) {
+	ret := _m.Called(ctx, tx, userID, since, excludeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaveRequestCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time, int64) (int, error)); ok {
+		return rf(ctx, tx, userID, since, excludeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, time.Time, int64) int); ok {
+		r0 = rf(ctx, tx, userID, since, excludeID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64, time.Time, int64) error); ok {
+		r1 = rf(ctx, tx, userID, since, excludeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UsageRepository_GetLeaveRequestCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaveRequestCount'
+type UsageRepository_GetLeaveRequestCount_Call struct {
+	*mock.Call
+}
+
+// GetLeaveRequestCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+//   - since time.Time
+//   - excludeID int64
+func (_e *UsageRepository_Expecter) GetLeaveRequestCount(ctx interface{}, tx interface{}, userID interface{}, since interface{}, excludeID interface{}) *UsageRepository_GetLeaveRequestCount_Call {
+	return &UsageRepository_GetLeaveRequestCount_Call{Call: _e.mock.On("GetLeaveRequestCount", ctx, tx, userID, since, excludeID)}
+}
+
+func (_c *UsageRepository_GetLeaveRequestCount_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time, excludeID int64)) *UsageRepository_GetLeaveRequestCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(time.Time), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *UsageRepository_GetLeaveRequestCount_Call) Return(_a0 int, _a1 error) *UsageRepository_GetLeaveRequestCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UsageRepository_GetLeaveRequestCount_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, time.Time, int64) (int, error)) *UsageRepository_GetLeaveRequestCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUsageRepository creates a new instance of UsageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUsageRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UsageRepository {
+	mock := &UsageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
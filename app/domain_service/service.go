@@ -2,6 +2,8 @@ package domain_service
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -15,6 +17,9 @@ type DiscountService struct {
 	balanceRepo     interfaces.BalanceRepository
 	ruleService     interfaces.RuleService
 	userRepo        interfaces.UserRepository
+	usageRepo       interfaces.UsageRepository
+	chainService    interfaces.ApprovalChainService
+	infoService     interfaces.InfoRequestService
 	db              interfaces.DB
 }
 
@@ -24,6 +29,9 @@ func NewDiscountService(
 	balanceRepo interfaces.BalanceRepository,
 	ruleService interfaces.RuleService,
 	userRepo interfaces.UserRepository,
+	usageRepo interfaces.UsageRepository,
+	chainService interfaces.ApprovalChainService,
+	infoService interfaces.InfoRequestService,
 	db interfaces.DB,
 ) interfaces.DiscountService {
 	return &DiscountService{
@@ -31,6 +39,9 @@ func NewDiscountService(
 		balanceRepo:     balanceRepo,
 		ruleService:     ruleService,
 		userRepo:        userRepo,
+		usageRepo:       usageRepo,
+		chainService:    chainService,
+		infoService:     infoService,
 		db:              db,
 	}
 }
@@ -57,32 +68,12 @@ func (s *DiscountService) ApplyDiscount(
 	}
 	defer tx.Rollback(ctx)
 
-	// fetch remaining
-	remaining, err := s.balanceRepo.GetDiscountBalance(ctx, tx, userID)
+	evaluation, err := s.evaluate(ctx, tx, userID, percent)
 	if err != nil {
 		return "", "", err
 	}
-
-	if percent > remaining {
-		return "", "", apperrors.ErrDiscountLimitExceeded
-	}
-
-	// user grade
-	gradeID, err := s.userRepo.GetGrade(ctx, tx, userID)
-	if err != nil {
-		return "", "", err
-	}
-
-	// fetch rule
-	rule, err := s.ruleService.GetRule(ctx, "DISCOUNT", gradeID)
-	if err != nil {
-		return "", "", apperrors.ErrRuleNotFound
-	}
-
-	// apply rule
-	result := utils.MakeDecision("DISCOUNT", rule.Condition, percent)
-	status := result.Status
-	message := result.Message
+	status := evaluation.Status
+	message := evaluation.Message
 
 	// create request
 	discountReq := &models.DiscountRequest{
@@ -90,7 +81,14 @@ func (s *DiscountService) ApplyDiscount(
 		DiscountPercentage: percent,
 		Reason:             reason,
 		Status:             status,
-		RuleID:             &rule.ID,
+		RuleID:             evaluation.RuleID(),
+		RuleVersionID:      evaluation.RuleVersionID(),
+		DecisionTrace:      evaluation.Trace,
+	}
+
+	// rejecting rules record their reason on the request
+	if status == constants.StatusAutoRejected {
+		discountReq.ApprovalComment = message
 	}
 
 	err = s.discountReqRepo.Create(ctx, tx, discountReq)
@@ -98,6 +96,14 @@ func (s *DiscountService) ApplyDiscount(
 		return "", "", apperrors.ErrInsertFailed
 	}
 
+	// pending requests go through the approval chain their facts select, if any
+	if status == constants.StatusPending {
+		err = s.chainService.Start(ctx, tx, "DISCOUNT", discountReq.ID, userID, evaluation.Facts)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	// deduct if auto-approved
 	if status == constants.StatusAutoApproved {
 		err = s.balanceRepo.DeductDiscountBalance(ctx, tx, userID, percent)
@@ -113,6 +119,62 @@ func (s *DiscountService) ApplyDiscount(
 	return message, status, nil
 }
 
+// runs the balance check and the rules that apply to the requester; shared by apply and simulate
+func (s *DiscountService) evaluate(ctx context.Context, tx interfaces.Tx, userID int64, percent float64) (*utils.Evaluation, error) {
+	// fetch remaining
+	remaining, err := s.balanceRepo.GetDiscountBalance(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if percent > remaining {
+		return nil, apperrors.ErrDiscountLimitExceeded
+	}
+
+	// requester grade, role, manager and department
+	subject, err := s.userRepo.GetRuleSubject(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// discounts approved this quarter, for cumulative conditions
+	quarterTotal, err := s.usageRepo.GetApprovedDiscountTotal(ctx, tx, userID, utils.QuarterStart(now))
+	if err != nil {
+		return nil, err
+	}
+
+	// evaluate rule set
+	facts := utils.DiscountFacts(percent, subject.GradeID, subject.Role, now)
+	facts[utils.AttrQuarterDiscountTotal] = quarterTotal
+	result, err := s.ruleService.Evaluate(ctx, "DISCOUNT", *subject, facts)
+	if err != nil {
+		return nil, apperrors.ErrRuleNotFound
+	}
+
+	balance := remaining
+	return &utils.Evaluation{DecisionResult: *result, Facts: facts, RemainingBalance: &balance}, nil
+}
+
+// dry-runs a discount application in a transaction that is always rolled back
+func (s *DiscountService) SimulateDiscount(ctx context.Context, userID int64, percent float64) (*utils.Evaluation, error) {
+	if userID <= 0 {
+		return nil, apperrors.ErrInvalidUser
+	}
+
+	if percent <= 0 {
+		return nil, apperrors.ErrInvalidDiscountPercent
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	return s.evaluate(ctx, tx, userID, percent)
+}
+
 func (s *DiscountService) CancelDiscount(ctx context.Context, userID, requestID int64) error {
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
@@ -139,6 +201,13 @@ func (s *DiscountService) CancelDiscount(ctx context.Context, userID, requestID
 		return apperrors.ErrUpdateFailed
 	}
 
+	if discountReq.Status == constants.StatusPending || discountReq.Status == constants.StatusNeedsInfo {
+		err = s.chainService.Close(ctx, tx, "DISCOUNT", requestID)
+		if err != nil {
+			return err
+		}
+	}
+
 	if discountReq.Status == constants.StatusAutoApproved {
 		err = s.balanceRepo.RestoreDiscountBalance(ctx, tx, userID, discountReq.DiscountPercentage)
 		if err != nil {
@@ -149,11 +218,54 @@ func (s *DiscountService) CancelDiscount(ctx context.Context, userID, requestID
 	return tx.Commit(ctx)
 }
 
+// ResubmitDiscount answers the approver's question on a discount request waiting for more information and
+// puts it back in its approver's queue
+func (s *DiscountService) ResubmitDiscount(ctx context.Context, userID, requestID int64, answer string) error {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return apperrors.ErrAnswerRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	discountReq, err := s.discountReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return apperrors.ErrDiscountRequestNotFound
+	}
+
+	if discountReq.EmployeeID != userID {
+		return apperrors.ErrDiscountRequestNotFound
+	}
+
+	if discountReq.Status != constants.StatusNeedsInfo {
+		return apperrors.ErrRequestNotAwaitingInfo
+	}
+
+	if err := s.infoService.Answer(ctx, tx, "DISCOUNT", requestID, answer); err != nil {
+		return err
+	}
+
+	if err := s.discountReqRepo.Resubmit(ctx, tx, requestID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 type DiscountApprovalService struct {
-	discountReqRepo interfaces.DiscountRequestRepository
-	balanceRepo     interfaces.BalanceRepository
-	userRepo        interfaces.UserRepository
-	db              interfaces.DB
+	discountReqRepo   interfaces.DiscountRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	userRepo          interfaces.UserRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	expiryService     interfaces.ExpiryPolicyService
+	infoService       interfaces.InfoRequestService
+	revocationService interfaces.RevocationService
+	db                interfaces.DB
 }
 
 func NewDiscountApprovalService(
@@ -161,24 +273,46 @@ func NewDiscountApprovalService(
 	discountReqRepo interfaces.DiscountRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
 	userRepo interfaces.UserRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	expiryService interfaces.ExpiryPolicyService,
+	infoService interfaces.InfoRequestService,
+	revocationService interfaces.RevocationService,
 	db interfaces.DB,
 ) interfaces.DiscountApprovalService {
 	return &DiscountApprovalService{
-		discountReqRepo: discountReqRepo,
-		balanceRepo:     balanceRepo,
-		userRepo:        userRepo,
-		db:              db,
+		discountReqRepo:   discountReqRepo,
+		balanceRepo:       balanceRepo,
+		userRepo:          userRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		expiryService:     expiryService,
+		infoService:       infoService,
+		revocationService: revocationService,
+		db:                db,
 	}
 }
 
 func (s *DiscountApprovalService) GetPendingRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error) {
+	var queue []map[string]interface{}
+	var err error
+
 	if role == constants.RoleManager {
-		return s.discountReqRepo.GetPendingForManager(ctx, approverID)
+		queue, err = s.discountReqRepo.GetPendingForManager(ctx, approverID)
+		if err != nil {
+			return nil, err
+		}
+		queue, err = s.delegationService.AddDelegatedQueues(ctx, approverID, queue, s.discountReqRepo)
 	} else if role == constants.RoleAdmin {
-		return s.discountReqRepo.GetPendingForAdmin(ctx)
+		queue, err = s.discountReqRepo.GetPendingForAdmin(ctx)
 	} else {
 		return nil, apperrors.ErrUnauthorized
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.expiryService.AddDeadlines(ctx, "DISCOUNT", queue)
 }
 
 func (s *DiscountApprovalService) ApproveDiscount(ctx context.Context, role string, approverID, requestID int64, comment string) error {
@@ -209,13 +343,34 @@ func (s *DiscountApprovalService) ApproveDiscount(ctx context.Context, role stri
 		return err
 	}
 
-	requesterRole, err := s.userRepo.GetRole(ctx, tx, discountReq.EmployeeID)
+	// requests on an approval chain are decided one step at a time
+	decision, err := s.chainService.Decide(ctx, tx, "DISCOUNT", requestID, approverID, role, constants.StatusApproved, comment)
 	if err != nil {
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
-		return err
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, discountReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, discountReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	// the request stays pending until the last step approves
+	if decision.Chained && !decision.Final {
+		return tx.Commit(ctx)
 	}
 
 	// Update request
@@ -224,6 +379,12 @@ func (s *DiscountApprovalService) ApproveDiscount(ctx context.Context, role stri
 		return err
 	}
 
+	if onBehalfOf != nil {
+		if err := s.discountReqRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -255,13 +416,29 @@ func (s *DiscountApprovalService) RejectDiscount(ctx context.Context, role strin
 		return err
 	}
 
-	requesterRole, err := s.userRepo.GetRole(ctx, tx, discountReq.EmployeeID)
+	// a rejection at any step ends the chain
+	decision, err := s.chainService.Decide(ctx, tx, "DISCOUNT", requestID, approverID, role, constants.StatusRejected, comment)
 	if err != nil {
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
-		return err
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, discountReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, discountReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
 	}
 
 	// Update request
@@ -270,9 +447,163 @@ func (s *DiscountApprovalService) RejectDiscount(ctx context.Context, role strin
 		return err
 	}
 
+	if onBehalfOf != nil {
+		if err := s.discountReqRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RequestDiscountInfo puts a pending discount request in NEEDS_INFO with a question for the requester;
+// it leaves the approvers' queues until the requester answers and resubmits it
+func (s *DiscountApprovalService) RequestDiscountInfo(ctx context.Context, role string, approverID, requestID int64, question string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return apperrors.ErrQuestionRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	discountReq, err := s.discountReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return apperrors.ErrDiscountRequestNotFound
+	}
+
+	if approverID == discountReq.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.ValidatePendingStatus(discountReq.Status); err != nil {
+		return err
+	}
+
+	// on an approval chain only whoever may decide the current step can ask
+	decision, err := s.chainService.CheckApprover(ctx, tx, "DISCOUNT", requestID, approverID, role)
+	if err != nil {
+		return err
+	}
+
+	if !decision.Chained {
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, discountReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, discountReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	if err := s.discountReqRepo.PauseForInfo(ctx, tx, requestID, question); err != nil {
+		return err
+	}
+
+	if err := s.infoService.Ask(ctx, tx, "DISCOUNT", requestID, discountReq.EmployeeID, approverID, question); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RevokeDiscount withdraws an approved discount request and gives its percentage back to the requester's balance
+func (s *DiscountApprovalService) RevokeDiscount(ctx context.Context, role string, approverID, requestID int64, reason string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return apperrors.ErrRevokeReasonRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	discountReq, err := s.discountReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return apperrors.ErrDiscountRequestNotFound
+	}
+
+	if approverID == discountReq.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.CanRevoke(discountReq.Status); err != nil {
+		return err
+	}
+
+	// whoever may approve the requester's requests may revoke them
+	approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, discountReq.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	requesterRole, err := s.userRepo.GetRole(ctx, tx, discountReq.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+		return err
+	}
+
+	if err := s.discountReqRepo.Revoke(ctx, tx, requestID, reason); err != nil {
+		return err
+	}
+
+	if err := s.balanceRepo.RestoreDiscountBalance(ctx, tx, discountReq.EmployeeID, discountReq.DiscountPercentage); err != nil {
+		return err
+	}
+
+	err = s.revocationService.Record(ctx, tx, &models.RequestRevocation{
+		RequestType:    "DISCOUNT",
+		RequestID:      requestID,
+		EmployeeID:     discountReq.EmployeeID,
+		RevokedBy:      approverID,
+		PreviousStatus: discountReq.Status,
+		Reason:         reason,
+	})
+	if err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
+// BulkApproveDiscounts approves each of the discount requests in its own transaction, with the same checks as
+// ApproveDiscount, and reports how each went
+func (s *DiscountApprovalService) BulkApproveDiscounts(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.ApproveDiscount(ctx, role, approverID, requestID, comment)
+	})
+}
+
+// BulkRejectDiscounts rejects each of the discount requests in its own transaction, with the same checks as
+// RejectDiscount, and reports how each went
+func (s *DiscountApprovalService) BulkRejectDiscounts(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.RejectDiscount(ctx, role, approverID, requestID, comment)
+	})
+}
+
 type BalanceService struct {
 	balanceRepo interfaces.BalanceRepository
 	db          interfaces.DB
@@ -252,6 +252,86 @@ func TestDiscountApprovalHandler_RejectDiscount(t *testing.T) {
 	}
 }
 
+func TestDiscountApprovalHandler_RevokeDiscount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		reqID          string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.DiscountApprovalService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			reqID:   "10",
+			reqBody: map[string]interface{}{"reason": "Granted in error"},
+			mockSetup: func(s *mocks.DiscountApprovalService) {
+				s.EXPECT().RevokeDiscount(mock.Anything, "ADMIN", int64(9), int64(10), "Granted in error").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Missing Reason",
+			reqID:   "10",
+			reqBody: map[string]interface{}{},
+			mockSetup: func(s *mocks.DiscountApprovalService) {
+				s.EXPECT().RevokeDiscount(mock.Anything, "ADMIN", int64(9), int64(10), "").Return(apperrors.ErrRevokeReasonRequired)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Not Approved",
+			reqID:   "10",
+			reqBody: map[string]interface{}{"reason": "Granted in error"},
+			mockSetup: func(s *mocks.DiscountApprovalService) {
+				s.EXPECT().RevokeDiscount(mock.Anything, "ADMIN", int64(9), int64(10), "Granted in error").Return(apperrors.ErrRequestNotApproved)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Not Found",
+			reqID:   "10",
+			reqBody: map[string]interface{}{"reason": "Granted in error"},
+			mockSetup: func(s *mocks.DiscountApprovalService) {
+				s.EXPECT().RevokeDiscount(mock.Anything, "ADMIN", int64(9), int64(10), "Granted in error").Return(apperrors.ErrDiscountRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			reqID:          "abc",
+			reqBody:        map[string]interface{}{"reason": "Granted in error"},
+			mockSetup:      func(s *mocks.DiscountApprovalService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewDiscountApprovalService(t)
+			tt.mockSetup(mockS)
+
+			handler := domain_service.NewDiscountApprovalHandler(nil, mockS)
+			r := gin.New()
+			r.POST("/revoke/:id", func(c *gin.Context) {
+				c.Set("user_id", int64(9))
+				c.Set("role", "ADMIN")
+				handler.RevokeDiscount(c)
+			})
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, "/revoke/"+tt.reqID, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
 func TestBalanceHandler_GetBalances(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
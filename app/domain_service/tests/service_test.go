@@ -6,8 +6,11 @@ import (
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -21,22 +24,25 @@ func TestDiscountService_ApplyDiscount(t *testing.T) {
 		mockBalanceRepo := mocks.NewBalanceRepository(t)
 		mockRuleService := mocks.NewRuleService(t)
 		mockUserRepo := mocks.NewUserRepository(t)
+		mockUsageRepo := mocks.NewUsageRepository(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
 		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
 		mockBalanceRepo.EXPECT().GetDiscountBalance(ctx, mockTx, userID).Return(50.0, nil)
-		mockUserRepo.EXPECT().GetGrade(ctx, mockTx, userID).Return(int64(1), nil)
-		mockRuleService.EXPECT().GetRule(ctx, "DISCOUNT", int64(1)).Return(&models.Rule{
-			ID:        1,
-			Condition: map[string]interface{}{"max_percent": 10.0},
+		mockUserRepo.EXPECT().GetRuleSubject(ctx, mockTx, userID).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsageRepo.EXPECT().GetApprovedDiscountTotal(ctx, mockTx, userID, mock.Anything).Return(0.0, nil)
+		mockRuleService.EXPECT().Evaluate(ctx, "DISCOUNT", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusAutoApproved,
+			Message: "DISCOUNT approved by system",
+			Rule:    &models.Rule{ID: 1},
 		}, nil)
 		mockDiscountRepo.EXPECT().Create(ctx, mockTx, mock.Anything).Return(nil)
 		mockBalanceRepo.EXPECT().DeductDiscountBalance(ctx, mockTx, userID, 5.0).Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockDB)
+		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockUsageRepo, nil, nil, mockDB)
 		msg, status, err := service.ApplyDiscount(ctx, userID, 5.0, "Reward")
 
 		assert.NoError(t, err)
@@ -49,6 +55,7 @@ func TestDiscountService_ApplyDiscount(t *testing.T) {
 		mockBalanceRepo := mocks.NewBalanceRepository(t)
 		mockRuleService := mocks.NewRuleService(t)
 		mockUserRepo := mocks.NewUserRepository(t)
+		mockUsageRepo := mocks.NewUsageRepository(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -56,7 +63,7 @@ func TestDiscountService_ApplyDiscount(t *testing.T) {
 		mockBalanceRepo.EXPECT().GetDiscountBalance(ctx, mockTx, userID).Return(2.0, nil)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
 
-		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockDB)
+		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockUsageRepo, nil, nil, mockDB)
 		_, _, err := service.ApplyDiscount(ctx, userID, 5.0, "Too much")
 
 		assert.ErrorIs(t, err, apperrors.ErrDiscountLimitExceeded)
@@ -67,32 +74,71 @@ func TestDiscountService_ApplyDiscount(t *testing.T) {
 		mockBalanceRepo := mocks.NewBalanceRepository(t)
 		mockRuleService := mocks.NewRuleService(t)
 		mockUserRepo := mocks.NewUserRepository(t)
+		mockUsageRepo := mocks.NewUsageRepository(t)
+		mockChainService := mocks.NewApprovalChainService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
 		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
 		mockBalanceRepo.EXPECT().GetDiscountBalance(ctx, mockTx, userID).Return(50.0, nil)
-		mockUserRepo.EXPECT().GetGrade(ctx, mockTx, userID).Return(int64(1), nil)
-		mockRuleService.EXPECT().GetRule(ctx, "DISCOUNT", int64(1)).Return(&models.Rule{
-			ID:        1,
-			Condition: map[string]interface{}{"max_percent": 1.0}, // max < percent
+		mockUserRepo.EXPECT().GetRuleSubject(ctx, mockTx, userID).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsageRepo.EXPECT().GetApprovedDiscountTotal(ctx, mockTx, userID, mock.Anything).Return(0.0, nil)
+		mockRuleService.EXPECT().Evaluate(ctx, "DISCOUNT", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusPending,
+			Message: "DISCOUNT submitted for approval",
+			Rule:    &models.Rule{ID: 1},
 		}, nil)
-		mockDiscountRepo.EXPECT().Create(ctx, mockTx, mock.Anything).Return(nil)
+		mockDiscountRepo.EXPECT().Create(ctx, mockTx, mock.Anything).Run(func(_ context.Context, _ interfaces.Tx, req *models.DiscountRequest) {
+			req.ID = 7
+		}).Return(nil)
+		mockChainService.EXPECT().Start(ctx, mockTx, "DISCOUNT", int64(7), userID, mock.Anything).Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockDB)
+		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockUsageRepo, mockChainService, nil, mockDB)
 		_, status, err := service.ApplyDiscount(ctx, userID, 5.0, "Reward")
 
 		assert.NoError(t, err)
 		assert.Equal(t, "PENDING", status)
 	})
 
+	t.Run("Auto Rejected By Rule", func(t *testing.T) {
+		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockRuleService := mocks.NewRuleService(t)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockUsageRepo := mocks.NewUsageRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockBalanceRepo.EXPECT().GetDiscountBalance(ctx, mockTx, userID).Return(80.0, nil)
+		mockUserRepo.EXPECT().GetRuleSubject(ctx, mockTx, userID).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsageRepo.EXPECT().GetApprovedDiscountTotal(ctx, mockTx, userID, mock.Anything).Return(0.0, nil)
+		mockRuleService.EXPECT().Evaluate(ctx, "DISCOUNT", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusAutoRejected,
+			Message: "Discounts above 50% are not allowed",
+			Rule:    &models.Rule{ID: 2},
+		}, nil)
+		mockDiscountRepo.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(req *models.DiscountRequest) bool {
+			return req.Status == constants.StatusAutoRejected && req.ApprovalComment == "Discounts above 50% are not allowed"
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockUsageRepo, nil, nil, mockDB)
+		msg, status, err := service.ApplyDiscount(ctx, userID, 60.0, "Bulk order")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "AUTO_REJECTED", status)
+		assert.Equal(t, "Discounts above 50% are not allowed", msg)
+	})
+
 	t.Run("DB Begin Error", func(t *testing.T) {
 		mockDB := mocks.NewDB(t)
 		mockDB.EXPECT().Begin(ctx).Return(nil, apperrors.ErrTransactionBegin)
 
-		service := domain_service.NewDiscountService(ctx, nil, nil, nil, nil, mockDB)
+		service := domain_service.NewDiscountService(ctx, nil, nil, nil, nil, nil, nil, nil, mockDB)
 		_, _, err := service.ApplyDiscount(ctx, userID, 5.0, "Fail")
 		assert.ErrorIs(t, err, apperrors.ErrTransactionBegin)
 	})
@@ -131,6 +177,8 @@ func TestDiscountApprovalService(t *testing.T) {
 		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
 		mockBalanceRepo := mocks.NewBalanceRepository(t)
 		mockUserRepo := mocks.NewUserRepository(t)
+		mockChainService := mocks.NewApprovalChainService(t)
+		mockDelegationService := mocks.NewDelegationService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -141,12 +189,38 @@ func TestDiscountApprovalService(t *testing.T) {
 			DiscountPercentage: 5.0,
 			Status:             "PENDING",
 		}, nil)
+		mockChainService.EXPECT().Decide(ctx, mockTx, "DISCOUNT", int64(10), int64(2), "MANAGER", constants.StatusApproved, "Good").Return(models.ChainDecision{}, nil)
+		mockDelegationService.EXPECT().ResolveApprover(ctx, mockTx, int64(2), "MANAGER", int64(1)).Return(&models.Approver{ID: 2, Role: "MANAGER"}, nil)
 		mockUserRepo.EXPECT().GetRole(ctx, mockTx, int64(1)).Return("EMPLOYEE", nil)
 		mockDiscountRepo.EXPECT().UpdateStatus(ctx, mockTx, int64(10), "APPROVED", int64(2), "Good").Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, mockBalanceRepo, mockUserRepo, mockDB)
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, mockBalanceRepo, mockUserRepo, mockChainService, mockDelegationService, nil, nil, nil, mockDB)
+		err := service.ApproveDiscount(ctx, "MANAGER", 2, 10, "Good")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ApproveDiscount - Chain Step Leaves Request Pending", func(t *testing.T) {
+		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockChainService := mocks.NewApprovalChainService(t)
+		mockDelegationService := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockDiscountRepo.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.DiscountRequest{
+			ID:                 10,
+			EmployeeID:         1,
+			DiscountPercentage: 30.0,
+			Status:             "PENDING",
+		}, nil)
+		mockChainService.EXPECT().Decide(ctx, mockTx, "DISCOUNT", int64(10), int64(2), "MANAGER", constants.StatusApproved, "Good").Return(models.ChainDecision{Chained: true}, nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, nil, mockChainService, mockDelegationService, nil, nil, nil, mockDB)
 		err := service.ApproveDiscount(ctx, "MANAGER", 2, 10, "Good")
 
 		assert.NoError(t, err)
@@ -161,7 +235,7 @@ func TestDiscountApprovalService(t *testing.T) {
 		mockDiscountRepo.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(nil, apperrors.ErrDatabase)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
 
-		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, nil, mockDB)
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, nil, nil, nil, nil, nil, nil, mockDB)
 		err := service.ApproveDiscount(ctx, "ADMIN", 1, 10, "OK")
 
 		assert.Error(t, err)
@@ -174,6 +248,8 @@ func TestDiscountApprovalService_RejectDiscount(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
 		mockUserRepo := mocks.NewUserRepository(t)
+		mockChainService := mocks.NewApprovalChainService(t)
+		mockDelegationService := mocks.NewDelegationService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -183,19 +259,43 @@ func TestDiscountApprovalService_RejectDiscount(t *testing.T) {
 			EmployeeID: 1,
 			Status:     "PENDING",
 		}, nil)
+		mockChainService.EXPECT().Decide(ctx, mockTx, "DISCOUNT", int64(10), int64(2), "MANAGER", constants.StatusRejected, "No").Return(models.ChainDecision{}, nil)
+		mockDelegationService.EXPECT().ResolveApprover(ctx, mockTx, int64(2), "MANAGER", int64(1)).Return(&models.Approver{ID: 2, Role: "MANAGER"}, nil)
 		mockUserRepo.EXPECT().GetRole(ctx, mockTx, int64(1)).Return("EMPLOYEE", nil)
 		mockDiscountRepo.EXPECT().UpdateStatus(ctx, mockTx, int64(10), "REJECTED", int64(2), "No").Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, mockUserRepo, mockDB)
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, mockUserRepo, mockChainService, mockDelegationService, nil, nil, nil, mockDB)
 		err := service.RejectDiscount(ctx, "MANAGER", 2, 10, "No")
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("Approver Already Decided An Earlier Step", func(t *testing.T) {
+		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockChainService := mocks.NewApprovalChainService(t)
+		mockDelegationService := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockDiscountRepo.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.DiscountRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     "PENDING",
+		}, nil)
+		mockChainService.EXPECT().Decide(ctx, mockTx, "DISCOUNT", int64(10), int64(2), "MANAGER", constants.StatusRejected, "No").Return(models.ChainDecision{}, apperrors.ErrApproverAlreadyDecided)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
+
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, nil, mockChainService, mockDelegationService, nil, nil, nil, mockDB)
+		err := service.RejectDiscount(ctx, "MANAGER", 2, 10, "No")
+
+		assert.ErrorIs(t, err, apperrors.ErrApproverAlreadyDecided)
+	})
+
 	t.Run("Unauthorized Role", func(t *testing.T) {
-		service := domain_service.NewDiscountApprovalService(ctx, nil, nil, nil, nil)
+		service := domain_service.NewDiscountApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		err := service.RejectDiscount(ctx, "EMPLOYEE", 1, 10, "No")
 
 		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
@@ -207,6 +307,7 @@ func TestDiscountService_CancelDiscount(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockChainService := mocks.NewApprovalChainService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -217,12 +318,109 @@ func TestDiscountService_CancelDiscount(t *testing.T) {
 			Status:     "PENDING",
 		}, nil)
 		mockDiscountRepo.EXPECT().Cancel(ctx, mockTx, int64(10)).Return(nil)
+		mockChainService.EXPECT().Close(ctx, mockTx, "DISCOUNT", int64(10)).Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, nil, nil, nil, mockDB)
+		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, nil, nil, nil, nil, mockChainService, nil, mockDB)
 		err := service.CancelDiscount(ctx, 1, 10)
 
 		assert.NoError(t, err)
 	})
 }
+
+func TestDiscountService_SimulateDiscount(t *testing.T) {
+	ctx := context.Background()
+	userID := int64(1)
+
+	t.Run("Success - Nothing Persisted", func(t *testing.T) {
+		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockRuleService := mocks.NewRuleService(t)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockUsageRepo := mocks.NewUsageRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockBalanceRepo.EXPECT().GetDiscountBalance(ctx, mockTx, userID).Return(50.0, nil)
+		mockUserRepo.EXPECT().GetRuleSubject(ctx, mockTx, userID).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsageRepo.EXPECT().GetApprovedDiscountTotal(ctx, mockTx, userID, mock.Anything).Return(0.0, nil)
+		mockRuleService.EXPECT().Evaluate(ctx, "DISCOUNT", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusAutoApproved,
+			Message: "DISCOUNT approved by system",
+			Rule:    &models.Rule{ID: 1},
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
+
+		service := domain_service.NewDiscountService(ctx, mockDiscountRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockUsageRepo, nil, nil, mockDB)
+		result, err := service.SimulateDiscount(ctx, userID, 5.0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "AUTO_APPROVED", result.Status)
+		assert.Equal(t, 5.0, result.Facts[utils.AttrPercent])
+	})
+
+	t.Run("Invalid Percent", func(t *testing.T) {
+		service := domain_service.NewDiscountService(ctx, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.SimulateDiscount(ctx, userID, 0)
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidDiscountPercent)
+	})
+}
+
+func TestDiscountApprovalService_RevokeDiscount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Balance Restored", func(t *testing.T) {
+		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockBalanceRepo := mocks.NewBalanceRepository(t)
+		mockUserRepo := mocks.NewUserRepository(t)
+		mockDelegationService := mocks.NewDelegationService(t)
+		mockRevocationService := mocks.NewRevocationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockDiscountRepo.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.DiscountRequest{
+			ID:                 10,
+			EmployeeID:         1,
+			DiscountPercentage: 5.0,
+			Status:             constants.StatusApproved,
+		}, nil)
+		mockDelegationService.EXPECT().ResolveApprover(ctx, mockTx, int64(2), "MANAGER", int64(1)).Return(&models.Approver{ID: 2, Role: "MANAGER"}, nil)
+		mockUserRepo.EXPECT().GetRole(ctx, mockTx, int64(1)).Return("EMPLOYEE", nil)
+		mockDiscountRepo.EXPECT().Revoke(ctx, mockTx, int64(10), "Granted in error").Return(nil)
+		mockBalanceRepo.EXPECT().RestoreDiscountBalance(ctx, mockTx, int64(1), 5.0).Return(nil)
+		mockRevocationService.EXPECT().Record(ctx, mockTx, mock.MatchedBy(func(v *models.RequestRevocation) bool {
+			return v.RequestType == "DISCOUNT" && v.RequestID == 10 && v.EmployeeID == 1 && v.RevokedBy == 2 &&
+				v.PreviousStatus == constants.StatusApproved
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil).Once()
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, mockBalanceRepo, mockUserRepo, nil, mockDelegationService, nil, nil, mockRevocationService, mockDB)
+		err := service.RevokeDiscount(ctx, "MANAGER", 2, 10, "Granted in error")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Already Revoked", func(t *testing.T) {
+		mockDiscountRepo := mocks.NewDiscountRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockDiscountRepo.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.DiscountRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusRevoked,
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := domain_service.NewDiscountApprovalService(ctx, mockDiscountRepo, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		err := service.RevokeDiscount(ctx, "ADMIN", 9, 10, "Granted in error")
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotApproved)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -109,6 +110,104 @@ func handleCancelExpenseError(c *gin.Context, err error) {
 	response.Error(c, status, err.Error(), nil)
 }
 
+// ResubmitExpense answers the approver's question on a expense request and sends it back for approval
+func (h *ExpenseHandler) ResubmitExpense(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleResubmitExpenseError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req InfoAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleResubmitExpenseError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.expenseService.ResubmitExpense(ctx, userID, requestID, req.Answer)
+	if err != nil {
+		handleResubmitExpenseError(c, err)
+		return
+	}
+
+	response.Success(c, "expense request resubmitted successfully", nil)
+}
+
+func handleResubmitExpenseError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrExpenseRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrRequestNotAwaitingInfo, apperrors.ErrInfoRequestNotFound:
+		status = http.StatusConflict
+	case apperrors.ErrAnswerRequired, apperrors.ErrInvalidID, apperrors.ErrInvalidRequestPayload:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
+
+// AmendExpense changes the amount, category or reason of a pending expense request and re-runs its rules
+func (h *ExpenseHandler) AmendExpense(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		handleAmendExpenseError(c, apperrors.ErrUnauthorizedUser)
+		return
+	}
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleAmendExpenseError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req ExpenseApplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleAmendExpenseError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	message, status, err := h.expenseService.AmendExpense(
+		ctx,
+		userID,
+		requestID,
+		req.Amount,
+		req.Category,
+		req.Reason,
+	)
+	if err != nil {
+		handleAmendExpenseError(c, err)
+		return
+	}
+
+	response.Success(c, message, gin.H{
+		"status": status,
+	})
+}
+
+func handleAmendExpenseError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrExpenseRequestNotFound, apperrors.ErrExpenseBalanceMissing, apperrors.ErrUserNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrRequestNotPending, apperrors.ErrAmendmentUnchanged:
+		status = http.StatusConflict
+	case apperrors.ErrInvalidExpenseAmount, apperrors.ErrInvalidExpenseCategory,
+		apperrors.ErrExpenseLimitExceeded, apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID:
+		status = http.StatusBadRequest
+	case apperrors.ErrUnauthorizedUser:
+		status = http.StatusUnauthorized
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
+
 // handles expense approval-related HTTP requests
 type ExpenseApprovalHandler struct {
 	expenseApprovalService interfaces.ExpenseApprovalService
@@ -199,20 +298,127 @@ func (h *ExpenseApprovalHandler) RejectExpense(c *gin.Context) {
 	response.Success(c, "expense rejected successfully", nil)
 }
 
-func handleExpenseApprovalError(c *gin.Context, err error) {
+// BulkApproveExpenses approves the listed expense requests one by one and reports each outcome
+func (h *ExpenseApprovalHandler) BulkApproveExpenses(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleExpenseApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.expenseApprovalService.BulkApproveExpenses(ctx, role, approverID, req.RequestIDs, req.Comment)
+	if err != nil {
+		handleExpenseApprovalError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, expenseApprovalErrorStatus)
+	response.Success(c, "bulk approval processed", result)
+}
+
+// BulkRejectExpenses rejects the listed expense requests one by one and reports each outcome
+func (h *ExpenseApprovalHandler) BulkRejectExpenses(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleExpenseApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	if req.Comment == "" {
+		handleExpenseApprovalError(c, apperrors.ErrCommentMissing)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.expenseApprovalService.BulkRejectExpenses(ctx, role, approverID, req.RequestIDs, req.Comment)
+	if err != nil {
+		handleExpenseApprovalError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, expenseApprovalErrorStatus)
+	response.Success(c, "bulk rejection processed", result)
+}
+
+// RequestExpenseInfo pauses a pending expense request with a question for the requester
+func (h *ExpenseApprovalHandler) RequestExpenseInfo(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleExpenseApprovalError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req InfoQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleExpenseApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.expenseApprovalService.RequestExpenseInfo(ctx, role, approverID, requestID, req.Question)
+	if err != nil {
+		handleExpenseApprovalError(c, err)
+		return
+	}
+
+	response.Success(c, "more information requested successfully", nil)
+}
+
+// RevokeExpense withdraws an approved expense request; a reason is required
+func (h *ExpenseApprovalHandler) RevokeExpense(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleExpenseApprovalError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req RevokeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleExpenseApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.expenseApprovalService.RevokeExpense(ctx, role, approverID, requestID, req.Reason)
+	if err != nil {
+		handleExpenseApprovalError(c, err)
+		return
+	}
+
+	response.Success(c, "expense request revoked successfully", nil)
+}
+
+func expenseApprovalErrorStatus(err error) int {
 	status := http.StatusInternalServerError
 
 	switch err {
-	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
-		apperrors.ErrSelfApprovalNotAllowed:
+	case apperrors.ErrEmployeeCannotApprove, apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
+		apperrors.ErrSelfApprovalNotAllowed, apperrors.ErrNotStepApprover, apperrors.ErrApproverAlreadyDecided:
 		status = http.StatusForbidden
 	case apperrors.ErrExpenseRequestNotFound, apperrors.ErrUserNotFound:
 		status = http.StatusNotFound
 	case apperrors.ErrRequestNotPending, apperrors.ErrCommentRequired,
-		apperrors.ErrCommentMissing, apperrors.ErrInvalidID,
-		apperrors.ErrInvalidRequestPayload:
+		apperrors.ErrCommentMissing, apperrors.ErrQuestionRequired, apperrors.ErrInvalidID,
+		apperrors.ErrRevokeReasonRequired, apperrors.ErrRequestNotApproved,
+		apperrors.ErrInvalidRequestPayload, apperrors.ErrBulkRequestIDsMissing, apperrors.ErrBulkTooManyRequests:
 		status = http.StatusBadRequest
 	}
+	return status
+}
 
-	response.Error(c, status, err.Error(), nil)
+func handleExpenseApprovalError(c *gin.Context, err error) {
+	response.Error(c, expenseApprovalErrorStatus(err), err.Error(), nil)
 }
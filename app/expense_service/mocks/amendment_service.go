@@ -0,0 +1,149 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// AmendmentService is an autogenerated mock type for the AmendmentService type
+type AmendmentService struct {
+	mock.Mock
+}
+
+type AmendmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AmendmentService) EXPECT() *AmendmentService_Expecter {
+	return &AmendmentService_Expecter{mock: &_m.Mock}
+}
+
+// GetAmendments provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *AmendmentService) GetAmendments(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestAmendment, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAmendments")
+	}
+
+	var r0 []models.RequestAmendment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.RequestAmendment, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.RequestAmendment); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestAmendment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmendmentService_GetAmendments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAmendments'
+type AmendmentService_GetAmendments_Call struct {
+	*mock.Call
+}
+
+// GetAmendments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *AmendmentService_Expecter) GetAmendments(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *AmendmentService_GetAmendments_Call {
+	return &AmendmentService_GetAmendments_Call{Call: _e.mock.On("GetAmendments", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *AmendmentService_GetAmendments_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *AmendmentService_GetAmendments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *AmendmentService_GetAmendments_Call) Return(_a0 []models.RequestAmendment, _a1 error) *AmendmentService_GetAmendments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AmendmentService_GetAmendments_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.RequestAmendment, error)) *AmendmentService_GetAmendments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, tx, amendment
+func (_m *AmendmentService) Record(ctx context.Context, tx interfaces.Tx, amendment *models.RequestAmendment) error {
+	ret := _m.Called(ctx, tx, amendment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestAmendment) error); ok {
+		r0 = rf(ctx, tx, amendment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AmendmentService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type AmendmentService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - amendment *models.RequestAmendment
+func (_e *AmendmentService_Expecter) Record(ctx interface{}, tx interface{}, amendment interface{}) *AmendmentService_Record_Call {
+	return &AmendmentService_Record_Call{Call: _e.mock.On("Record", ctx, tx, amendment)}
+}
+
+func (_c *AmendmentService_Record_Call) Run(run func(ctx context.Context, tx interfaces.Tx, amendment *models.RequestAmendment)) *AmendmentService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RequestAmendment))
+	})
+	return _c
+}
+
+func (_c *AmendmentService_Record_Call) Return(_a0 error) *AmendmentService_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AmendmentService_Record_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RequestAmendment) error) *AmendmentService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAmendmentService creates a new instance of AmendmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAmendmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AmendmentService {
+	mock := &AmendmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
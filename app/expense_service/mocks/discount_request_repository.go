@@ -9,8 +9,6 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
-
-	time "time"
 )
 
 // DiscountRequestRepository is an autogenerated mock type for the DiscountRequestRepository type
@@ -122,6 +120,56 @@ func (_c *DiscountRequestRepository_Create_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// Escalate provides a mock function with given fields: ctx, tx, requestID, level, escalatedTo
+func (_m *DiscountRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	ret := _m.Called(ctx, tx, requestID, level, escalatedTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Escalate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int, *int64) error); ok {
+		r0 = rf(ctx, tx, requestID, level, escalatedTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountRequestRepository_Escalate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Escalate'
+type DiscountRequestRepository_Escalate_Call struct {
+	*mock.Call
+}
+
+// Escalate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - level int
+//   - escalatedTo *int64
+func (_e *DiscountRequestRepository_Expecter) Escalate(ctx interface{}, tx interface{}, requestID interface{}, level interface{}, escalatedTo interface{}) *DiscountRequestRepository_Escalate_Call {
+	return &DiscountRequestRepository_Escalate_Call{Call: _e.mock.On("Escalate", ctx, tx, requestID, level, escalatedTo)}
+}
+
+func (_c *DiscountRequestRepository_Escalate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64)) *DiscountRequestRepository_Escalate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int), args[4].(*int64))
+	})
+	return _c
+}
+
+func (_c *DiscountRequestRepository_Escalate_Call) Return(_a0 error) *DiscountRequestRepository_Escalate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountRequestRepository_Escalate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int, *int64) error) *DiscountRequestRepository_Escalate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, tx, requestID
 func (_m *DiscountRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.DiscountRequest, error) {
 	ret := _m.Called(ctx, tx, requestID)
@@ -300,38 +348,23 @@ func (_c *DiscountRequestRepository_GetPendingForManager_Call) RunAndReturn(run
 }
 
 // GetPendingRequests provides a mock function with given fields: ctx
-func (_m *DiscountRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
+func (_m *DiscountRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingRequests")
 	}
 
-	var r0 []struct {
-		ID        int64
-		CreatedAt time.Time
-	}
+	var r0 []models.PendingRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.PendingRequest, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []struct {
-		ID        int64
-		CreatedAt time.Time
-	}); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []models.PendingRequest); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]struct {
-				ID        int64
-				CreatedAt time.Time
-			})
+			r0 = ret.Get(0).([]models.PendingRequest)
 		}
 	}
 
@@ -362,18 +395,265 @@ func (_c *DiscountRequestRepository_GetPendingRequests_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingRequests_Call) Return(_a0 []struct {
-	ID        int64
-	CreatedAt time.Time
-}, _a1 error) *DiscountRequestRepository_GetPendingRequests_Call {
+func (_c *DiscountRequestRepository_GetPendingRequests_Call) Return(_a0 []models.PendingRequest, _a1 error) *DiscountRequestRepository_GetPendingRequests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DiscountRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error)) *DiscountRequestRepository_GetPendingRequests_Call {
+func (_c *DiscountRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]models.PendingRequest, error)) *DiscountRequestRepository_GetPendingRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPending provides a mock function with given fields: ctx, tx, requestID
+func (_m *DiscountRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPending")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (bool, error)); ok {
+		return rf(ctx, tx, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) bool); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscountRequestRepository_LockPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPending'
+type DiscountRequestRepository_LockPending_Call struct {
+	*mock.Call
+}
+
+// LockPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *DiscountRequestRepository_Expecter) LockPending(ctx interface{}, tx interface{}, requestID interface{}) *DiscountRequestRepository_LockPending_Call {
+	return &DiscountRequestRepository_LockPending_Call{Call: _e.mock.On("LockPending", ctx, tx, requestID)}
+}
+
+func (_c *DiscountRequestRepository_LockPending_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *DiscountRequestRepository_LockPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DiscountRequestRepository_LockPending_Call) Return(_a0 bool, _a1 error) *DiscountRequestRepository_LockPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscountRequestRepository_LockPending_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (bool, error)) *DiscountRequestRepository_LockPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseForInfo provides a mock function with given fields: ctx, tx, requestID, question
+func (_m *DiscountRequestRepository) PauseForInfo(ctx context.Context, tx interfaces.Tx, requestID int64, question string) error {
+	ret := _m.Called(ctx, tx, requestID, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseForInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountRequestRepository_PauseForInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseForInfo'
+type DiscountRequestRepository_PauseForInfo_Call struct {
+	*mock.Call
+}
+
+// PauseForInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - question string
+func (_e *DiscountRequestRepository_Expecter) PauseForInfo(ctx interface{}, tx interface{}, requestID interface{}, question interface{}) *DiscountRequestRepository_PauseForInfo_Call {
+	return &DiscountRequestRepository_PauseForInfo_Call{Call: _e.mock.On("PauseForInfo", ctx, tx, requestID, question)}
+}
+
+func (_c *DiscountRequestRepository_PauseForInfo_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, question string)) *DiscountRequestRepository_PauseForInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountRequestRepository_PauseForInfo_Call) Return(_a0 error) *DiscountRequestRepository_PauseForInfo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountRequestRepository_PauseForInfo_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *DiscountRequestRepository_PauseForInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resubmit provides a mock function with given fields: ctx, tx, requestID
+func (_m *DiscountRequestRepository) Resubmit(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resubmit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountRequestRepository_Resubmit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resubmit'
+type DiscountRequestRepository_Resubmit_Call struct {
+	*mock.Call
+}
+
+// Resubmit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *DiscountRequestRepository_Expecter) Resubmit(ctx interface{}, tx interface{}, requestID interface{}) *DiscountRequestRepository_Resubmit_Call {
+	return &DiscountRequestRepository_Resubmit_Call{Call: _e.mock.On("Resubmit", ctx, tx, requestID)}
+}
+
+func (_c *DiscountRequestRepository_Resubmit_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *DiscountRequestRepository_Resubmit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DiscountRequestRepository_Resubmit_Call) Return(_a0 error) *DiscountRequestRepository_Resubmit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountRequestRepository_Resubmit_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *DiscountRequestRepository_Resubmit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, tx, requestID, reason
+func (_m *DiscountRequestRepository) Revoke(ctx context.Context, tx interfaces.Tx, requestID int64, reason string) error {
+	ret := _m.Called(ctx, tx, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountRequestRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type DiscountRequestRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - reason string
+func (_e *DiscountRequestRepository_Expecter) Revoke(ctx interface{}, tx interface{}, requestID interface{}, reason interface{}) *DiscountRequestRepository_Revoke_Call {
+	return &DiscountRequestRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, tx, requestID, reason)}
+}
+
+func (_c *DiscountRequestRepository_Revoke_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, reason string)) *DiscountRequestRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *DiscountRequestRepository_Revoke_Call) Return(_a0 error) *DiscountRequestRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountRequestRepository_Revoke_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *DiscountRequestRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOnBehalfOf provides a mock function with given fields: ctx, tx, requestID, delegatorID
+func (_m *DiscountRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64) error {
+	ret := _m.Called(ctx, tx, requestID, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOnBehalfOf")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, requestID, delegatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiscountRequestRepository_SetOnBehalfOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOnBehalfOf'
+type DiscountRequestRepository_SetOnBehalfOf_Call struct {
+	*mock.Call
+}
+
+// SetOnBehalfOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - delegatorID int64
+func (_e *DiscountRequestRepository_Expecter) SetOnBehalfOf(ctx interface{}, tx interface{}, requestID interface{}, delegatorID interface{}) *DiscountRequestRepository_SetOnBehalfOf_Call {
+	return &DiscountRequestRepository_SetOnBehalfOf_Call{Call: _e.mock.On("SetOnBehalfOf", ctx, tx, requestID, delegatorID)}
+}
+
+func (_c *DiscountRequestRepository_SetOnBehalfOf_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64)) *DiscountRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *DiscountRequestRepository_SetOnBehalfOf_Call) Return(_a0 error) *DiscountRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiscountRequestRepository_SetOnBehalfOf_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) error) *DiscountRequestRepository_SetOnBehalfOf_Call {
 	_c.Call.Return(run)
 	return _c
 }
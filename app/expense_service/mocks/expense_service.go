@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 // ExpenseService is an autogenerated mock type for the ExpenseService type
@@ -21,6 +23,74 @@ func (_m *ExpenseService) EXPECT() *ExpenseService_Expecter {
 	return &ExpenseService_Expecter{mock: &_m.Mock}
 }
 
+// AmendExpense provides a mock function with given fields: ctx, userID, requestID, amount, category, reason
+func (_m *ExpenseService) AmendExpense(ctx context.Context, userID int64, requestID int64, amount float64, category string, reason string) (string, string, error) {
+	ret := _m.Called(ctx, userID, requestID, amount, category, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmendExpense")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, float64, string, string) (string, string, error)); ok {
+		return rf(ctx, userID, requestID, amount, category, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, float64, string, string) string); ok {
+		r0 = rf(ctx, userID, requestID, amount, category, reason)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, float64, string, string) string); ok {
+		r1 = rf(ctx, userID, requestID, amount, category, reason)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, float64, string, string) error); ok {
+		r2 = rf(ctx, userID, requestID, amount, category, reason)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ExpenseService_AmendExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendExpense'
+type ExpenseService_AmendExpense_Call struct {
+	*mock.Call
+}
+
+// AmendExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestID int64
+//   - amount float64
+//   - category string
+//   - reason string
+func (_e *ExpenseService_Expecter) AmendExpense(ctx interface{}, userID interface{}, requestID interface{}, amount interface{}, category interface{}, reason interface{}) *ExpenseService_AmendExpense_Call {
+	return &ExpenseService_AmendExpense_Call{Call: _e.mock.On("AmendExpense", ctx, userID, requestID, amount, category, reason)}
+}
+
+func (_c *ExpenseService_AmendExpense_Call) Run(run func(ctx context.Context, userID int64, requestID int64, amount float64, category string, reason string)) *ExpenseService_AmendExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(float64), args[4].(string), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseService_AmendExpense_Call) Return(_a0 string, _a1 string, _a2 error) *ExpenseService_AmendExpense_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ExpenseService_AmendExpense_Call) RunAndReturn(run func(context.Context, int64, int64, float64, string, string) (string, string, error)) *ExpenseService_AmendExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ApplyExpense provides a mock function with given fields: ctx, userID, amount, category, reason
 func (_m *ExpenseService) ApplyExpense(ctx context.Context, userID int64, amount float64, category string, reason string) (string, string, error) {
 	ret := _m.Called(ctx, userID, amount, category, reason)
@@ -136,6 +206,116 @@ func (_c *ExpenseService_CancelExpense_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ResubmitExpense provides a mock function with given fields: ctx, userID, requestID, answer
+func (_m *ExpenseService) ResubmitExpense(ctx context.Context, userID int64, requestID int64, answer string) error {
+	ret := _m.Called(ctx, userID, requestID, answer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResubmitExpense")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) error); ok {
+		r0 = rf(ctx, userID, requestID, answer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseService_ResubmitExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResubmitExpense'
+type ExpenseService_ResubmitExpense_Call struct {
+	*mock.Call
+}
+
+// ResubmitExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestID int64
+//   - answer string
+func (_e *ExpenseService_Expecter) ResubmitExpense(ctx interface{}, userID interface{}, requestID interface{}, answer interface{}) *ExpenseService_ResubmitExpense_Call {
+	return &ExpenseService_ResubmitExpense_Call{Call: _e.mock.On("ResubmitExpense", ctx, userID, requestID, answer)}
+}
+
+func (_c *ExpenseService_ResubmitExpense_Call) Run(run func(ctx context.Context, userID int64, requestID int64, answer string)) *ExpenseService_ResubmitExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseService_ResubmitExpense_Call) Return(_a0 error) *ExpenseService_ResubmitExpense_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseService_ResubmitExpense_Call) RunAndReturn(run func(context.Context, int64, int64, string) error) *ExpenseService_ResubmitExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateExpense provides a mock function with given fields: ctx, userID, amount, category
+func (_m *ExpenseService) SimulateExpense(ctx context.Context, userID int64, amount float64, category string) (*utils.Evaluation, error) {
+	ret := _m.Called(ctx, userID, amount, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateExpense")
+	}
+
+	var r0 *utils.Evaluation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, float64, string) (*utils.Evaluation, error)); ok {
+		return rf(ctx, userID, amount, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, float64, string) *utils.Evaluation); ok {
+		r0 = rf(ctx, userID, amount, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.Evaluation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, float64, string) error); ok {
+		r1 = rf(ctx, userID, amount, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpenseService_SimulateExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateExpense'
+type ExpenseService_SimulateExpense_Call struct {
+	*mock.Call
+}
+
+// SimulateExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - amount float64
+//   - category string
+func (_e *ExpenseService_Expecter) SimulateExpense(ctx interface{}, userID interface{}, amount interface{}, category interface{}) *ExpenseService_SimulateExpense_Call {
+	return &ExpenseService_SimulateExpense_Call{Call: _e.mock.On("SimulateExpense", ctx, userID, amount, category)}
+}
+
+func (_c *ExpenseService_SimulateExpense_Call) Run(run func(ctx context.Context, userID int64, amount float64, category string)) *ExpenseService_SimulateExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(float64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseService_SimulateExpense_Call) Return(_a0 *utils.Evaluation, _a1 error) *ExpenseService_SimulateExpense_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpenseService_SimulateExpense_Call) RunAndReturn(run func(context.Context, int64, float64, string) (*utils.Evaluation, error)) *ExpenseService_SimulateExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewExpenseService creates a new instance of ExpenseService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewExpenseService(t interface {
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	utils "github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 // LeaveService is an autogenerated mock type for the LeaveService type
@@ -23,9 +25,79 @@ func (_m *LeaveService) EXPECT() *LeaveService_Expecter {
 	return &LeaveService_Expecter{mock: &_m.Mock}
 }
 
-// ApplyLeave provides a mock function with given fields: ctx, userID, from, to, days, leaveType, reason
-func (_m *LeaveService) ApplyLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string) (string, string, error) {
-	ret := _m.Called(ctx, userID, from, to, days, leaveType, reason)
+// AmendLeave provides a mock function with given fields: ctx, userID, requestID, from, to, days, leaveType, reason
+func (_m *LeaveService) AmendLeave(ctx context.Context, userID int64, requestID int64, from time.Time, to time.Time, days int, leaveType string, reason string) (string, string, error) {
+	ret := _m.Called(ctx, userID, requestID, from, to, days, leaveType, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmendLeave")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time, time.Time, int, string, string) (string, string, error)); ok {
+		return rf(ctx, userID, requestID, from, to, days, leaveType, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time, time.Time, int, string, string) string); ok {
+		r0 = rf(ctx, userID, requestID, from, to, days, leaveType, reason)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Time, time.Time, int, string, string) string); ok {
+		r1 = rf(ctx, userID, requestID, from, to, days, leaveType, reason)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, time.Time, time.Time, int, string, string) error); ok {
+		r2 = rf(ctx, userID, requestID, from, to, days, leaveType, reason)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LeaveService_AmendLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AmendLeave'
+type LeaveService_AmendLeave_Call struct {
+	*mock.Call
+}
+
+// AmendLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestID int64
+//   - from time.Time
+//   - to time.Time
+//   - days int
+//   - leaveType string
+//   - reason string
+func (_e *LeaveService_Expecter) AmendLeave(ctx interface{}, userID interface{}, requestID interface{}, from interface{}, to interface{}, days interface{}, leaveType interface{}, reason interface{}) *LeaveService_AmendLeave_Call {
+	return &LeaveService_AmendLeave_Call{Call: _e.mock.On("AmendLeave", ctx, userID, requestID, from, to, days, leaveType, reason)}
+}
+
+func (_c *LeaveService_AmendLeave_Call) Run(run func(ctx context.Context, userID int64, requestID int64, from time.Time, to time.Time, days int, leaveType string, reason string)) *LeaveService_AmendLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(time.Time), args[4].(time.Time), args[5].(int), args[6].(string), args[7].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveService_AmendLeave_Call) Return(_a0 string, _a1 string, _a2 error) *LeaveService_AmendLeave_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *LeaveService_AmendLeave_Call) RunAndReturn(run func(context.Context, int64, int64, time.Time, time.Time, int, string, string) (string, string, error)) *LeaveService_AmendLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyLeave provides a mock function with given fields: ctx, userID, from, to, days, leaveType, reason, delegateID
+func (_m *LeaveService) ApplyLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string, delegateID *int64) (string, string, error) {
+	ret := _m.Called(ctx, userID, from, to, days, leaveType, reason, delegateID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ApplyLeave")
@@ -34,23 +106,23 @@ func (_m *LeaveService) ApplyLeave(ctx context.Context, userID int64, from time.
 	var r0 string
 	var r1 string
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string, string) (string, string, error)); ok {
-		return rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string, string, *int64) (string, string, error)); ok {
+		return rf(ctx, userID, from, to, days, leaveType, reason, delegateID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string, string) string); ok {
-		r0 = rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string, string, *int64) string); ok {
+		r0 = rf(ctx, userID, from, to, days, leaveType, reason, delegateID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, int, string, string) string); ok {
-		r1 = rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, int, string, string, *int64) string); ok {
+		r1 = rf(ctx, userID, from, to, days, leaveType, reason, delegateID)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, time.Time, time.Time, int, string, string) error); ok {
-		r2 = rf(ctx, userID, from, to, days, leaveType, reason)
+	if rf, ok := ret.Get(2).(func(context.Context, int64, time.Time, time.Time, int, string, string, *int64) error); ok {
+		r2 = rf(ctx, userID, from, to, days, leaveType, reason, delegateID)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -71,13 +143,14 @@ type LeaveService_ApplyLeave_Call struct {
 //   - days int
 //   - leaveType string
 //   - reason string
-func (_e *LeaveService_Expecter) ApplyLeave(ctx interface{}, userID interface{}, from interface{}, to interface{}, days interface{}, leaveType interface{}, reason interface{}) *LeaveService_ApplyLeave_Call {
-	return &LeaveService_ApplyLeave_Call{Call: _e.mock.On("ApplyLeave", ctx, userID, from, to, days, leaveType, reason)}
+//   - delegateID *int64
+func (_e *LeaveService_Expecter) ApplyLeave(ctx interface{}, userID interface{}, from interface{}, to interface{}, days interface{}, leaveType interface{}, reason interface{}, delegateID interface{}) *LeaveService_ApplyLeave_Call {
+	return &LeaveService_ApplyLeave_Call{Call: _e.mock.On("ApplyLeave", ctx, userID, from, to, days, leaveType, reason, delegateID)}
 }
 
-func (_c *LeaveService_ApplyLeave_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string)) *LeaveService_ApplyLeave_Call {
+func (_c *LeaveService_ApplyLeave_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string, delegateID *int64)) *LeaveService_ApplyLeave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(int), args[5].(string), args[6].(string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(int), args[5].(string), args[6].(string), args[7].(*int64))
 	})
 	return _c
 }
@@ -87,7 +160,7 @@ func (_c *LeaveService_ApplyLeave_Call) Return(_a0 string, _a1 string, _a2 error
 	return _c
 }
 
-func (_c *LeaveService_ApplyLeave_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, int, string, string) (string, string, error)) *LeaveService_ApplyLeave_Call {
+func (_c *LeaveService_ApplyLeave_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, int, string, string, *int64) (string, string, error)) *LeaveService_ApplyLeave_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -140,6 +213,118 @@ func (_c *LeaveService_CancelLeave_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ResubmitLeave provides a mock function with given fields: ctx, userID, requestID, answer
+func (_m *LeaveService) ResubmitLeave(ctx context.Context, userID int64, requestID int64, answer string) error {
+	ret := _m.Called(ctx, userID, requestID, answer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResubmitLeave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) error); ok {
+		r0 = rf(ctx, userID, requestID, answer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveService_ResubmitLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResubmitLeave'
+type LeaveService_ResubmitLeave_Call struct {
+	*mock.Call
+}
+
+// ResubmitLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestID int64
+//   - answer string
+func (_e *LeaveService_Expecter) ResubmitLeave(ctx interface{}, userID interface{}, requestID interface{}, answer interface{}) *LeaveService_ResubmitLeave_Call {
+	return &LeaveService_ResubmitLeave_Call{Call: _e.mock.On("ResubmitLeave", ctx, userID, requestID, answer)}
+}
+
+func (_c *LeaveService_ResubmitLeave_Call) Run(run func(ctx context.Context, userID int64, requestID int64, answer string)) *LeaveService_ResubmitLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveService_ResubmitLeave_Call) Return(_a0 error) *LeaveService_ResubmitLeave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveService_ResubmitLeave_Call) RunAndReturn(run func(context.Context, int64, int64, string) error) *LeaveService_ResubmitLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SimulateLeave provides a mock function with given fields: ctx, userID, from, to, days, leaveType
+func (_m *LeaveService) SimulateLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string) (*utils.Evaluation, error) {
+	ret := _m.Called(ctx, userID, from, to, days, leaveType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateLeave")
+	}
+
+	var r0 *utils.Evaluation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string) (*utils.Evaluation, error)); ok {
+		return rf(ctx, userID, from, to, days, leaveType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int, string) *utils.Evaluation); ok {
+		r0 = rf(ctx, userID, from, to, days, leaveType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.Evaluation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, int, string) error); ok {
+		r1 = rf(ctx, userID, from, to, days, leaveType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveService_SimulateLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateLeave'
+type LeaveService_SimulateLeave_Call struct {
+	*mock.Call
+}
+
+// SimulateLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+//   - days int
+//   - leaveType string
+func (_e *LeaveService_Expecter) SimulateLeave(ctx interface{}, userID interface{}, from interface{}, to interface{}, days interface{}, leaveType interface{}) *LeaveService_SimulateLeave_Call {
+	return &LeaveService_SimulateLeave_Call{Call: _e.mock.On("SimulateLeave", ctx, userID, from, to, days, leaveType)}
+}
+
+func (_c *LeaveService_SimulateLeave_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string)) *LeaveService_SimulateLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(int), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveService_SimulateLeave_Call) Return(_a0 *utils.Evaluation, _a1 error) *LeaveService_SimulateLeave_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveService_SimulateLeave_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, int, string) (*utils.Evaluation, error)) *LeaveService_SimulateLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLeaveService creates a new instance of LeaveService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLeaveService(t interface {
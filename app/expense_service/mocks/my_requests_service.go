@@ -82,6 +82,67 @@ func (_c *MyRequestsService_GetMyAllRequests_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetMyRequest provides a mock function with given fields: ctx, userID, reqType, requestID
+func (_m *MyRequestsService) GetMyRequest(ctx context.Context, userID int64, reqType string, requestID int64) (map[string]interface{}, error) {
+	ret := _m.Called(ctx, userID, reqType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyRequest")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) (map[string]interface{}, error)); ok {
+		return rf(ctx, userID, reqType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) map[string]interface{}); ok {
+		r0 = rf(ctx, userID, reqType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int64) error); ok {
+		r1 = rf(ctx, userID, reqType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MyRequestsService_GetMyRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMyRequest'
+type MyRequestsService_GetMyRequest_Call struct {
+	*mock.Call
+}
+
+// GetMyRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - reqType string
+//   - requestID int64
+func (_e *MyRequestsService_Expecter) GetMyRequest(ctx interface{}, userID interface{}, reqType interface{}, requestID interface{}) *MyRequestsService_GetMyRequest_Call {
+	return &MyRequestsService_GetMyRequest_Call{Call: _e.mock.On("GetMyRequest", ctx, userID, reqType, requestID)}
+}
+
+func (_c *MyRequestsService_GetMyRequest_Call) Run(run func(ctx context.Context, userID int64, reqType string, requestID int64)) *MyRequestsService_GetMyRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MyRequestsService_GetMyRequest_Call) Return(_a0 map[string]interface{}, _a1 error) *MyRequestsService_GetMyRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MyRequestsService_GetMyRequest_Call) RunAndReturn(run func(context.Context, int64, string, int64) (map[string]interface{}, error)) *MyRequestsService_GetMyRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMyRequests provides a mock function with given fields: ctx, userID, reqType
 func (_m *MyRequestsService) GetMyRequests(ctx context.Context, userID int64, reqType string) ([]map[string]interface{}, error) {
 	ret := _m.Called(ctx, userID, reqType)
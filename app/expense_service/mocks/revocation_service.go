@@ -0,0 +1,149 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RevocationService is an autogenerated mock type for the RevocationService type
+type RevocationService struct {
+	mock.Mock
+}
+
+type RevocationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RevocationService) EXPECT() *RevocationService_Expecter {
+	return &RevocationService_Expecter{mock: &_m.Mock}
+}
+
+// GetRevocations provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *RevocationService) GetRevocations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestRevocation, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevocations")
+	}
+
+	var r0 []models.RequestRevocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.RequestRevocation, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.RequestRevocation); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestRevocation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevocationService_GetRevocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevocations'
+type RevocationService_GetRevocations_Call struct {
+	*mock.Call
+}
+
+// GetRevocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *RevocationService_Expecter) GetRevocations(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *RevocationService_GetRevocations_Call {
+	return &RevocationService_GetRevocations_Call{Call: _e.mock.On("GetRevocations", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *RevocationService_GetRevocations_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *RevocationService_GetRevocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *RevocationService_GetRevocations_Call) Return(_a0 []models.RequestRevocation, _a1 error) *RevocationService_GetRevocations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RevocationService_GetRevocations_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.RequestRevocation, error)) *RevocationService_GetRevocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, tx, revocation
+func (_m *RevocationService) Record(ctx context.Context, tx interfaces.Tx, revocation *models.RequestRevocation) error {
+	ret := _m.Called(ctx, tx, revocation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestRevocation) error); ok {
+		r0 = rf(ctx, tx, revocation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevocationService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type RevocationService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - revocation *models.RequestRevocation
+func (_e *RevocationService_Expecter) Record(ctx interface{}, tx interface{}, revocation interface{}) *RevocationService_Record_Call {
+	return &RevocationService_Record_Call{Call: _e.mock.On("Record", ctx, tx, revocation)}
+}
+
+func (_c *RevocationService_Record_Call) Run(run func(ctx context.Context, tx interfaces.Tx, revocation *models.RequestRevocation)) *RevocationService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RequestRevocation))
+	})
+	return _c
+}
+
+func (_c *RevocationService_Record_Call) Return(_a0 error) *RevocationService_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RevocationService_Record_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RequestRevocation) error) *RevocationService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRevocationService creates a new instance of RevocationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevocationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevocationService {
+	mock := &RevocationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
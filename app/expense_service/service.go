@@ -2,7 +2,9 @@ package expense_service
 
 import (
 	"context"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -13,11 +15,15 @@ import (
 
 // handles business logic for expense requests
 type ExpenseService struct {
-	expenseReqRepo interfaces.ExpenseRequestRepository
-	balanceRepo    interfaces.BalanceRepository
-	ruleService    interfaces.RuleService
-	userRepo       interfaces.UserRepository
-	db             interfaces.DB
+	expenseReqRepo   interfaces.ExpenseRequestRepository
+	balanceRepo      interfaces.BalanceRepository
+	ruleService      interfaces.RuleService
+	userRepo         interfaces.UserRepository
+	usageRepo        interfaces.UsageRepository
+	chainService     interfaces.ApprovalChainService
+	infoService      interfaces.InfoRequestService
+	amendmentService interfaces.AmendmentService
+	db               interfaces.DB
 }
 
 // creates a new instance of ExpenseService
@@ -27,14 +33,22 @@ func NewExpenseService(
 	balanceRepo interfaces.BalanceRepository,
 	ruleService interfaces.RuleService,
 	userRepo interfaces.UserRepository,
+	usageRepo interfaces.UsageRepository,
+	chainService interfaces.ApprovalChainService,
+	infoService interfaces.InfoRequestService,
+	amendmentService interfaces.AmendmentService,
 	db interfaces.DB,
 ) interfaces.ExpenseService {
 	return &ExpenseService{
-		expenseReqRepo: expenseReqRepo,
-		balanceRepo:    balanceRepo,
-		ruleService:    ruleService,
-		userRepo:       userRepo,
-		db:             db,
+		expenseReqRepo:   expenseReqRepo,
+		balanceRepo:      balanceRepo,
+		ruleService:      ruleService,
+		userRepo:         userRepo,
+		usageRepo:        usageRepo,
+		chainService:     chainService,
+		infoService:      infoService,
+		amendmentService: amendmentService,
+		db:               db,
 	}
 }
 
@@ -65,41 +79,28 @@ func (s *ExpenseService) ApplyExpense(
 	}
 	defer tx.Rollback(ctx)
 
-	// expense balance
-	remaining, err := s.balanceRepo.GetExpenseBalance(ctx, tx, userID)
-	if err != nil {
-		return "", "", err
-	}
-
-	if amount > remaining {
-		return "", "", apperrors.ErrExpenseLimitExceeded
-	}
-
-	// user grade
-	gradeID, err := s.userRepo.GetGrade(ctx, tx, userID)
+	evaluation, err := s.evaluate(ctx, tx, userID, amount, category)
 	if err != nil {
 		return "", "", err
 	}
-
-	// fetch rule
-	rule, err := s.ruleService.GetRule(ctx, "EXPENSE", gradeID)
-	if err != nil {
-		return "", "", apperrors.ErrRuleNotFound
-	}
-
-	// apply rule
-	result := utils.MakeDecision("EXPENSE", rule.Condition, amount)
-	status := result.Status
-	message := result.Message
+	status := evaluation.Status
+	message := evaluation.Message
 
 	// create request
 	expenseReq := &models.ExpenseRequest{
-		EmployeeID: userID,
-		Amount:     amount,
-		Category:   category,
-		Reason:     reason,
-		Status:     status,
-		RuleID:     &rule.ID,
+		EmployeeID:    userID,
+		Amount:        amount,
+		Category:      category,
+		Reason:        reason,
+		Status:        status,
+		RuleID:        evaluation.RuleID(),
+		RuleVersionID: evaluation.RuleVersionID(),
+		DecisionTrace: evaluation.Trace,
+	}
+
+	// rejecting rules record their reason on the request
+	if status == constants.StatusAutoRejected {
+		expenseReq.ApprovalComment = message
 	}
 
 	err = s.expenseReqRepo.Create(ctx, tx, expenseReq)
@@ -107,6 +108,14 @@ func (s *ExpenseService) ApplyExpense(
 		return "", "", apperrors.ErrInsertFailed
 	}
 
+	// pending requests go through the approval chain their facts select, if any
+	if status == constants.StatusPending {
+		err = s.chainService.Start(ctx, tx, "EXPENSE", expenseReq.ID, userID, evaluation.Facts)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	// deduct if auto-approved
 	if status == constants.StatusAutoApproved {
 		err = s.balanceRepo.DeductExpenseBalance(ctx, tx, userID, amount)
@@ -122,6 +131,66 @@ func (s *ExpenseService) ApplyExpense(
 	return message, status, nil
 }
 
+// runs the balance check and the rules that apply to the requester; shared by apply, amend and simulate
+func (s *ExpenseService) evaluate(ctx context.Context, tx interfaces.Tx, userID int64, amount float64, category string) (*utils.Evaluation, error) {
+	// expense balance
+	remaining, err := s.balanceRepo.GetExpenseBalance(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount > remaining {
+		return nil, apperrors.ErrExpenseLimitExceeded
+	}
+
+	// requester grade, role, manager and department
+	subject, err := s.userRepo.GetRuleSubject(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// expenses approved this month, for cumulative conditions
+	monthTotal, err := s.usageRepo.GetApprovedExpenseTotal(ctx, tx, userID, utils.MonthStart(now))
+	if err != nil {
+		return nil, err
+	}
+
+	// evaluate rule set
+	facts := utils.ExpenseFacts(amount, category, subject.GradeID, subject.Role, now)
+	facts[utils.AttrMonthExpenseTotal] = monthTotal
+	result, err := s.ruleService.Evaluate(ctx, "EXPENSE", *subject, facts)
+	if err != nil {
+		return nil, apperrors.ErrRuleNotFound
+	}
+
+	balance := remaining
+	return &utils.Evaluation{DecisionResult: *result, Facts: facts, RemainingBalance: &balance}, nil
+}
+
+// dry-runs a expense application in a transaction that is always rolled back
+func (s *ExpenseService) SimulateExpense(ctx context.Context, userID int64, amount float64, category string) (*utils.Evaluation, error) {
+	if userID <= 0 {
+		return nil, apperrors.ErrInvalidUser
+	}
+
+	if amount <= 0 {
+		return nil, apperrors.ErrInvalidExpenseAmount
+	}
+
+	if strings.TrimSpace(category) == "" {
+		return nil, apperrors.ErrInvalidExpenseCategory
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	return s.evaluate(ctx, tx, userID, amount, category)
+}
+
 // cancels an expense request
 func (s *ExpenseService) CancelExpense(ctx context.Context, userID, requestID int64) error {
 	tx, err := s.db.Begin(ctx)
@@ -150,6 +219,13 @@ func (s *ExpenseService) CancelExpense(ctx context.Context, userID, requestID in
 		return err
 	}
 
+	if expenseReq.Status == constants.StatusPending || expenseReq.Status == constants.StatusNeedsInfo {
+		err = s.chainService.Close(ctx, tx, "EXPENSE", requestID)
+		if err != nil {
+			return err
+		}
+	}
+
 	if expenseReq.Status == constants.StatusAutoApproved {
 		err = s.balanceRepo.RestoreExpenseBalance(ctx, tx, userID, expenseReq.Amount)
 		if err != nil {
@@ -160,12 +236,173 @@ func (s *ExpenseService) CancelExpense(ctx context.Context, userID, requestID in
 	return tx.Commit(ctx)
 }
 
+// ResubmitExpense answers the approver's question on a expense request waiting for more information and
+// puts it back in its approver's queue
+func (s *ExpenseService) ResubmitExpense(ctx context.Context, userID, requestID int64, answer string) error {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return apperrors.ErrAnswerRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	expenseReq, err := s.expenseReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if expenseReq.EmployeeID != userID {
+		return apperrors.ErrExpenseRequestNotFound
+	}
+
+	if expenseReq.Status != constants.StatusNeedsInfo {
+		return apperrors.ErrRequestNotAwaitingInfo
+	}
+
+	if err := s.infoService.Answer(ctx, tx, "EXPENSE", requestID, answer); err != nil {
+		return err
+	}
+
+	if err := s.expenseReqRepo.Resubmit(ctx, tx, requestID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// AmendExpense changes the amount, category or reason of the user's pending expense request. The
+// request goes through the same checks and rules as a new application, so an amendment may approve or
+// reject it at once; one that stays pending starts its approvals again. The values before and after are kept.
+func (s *ExpenseService) AmendExpense(
+	ctx context.Context,
+	userID, requestID int64,
+	amount float64,
+	category string,
+	reason string,
+) (string, string, error) {
+	// validations
+	if userID <= 0 {
+		return "", "", apperrors.ErrInvalidUser
+	}
+
+	if amount <= 0 {
+		return "", "", apperrors.ErrInvalidExpenseAmount
+	}
+
+	if strings.TrimSpace(category) == "" {
+		return "", "", apperrors.ErrInvalidExpenseCategory
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", "", apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	expenseReq, err := s.expenseReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Verify ownership
+	if expenseReq.EmployeeID != userID {
+		return "", "", apperrors.ErrExpenseRequestNotFound
+	}
+
+	if err := utils.ValidatePendingStatus(expenseReq.Status); err != nil {
+		return "", "", err
+	}
+
+	before := expenseAmendmentValues(expenseReq)
+	expenseReq.Amount, expenseReq.Category, expenseReq.Reason = amount, category, reason
+	after := expenseAmendmentValues(expenseReq)
+	if reflect.DeepEqual(before, after) {
+		return "", "", apperrors.ErrAmendmentUnchanged
+	}
+
+	evaluation, err := s.evaluate(ctx, tx, userID, amount, category)
+	if err != nil {
+		return "", "", err
+	}
+	status := evaluation.Status
+	message := evaluation.Message
+
+	expenseReq.Status = status
+	expenseReq.RuleID = evaluation.RuleID()
+	expenseReq.RuleVersionID = evaluation.RuleVersionID()
+	expenseReq.DecisionTrace = evaluation.Trace
+	expenseReq.ApprovalComment = ""
+
+	// rejecting rules record their reason on the request
+	if status == constants.StatusAutoRejected {
+		expenseReq.ApprovalComment = message
+	}
+
+	if err := s.expenseReqRepo.Amend(ctx, tx, expenseReq); err != nil {
+		return "", "", err
+	}
+
+	// approvals given on what the request used to say no longer count
+	if status == constants.StatusPending {
+		err = s.chainService.Restart(ctx, tx, "EXPENSE", requestID, userID, evaluation.Facts)
+	} else {
+		err = s.chainService.Close(ctx, tx, "EXPENSE", requestID)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	// deduct if auto-approved
+	if status == constants.StatusAutoApproved {
+		err = s.balanceRepo.DeductExpenseBalance(ctx, tx, userID, amount)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	err = s.amendmentService.Record(ctx, tx, &models.RequestAmendment{
+		RequestType: "EXPENSE",
+		RequestID:   requestID,
+		EmployeeID:  userID,
+		Before:      before,
+		After:       after,
+		Status:      status,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", "", apperrors.ErrTransactionCommit
+	}
+
+	return message, status, nil
+}
+
+// the fields of an expense request an amendment can change
+func expenseAmendmentValues(expenseReq *models.ExpenseRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"amount":   expenseReq.Amount,
+		"category": expenseReq.Category,
+		"reason":   expenseReq.Reason,
+	}
+}
+
 // ExpenseApprovalService handles business logic for expense approval operations
 type ExpenseApprovalService struct {
-	expenseReqRepo interfaces.ExpenseRequestRepository
-	balanceRepo    interfaces.BalanceRepository
-	userRepo       interfaces.UserRepository
-	db             interfaces.DB
+	expenseReqRepo    interfaces.ExpenseRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	userRepo          interfaces.UserRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	expiryService     interfaces.ExpiryPolicyService
+	infoService       interfaces.InfoRequestService
+	revocationService interfaces.RevocationService
+	db                interfaces.DB
 }
 
 // NewExpenseApprovalService creates a new instance of ExpenseApprovalService
@@ -174,25 +411,48 @@ func NewExpenseApprovalService(
 	expenseReqRepo interfaces.ExpenseRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
 	userRepo interfaces.UserRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	expiryService interfaces.ExpiryPolicyService,
+	infoService interfaces.InfoRequestService,
+	revocationService interfaces.RevocationService,
 	db interfaces.DB,
 ) interfaces.ExpenseApprovalService {
 	return &ExpenseApprovalService{
-		expenseReqRepo: expenseReqRepo,
-		balanceRepo:    balanceRepo,
-		userRepo:       userRepo,
-		db:             db,
+		expenseReqRepo:    expenseReqRepo,
+		balanceRepo:       balanceRepo,
+		userRepo:          userRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		expiryService:     expiryService,
+		infoService:       infoService,
+		revocationService: revocationService,
+		db:                db,
 	}
 }
 
-// GetPendingExpenseRequests retrieves pending expense requests based on role
+// GetPendingExpenseRequests retrieves pending expense requests based on role, each with its expiry
+// deadline, soonest first
 func (s *ExpenseApprovalService) GetPendingExpenseRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error) {
+	var queue []map[string]interface{}
+	var err error
+
 	if role == constants.RoleManager {
-		return s.expenseReqRepo.GetPendingForManager(ctx, approverID)
+		queue, err = s.expenseReqRepo.GetPendingForManager(ctx, approverID)
+		if err != nil {
+			return nil, err
+		}
+		queue, err = s.delegationService.AddDelegatedQueues(ctx, approverID, queue, s.expenseReqRepo)
 	} else if role == constants.RoleAdmin {
-		return s.expenseReqRepo.GetPendingForAdmin(ctx)
+		queue, err = s.expenseReqRepo.GetPendingForAdmin(ctx)
 	} else {
 		return nil, apperrors.ErrUnauthorized
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.expiryService.AddDeadlines(ctx, "EXPENSE", queue)
 }
 
 // approves an expense request
@@ -232,15 +492,36 @@ func (s *ExpenseApprovalService) ApproveExpense(
 		return err
 	}
 
-	// fetch role
-	requesterRole, err := s.userRepo.GetRole(ctx, tx, expenseReq.EmployeeID)
+	// requests on an approval chain are decided one step at a time
+	decision, err := s.chainService.Decide(ctx, tx, "EXPENSE", requestID, approverID, role, constants.StatusApproved, comment)
 	if err != nil {
 		return err
 	}
 
-	// validate role
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
-		return err
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, expenseReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		// fetch role
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, expenseReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		// validate role
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	// the request stays pending until the last step approves
+	if decision.Chained && !decision.Final {
+		return tx.Commit(ctx)
 	}
 
 	// Deduct balance
@@ -255,6 +536,12 @@ func (s *ExpenseApprovalService) ApproveExpense(
 		return err
 	}
 
+	if onBehalfOf != nil {
+		if err := s.expenseReqRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -295,15 +582,31 @@ func (s *ExpenseApprovalService) RejectExpense(
 		return err
 	}
 
-	// 4. Fetch requester role
-	requesterRole, err := s.userRepo.GetRole(ctx, tx, expenseReq.EmployeeID)
+	// 3. A rejection at any step ends the approval chain
+	decision, err := s.chainService.Decide(ctx, tx, "EXPENSE", requestID, approverID, role, constants.StatusRejected, comment)
 	if err != nil {
 		return err
 	}
 
-	// 5. Validate approver role
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
-		return err
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, expenseReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		// 4. Fetch requester role
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, expenseReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		// 5. Validate approver role
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
 	}
 
 	// 6. Default rejection comment
@@ -317,5 +620,159 @@ func (s *ExpenseApprovalService) RejectExpense(
 		return err
 	}
 
+	if onBehalfOf != nil {
+		if err := s.expenseReqRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RequestExpenseInfo puts a pending expense request in NEEDS_INFO with a question for the requester;
+// it leaves the approvers' queues until the requester answers and resubmits it
+func (s *ExpenseApprovalService) RequestExpenseInfo(ctx context.Context, role string, approverID, requestID int64, question string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return apperrors.ErrQuestionRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	expenseReq, err := s.expenseReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if approverID == expenseReq.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.ValidatePendingStatus(expenseReq.Status); err != nil {
+		return err
+	}
+
+	// on an approval chain only whoever may decide the current step can ask
+	decision, err := s.chainService.CheckApprover(ctx, tx, "EXPENSE", requestID, approverID, role)
+	if err != nil {
+		return err
+	}
+
+	if !decision.Chained {
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, expenseReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, expenseReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	if err := s.expenseReqRepo.PauseForInfo(ctx, tx, requestID, question); err != nil {
+		return err
+	}
+
+	if err := s.infoService.Ask(ctx, tx, "EXPENSE", requestID, expenseReq.EmployeeID, approverID, question); err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
+
+// RevokeExpense withdraws an approved expense request and gives its amount back to the requester's balance
+func (s *ExpenseApprovalService) RevokeExpense(ctx context.Context, role string, approverID, requestID int64, reason string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return apperrors.ErrRevokeReasonRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	expenseReq, err := s.expenseReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if approverID == expenseReq.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.CanRevoke(expenseReq.Status); err != nil {
+		return err
+	}
+
+	// whoever may approve the requester's requests may revoke them
+	approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, expenseReq.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	requesterRole, err := s.userRepo.GetRole(ctx, tx, expenseReq.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+		return err
+	}
+
+	if err := s.expenseReqRepo.Revoke(ctx, tx, requestID, reason); err != nil {
+		return err
+	}
+
+	if err := s.balanceRepo.RestoreExpenseBalance(ctx, tx, expenseReq.EmployeeID, expenseReq.Amount); err != nil {
+		return err
+	}
+
+	err = s.revocationService.Record(ctx, tx, &models.RequestRevocation{
+		RequestType:    "EXPENSE",
+		RequestID:      requestID,
+		EmployeeID:     expenseReq.EmployeeID,
+		RevokedBy:      approverID,
+		PreviousStatus: expenseReq.Status,
+		Reason:         reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// BulkApproveExpenses approves each of the expense requests in its own transaction, with the same checks as
+// ApproveExpense, and reports how each went
+func (s *ExpenseApprovalService) BulkApproveExpenses(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.ApproveExpense(ctx, role, approverID, requestID, comment)
+	})
+}
+
+// BulkRejectExpenses rejects each of the expense requests in its own transaction, with the same checks as
+// RejectExpense, and reports how each went
+func (s *ExpenseApprovalService) BulkRejectExpenses(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.RejectExpense(ctx, role, approverID, requestID, comment)
+	})
+}
@@ -3,12 +3,14 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -315,3 +317,267 @@ func TestExpenseApprovalHandler_GetPendingExpenses(t *testing.T) {
 		})
 	}
 }
+
+func TestExpenseApprovalHandler_BulkDecisions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.ExpenseApprovalService)
+		expectedStatus int
+		expectedErrors []string
+	}{
+		{
+			name:    "Approve - Partial Success Is Reported",
+			path:    "/bulk-approve",
+			reqBody: map[string]interface{}{"request_ids": []int64{10, 11}, "comment": "Month end"},
+			mockSetup: func(s *mocks.ExpenseApprovalService) {
+				s.EXPECT().BulkApproveExpenses(mock.Anything, "MANAGER", int64(2), []int64{10, 11}, "Month end").Return(&models.BulkDecision{
+					Results: []models.BulkItemResult{
+						{RequestID: 10, Success: true},
+						{RequestID: 11, Err: apperrors.ErrRequestNotPending},
+						{RequestID: 12, Err: errors.New(`ERROR: deadlock detected (SQLSTATE 40P01)`)},
+					},
+					Succeeded: 1,
+					Failed:    2,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			// failures read as they would for one request; internal ones are not spelled out
+			expectedErrors: []string{"", apperrors.ErrRequestNotPending.Error(), apperrors.ErrInternalServer.Error()},
+		},
+		{
+			name:    "Approve - No Requests",
+			path:    "/bulk-approve",
+			reqBody: map[string]interface{}{"request_ids": []int64{}},
+			mockSetup: func(s *mocks.ExpenseApprovalService) {
+				s.EXPECT().BulkApproveExpenses(mock.Anything, "MANAGER", int64(2), []int64{}, "").Return(nil, apperrors.ErrBulkRequestIDsMissing)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Reject - Success",
+			path:    "/bulk-reject",
+			reqBody: map[string]interface{}{"request_ids": []int64{10}, "comment": "Duplicate"},
+			mockSetup: func(s *mocks.ExpenseApprovalService) {
+				s.EXPECT().BulkRejectExpenses(mock.Anything, "MANAGER", int64(2), []int64{10}, "Duplicate").Return(&models.BulkDecision{Succeeded: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Reject - Missing Comment",
+			path:           "/bulk-reject",
+			reqBody:        map[string]interface{}{"request_ids": []int64{10}},
+			mockSetup:      func(s *mocks.ExpenseApprovalService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Payload",
+			path:           "/bulk-approve",
+			reqBody:        map[string]interface{}{"request_ids": "10"},
+			mockSetup:      func(s *mocks.ExpenseApprovalService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewExpenseApprovalService(t)
+			tt.mockSetup(mockService)
+
+			handler := expense_service.NewExpenseApprovalHandler(nil, mockService)
+			r := gin.New()
+			setUser := func(c *gin.Context) {
+				c.Set("user_id", int64(2))
+				c.Set("role", "MANAGER")
+			}
+			r.POST("/bulk-approve", setUser, handler.BulkApproveExpenses)
+			r.POST("/bulk-reject", setUser, handler.BulkRejectExpenses)
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedErrors != nil {
+				var resp struct {
+					Data models.BulkDecision `json:"data"`
+				}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				for i, want := range tt.expectedErrors {
+					assert.Equal(t, want, resp.Data.Results[i].Error)
+				}
+			}
+		})
+	}
+}
+
+func TestExpenseHandlers_NeedsInfo(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		reqBody        interface{}
+		mockSetup      func(a *mocks.ExpenseApprovalService, s *mocks.ExpenseService)
+		expectedStatus int
+	}{
+		{
+			name:    "Request Info - Success",
+			path:    "/request-info/10",
+			reqBody: map[string]interface{}{"question": "Please attach the receipt"},
+			mockSetup: func(a *mocks.ExpenseApprovalService, s *mocks.ExpenseService) {
+				a.EXPECT().RequestExpenseInfo(mock.Anything, "MANAGER", int64(2), int64(10), "Please attach the receipt").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Request Info - No Question",
+			path:    "/request-info/10",
+			reqBody: map[string]interface{}{},
+			mockSetup: func(a *mocks.ExpenseApprovalService, s *mocks.ExpenseService) {
+				a.EXPECT().RequestExpenseInfo(mock.Anything, "MANAGER", int64(2), int64(10), "").Return(apperrors.ErrQuestionRequired)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Resubmit - Success",
+			path:    "/resubmit/10",
+			reqBody: map[string]interface{}{"answer": "Receipt attached"},
+			mockSetup: func(a *mocks.ExpenseApprovalService, s *mocks.ExpenseService) {
+				s.EXPECT().ResubmitExpense(mock.Anything, int64(2), int64(10), "Receipt attached").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Resubmit - Not Waiting For Information",
+			path:    "/resubmit/10",
+			reqBody: map[string]interface{}{"answer": "Receipt attached"},
+			mockSetup: func(a *mocks.ExpenseApprovalService, s *mocks.ExpenseService) {
+				s.EXPECT().ResubmitExpense(mock.Anything, int64(2), int64(10), "Receipt attached").Return(apperrors.ErrRequestNotAwaitingInfo)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Resubmit - Invalid ID",
+			path:           "/resubmit/abc",
+			reqBody:        map[string]interface{}{"answer": "Receipt attached"},
+			mockSetup:      func(a *mocks.ExpenseApprovalService, s *mocks.ExpenseService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockApproval := mocks.NewExpenseApprovalService(t)
+			mockService := mocks.NewExpenseService(t)
+			tt.mockSetup(mockApproval, mockService)
+
+			approvalHandler := expense_service.NewExpenseApprovalHandler(nil, mockApproval)
+			handler := expense_service.NewExpenseHandler(nil, mockService)
+			r := gin.New()
+			setUser := func(c *gin.Context) {
+				c.Set("user_id", int64(2))
+				c.Set("role", "MANAGER")
+			}
+			r.POST("/request-info/:id", setUser, approvalHandler.RequestExpenseInfo)
+			r.POST("/resubmit/:id", setUser, handler.ResubmitExpense)
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestExpenseHandler_AmendExpense(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.ExpenseService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			path:    "/expense/10",
+			reqBody: map[string]interface{}{"amount": 50.0, "category": "TRAVEL", "reason": "Client meeting"},
+			mockSetup: func(s *mocks.ExpenseService) {
+				s.EXPECT().AmendExpense(mock.Anything, int64(1), int64(10), 50.0, "TRAVEL", "Client meeting").
+					Return("EXPENSE approved by system", "AUTO_APPROVED", nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Not Pending",
+			path:    "/expense/10",
+			reqBody: map[string]interface{}{"amount": 50.0, "category": "TRAVEL"},
+			mockSetup: func(s *mocks.ExpenseService) {
+				s.EXPECT().AmendExpense(mock.Anything, int64(1), int64(10), 50.0, "TRAVEL", "").
+					Return("", "", apperrors.ErrRequestNotPending)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:    "Limit Exceeded",
+			path:    "/expense/10",
+			reqBody: map[string]interface{}{"amount": 5000.0, "category": "TRAVEL"},
+			mockSetup: func(s *mocks.ExpenseService) {
+				s.EXPECT().AmendExpense(mock.Anything, int64(1), int64(10), 5000.0, "TRAVEL", "").
+					Return("", "", apperrors.ErrExpenseLimitExceeded)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Someone Else's Request",
+			path:    "/expense/10",
+			reqBody: map[string]interface{}{"amount": 50.0, "category": "TRAVEL"},
+			mockSetup: func(s *mocks.ExpenseService) {
+				s.EXPECT().AmendExpense(mock.Anything, int64(1), int64(10), 50.0, "TRAVEL", "").
+					Return("", "", apperrors.ErrExpenseRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			path:           "/expense/abc",
+			reqBody:        map[string]interface{}{"amount": 50.0, "category": "TRAVEL"},
+			mockSetup:      func(s *mocks.ExpenseService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewExpenseService(t)
+			tt.mockSetup(mockService)
+
+			handler := expense_service.NewExpenseHandler(nil, mockService)
+			r := gin.New()
+			r.PUT("/expense/:id", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+			}, handler.AmendExpense)
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPut, tt.path, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
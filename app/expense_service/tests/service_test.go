@@ -7,8 +7,10 @@ import (
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service/mocks"
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -23,7 +25,7 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 		amount        float64
 		category      string
 		reason        string
-		mockSetup     func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		mockSetup     func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx)
 		expectedError error
 	}{
 		{
@@ -32,13 +34,14 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 			amount:   50.0,
 			category: "TRAVEL",
 			reason:   "Client meeting",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
-				u.EXPECT().GetGrade(ctx, tx, int64(1)).Return(int64(1), nil)
-				r.EXPECT().GetRule(ctx, "EXPENSE", int64(1)).Return(&models.Rule{
-					ID:        1,
-					Condition: map[string]interface{}{"max_amount": 100.0},
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+				r.EXPECT().Evaluate(ctx, "EXPENSE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoApproved,
+					Message: "EXPENSE approved by system",
+					Rule:    &models.Rule{ID: 1},
 				}, nil)
 				e.EXPECT().Create(ctx, tx, mock.AnythingOfType("*models.ExpenseRequest")).Return(nil)
 				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 50.0).Return(nil)
@@ -52,7 +55,7 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 			userID:   1,
 			amount:   2000.0,
 			category: "EQUIPMENT",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil).Once()
@@ -64,7 +67,7 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 			userID:   1,
 			amount:   0,
 			category: "FOOD",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
 			},
 			expectedError: apperrors.ErrInvalidExpenseAmount,
 		},
@@ -73,15 +76,44 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 			userID:   1,
 			amount:   500.0,
 			category: "TRAVEL",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
-				u.EXPECT().GetGrade(ctx, tx, int64(1)).Return(int64(1), nil)
-				r.EXPECT().GetRule(ctx, "EXPENSE", int64(1)).Return(&models.Rule{
-					ID:        1,
-					Condition: map[string]interface{}{"max_amount": 100.0}, // max_amount < amount
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+				r.EXPECT().Evaluate(ctx, "EXPENSE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusPending,
+					Message: "EXPENSE submitted for approval",
+					Rule:    &models.Rule{ID: 1},
 				}, nil)
-				e.EXPECT().Create(ctx, tx, mock.Anything).Return(nil)
+				e.EXPECT().Create(ctx, tx, mock.Anything).Run(func(_ context.Context, _ interfaces.Tx, req *models.ExpenseRequest) {
+					req.ID = 42
+				}).Return(nil)
+				c.EXPECT().Start(ctx, tx, "EXPENSE", int64(42), int64(1), mock.MatchedBy(func(facts utils.Facts) bool {
+					return facts[utils.AttrAmount] == 500.0
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Auto Rejected By Rule",
+			userID:   1,
+			amount:   40.0,
+			category: "ALCOHOL",
+			reason:   "Team dinner",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+				r.EXPECT().Evaluate(ctx, "EXPENSE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoRejected,
+					Message: "Alcohol is not reimbursable",
+					Rule:    &models.Rule{ID: 2},
+				}, nil)
+				e.EXPECT().Create(ctx, tx, mock.MatchedBy(func(req *models.ExpenseRequest) bool {
+					return req.Status == constants.StatusAutoRejected && req.ApprovalComment == "Alcohol is not reimbursable"
+				})).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 			},
@@ -92,7 +124,7 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 			userID:   1,
 			amount:   50.0,
 			category: "TRAVEL",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(nil, apperrors.ErrTransactionBegin)
 			},
 			expectedError: apperrors.ErrTransactionBegin,
@@ -105,12 +137,15 @@ func TestExpenseService_ApplyExpense(t *testing.T) {
 			mockB := mocks.NewBalanceRepository(t)
 			mockR := mocks.NewRuleService(t)
 			mockU := mocks.NewUserRepository(t)
+			mockUsage := mocks.NewUsageRepository(t)
+			mockC := mocks.NewApprovalChainService(t)
 			mockDB := mocks.NewDB(t)
 			mockTx := mocks.NewTx(t)
 
-			tt.mockSetup(mockE, mockB, mockR, mockU, mockDB, mockTx)
+			tt.mockSetup(mockE, mockB, mockR, mockU, mockC, mockDB, mockTx)
+			mockUsage.EXPECT().GetApprovedExpenseTotal(ctx, mockTx, tt.userID, mock.Anything).Return(0.0, nil).Maybe()
 
-			service := expense_service.NewExpenseService(ctx, mockE, mockB, mockR, mockU, mockDB)
+			service := expense_service.NewExpenseService(ctx, mockE, mockB, mockR, mockU, mockUsage, mockC, nil, nil, mockDB)
 			_, _, err := service.ApplyExpense(ctx, tt.userID, tt.amount, tt.category, tt.reason)
 
 			if tt.expectedError != nil {
@@ -131,7 +166,7 @@ func TestExpenseApprovalService_ApproveExpense(t *testing.T) {
 		approverID    int64
 		requestID     int64
 		comment       string
-		mockSetup     func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		mockSetup     func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx)
 		expectedError error
 	}{
 		{
@@ -140,7 +175,7 @@ func TestExpenseApprovalService_ApproveExpense(t *testing.T) {
 			approverID: 3,
 			requestID:  10,
 			comment:    "Valid expense",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{
 					ID:         10,
@@ -148,6 +183,8 @@ func TestExpenseApprovalService_ApproveExpense(t *testing.T) {
 					Amount:     50.0,
 					Status:     constants.StatusPending,
 				}, nil)
+				c.EXPECT().Decide(ctx, tx, "EXPENSE", int64(10), int64(3), constants.RoleAdmin, constants.StatusApproved, "Valid expense").Return(models.ChainDecision{}, nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(3), constants.RoleAdmin, int64(1)).Return(&models.Approver{ID: 3, Role: constants.RoleAdmin}, nil)
 				u.EXPECT().GetRole(ctx, tx, int64(1)).Return(constants.RoleEmployee, nil)
 				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 50.0).Return(nil)
 				e.EXPECT().UpdateStatus(ctx, tx, int64(10), "APPROVED", int64(3), "Valid expense").Return(nil)
@@ -156,13 +193,74 @@ func TestExpenseApprovalService_ApproveExpense(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:       "Chain Step Approved - Waits For Next Step",
+			role:       constants.RoleManager,
+			approverID: 2,
+			requestID:  10,
+			comment:    "Looks right",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{
+					ID:         10,
+					EmployeeID: 1,
+					Amount:     5000.0,
+					Status:     constants.StatusPending,
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "EXPENSE", int64(10), int64(2), constants.RoleManager, constants.StatusApproved, "Looks right").Return(models.ChainDecision{Chained: true}, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Final Chain Step Approves",
+			role:       constants.RoleAdmin,
+			approverID: 3,
+			requestID:  10,
+			comment:    "Approved",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{
+					ID:         10,
+					EmployeeID: 1,
+					Amount:     5000.0,
+					Status:     constants.StatusPending,
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "EXPENSE", int64(10), int64(3), constants.RoleAdmin, constants.StatusApproved, "Approved").Return(models.ChainDecision{Chained: true, Final: true}, nil)
+				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 5000.0).Return(nil)
+				e.EXPECT().UpdateStatus(ctx, tx, int64(10), "APPROVED", int64(3), "Approved").Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Not The Step Approver",
+			role:       constants.RoleManager,
+			approverID: 4,
+			requestID:  10,
+			comment:    "OK",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{
+					ID:         10,
+					EmployeeID: 1,
+					Amount:     5000.0,
+					Status:     constants.StatusPending,
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "EXPENSE", int64(10), int64(4), constants.RoleManager, constants.StatusApproved, "OK").Return(models.ChainDecision{}, apperrors.ErrNotStepApprover)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrNotStepApprover,
+		},
 		{
 			name:       "Unauthorized Role",
 			role:       constants.RoleEmployee,
 			approverID: 1,
 			requestID:  10,
 			comment:    "Try to approve",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 			},
 			expectedError: apperrors.ErrEmployeeCannotApprove,
 		},
@@ -172,7 +270,7 @@ func TestExpenseApprovalService_ApproveExpense(t *testing.T) {
 			approverID: 3,
 			requestID:  10,
 			comment:    "OK",
-			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(nil, apperrors.ErrDatabase)
 				tx.EXPECT().Rollback(ctx).Return(nil).Once()
@@ -186,12 +284,14 @@ func TestExpenseApprovalService_ApproveExpense(t *testing.T) {
 			mockE := mocks.NewExpenseRequestRepository(t)
 			mockB := mocks.NewBalanceRepository(t)
 			mockU := mocks.NewUserRepository(t)
+			mockC := mocks.NewApprovalChainService(t)
+			mockD := mocks.NewDelegationService(t)
 			mockDB := mocks.NewDB(t)
 			mockTx := mocks.NewTx(t)
 
-			tt.mockSetup(mockE, mockB, mockU, mockDB, mockTx)
+			tt.mockSetup(mockE, mockB, mockU, mockC, mockD, mockDB, mockTx)
 
-			service := expense_service.NewExpenseApprovalService(ctx, mockE, mockB, mockU, mockDB)
+			service := expense_service.NewExpenseApprovalService(ctx, mockE, mockB, mockU, mockC, mockD, nil, nil, nil, mockDB)
 			err := service.ApproveExpense(ctx, tt.role, tt.approverID, tt.requestID, tt.comment)
 
 			if tt.expectedError != nil {
@@ -209,6 +309,8 @@ func TestExpenseApprovalService_RejectExpense(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockE := mocks.NewExpenseRequestRepository(t)
 		mockU := mocks.NewUserRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockD := mocks.NewDelegationService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -218,19 +320,21 @@ func TestExpenseApprovalService_RejectExpense(t *testing.T) {
 			EmployeeID: 1,
 			Status:     constants.StatusPending,
 		}, nil)
+		mockC.EXPECT().Decide(ctx, mockTx, "EXPENSE", int64(10), int64(2), constants.RoleManager, constants.StatusRejected, "Too high").Return(models.ChainDecision{}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
 		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleEmployee, nil)
 		mockE.EXPECT().UpdateStatus(ctx, mockTx, int64(10), "REJECTED", int64(2), "Too high").Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, mockU, mockDB)
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, mockU, mockC, mockD, nil, nil, nil, mockDB)
 		err := service.RejectExpense(ctx, constants.RoleManager, 2, 10, "Too high")
 
 		assert.NoError(t, err)
 	})
 
 	t.Run("Unauthorized Role", func(t *testing.T) {
-		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil)
+		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		err := service.RejectExpense(ctx, constants.RoleEmployee, 1, 10, "No")
 
 		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
@@ -242,6 +346,7 @@ func TestExpenseService_CancelExpense(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockE := mocks.NewExpenseRequestRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -252,10 +357,11 @@ func TestExpenseService_CancelExpense(t *testing.T) {
 			Status:     constants.StatusPending,
 		}, nil)
 		mockE.EXPECT().Cancel(ctx, mockTx, int64(10)).Return(nil)
+		mockC.EXPECT().Close(ctx, mockTx, "EXPENSE", int64(10)).Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := expense_service.NewExpenseService(ctx, mockE, nil, nil, nil, mockDB)
+		service := expense_service.NewExpenseService(ctx, mockE, nil, nil, nil, nil, mockC, nil, nil, mockDB)
 		err := service.CancelExpense(ctx, 1, 10)
 
 		assert.NoError(t, err)
@@ -270,11 +376,34 @@ func TestExpenseService_CancelExpense(t *testing.T) {
 		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(nil, apperrors.ErrExpenseRequestNotFound)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
 
-		service := expense_service.NewExpenseService(ctx, mockE, nil, nil, nil, mockDB)
+		service := expense_service.NewExpenseService(ctx, mockE, nil, nil, nil, nil, nil, nil, nil, mockDB)
 		err := service.CancelExpense(ctx, 1, 10)
 
 		assert.ErrorIs(t, err, apperrors.ErrExpenseRequestNotFound)
 	})
+
+	t.Run("Waiting For Information Closes The Chain", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusNeedsInfo,
+		}, nil)
+		mockE.EXPECT().Cancel(ctx, mockTx, int64(10)).Return(nil)
+		mockC.EXPECT().Close(ctx, mockTx, "EXPENSE", int64(10)).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := expense_service.NewExpenseService(ctx, mockE, nil, nil, nil, nil, mockC, nil, nil, mockDB)
+		err := service.CancelExpense(ctx, 1, 10)
+
+		assert.NoError(t, err)
+	})
 }
 
 func TestExpenseApprovalService_GetPendingExpenseRequests(t *testing.T) {
@@ -282,11 +411,574 @@ func TestExpenseApprovalService_GetPendingExpenseRequests(t *testing.T) {
 
 	t.Run("Success - Manager", func(t *testing.T) {
 		mockE := mocks.NewExpenseRequestRepository(t)
-		mockE.EXPECT().GetPendingForManager(ctx, int64(2)).Return([]map[string]interface{}{}, nil)
-
-		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, nil)
+		mockD := mocks.NewDelegationService(t)
+		mockX := mocks.NewExpiryPolicyService(t)
+		queue := []map[string]interface{}{}
+		mockE.EXPECT().GetPendingForManager(ctx, int64(2)).Return(queue, nil)
+		mockD.EXPECT().AddDelegatedQueues(ctx, int64(2), queue, mockE).Return(queue, nil)
+		mockX.EXPECT().AddDeadlines(ctx, "EXPENSE", queue).Return(queue, nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, nil, mockD, mockX, nil, nil, nil)
 		_, err := service.GetPendingExpenseRequests(ctx, constants.RoleManager, 2)
 
 		assert.NoError(t, err)
 	})
+
+	t.Run("Success - Admin with deadlines", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockX := mocks.NewExpiryPolicyService(t)
+		queue := []map[string]interface{}{{"id": int64(7)}}
+		withDeadlines := []map[string]interface{}{{"id": int64(7), "deadline": "2026-10-20T09:00:00Z"}}
+		mockE.EXPECT().GetPendingForAdmin(ctx).Return(queue, nil)
+		mockX.EXPECT().AddDeadlines(ctx, "EXPENSE", queue).Return(withDeadlines, nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, nil, nil, mockX, nil, nil, nil)
+		result, err := service.GetPendingExpenseRequests(ctx, constants.RoleAdmin, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, withDeadlines, result)
+	})
+}
+
+func TestExpenseService_SimulateExpense(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Nothing Persisted", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockR := mocks.NewRuleService(t)
+		mockU := mocks.NewUserRepository(t)
+		mockUsage := mocks.NewUsageRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockB.EXPECT().GetExpenseBalance(ctx, mockTx, int64(1)).Return(1000.0, nil)
+		mockU.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsage.EXPECT().GetApprovedExpenseTotal(ctx, mockTx, int64(1), mock.Anything).Return(12000.0, nil)
+		mockR.EXPECT().Evaluate(ctx, "EXPENSE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.MatchedBy(func(facts utils.Facts) bool {
+			return facts[utils.AttrMonthExpenseTotal] == 12000.0
+		})).Return(&utils.DecisionResult{
+			Status:  constants.StatusPending,
+			Message: "EXPENSE submitted for approval",
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
+
+		service := expense_service.NewExpenseService(ctx, mockE, mockB, mockR, mockU, mockUsage, nil, nil, nil, mockDB)
+		result, err := service.SimulateExpense(ctx, 1, 250.0, "TRAVEL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.StatusPending, result.Status)
+		assert.Nil(t, result.RuleID())
+		assert.Equal(t, 1000.0, *result.RemainingBalance)
+		assert.Equal(t, 12000.0, result.Facts[utils.AttrMonthExpenseTotal])
+	})
+
+	t.Run("Usage Lookup Fails", func(t *testing.T) {
+		mockB := mocks.NewBalanceRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockUsage := mocks.NewUsageRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockB.EXPECT().GetExpenseBalance(ctx, mockTx, int64(1)).Return(1000.0, nil)
+		mockU.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsage.EXPECT().GetApprovedExpenseTotal(ctx, mockTx, int64(1), mock.Anything).Return(0, apperrors.ErrQueryFailed)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
+
+		service := expense_service.NewExpenseService(ctx, nil, mockB, nil, mockU, mockUsage, nil, nil, nil, mockDB)
+		_, err := service.SimulateExpense(ctx, 1, 250.0, "TRAVEL")
+
+		assert.ErrorIs(t, err, apperrors.ErrQueryFailed)
+	})
+
+	t.Run("Invalid Category", func(t *testing.T) {
+		service := expense_service.NewExpenseService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.SimulateExpense(ctx, 1, 250.0, " ")
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidExpenseCategory)
+	})
+}
+
+func TestExpenseApprovalService_BulkApproveExpenses(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("A Failure Leaves The Others Approved", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockD := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		okTx := mocks.NewTx(t)
+		failedTx := mocks.NewTx(t)
+
+		// each request gets its own transaction
+		mockDB.EXPECT().Begin(ctx).Return(okTx, nil).Once()
+		mockDB.EXPECT().Begin(ctx).Return(failedTx, nil).Once()
+
+		mockE.EXPECT().GetByID(ctx, okTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Amount:     500,
+			Status:     constants.StatusPending,
+		}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, okTx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
+		mockU.EXPECT().GetRole(ctx, okTx, int64(1)).Return(constants.RoleEmployee, nil)
+		mockC.EXPECT().Decide(ctx, okTx, "EXPENSE", int64(10), int64(2), constants.RoleManager, constants.StatusApproved, "Month end").Return(models.ChainDecision{}, nil)
+		mockB.EXPECT().DeductExpenseBalance(ctx, okTx, int64(1), float64(500)).Return(nil)
+		mockE.EXPECT().UpdateStatus(ctx, okTx, int64(10), "APPROVED", int64(2), "Month end").Return(nil)
+		okTx.EXPECT().Commit(ctx).Return(nil)
+		okTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		mockE.EXPECT().GetByID(ctx, failedTx, int64(11)).Return(&models.ExpenseRequest{
+			ID:         11,
+			EmployeeID: 1,
+			Status:     constants.StatusApproved,
+		}, nil)
+		failedTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, mockB, mockU, mockC, mockD, nil, nil, nil, mockDB)
+		result, err := service.BulkApproveExpenses(ctx, constants.RoleManager, 2, []int64{10, 11}, "Month end")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, []models.BulkItemResult{
+			{RequestID: 10, Success: true},
+			{RequestID: 11, Success: false, Err: apperrors.ErrRequestNotPending},
+		}, result.Results)
+	})
+
+	t.Run("No Requests", func(t *testing.T) {
+		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.BulkApproveExpenses(ctx, constants.RoleManager, 2, nil, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrBulkRequestIDsMissing)
+	})
+}
+
+func TestExpenseApprovalService_RequestExpenseInfo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockD := mocks.NewDelegationService(t)
+		mockI := mocks.NewInfoRequestService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusPending,
+		}, nil)
+		mockC.EXPECT().CheckApprover(ctx, mockTx, "EXPENSE", int64(10), int64(2), constants.RoleManager).Return(models.ChainDecision{}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
+		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleEmployee, nil)
+		mockE.EXPECT().PauseForInfo(ctx, mockTx, int64(10), "Please attach the receipt").Return(nil)
+		mockI.EXPECT().Ask(ctx, mockTx, "EXPENSE", int64(10), int64(1), int64(2), "Please attach the receipt").Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, mockU, mockC, mockD, nil, mockI, nil, mockDB)
+		err := service.RequestExpenseInfo(ctx, constants.RoleManager, 2, 10, "  Please attach the receipt ")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not The Current Step Approver", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusPending,
+		}, nil)
+		mockC.EXPECT().CheckApprover(ctx, mockTx, "EXPENSE", int64(10), int64(2), constants.RoleManager).Return(models.ChainDecision{}, apperrors.ErrNotStepApprover)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, mockC, nil, nil, nil, nil, mockDB)
+		err := service.RequestExpenseInfo(ctx, constants.RoleManager, 2, 10, "Please attach the receipt")
+
+		assert.ErrorIs(t, err, apperrors.ErrNotStepApprover)
+	})
+
+	t.Run("Already Waiting For Information", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusNeedsInfo,
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		err := service.RequestExpenseInfo(ctx, constants.RoleManager, 2, 10, "Please attach the receipt")
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotPending)
+	})
+
+	t.Run("Question Required", func(t *testing.T) {
+		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := service.RequestExpenseInfo(ctx, constants.RoleManager, 2, 10, "   ")
+
+		assert.ErrorIs(t, err, apperrors.ErrQuestionRequired)
+	})
+
+	t.Run("Employee Cannot Ask", func(t *testing.T) {
+		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := service.RequestExpenseInfo(ctx, constants.RoleEmployee, 2, 10, "Please attach the receipt")
+
+		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
+	})
+}
+
+func TestExpenseService_ResubmitExpense(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		userID        int64
+		answer        string
+		mockSetup     func(e *mocks.ExpenseRequestRepository, i *mocks.InfoRequestService, db *mocks.DB, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:   "Success",
+			userID: 1,
+			answer: "Receipt attached",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, i *mocks.InfoRequestService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{ID: 10, EmployeeID: 1, Status: constants.StatusNeedsInfo}, nil)
+				i.EXPECT().Answer(ctx, tx, "EXPENSE", int64(10), "Receipt attached").Return(nil)
+				e.EXPECT().Resubmit(ctx, tx, int64(10)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+		},
+		{
+			name:   "Not Waiting For Information",
+			userID: 1,
+			answer: "Receipt attached",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, i *mocks.InfoRequestService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{ID: 10, EmployeeID: 1, Status: constants.StatusPending}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrRequestNotAwaitingInfo,
+		},
+		{
+			name:   "Someone Else's Request",
+			userID: 3,
+			answer: "Receipt attached",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, i *mocks.InfoRequestService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.ExpenseRequest{ID: 10, EmployeeID: 1, Status: constants.StatusNeedsInfo}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrExpenseRequestNotFound,
+		},
+		{
+			name:          "Answer Required",
+			userID:        1,
+			answer:        " ",
+			mockSetup:     func(e *mocks.ExpenseRequestRepository, i *mocks.InfoRequestService, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrAnswerRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockE := mocks.NewExpenseRequestRepository(t)
+			mockI := mocks.NewInfoRequestService(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockE, mockI, mockDB, mockTx)
+
+			service := expense_service.NewExpenseService(ctx, mockE, nil, nil, nil, nil, nil, mockI, nil, mockDB)
+			err := service.ResubmitExpense(ctx, tt.userID, 10, tt.answer)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExpenseService_AmendExpense(t *testing.T) {
+	ctx := context.Background()
+	subject := models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}
+	pending := func() *models.ExpenseRequest {
+		return &models.ExpenseRequest{ID: 10, EmployeeID: 1, Amount: 500.0, Category: "TRAVEL", Reason: "Client meeting", Status: constants.StatusPending}
+	}
+
+	tests := []struct {
+		name           string
+		userID         int64
+		amount         float64
+		category       string
+		mockSetup      func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx)
+		expectedStatus string
+		expectedError  error
+	}{
+		{
+			name:     "Lower Amount Now Auto Approves",
+			userID:   1,
+			amount:   50.0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(pending(), nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&subject, nil)
+				r.EXPECT().Evaluate(ctx, "EXPENSE", subject, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoApproved,
+					Message: "EXPENSE approved by system",
+					Rule:    &models.Rule{ID: 1},
+				}, nil)
+				e.EXPECT().Amend(ctx, tx, mock.MatchedBy(func(req *models.ExpenseRequest) bool {
+					return req.ID == 10 && req.Amount == 50.0 && req.Status == constants.StatusAutoApproved
+				})).Return(nil)
+				c.EXPECT().Close(ctx, tx, "EXPENSE", int64(10)).Return(nil)
+				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 50.0).Return(nil)
+				a.EXPECT().Record(ctx, tx, mock.MatchedBy(func(am *models.RequestAmendment) bool {
+					return am.RequestType == "EXPENSE" && am.RequestID == 10 &&
+						am.Before["amount"] == 500.0 && am.After["amount"] == 50.0 &&
+						am.Status == constants.StatusAutoApproved
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedStatus: constants.StatusAutoApproved,
+		},
+		{
+			name:     "Decided Since Read",
+			userID:   1,
+			amount:   50.0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(pending(), nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&subject, nil)
+				r.EXPECT().Evaluate(ctx, "EXPENSE", subject, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoApproved,
+					Message: "EXPENSE approved by system",
+					Rule:    &models.Rule{ID: 1},
+				}, nil)
+				// nothing is closed or deducted for a request no longer pending
+				e.EXPECT().Amend(ctx, tx, mock.Anything).Return(apperrors.ErrRequestNotPending)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrRequestNotPending,
+		},
+		{
+			name:     "Still Pending Restarts Approvals",
+			userID:   1,
+			amount:   600.0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(pending(), nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&subject, nil)
+				r.EXPECT().Evaluate(ctx, "EXPENSE", subject, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusPending,
+					Message: "EXPENSE submitted for approval",
+					Rule:    &models.Rule{ID: 1},
+				}, nil)
+				e.EXPECT().Amend(ctx, tx, mock.Anything).Return(nil)
+				c.EXPECT().Restart(ctx, tx, "EXPENSE", int64(10), int64(1), mock.MatchedBy(func(facts utils.Facts) bool {
+					return facts[utils.AttrAmount] == 600.0
+				})).Return(nil)
+				a.EXPECT().Record(ctx, tx, mock.Anything).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedStatus: constants.StatusPending,
+		},
+		{
+			name:     "Nothing Changed",
+			userID:   1,
+			amount:   500.0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(pending(), nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrAmendmentUnchanged,
+		},
+		{
+			name:     "Already Decided",
+			userID:   1,
+			amount:   50.0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				req := pending()
+				req.Status = constants.StatusApproved
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(req, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrRequestNotPending,
+		},
+		{
+			name:     "Someone Else's Request",
+			userID:   3,
+			amount:   50.0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				e.EXPECT().GetByID(ctx, tx, int64(10)).Return(pending(), nil)
+				tx.EXPECT().Rollback(ctx).Return(nil)
+			},
+			expectedError: apperrors.ErrExpenseRequestNotFound,
+		},
+		{
+			name:     "Invalid Amount",
+			userID:   1,
+			amount:   0,
+			category: "TRAVEL",
+			mockSetup: func(e *mocks.ExpenseRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, a *mocks.AmendmentService, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrInvalidExpenseAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockE := mocks.NewExpenseRequestRepository(t)
+			mockB := mocks.NewBalanceRepository(t)
+			mockR := mocks.NewRuleService(t)
+			mockU := mocks.NewUserRepository(t)
+			mockUsage := mocks.NewUsageRepository(t)
+			mockC := mocks.NewApprovalChainService(t)
+			mockA := mocks.NewAmendmentService(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockE, mockB, mockR, mockU, mockC, mockA, mockDB, mockTx)
+			mockUsage.EXPECT().GetApprovedExpenseTotal(ctx, mockTx, tt.userID, mock.Anything).Return(0.0, nil).Maybe()
+
+			service := expense_service.NewExpenseService(ctx, mockE, mockB, mockR, mockU, mockUsage, mockC, nil, mockA, mockDB)
+			_, status, err := service.AmendExpense(ctx, tt.userID, 10, tt.amount, tt.category, "Client meeting")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, status)
+			}
+		})
+	}
+}
+
+func TestExpenseApprovalService_RevokeExpense(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Balance Restored", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockD := mocks.NewDelegationService(t)
+		mockRv := mocks.NewRevocationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Amount:     500.0,
+			Status:     constants.StatusApproved,
+		}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
+		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleEmployee, nil)
+		mockE.EXPECT().Revoke(ctx, mockTx, int64(10), "Duplicate claim").Return(nil)
+		mockB.EXPECT().RestoreExpenseBalance(ctx, mockTx, int64(1), 500.0).Return(nil)
+		mockRv.EXPECT().Record(ctx, mockTx, &models.RequestRevocation{
+			RequestType:    "EXPENSE",
+			RequestID:      10,
+			EmployeeID:     1,
+			RevokedBy:      2,
+			PreviousStatus: constants.StatusApproved,
+			Reason:         "Duplicate claim",
+		}).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, mockB, mockU, nil, mockD, nil, nil, mockRv, mockDB)
+		err := service.RevokeExpense(ctx, constants.RoleManager, 2, 10, " Duplicate claim ")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Not Approved", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusPending,
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		err := service.RevokeExpense(ctx, constants.RoleAdmin, 2, 10, "Duplicate claim")
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotApproved)
+	})
+
+	t.Run("Own Request", func(t *testing.T) {
+		mockE := mocks.NewExpenseRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockE.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.ExpenseRequest{
+			ID:         10,
+			EmployeeID: 2,
+			Status:     constants.StatusAutoApproved,
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := expense_service.NewExpenseApprovalService(ctx, mockE, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		err := service.RevokeExpense(ctx, constants.RoleManager, 2, 10, "Duplicate claim")
+
+		assert.ErrorIs(t, err, apperrors.ErrSelfApprovalNotAllowed)
+	})
+
+	t.Run("Reason Required", func(t *testing.T) {
+		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := service.RevokeExpense(ctx, constants.RoleManager, 2, 10, "  ")
+
+		assert.ErrorIs(t, err, apperrors.ErrRevokeReasonRequired)
+	})
+
+	t.Run("Employee Cannot Revoke", func(t *testing.T) {
+		service := expense_service.NewExpenseApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		err := service.RevokeExpense(ctx, constants.RoleEmployee, 2, 10, "Duplicate claim")
+
+		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
+	})
 }
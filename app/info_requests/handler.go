@@ -0,0 +1,56 @@
+package info_requests
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for the questions asked on requests
+type InfoRequestHandler struct {
+	infoService interfaces.InfoRequestService
+}
+
+// creates a new InfoRequestHandler instance
+func NewInfoRequestHandler(ctx context.Context, infoService interfaces.InfoRequestService) *InfoRequestHandler {
+	return &InfoRequestHandler{infoService: infoService}
+}
+
+func (h *InfoRequestHandler) GetInfoRequests(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleInfoRequestError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	infos, err := h.infoService.GetInfoRequests(ctx, role, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleInfoRequestError(c, err)
+		return
+	}
+
+	response.Success(c, "information requests fetched successfully", infos)
+}
+
+func handleInfoRequestError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidID:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// InfoRequestService is an autogenerated mock type for the InfoRequestService type
+type InfoRequestService struct {
+	mock.Mock
+}
+
+type InfoRequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InfoRequestService) EXPECT() *InfoRequestService_Expecter {
+	return &InfoRequestService_Expecter{mock: &_m.Mock}
+}
+
+// Answer provides a mock function with given fields: ctx, tx, requestType, requestID, answer
+func (_m *InfoRequestService) Answer(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, answer string) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, answer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Answer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, answer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InfoRequestService_Answer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Answer'
+type InfoRequestService_Answer_Call struct {
+	*mock.Call
+}
+
+// Answer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - answer string
+func (_e *InfoRequestService_Expecter) Answer(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, answer interface{}) *InfoRequestService_Answer_Call {
+	return &InfoRequestService_Answer_Call{Call: _e.mock.On("Answer", ctx, tx, requestType, requestID, answer)}
+}
+
+func (_c *InfoRequestService_Answer_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, answer string)) *InfoRequestService_Answer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *InfoRequestService_Answer_Call) Return(_a0 error) *InfoRequestService_Answer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InfoRequestService_Answer_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, string) error) *InfoRequestService_Answer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ask provides a mock function with given fields: ctx, tx, requestType, requestID, employeeID, askedBy, question
+func (_m *InfoRequestService) Ask(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, employeeID int64, askedBy int64, question string) error {
+	ret := _m.Called(ctx, tx, requestType, requestID, employeeID, askedBy, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64, int64, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestType, requestID, employeeID, askedBy, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InfoRequestService_Ask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ask'
+type InfoRequestService_Ask_Call struct {
+	*mock.Call
+}
+
+// Ask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+//   - employeeID int64
+//   - askedBy int64
+//   - question string
+func (_e *InfoRequestService_Expecter) Ask(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}, employeeID interface{}, askedBy interface{}, question interface{}) *InfoRequestService_Ask_Call {
+	return &InfoRequestService_Ask_Call{Call: _e.mock.On("Ask", ctx, tx, requestType, requestID, employeeID, askedBy, question)}
+}
+
+func (_c *InfoRequestService_Ask_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, employeeID int64, askedBy int64, question string)) *InfoRequestService_Ask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64), args[4].(int64), args[5].(int64), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *InfoRequestService_Ask_Call) Return(_a0 error) *InfoRequestService_Ask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InfoRequestService_Ask_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64, int64, int64, string) error) *InfoRequestService_Ask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInfoRequests provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *InfoRequestService) GetInfoRequests(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.InfoRequest, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInfoRequests")
+	}
+
+	var r0 []models.InfoRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.InfoRequest, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.InfoRequest); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.InfoRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoRequestService_GetInfoRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInfoRequests'
+type InfoRequestService_GetInfoRequests_Call struct {
+	*mock.Call
+}
+
+// GetInfoRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *InfoRequestService_Expecter) GetInfoRequests(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *InfoRequestService_GetInfoRequests_Call {
+	return &InfoRequestService_GetInfoRequests_Call{Call: _e.mock.On("GetInfoRequests", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *InfoRequestService_GetInfoRequests_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *InfoRequestService_GetInfoRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *InfoRequestService_GetInfoRequests_Call) Return(_a0 []models.InfoRequest, _a1 error) *InfoRequestService_GetInfoRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoRequestService_GetInfoRequests_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.InfoRequest, error)) *InfoRequestService_GetInfoRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInfoRequestService creates a new instance of InfoRequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInfoRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InfoRequestService {
+	mock := &InfoRequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
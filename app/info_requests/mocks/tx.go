@@ -0,0 +1,330 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pgconn "github.com/jackc/pgx/v5/pgconn"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// Tx is an autogenerated mock type for the Tx type
+type Tx struct {
+	mock.Mock
+}
+
+type Tx_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Tx) EXPECT() *Tx_Expecter {
+	return &Tx_Expecter{mock: &_m.Mock}
+}
+
+// Commit provides a mock function with given fields: ctx
+func (_m *Tx) Commit(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type Tx_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Tx_Expecter) Commit(ctx interface{}) *Tx_Commit_Call {
+	return &Tx_Commit_Call{Call: _e.mock.On("Commit", ctx)}
+}
+
+func (_c *Tx_Commit_Call) Run(run func(ctx context.Context)) *Tx_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Tx_Commit_Call) Return(_a0 error) *Tx_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_Commit_Call) RunAndReturn(run func(context.Context) error) *Tx_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exec provides a mock function with given fields: ctx, sql, args
+func (_m *Tx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 pgconn.CommandTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)); ok {
+		return rf(ctx, sql, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgconn.CommandTag); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		r0 = ret.Get(0).(pgconn.CommandTag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+		r1 = rf(ctx, sql, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type Tx_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *Tx_Expecter) Exec(ctx interface{}, sql interface{}, args ...interface{}) *Tx_Exec_Call {
+	return &Tx_Exec_Call{Call: _e.mock.On("Exec",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *Tx_Exec_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *Tx_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Tx_Exec_Call) Return(_a0 pgconn.CommandTag, _a1 error) *Tx_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_Exec_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (pgconn.CommandTag, error)) *Tx_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: ctx, sql, args
+func (_m *Tx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 pgx.Rows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) (pgx.Rows, error)); ok {
+		return rf(ctx, sql, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgx.Rows); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Rows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...interface{}) error); ok {
+		r1 = rf(ctx, sql, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tx_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type Tx_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *Tx_Expecter) Query(ctx interface{}, sql interface{}, args ...interface{}) *Tx_Query_Call {
+	return &Tx_Query_Call{Call: _e.mock.On("Query",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *Tx_Query_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *Tx_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Tx_Query_Call) Return(_a0 pgx.Rows, _a1 error) *Tx_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Tx_Query_Call) RunAndReturn(run func(context.Context, string, ...interface{}) (pgx.Rows, error)) *Tx_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryRow provides a mock function with given fields: ctx, sql, args
+func (_m *Tx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sql)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryRow")
+	}
+
+	var r0 pgx.Row
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) pgx.Row); ok {
+		r0 = rf(ctx, sql, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Row)
+		}
+	}
+
+	return r0
+}
+
+// Tx_QueryRow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryRow'
+type Tx_QueryRow_Call struct {
+	*mock.Call
+}
+
+// QueryRow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sql string
+//   - args ...interface{}
+func (_e *Tx_Expecter) QueryRow(ctx interface{}, sql interface{}, args ...interface{}) *Tx_QueryRow_Call {
+	return &Tx_QueryRow_Call{Call: _e.mock.On("QueryRow",
+		append([]interface{}{ctx, sql}, args...)...)}
+}
+
+func (_c *Tx_QueryRow_Call) Run(run func(ctx context.Context, sql string, args ...interface{})) *Tx_QueryRow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Tx_QueryRow_Call) Return(_a0 pgx.Row) *Tx_QueryRow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_QueryRow_Call) RunAndReturn(run func(context.Context, string, ...interface{}) pgx.Row) *Tx_QueryRow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: ctx
+func (_m *Tx) Rollback(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Tx_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type Tx_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Tx_Expecter) Rollback(ctx interface{}) *Tx_Rollback_Call {
+	return &Tx_Rollback_Call{Call: _e.mock.On("Rollback", ctx)}
+}
+
+func (_c *Tx_Rollback_Call) Run(run func(ctx context.Context)) *Tx_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Tx_Rollback_Call) Return(_a0 error) *Tx_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Tx_Rollback_Call) RunAndReturn(run func(context.Context) error) *Tx_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTx creates a new instance of Tx. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Tx {
+	mock := &Tx{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
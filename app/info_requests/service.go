@@ -0,0 +1,53 @@
+package info_requests
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// InfoRequestService keeps the questions approvers ask on requests they pause, and the answers
+// the requesters resubmit them with
+type InfoRequestService struct {
+	infoRepo      interfaces.InfoRequestRepository
+	historyReader interfaces.RequestHistoryReader
+}
+
+// NewInfoRequestService creates a new instance of InfoRequestService
+func NewInfoRequestService(
+	ctx context.Context,
+	infoRepo interfaces.InfoRequestRepository,
+	historyReader interfaces.RequestHistoryReader,
+) interfaces.InfoRequestService {
+	return &InfoRequestService{infoRepo: infoRepo, historyReader: historyReader}
+}
+
+// Ask records an approver's question on a request they pause
+func (s *InfoRequestService) Ask(ctx context.Context, tx interfaces.Tx, requestType string, requestID, employeeID, askedBy int64, question string) error {
+	return s.infoRepo.Create(ctx, tx, &models.InfoRequest{
+		RequestType: requestType,
+		RequestID:   requestID,
+		EmployeeID:  employeeID,
+		AskedBy:     askedBy,
+		Question:    question,
+	})
+}
+
+// Answer records the requester's answer to the open question of a request they resubmit
+func (s *InfoRequestService) Answer(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64, answer string) error {
+	info, err := s.infoRepo.GetOpen(ctx, tx, requestType, requestID)
+	if err != nil {
+		return err
+	}
+	return s.infoRepo.Answer(ctx, tx, info.ID, answer)
+}
+
+// GetInfoRequests returns the questions asked on a request and their answers
+func (s *InfoRequestService) GetInfoRequests(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.InfoRequest, error) {
+	requestType, err := s.historyReader.Authorize(ctx, role, userID, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return s.infoRepo.GetForRequest(ctx, requestType, requestID)
+}
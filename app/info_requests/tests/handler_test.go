@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/info_requests"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/info_requests/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInfoRequestHandler_GetInfoRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(s *mocks.InfoRequestService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			path: "/info-requests/expense/10",
+			mockSetup: func(s *mocks.InfoRequestService) {
+				s.EXPECT().GetInfoRequests(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return([]models.InfoRequest{{ID: 4}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone Else's Request",
+			path: "/info-requests/expense/10",
+			mockSetup: func(s *mocks.InfoRequestService) {
+				s.EXPECT().GetInfoRequests(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return(nil, apperrors.ErrRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			path:           "/info-requests/expense/abc",
+			mockSetup:      func(s *mocks.InfoRequestService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewInfoRequestService(t)
+			tt.mockSetup(mockService)
+
+			handler := info_requests.NewInfoRequestHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/info-requests/:type/:id", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+				c.Set("role", "EMPLOYEE")
+			}, handler.GetInfoRequests)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
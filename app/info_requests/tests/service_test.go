@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/info_requests"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/info_requests/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInfoRequestService_Ask(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewInfoRequestRepository(t)
+	mockTx := mocks.NewTx(t)
+
+	mockRepo.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(info *models.InfoRequest) bool {
+		return info.RequestType == "EXPENSE" && info.RequestID == 10 && info.EmployeeID == 1 &&
+			info.AskedBy == 2 && info.Question == "Please attach the receipt"
+	})).Return(nil)
+
+	service := info_requests.NewInfoRequestService(ctx, mockRepo, nil)
+	err := service.Ask(ctx, mockTx, "EXPENSE", 10, 1, 2, "Please attach the receipt")
+
+	assert.NoError(t, err)
+}
+
+func TestInfoRequestService_Answer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := mocks.NewInfoRequestRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetOpen(ctx, mockTx, "EXPENSE", int64(10)).Return(&models.InfoRequest{ID: 4}, nil)
+		mockRepo.EXPECT().Answer(ctx, mockTx, int64(4), "Receipt attached").Return(nil)
+
+		service := info_requests.NewInfoRequestService(ctx, mockRepo, nil)
+		err := service.Answer(ctx, mockTx, "EXPENSE", 10, "Receipt attached")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("No Open Question", func(t *testing.T) {
+		mockRepo := mocks.NewInfoRequestRepository(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetOpen(ctx, mockTx, "EXPENSE", int64(10)).Return(nil, apperrors.ErrInfoRequestNotFound)
+
+		service := info_requests.NewInfoRequestService(ctx, mockRepo, nil)
+		err := service.Answer(ctx, mockTx, "EXPENSE", 10, "Receipt attached")
+
+		assert.ErrorIs(t, err, apperrors.ErrInfoRequestNotFound)
+	})
+}
+
+func TestInfoRequestService_GetInfoRequests(t *testing.T) {
+	ctx := context.Background()
+	history := []models.InfoRequest{{ID: 4, RequestType: "EXPENSE", RequestID: 10, EmployeeID: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := mocks.NewInfoRequestRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleManager, int64(2), "expense", int64(10)).Return("EXPENSE", nil)
+		mockRepo.EXPECT().GetForRequest(ctx, "EXPENSE", int64(10)).Return(history, nil)
+
+		service := info_requests.NewInfoRequestService(ctx, mockRepo, mockReader)
+		result, err := service.GetInfoRequests(ctx, constants.RoleManager, 2, "expense", 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, history, result)
+	})
+
+	t.Run("Not Allowed", func(t *testing.T) {
+		mockRepo := mocks.NewInfoRequestRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleEmployee, int64(3), "expense", int64(10)).Return("", apperrors.ErrRequestNotFound)
+
+		service := info_requests.NewInfoRequestService(ctx, mockRepo, mockReader)
+		_, err := service.GetInfoRequests(ctx, constants.RoleEmployee, 3, "expense", 10)
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotFound)
+	})
+}
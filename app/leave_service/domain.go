@@ -5,4 +5,35 @@ type LeaveApplyRequest struct {
 	ToDate    string `json:"to_date"`
 	LeaveType string `json:"leave_type"`
 	Reason    string `json:"reason"`
+	// an approver's delegate while they are away
+	DelegateID *int64 `json:"delegate_id"`
+}
+
+// LeaveAmendRequest carries the new values of a pending leave request
+type LeaveAmendRequest struct {
+	FromDate  string `json:"from_date"`
+	ToDate    string `json:"to_date"`
+	LeaveType string `json:"leave_type"`
+	Reason    string `json:"reason"`
+}
+
+// BulkDecisionRequest lists the requests a bulk approval or rejection decides, with one comment for all
+type BulkDecisionRequest struct {
+	RequestIDs []int64 `json:"request_ids"`
+	Comment    string  `json:"comment"`
+}
+
+// InfoQuestionRequest carries the question an approver pauses a request with
+type InfoQuestionRequest struct {
+	Question string `json:"question"`
+}
+
+// RevokeRequest carries the reason an approver or admin revokes an approved request with
+type RevokeRequest struct {
+	Reason string `json:"reason"`
+}
+
+// InfoAnswerRequest carries the requester's answer when they resubmit a paused request
+type InfoAnswerRequest struct {
+	Answer string `json:"answer"`
 }
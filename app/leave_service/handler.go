@@ -57,7 +57,7 @@ func (h *LeaveHandler) ApplyLeave(c *gin.Context) {
 
 	ctx := c.Request.Context()
 	message, status, err := h.leaveService.ApplyLeave(
-		ctx, userID, from, to, days, req.LeaveType, req.Reason,
+		ctx, userID, from, to, days, req.LeaveType, req.Reason, req.DelegateID,
 	)
 
 	if err != nil {
@@ -75,7 +75,8 @@ func handleApplyLeaveError(c *gin.Context, err error) {
 	switch err {
 	case apperrors.ErrLeaveBalanceExceeded, apperrors.ErrInvalidLeaveDays,
 		apperrors.ErrLeaveOverlap, apperrors.ErrPastDate,
-		apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidRequestPayload:
+		apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidRequestPayload,
+		apperrors.ErrDelegatorNotApprover, apperrors.ErrInvalidDelegate:
 		status = http.StatusBadRequest
 	case apperrors.ErrUserNotFound, apperrors.ErrLeaveBalanceMissing:
 		status = http.StatusNotFound
@@ -118,6 +119,118 @@ func handleCancelLeaveError(c *gin.Context, err error) {
 	response.Error(c, status, err.Error(), nil)
 }
 
+// ResubmitLeave answers the approver's question on a leave request and sends it back for approval
+func (h *LeaveHandler) ResubmitLeave(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleResubmitLeaveError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req InfoAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleResubmitLeaveError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.leaveService.ResubmitLeave(ctx, userID, requestID, req.Answer)
+	if err != nil {
+		handleResubmitLeaveError(c, err)
+		return
+	}
+
+	response.Success(c, "leave request resubmitted successfully", nil)
+}
+
+func handleResubmitLeaveError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrLeaveRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrRequestNotAwaitingInfo, apperrors.ErrInfoRequestNotFound:
+		status = http.StatusConflict
+	case apperrors.ErrAnswerRequired, apperrors.ErrInvalidID, apperrors.ErrInvalidRequestPayload:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
+
+// AmendLeave changes the dates, type or reason of a pending leave request and re-runs its rules
+func (h *LeaveHandler) AmendLeave(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		handleAmendLeaveError(c, apperrors.ErrUnauthorizedUser)
+		return
+	}
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleAmendLeaveError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req LeaveAmendRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleAmendLeaveError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	from, err := time.Parse("2006-01-02", req.FromDate)
+	if err != nil {
+		handleAmendLeaveError(c, apperrors.ErrInvalidDateFormat)
+		return
+	}
+
+	to, err := time.Parse("2006-01-02", req.ToDate)
+	if err != nil {
+		handleAmendLeaveError(c, apperrors.ErrInvalidDateFormat)
+		return
+	}
+
+	days := utils.CalculateLeaveDays(from, to)
+	if days <= 0 {
+		handleAmendLeaveError(c, apperrors.ErrInvalidLeaveDays)
+		return
+	}
+
+	ctx := c.Request.Context()
+	message, status, err := h.leaveService.AmendLeave(
+		ctx, userID, requestID, from, to, days, req.LeaveType, req.Reason,
+	)
+	if err != nil {
+		handleAmendLeaveError(c, err)
+		return
+	}
+
+	response.Success(c, message, gin.H{
+		"status": status,
+	})
+}
+
+func handleAmendLeaveError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrLeaveRequestNotFound, apperrors.ErrUserNotFound, apperrors.ErrLeaveBalanceMissing:
+		status = http.StatusNotFound
+	case apperrors.ErrRequestNotPending, apperrors.ErrAmendmentUnchanged:
+		status = http.StatusConflict
+	case apperrors.ErrLeaveBalanceExceeded, apperrors.ErrInvalidLeaveDays,
+		apperrors.ErrLeaveOverlap, apperrors.ErrPastDate, apperrors.ErrInvalidDateRange,
+		apperrors.ErrInvalidDateFormat, apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID:
+		status = http.StatusBadRequest
+	case apperrors.ErrUnauthorizedUser:
+		status = http.StatusUnauthorized
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
+
 // handles leave approval-related HTTP requests
 type LeaveApprovalHandler struct {
 	leaveApprovalService interfaces.LeaveApprovalService
@@ -202,19 +315,127 @@ func (h *LeaveApprovalHandler) RejectLeave(c *gin.Context) {
 	response.Success(c, "leave rejected successfully", nil)
 }
 
-func handleApprovalError(c *gin.Context, err error) {
+// BulkApproveLeaves approves the listed leave requests one by one and reports each outcome
+func (h *LeaveApprovalHandler) BulkApproveLeaves(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.leaveApprovalService.BulkApproveLeaves(ctx, role, approverID, req.RequestIDs, req.Comment)
+	if err != nil {
+		handleApprovalError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, approvalErrorStatus)
+	response.Success(c, "bulk approval processed", result)
+}
+
+// BulkRejectLeaves rejects the listed leave requests one by one and reports each outcome
+func (h *LeaveApprovalHandler) BulkRejectLeaves(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	if req.Comment == "" {
+		handleApprovalError(c, apperrors.ErrCommentMissing)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.leaveApprovalService.BulkRejectLeaves(ctx, role, approverID, req.RequestIDs, req.Comment)
+	if err != nil {
+		handleApprovalError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, approvalErrorStatus)
+	response.Success(c, "bulk rejection processed", result)
+}
+
+// RequestLeaveInfo pauses a pending leave request with a question for the requester
+func (h *LeaveApprovalHandler) RequestLeaveInfo(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleApprovalError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req InfoQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.leaveApprovalService.RequestLeaveInfo(ctx, role, approverID, requestID, req.Question)
+	if err != nil {
+		handleApprovalError(c, err)
+		return
+	}
+
+	response.Success(c, "more information requested successfully", nil)
+}
+
+// RevokeLeave withdraws an approved leave request; a reason is required
+func (h *LeaveApprovalHandler) RevokeLeave(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleApprovalError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var req RevokeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleApprovalError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.leaveApprovalService.RevokeLeave(ctx, role, approverID, requestID, req.Reason)
+	if err != nil {
+		handleApprovalError(c, err)
+		return
+	}
+
+	response.Success(c, "leave request revoked successfully", nil)
+}
+
+func approvalErrorStatus(err error) int {
 	status := http.StatusInternalServerError
 
 	switch err {
-	case apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole, apperrors.ErrSelfApprovalNotAllowed:
+	case apperrors.ErrEmployeeCannotApprove, apperrors.ErrUnauthorizedApprover, apperrors.ErrUnauthorizedRole,
+		apperrors.ErrSelfApprovalNotAllowed, apperrors.ErrNotStepApprover, apperrors.ErrApproverAlreadyDecided:
 		status = http.StatusForbidden
 	case apperrors.ErrLeaveRequestNotFound, apperrors.ErrUserNotFound:
 		status = http.StatusNotFound
 	case apperrors.ErrRequestNotPending, apperrors.ErrCommentRequired,
-		apperrors.ErrCommentMissing, apperrors.ErrInvalidID,
-		apperrors.ErrInvalidRequestPayload:
+		apperrors.ErrCommentMissing, apperrors.ErrQuestionRequired, apperrors.ErrInvalidID,
+		apperrors.ErrRevokeReasonRequired, apperrors.ErrRequestNotApproved, apperrors.ErrLeaveAlreadyStarted,
+		apperrors.ErrInvalidRequestPayload, apperrors.ErrBulkRequestIDsMissing, apperrors.ErrBulkTooManyRequests:
 		status = http.StatusBadRequest
 	}
+	return status
+}
 
-	response.Error(c, status, err.Error(), nil)
+func handleApprovalError(c *gin.Context, err error) {
+	response.Error(c, approvalErrorStatus(err), err.Error(), nil)
 }
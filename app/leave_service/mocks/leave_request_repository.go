@@ -26,6 +26,54 @@ func (_m *LeaveRequestRepository) EXPECT() *LeaveRequestRepository_Expecter {
 	return &LeaveRequestRepository_Expecter{mock: &_m.Mock}
 }
 
+// Amend provides a mock function with given fields: ctx, tx, req
+func (_m *LeaveRequestRepository) Amend(ctx context.Context, tx interfaces.Tx, req *models.LeaveRequest) error {
+	ret := _m.Called(ctx, tx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Amend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.LeaveRequest) error); ok {
+		r0 = rf(ctx, tx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveRequestRepository_Amend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Amend'
+type LeaveRequestRepository_Amend_Call struct {
+	*mock.Call
+}
+
+// Amend is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - req *models.LeaveRequest
+func (_e *LeaveRequestRepository_Expecter) Amend(ctx interface{}, tx interface{}, req interface{}) *LeaveRequestRepository_Amend_Call {
+	return &LeaveRequestRepository_Amend_Call{Call: _e.mock.On("Amend", ctx, tx, req)}
+}
+
+func (_c *LeaveRequestRepository_Amend_Call) Run(run func(ctx context.Context, tx interfaces.Tx, req *models.LeaveRequest)) *LeaveRequestRepository_Amend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.LeaveRequest))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Amend_Call) Return(_a0 error) *LeaveRequestRepository_Amend_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Amend_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.LeaveRequest) error) *LeaveRequestRepository_Amend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Cancel provides a mock function with given fields: ctx, tx, requestID
 func (_m *LeaveRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx, requestID int64) error {
 	ret := _m.Called(ctx, tx, requestID)
@@ -74,9 +122,9 @@ func (_c *LeaveRequestRepository_Cancel_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// CheckOverlap provides a mock function with given fields: ctx, userID, fromDate, toDate
-func (_m *LeaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, fromDate time.Time, toDate time.Time) (bool, error) {
-	ret := _m.Called(ctx, userID, fromDate, toDate)
+// CheckOverlap provides a mock function with given fields: ctx, userID, fromDate, toDate, excludeID
+func (_m *LeaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, fromDate time.Time, toDate time.Time, excludeID int64) (bool, error) {
+	ret := _m.Called(ctx, userID, fromDate, toDate, excludeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CheckOverlap")
@@ -84,17 +132,17 @@ func (_m *LeaveRequestRepository) CheckOverlap(ctx context.Context, userID int64
 
 	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) (bool, error)); ok {
-		return rf(ctx, userID, fromDate, toDate)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int64) (bool, error)); ok {
+		return rf(ctx, userID, fromDate, toDate, excludeID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) bool); ok {
-		r0 = rf(ctx, userID, fromDate, toDate)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, int64) bool); ok {
+		r0 = rf(ctx, userID, fromDate, toDate, excludeID)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time) error); ok {
-		r1 = rf(ctx, userID, fromDate, toDate)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, int64) error); ok {
+		r1 = rf(ctx, userID, fromDate, toDate, excludeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,13 +160,14 @@ type LeaveRequestRepository_CheckOverlap_Call struct {
 //   - userID int64
 //   - fromDate time.Time
 //   - toDate time.Time
-func (_e *LeaveRequestRepository_Expecter) CheckOverlap(ctx interface{}, userID interface{}, fromDate interface{}, toDate interface{}) *LeaveRequestRepository_CheckOverlap_Call {
-	return &LeaveRequestRepository_CheckOverlap_Call{Call: _e.mock.On("CheckOverlap", ctx, userID, fromDate, toDate)}
+//   - excludeID int64
+func (_e *LeaveRequestRepository_Expecter) CheckOverlap(ctx interface{}, userID interface{}, fromDate interface{}, toDate interface{}, excludeID interface{}) *LeaveRequestRepository_CheckOverlap_Call {
+	return &LeaveRequestRepository_CheckOverlap_Call{Call: _e.mock.On("CheckOverlap", ctx, userID, fromDate, toDate, excludeID)}
 }
 
-func (_c *LeaveRequestRepository_CheckOverlap_Call) Run(run func(ctx context.Context, userID int64, fromDate time.Time, toDate time.Time)) *LeaveRequestRepository_CheckOverlap_Call {
+func (_c *LeaveRequestRepository_CheckOverlap_Call) Run(run func(ctx context.Context, userID int64, fromDate time.Time, toDate time.Time, excludeID int64)) *LeaveRequestRepository_CheckOverlap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(int64))
 	})
 	return _c
 }
@@ -128,7 +177,7 @@ func (_c *LeaveRequestRepository_CheckOverlap_Call) Return(_a0 bool, _a1 error)
 	return _c
 }
 
-func (_c *LeaveRequestRepository_CheckOverlap_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) (bool, error)) *LeaveRequestRepository_CheckOverlap_Call {
+func (_c *LeaveRequestRepository_CheckOverlap_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, int64) (bool, error)) *LeaveRequestRepository_CheckOverlap_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -181,6 +230,56 @@ func (_c *LeaveRequestRepository_Create_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// Escalate provides a mock function with given fields: ctx, tx, requestID, level, escalatedTo
+func (_m *LeaveRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	ret := _m.Called(ctx, tx, requestID, level, escalatedTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Escalate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int, *int64) error); ok {
+		r0 = rf(ctx, tx, requestID, level, escalatedTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveRequestRepository_Escalate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Escalate'
+type LeaveRequestRepository_Escalate_Call struct {
+	*mock.Call
+}
+
+// Escalate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - level int
+//   - escalatedTo *int64
+func (_e *LeaveRequestRepository_Expecter) Escalate(ctx interface{}, tx interface{}, requestID interface{}, level interface{}, escalatedTo interface{}) *LeaveRequestRepository_Escalate_Call {
+	return &LeaveRequestRepository_Escalate_Call{Call: _e.mock.On("Escalate", ctx, tx, requestID, level, escalatedTo)}
+}
+
+func (_c *LeaveRequestRepository_Escalate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64)) *LeaveRequestRepository_Escalate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int), args[4].(*int64))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Escalate_Call) Return(_a0 error) *LeaveRequestRepository_Escalate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Escalate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int, *int64) error) *LeaveRequestRepository_Escalate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: ctx, tx, requestID
 func (_m *LeaveRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestID int64) (*models.LeaveRequest, error) {
 	ret := _m.Called(ctx, tx, requestID)
@@ -359,38 +458,23 @@ func (_c *LeaveRequestRepository_GetPendingForManager_Call) RunAndReturn(run fun
 }
 
 // GetPendingRequests provides a mock function with given fields: ctx
-func (_m *LeaveRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
+func (_m *LeaveRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPendingRequests")
 	}
 
-	var r0 []struct {
-		ID        int64
-		CreatedAt time.Time
-	}
+	var r0 []models.PendingRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.PendingRequest, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []struct {
-		ID        int64
-		CreatedAt time.Time
-	}); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []models.PendingRequest); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]struct {
-				ID        int64
-				CreatedAt time.Time
-			})
+			r0 = ret.Get(0).([]models.PendingRequest)
 		}
 	}
 
@@ -421,18 +505,265 @@ func (_c *LeaveRequestRepository_GetPendingRequests_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *LeaveRequestRepository_GetPendingRequests_Call) Return(_a0 []struct {
-	ID        int64
-	CreatedAt time.Time
-}, _a1 error) *LeaveRequestRepository_GetPendingRequests_Call {
+func (_c *LeaveRequestRepository_GetPendingRequests_Call) Return(_a0 []models.PendingRequest, _a1 error) *LeaveRequestRepository_GetPendingRequests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *LeaveRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error)) *LeaveRequestRepository_GetPendingRequests_Call {
+func (_c *LeaveRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]models.PendingRequest, error)) *LeaveRequestRepository_GetPendingRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPending provides a mock function with given fields: ctx, tx, requestID
+func (_m *LeaveRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPending")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (bool, error)); ok {
+		return rf(ctx, tx, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) bool); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveRequestRepository_LockPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPending'
+type LeaveRequestRepository_LockPending_Call struct {
+	*mock.Call
+}
+
+// LockPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *LeaveRequestRepository_Expecter) LockPending(ctx interface{}, tx interface{}, requestID interface{}) *LeaveRequestRepository_LockPending_Call {
+	return &LeaveRequestRepository_LockPending_Call{Call: _e.mock.On("LockPending", ctx, tx, requestID)}
+}
+
+func (_c *LeaveRequestRepository_LockPending_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *LeaveRequestRepository_LockPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_LockPending_Call) Return(_a0 bool, _a1 error) *LeaveRequestRepository_LockPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_LockPending_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (bool, error)) *LeaveRequestRepository_LockPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseForInfo provides a mock function with given fields: ctx, tx, requestID, question
+func (_m *LeaveRequestRepository) PauseForInfo(ctx context.Context, tx interfaces.Tx, requestID int64, question string) error {
+	ret := _m.Called(ctx, tx, requestID, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseForInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveRequestRepository_PauseForInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseForInfo'
+type LeaveRequestRepository_PauseForInfo_Call struct {
+	*mock.Call
+}
+
+// PauseForInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - question string
+func (_e *LeaveRequestRepository_Expecter) PauseForInfo(ctx interface{}, tx interface{}, requestID interface{}, question interface{}) *LeaveRequestRepository_PauseForInfo_Call {
+	return &LeaveRequestRepository_PauseForInfo_Call{Call: _e.mock.On("PauseForInfo", ctx, tx, requestID, question)}
+}
+
+func (_c *LeaveRequestRepository_PauseForInfo_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, question string)) *LeaveRequestRepository_PauseForInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_PauseForInfo_Call) Return(_a0 error) *LeaveRequestRepository_PauseForInfo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_PauseForInfo_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *LeaveRequestRepository_PauseForInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resubmit provides a mock function with given fields: ctx, tx, requestID
+func (_m *LeaveRequestRepository) Resubmit(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resubmit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveRequestRepository_Resubmit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resubmit'
+type LeaveRequestRepository_Resubmit_Call struct {
+	*mock.Call
+}
+
+// Resubmit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *LeaveRequestRepository_Expecter) Resubmit(ctx interface{}, tx interface{}, requestID interface{}) *LeaveRequestRepository_Resubmit_Call {
+	return &LeaveRequestRepository_Resubmit_Call{Call: _e.mock.On("Resubmit", ctx, tx, requestID)}
+}
+
+func (_c *LeaveRequestRepository_Resubmit_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *LeaveRequestRepository_Resubmit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Resubmit_Call) Return(_a0 error) *LeaveRequestRepository_Resubmit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Resubmit_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *LeaveRequestRepository_Resubmit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: ctx, tx, requestID, reason
+func (_m *LeaveRequestRepository) Revoke(ctx context.Context, tx interfaces.Tx, requestID int64, reason string) error {
+	ret := _m.Called(ctx, tx, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveRequestRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type LeaveRequestRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - reason string
+func (_e *LeaveRequestRepository_Expecter) Revoke(ctx interface{}, tx interface{}, requestID interface{}, reason interface{}) *LeaveRequestRepository_Revoke_Call {
+	return &LeaveRequestRepository_Revoke_Call{Call: _e.mock.On("Revoke", ctx, tx, requestID, reason)}
+}
+
+func (_c *LeaveRequestRepository_Revoke_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, reason string)) *LeaveRequestRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Revoke_Call) Return(_a0 error) *LeaveRequestRepository_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_Revoke_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *LeaveRequestRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOnBehalfOf provides a mock function with given fields: ctx, tx, requestID, delegatorID
+func (_m *LeaveRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64) error {
+	ret := _m.Called(ctx, tx, requestID, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOnBehalfOf")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, requestID, delegatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveRequestRepository_SetOnBehalfOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOnBehalfOf'
+type LeaveRequestRepository_SetOnBehalfOf_Call struct {
+	*mock.Call
+}
+
+// SetOnBehalfOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - delegatorID int64
+func (_e *LeaveRequestRepository_Expecter) SetOnBehalfOf(ctx interface{}, tx interface{}, requestID interface{}, delegatorID interface{}) *LeaveRequestRepository_SetOnBehalfOf_Call {
+	return &LeaveRequestRepository_SetOnBehalfOf_Call{Call: _e.mock.On("SetOnBehalfOf", ctx, tx, requestID, delegatorID)}
+}
+
+func (_c *LeaveRequestRepository_SetOnBehalfOf_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64)) *LeaveRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LeaveRequestRepository_SetOnBehalfOf_Call) Return(_a0 error) *LeaveRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveRequestRepository_SetOnBehalfOf_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) error) *LeaveRequestRepository_SetOnBehalfOf_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2,6 +2,8 @@ package leave_service
 
 import (
 	"context"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
@@ -13,11 +15,17 @@ import (
 
 // handles business logic for leave requests
 type LeaveService struct {
-	leaveReqRepo interfaces.LeaveRequestRepository
-	balanceRepo  interfaces.BalanceRepository
-	ruleService  interfaces.RuleService
-	userRepo     interfaces.UserRepository
-	db           interfaces.DB
+	leaveReqRepo      interfaces.LeaveRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	ruleService       interfaces.RuleService
+	userRepo          interfaces.UserRepository
+	usageRepo         interfaces.UsageRepository
+	holidayRepo       interfaces.HolidayRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	infoService       interfaces.InfoRequestService
+	amendmentService  interfaces.AmendmentService
+	db                interfaces.DB
 }
 
 // creates a new instance of LeaveService
@@ -27,14 +35,26 @@ func NewLeaveService(
 	balanceRepo interfaces.BalanceRepository,
 	ruleService interfaces.RuleService,
 	userRepo interfaces.UserRepository,
+	usageRepo interfaces.UsageRepository,
+	holidayRepo interfaces.HolidayRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	infoService interfaces.InfoRequestService,
+	amendmentService interfaces.AmendmentService,
 	db interfaces.DB,
 ) interfaces.LeaveService {
 	return &LeaveService{
-		leaveReqRepo: leaveReqRepo,
-		balanceRepo:  balanceRepo,
-		ruleService:  ruleService,
-		userRepo:     userRepo,
-		db:           db,
+		leaveReqRepo:      leaveReqRepo,
+		balanceRepo:       balanceRepo,
+		ruleService:       ruleService,
+		userRepo:          userRepo,
+		usageRepo:         usageRepo,
+		holidayRepo:       holidayRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		infoService:       infoService,
+		amendmentService:  amendmentService,
+		db:                db,
 	}
 }
 
@@ -47,6 +67,7 @@ func (s *LeaveService) ApplyLeave(
 	days int,
 	leaveType string,
 	reason string,
+	delegateID *int64,
 ) (string, string, error) {
 	// validations
 	if userID <= 0 {
@@ -68,7 +89,7 @@ func (s *LeaveService) ApplyLeave(
 	}
 
 	// check overlap
-	overlap, err := s.leaveReqRepo.CheckOverlap(ctx, userID, from, to)
+	overlap, err := s.leaveReqRepo.CheckOverlap(ctx, userID, from, to, 0)
 	if err != nil {
 		return "", "", apperrors.ErrLeaveVerificationFailed
 	}
@@ -83,41 +104,36 @@ func (s *LeaveService) ApplyLeave(
 	}
 	defer tx.Rollback(ctx)
 
-	// leave balance
-	remaining, err := s.balanceRepo.GetLeaveBalance(ctx, tx, userID)
-	if err != nil {
-		return "", "", err
-	}
-
-	if days > remaining {
-		return "", "", apperrors.ErrLeaveBalanceExceeded
+	// the approver's queue goes to the delegate once the leave is approved
+	if delegateID != nil {
+		if err := s.delegationService.ValidateDelegate(ctx, tx, userID, *delegateID); err != nil {
+			return "", "", err
+		}
 	}
 
-	// user grade
-	gradeID, err := s.userRepo.GetGrade(ctx, tx, userID)
+	evaluation, err := s.evaluate(ctx, tx, userID, from, to, days, leaveType, 0)
 	if err != nil {
 		return "", "", err
 	}
+	status := evaluation.Status
+	message := evaluation.Message
 
-	// fetch rule
-	rule, err := s.ruleService.GetRule(ctx, "LEAVE", gradeID)
-	if err != nil {
-		return "", "", apperrors.ErrRuleNotFound
+	leaveReq := &models.LeaveRequest{
+		EmployeeID:    userID,
+		FromDate:      from,
+		ToDate:        to,
+		Reason:        reason,
+		LeaveType:     leaveType,
+		Status:        status,
+		RuleID:        evaluation.RuleID(),
+		RuleVersionID: evaluation.RuleVersionID(),
+		DecisionTrace: evaluation.Trace,
+		DelegateID:    delegateID,
 	}
 
-	// apply rule
-	result := utils.MakeDecision("LEAVE", rule.Condition, float64(days))
-	status := result.Status
-	message := result.Message
-
-	leaveReq := &models.LeaveRequest{
-		EmployeeID: userID,
-		FromDate:   from,
-		ToDate:     to,
-		Reason:     reason,
-		LeaveType:  leaveType,
-		Status:     status,
-		RuleID:     &rule.ID,
+	// rejecting rules record their reason on the request
+	if status == constants.StatusAutoRejected {
+		leaveReq.ApprovalComment = message
 	}
 
 	err = s.leaveReqRepo.Create(ctx, tx, leaveReq)
@@ -125,6 +141,14 @@ func (s *LeaveService) ApplyLeave(
 		return "", "", utils.MapPgError(err)
 	}
 
+	// pending requests go through the approval chain their facts select, if any
+	if status == constants.StatusPending {
+		err = s.chainService.Start(ctx, tx, "LEAVE", leaveReq.ID, userID, evaluation.Facts)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	// deduct if auto-approved
 	if status == constants.StatusAutoApproved {
 		err = s.balanceRepo.DeductLeaveBalance(ctx, tx, userID, days)
@@ -133,6 +157,14 @@ func (s *LeaveService) ApplyLeave(
 		}
 	}
 
+	// an approved leave hands the applicant's approvals to their delegate
+	if status == constants.StatusAutoApproved && delegateID != nil {
+		err = s.delegationService.DelegateForLeave(ctx, tx, leaveReq)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return "", "", apperrors.ErrTransactionCommit
 	}
@@ -140,6 +172,75 @@ func (s *LeaveService) ApplyLeave(
 	return message, status, nil
 }
 
+// runs the balance check and the rules that apply to the requester; shared by apply, amend and simulate.
+// excludeID is the request being amended, left out of the requester's recent leaves; 0 otherwise.
+func (s *LeaveService) evaluate(ctx context.Context, tx interfaces.Tx, userID int64, from, to time.Time, days int, leaveType string, excludeID int64) (*utils.Evaluation, error) {
+	// leave balance
+	remaining, err := s.balanceRepo.GetLeaveBalance(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if days > remaining {
+		return nil, apperrors.ErrLeaveBalanceExceeded
+	}
+
+	// requester grade, role, manager and department
+	subject, err := s.userRepo.GetRuleSubject(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// leave requests filed recently, for cumulative conditions
+	recentLeaves, err := s.usageRepo.GetLeaveRequestCount(ctx, tx, userID, utils.LeaveCountWindowStart(now), excludeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// holidays around the leave, for notice and calendar conditions
+	start, end := utils.LeaveCalendarWindow(now, from, to)
+	holidays, err := s.holidayRepo.GetHolidayDates(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// evaluate rule set
+	facts := utils.LeaveFacts(days, leaveType, subject.GradeID, subject.Role, now)
+	facts[utils.AttrLeaveCount30d] = recentLeaves
+	utils.AddLeaveCalendarFacts(facts, utils.NewCalendar(holidays), now, from, to)
+	result, err := s.ruleService.Evaluate(ctx, "LEAVE", *subject, facts)
+	if err != nil {
+		return nil, apperrors.ErrRuleNotFound
+	}
+
+	balance := float64(remaining)
+	return &utils.Evaluation{DecisionResult: *result, Facts: facts, RemainingBalance: &balance}, nil
+}
+
+// dry-runs a leave application in a transaction that is always rolled back
+func (s *LeaveService) SimulateLeave(ctx context.Context, userID int64, from, to time.Time, days int, leaveType string) (*utils.Evaluation, error) {
+	if userID <= 0 {
+		return nil, apperrors.ErrInvalidUser
+	}
+
+	if days <= 0 {
+		return nil, apperrors.ErrInvalidLeaveDays
+	}
+
+	if from.After(to) {
+		return nil, apperrors.ErrInvalidDateRange
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	return s.evaluate(ctx, tx, userID, from, to, days, leaveType, 0)
+}
+
 // cancels a leave request
 func (s *LeaveService) CancelLeave(ctx context.Context, userID, requestID int64) error {
 	tx, err := s.db.Begin(ctx)
@@ -170,22 +271,218 @@ func (s *LeaveService) CancelLeave(ctx context.Context, userID, requestID int64)
 		return err
 	}
 
+	if leaveReq.Status == constants.StatusPending || leaveReq.Status == constants.StatusNeedsInfo {
+		err = s.chainService.Close(ctx, tx, "LEAVE", requestID)
+		if err != nil {
+			return err
+		}
+	}
+
 	if leaveReq.Status == constants.StatusAutoApproved {
 		err = s.balanceRepo.RestoreLeaveBalance(ctx, tx, userID, days)
 		if err != nil {
 			return err
 		}
+
+		err = s.delegationService.RevokeForLeave(ctx, tx, requestID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ResubmitLeave answers the approver's question on a leave request waiting for more information and
+// puts it back in its approver's queue
+func (s *LeaveService) ResubmitLeave(ctx context.Context, userID, requestID int64, answer string) error {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return apperrors.ErrAnswerRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	leaveReq, err := s.leaveReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if leaveReq.EmployeeID != userID {
+		return apperrors.ErrLeaveRequestNotFound
+	}
+
+	if leaveReq.Status != constants.StatusNeedsInfo {
+		return apperrors.ErrRequestNotAwaitingInfo
+	}
+
+	if err := s.infoService.Answer(ctx, tx, "LEAVE", requestID, answer); err != nil {
+		return err
+	}
+
+	if err := s.leaveReqRepo.Resubmit(ctx, tx, requestID); err != nil {
+		return err
 	}
 
 	return tx.Commit(ctx)
 }
 
+// AmendLeave changes the dates, type or reason of the user's pending leave request. The request goes
+// through the same checks and rules as a new application, so an amendment may approve or reject it
+// at once; one that stays pending starts its approvals again. The values before and after are kept.
+func (s *LeaveService) AmendLeave(
+	ctx context.Context,
+	userID, requestID int64,
+	from, to time.Time,
+	days int,
+	leaveType string,
+	reason string,
+) (string, string, error) {
+	// validations
+	if userID <= 0 {
+		return "", "", apperrors.ErrInvalidUser
+	}
+
+	if days <= 0 {
+		return "", "", apperrors.ErrInvalidLeaveDays
+	}
+
+	if from.After(to) {
+		return "", "", apperrors.ErrInvalidDateRange
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	if from.Before(today) {
+		return "", "", apperrors.ErrPastDate
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", "", apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	leaveReq, err := s.leaveReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Verify ownership
+	if leaveReq.EmployeeID != userID {
+		return "", "", apperrors.ErrLeaveRequestNotFound
+	}
+
+	if err := utils.ValidatePendingStatus(leaveReq.Status); err != nil {
+		return "", "", err
+	}
+
+	before := leaveAmendmentValues(leaveReq)
+	leaveReq.FromDate, leaveReq.ToDate, leaveReq.LeaveType, leaveReq.Reason = from, to, leaveType, reason
+	after := leaveAmendmentValues(leaveReq)
+	if reflect.DeepEqual(before, after) {
+		return "", "", apperrors.ErrAmendmentUnchanged
+	}
+
+	// check overlap with the user's other leaves
+	overlap, err := s.leaveReqRepo.CheckOverlap(ctx, userID, from, to, requestID)
+	if err != nil {
+		return "", "", apperrors.ErrLeaveVerificationFailed
+	}
+
+	if overlap {
+		return "", "", apperrors.ErrLeaveOverlap
+	}
+
+	evaluation, err := s.evaluate(ctx, tx, userID, from, to, days, leaveType, requestID)
+	if err != nil {
+		return "", "", err
+	}
+	status := evaluation.Status
+	message := evaluation.Message
+
+	leaveReq.Status = status
+	leaveReq.RuleID = evaluation.RuleID()
+	leaveReq.RuleVersionID = evaluation.RuleVersionID()
+	leaveReq.DecisionTrace = evaluation.Trace
+	leaveReq.ApprovalComment = ""
+
+	// rejecting rules record their reason on the request
+	if status == constants.StatusAutoRejected {
+		leaveReq.ApprovalComment = message
+	}
+
+	if err := s.leaveReqRepo.Amend(ctx, tx, leaveReq); err != nil {
+		return "", "", err
+	}
+
+	// approvals given on what the request used to say no longer count
+	if status == constants.StatusPending {
+		err = s.chainService.Restart(ctx, tx, "LEAVE", requestID, userID, evaluation.Facts)
+	} else {
+		err = s.chainService.Close(ctx, tx, "LEAVE", requestID)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	// deduct if auto-approved
+	if status == constants.StatusAutoApproved {
+		err = s.balanceRepo.DeductLeaveBalance(ctx, tx, userID, days)
+		if err != nil {
+			return "", "", err
+		}
+
+		if leaveReq.DelegateID != nil {
+			if err := s.delegationService.DelegateForLeave(ctx, tx, leaveReq); err != nil {
+				return "", "", err
+			}
+		}
+	}
+
+	err = s.amendmentService.Record(ctx, tx, &models.RequestAmendment{
+		RequestType: "LEAVE",
+		RequestID:   requestID,
+		EmployeeID:  userID,
+		Before:      before,
+		After:       after,
+		Status:      status,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", "", apperrors.ErrTransactionCommit
+	}
+
+	return message, status, nil
+}
+
+// the fields of a leave request an amendment can change
+func leaveAmendmentValues(leaveReq *models.LeaveRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"from_date":  leaveReq.FromDate.Format("2006-01-02"),
+		"to_date":    leaveReq.ToDate.Format("2006-01-02"),
+		"leave_type": leaveReq.LeaveType,
+		"reason":     leaveReq.Reason,
+	}
+}
+
 // handles business logic for leave approval operations
 type LeaveApprovalService struct {
-	leaveReqRepo interfaces.LeaveRequestRepository
-	balanceRepo  interfaces.BalanceRepository
-	userRepo     interfaces.UserRepository
-	db           interfaces.DB
+	leaveReqRepo      interfaces.LeaveRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	userRepo          interfaces.UserRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	expiryService     interfaces.ExpiryPolicyService
+	infoService       interfaces.InfoRequestService
+	revocationService interfaces.RevocationService
+	db                interfaces.DB
 }
 
 // creates a new instance of LeaveApprovalService
@@ -194,26 +491,48 @@ func NewLeaveApprovalService(
 	leaveReqRepo interfaces.LeaveRequestRepository,
 	balanceRepo interfaces.BalanceRepository,
 	userRepo interfaces.UserRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	expiryService interfaces.ExpiryPolicyService,
+	infoService interfaces.InfoRequestService,
+	revocationService interfaces.RevocationService,
 	db interfaces.DB,
 ) interfaces.LeaveApprovalService {
 	return &LeaveApprovalService{
-		leaveReqRepo: leaveReqRepo,
-		balanceRepo:  balanceRepo,
-		userRepo:     userRepo,
-		db:           db,
+		leaveReqRepo:      leaveReqRepo,
+		balanceRepo:       balanceRepo,
+		userRepo:          userRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		expiryService:     expiryService,
+		infoService:       infoService,
+		revocationService: revocationService,
+		db:                db,
 	}
 }
 
-// retrieves pending leave requests based on role
+// retrieves pending leave requests based on role, each with its expiry deadline, soonest first
 func (s *LeaveApprovalService) GetPendingLeaveRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error) {
+	var queue []map[string]interface{}
+	var err error
+
 	switch role {
 	case constants.RoleManager:
-		return s.leaveReqRepo.GetPendingForManager(ctx, approverID)
+		queue, err = s.leaveReqRepo.GetPendingForManager(ctx, approverID)
+		if err != nil {
+			return nil, err
+		}
+		queue, err = s.delegationService.AddDelegatedQueues(ctx, approverID, queue, s.leaveReqRepo)
 	case constants.RoleAdmin:
-		return s.leaveReqRepo.GetPendingForAdmin(ctx)
+		queue, err = s.leaveReqRepo.GetPendingForAdmin(ctx)
 	default:
 		return nil, apperrors.ErrUnauthorizedRole
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.expiryService.AddDeadlines(ctx, "LEAVE", queue)
 }
 
 // approves a leave request
@@ -252,14 +571,35 @@ func (s *LeaveApprovalService) ApproveLeave(
 		return err
 	}
 
-	// Authorization against requester
-	requesterRole, err := s.userRepo.GetRole(ctx, tx, leaveReq.EmployeeID)
+	// requests on an approval chain are decided one step at a time
+	decision, err := s.chainService.Decide(ctx, tx, "LEAVE", requestID, approverID, role, constants.StatusApproved, approvalComment)
 	if err != nil {
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
-		return err
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, leaveReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		// Authorization against requester
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, leaveReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	// the request stays pending until the last step approves
+	if decision.Chained && !decision.Final {
+		return tx.Commit(ctx)
 	}
 
 	days := utils.CalculateLeaveDays(leaveReq.FromDate, leaveReq.ToDate)
@@ -281,6 +621,19 @@ func (s *LeaveApprovalService) ApproveLeave(
 		return err
 	}
 
+	if leaveReq.DelegateID != nil {
+		err = s.delegationService.DelegateForLeave(ctx, tx, leaveReq)
+		if err != nil {
+			return err
+		}
+	}
+
+	if onBehalfOf != nil {
+		if err := s.leaveReqRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -320,14 +673,30 @@ func (s *LeaveApprovalService) RejectLeave(
 		return apperrors.ErrSelfApprovalNotAllowed
 	}
 
-	// Authorization
-	requesterRole, err := s.userRepo.GetRole(ctx, tx, leaveReq.EmployeeID)
+	// a rejection at any step ends the chain
+	decision, err := s.chainService.Decide(ctx, tx, "LEAVE", requestID, approverID, role, constants.StatusRejected, rejectionComment)
 	if err != nil {
 		return err
 	}
 
-	if err := utils.ValidateApproverRole(role, requesterRole); err != nil {
-		return err
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, leaveReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		// Authorization
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, leaveReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
 	}
 
 	// Update request
@@ -336,5 +705,171 @@ func (s *LeaveApprovalService) RejectLeave(
 		return err
 	}
 
+	if onBehalfOf != nil {
+		if err := s.leaveReqRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit(ctx)
 }
+
+// RequestLeaveInfo puts a pending leave request in NEEDS_INFO with a question for the requester;
+// it leaves the approvers' queues until the requester answers and resubmits it
+func (s *LeaveApprovalService) RequestLeaveInfo(ctx context.Context, role string, approverID, requestID int64, question string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return apperrors.ErrQuestionRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	leaveReq, err := s.leaveReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if approverID == leaveReq.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.ValidatePendingStatus(leaveReq.Status); err != nil {
+		return err
+	}
+
+	// on an approval chain only whoever may decide the current step can ask
+	decision, err := s.chainService.CheckApprover(ctx, tx, "LEAVE", requestID, approverID, role)
+	if err != nil {
+		return err
+	}
+
+	if !decision.Chained {
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, leaveReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, leaveReq.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	if err := s.leaveReqRepo.PauseForInfo(ctx, tx, requestID, question); err != nil {
+		return err
+	}
+
+	if err := s.infoService.Ask(ctx, tx, "LEAVE", requestID, leaveReq.EmployeeID, approverID, question); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RevokeLeave withdraws an approved leave request, gives its days back to the requester and ends the
+// delegation it started. Leave that has already started cannot be revoked.
+func (s *LeaveApprovalService) RevokeLeave(ctx context.Context, role string, approverID, requestID int64, reason string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return apperrors.ErrRevokeReasonRequired
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	leaveReq, err := s.leaveReqRepo.GetByID(ctx, tx, requestID)
+	if err != nil {
+		return err
+	}
+
+	if approverID == leaveReq.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.CanRevoke(leaveReq.Status); err != nil {
+		return err
+	}
+
+	// days already taken cannot be given back
+	today := utils.DateOf(time.Now())
+	if leaveReq.FromDate.Before(today) {
+		return apperrors.ErrLeaveAlreadyStarted
+	}
+
+	// whoever may approve the requester's requests may revoke them
+	approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, leaveReq.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	requesterRole, err := s.userRepo.GetRole(ctx, tx, leaveReq.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+		return err
+	}
+
+	if err := s.leaveReqRepo.Revoke(ctx, tx, requestID, reason); err != nil {
+		return err
+	}
+
+	days := utils.CalculateLeaveDays(leaveReq.FromDate, leaveReq.ToDate)
+	if err := s.balanceRepo.RestoreLeaveBalance(ctx, tx, leaveReq.EmployeeID, days); err != nil {
+		return err
+	}
+
+	if err := s.delegationService.RevokeForLeave(ctx, tx, requestID); err != nil {
+		return err
+	}
+
+	err = s.revocationService.Record(ctx, tx, &models.RequestRevocation{
+		RequestType:    "LEAVE",
+		RequestID:      requestID,
+		EmployeeID:     leaveReq.EmployeeID,
+		RevokedBy:      approverID,
+		PreviousStatus: leaveReq.Status,
+		Reason:         reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// BulkApproveLeaves approves each of the leave requests in its own transaction, with the same checks as
+// ApproveLeave, and reports how each went
+func (s *LeaveApprovalService) BulkApproveLeaves(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.ApproveLeave(ctx, role, approverID, requestID, comment)
+	})
+}
+
+// BulkRejectLeaves rejects each of the leave requests in its own transaction, with the same checks as
+// RejectLeave, and reports how each went
+func (s *LeaveApprovalService) BulkRejectLeaves(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.RejectLeave(ctx, role, approverID, requestID, comment)
+	})
+}
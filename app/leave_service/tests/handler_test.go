@@ -38,7 +38,7 @@ func TestLeaveHandler_ApplyLeave(t *testing.T) {
 			mockSetup: func(s *mocks.LeaveService) {
 				from, _ := time.Parse("2006-01-02", "2023-10-01")
 				to, _ := time.Parse("2006-01-02", "2023-10-02")
-				s.EXPECT().ApplyLeave(mock.Anything, int64(1), from, to, 2, "SICK", "Flu").Return("Approved", "APPROVED", nil)
+				s.EXPECT().ApplyLeave(mock.Anything, int64(1), from, to, 2, "SICK", "Flu", (*int64)(nil)).Return("Approved", "APPROVED", nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -68,7 +68,7 @@ func TestLeaveHandler_ApplyLeave(t *testing.T) {
 				LeaveType: "SICK",
 			},
 			mockSetup: func(s *mocks.LeaveService) {
-				s.EXPECT().ApplyLeave(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				s.EXPECT().ApplyLeave(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return("", "", apperrors.ErrLeaveOverlap)
 			},
 			expectedStatus: http.StatusBadRequest,
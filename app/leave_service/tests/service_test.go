@@ -8,13 +8,17 @@ import (
 	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_service/mocks"
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+func int64Ptr(v int64) *int64 { return &v }
+
 func TestLeaveService_ApplyLeave(t *testing.T) {
 	ctx := context.Background()
 	tomorrow := time.Now().AddDate(0, 0, 1).Truncate(24 * time.Hour)
@@ -28,7 +32,8 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 		days          int
 		leaveType     string
 		reason        string
-		mockSetup     func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		delegateID    *int64
+		mockSetup     func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx)
 		expectedError error
 	}{
 		{
@@ -39,14 +44,15 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			days:      2,
 			leaveType: "SICK",
 			reason:    "Feeling unwell",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
-				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter).Return(false, nil)
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				b.EXPECT().GetLeaveBalance(ctx, tx, int64(1)).Return(10, nil)
-				u.EXPECT().GetGrade(ctx, tx, int64(1)).Return(int64(1), nil)
-				r.EXPECT().GetRule(ctx, "LEAVE", int64(1)).Return(&models.Rule{
-					ID:        1,
-					Condition: map[string]interface{}{"max_days": 5.0},
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+				r.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoApproved,
+					Message: "LEAVE approved by system",
+					Rule:    &models.Rule{ID: 1},
 				}, nil)
 				l.EXPECT().Create(ctx, tx, mock.AnythingOfType("*models.LeaveRequest")).Return(nil)
 				b.EXPECT().DeductLeaveBalance(ctx, tx, int64(1), 2).Return(nil)
@@ -63,8 +69,8 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			days:      20,
 			leaveType: "SICK",
 			reason:    "Long trip",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
-				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter).Return(false, nil)
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				b.EXPECT().GetLeaveBalance(ctx, tx, int64(1)).Return(10, nil)
 				tx.EXPECT().Rollback(ctx).Return(nil).Once()
@@ -77,7 +83,7 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			from:   tomorrow,
 			to:     dayAfter,
 			days:   2,
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 			},
 			expectedError: apperrors.ErrInvalidUser,
 		},
@@ -88,8 +94,8 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			to:        dayAfter,
 			days:      2,
 			leaveType: "SICK",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
-				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter).Return(true, nil)
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(true, nil)
 			},
 			expectedError: apperrors.ErrLeaveOverlap,
 		},
@@ -100,21 +106,104 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			to:        dayAfter,
 			days:      2,
 			leaveType: "SICK",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
-				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter).Return(false, nil)
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				b.EXPECT().GetLeaveBalance(ctx, tx, int64(1)).Return(10, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+				r.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusPending,
+					Message: "LEAVE submitted for approval",
+					Rule:    &models.Rule{ID: 1},
+					Trace:   &models.DecisionTrace{Outcome: constants.StatusPending},
+				}, nil)
+				l.EXPECT().Create(ctx, tx, mock.MatchedBy(func(req *models.LeaveRequest) bool {
+					return req.DecisionTrace != nil && req.DecisionTrace.Outcome == constants.StatusPending
+				})).Run(func(_ context.Context, _ interfaces.Tx, req *models.LeaveRequest) {
+					req.ID = 42
+				}).Return(nil)
+				c.EXPECT().Start(ctx, tx, "LEAVE", int64(42), int64(1), mock.MatchedBy(func(facts utils.Facts) bool {
+					return facts[utils.AttrDays] == 2
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "Auto Rejected By Rule",
+			userID:    1,
+			from:      tomorrow,
+			to:        dayAfter,
+			days:      2,
+			leaveType: "UNPAID",
+			reason:    "Personal work",
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				b.EXPECT().GetLeaveBalance(ctx, tx, int64(1)).Return(10, nil)
-				u.EXPECT().GetGrade(ctx, tx, int64(1)).Return(int64(1), nil)
-				r.EXPECT().GetRule(ctx, "LEAVE", int64(1)).Return(&models.Rule{
-					ID:        1,
-					Condition: map[string]interface{}{"max_days": 1.0}, // max_days < days
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+				r.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoRejected,
+					Message: "Unpaid leave is not allowed",
+					Rule:    &models.Rule{ID: 2, VersionID: 7},
 				}, nil)
-				l.EXPECT().Create(ctx, tx, mock.Anything).Return(nil)
+				l.EXPECT().Create(ctx, tx, mock.MatchedBy(func(req *models.LeaveRequest) bool {
+					return req.Status == constants.StatusAutoRejected && req.ApprovalComment == "Unpaid leave is not allowed" &&
+						*req.RuleID == 2 && *req.RuleVersionID == 7
+				})).Return(nil)
 				tx.EXPECT().Commit(ctx).Return(nil)
 				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 			},
 			expectedError: nil,
 		},
+		{
+			name:       "Auto Approved Leave Hands Over To The Delegate",
+			userID:     1,
+			from:       tomorrow,
+			to:         dayAfter,
+			days:       2,
+			leaveType:  "CASUAL",
+			delegateID: int64Ptr(7),
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				d.EXPECT().ValidateDelegate(ctx, tx, int64(1), int64(7)).Return(nil)
+				b.EXPECT().GetLeaveBalance(ctx, tx, int64(1)).Return(10, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "MANAGER"}, nil)
+				r.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "MANAGER"}, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoApproved,
+					Message: "LEAVE approved by system",
+					Rule:    &models.Rule{ID: 1},
+				}, nil)
+				l.EXPECT().Create(ctx, tx, mock.MatchedBy(func(req *models.LeaveRequest) bool {
+					return *req.DelegateID == 7
+				})).Return(nil)
+				b.EXPECT().DeductLeaveBalance(ctx, tx, int64(1), 2).Return(nil)
+				d.EXPECT().DelegateForLeave(ctx, tx, mock.MatchedBy(func(req *models.LeaveRequest) bool {
+					return req.EmployeeID == 1 && *req.DelegateID == 7
+				})).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:       "Delegate Is Not An Approver",
+			userID:     1,
+			from:       tomorrow,
+			to:         dayAfter,
+			days:       2,
+			leaveType:  "CASUAL",
+			delegateID: int64Ptr(3),
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				d.EXPECT().ValidateDelegate(ctx, tx, int64(1), int64(3)).Return(apperrors.ErrInvalidDelegate)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrInvalidDelegate,
+		},
 		{
 			name:      "DB Begin Error",
 			userID:    1,
@@ -122,8 +211,8 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			to:        dayAfter,
 			days:      2,
 			leaveType: "SICK",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
-				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter).Return(false, nil)
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				l.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(0)).Return(false, nil)
 				db.EXPECT().Begin(ctx).Return(nil, apperrors.ErrTransactionBegin)
 			},
 			expectedError: apperrors.ErrTransactionBegin,
@@ -136,13 +225,19 @@ func TestLeaveService_ApplyLeave(t *testing.T) {
 			mockB := mocks.NewBalanceRepository(t)
 			mockR := mocks.NewRuleService(t)
 			mockU := mocks.NewUserRepository(t)
+			mockUsage := mocks.NewUsageRepository(t)
+			mockH := mocks.NewHolidayRepository(t)
+			mockC := mocks.NewApprovalChainService(t)
+			mockD := mocks.NewDelegationService(t)
 			mockDB := mocks.NewDB(t)
 			mockTx := mocks.NewTx(t)
 
-			tt.mockSetup(mockL, mockB, mockR, mockU, mockDB, mockTx)
+			tt.mockSetup(mockL, mockB, mockR, mockU, mockC, mockD, mockDB, mockTx)
+			mockUsage.EXPECT().GetLeaveRequestCount(ctx, mockTx, tt.userID, mock.Anything, int64(0)).Return(0, nil).Maybe()
+			mockH.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 
-			service := leave_service.NewLeaveService(ctx, mockL, mockB, mockR, mockU, mockDB)
-			_, _, err := service.ApplyLeave(ctx, tt.userID, tt.from, tt.to, tt.days, tt.leaveType, tt.reason)
+			service := leave_service.NewLeaveService(ctx, mockL, mockB, mockR, mockU, mockUsage, mockH, mockC, mockD, nil, nil, mockDB)
+			_, _, err := service.ApplyLeave(ctx, tt.userID, tt.from, tt.to, tt.days, tt.leaveType, tt.reason, tt.delegateID)
 
 			if tt.expectedError != nil {
 				assert.ErrorIs(t, err, tt.expectedError)
@@ -162,7 +257,7 @@ func TestLeaveApprovalService_ApproveLeave(t *testing.T) {
 		approverID      int64
 		requestID       int64
 		approvalComment string
-		mockSetup       func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx)
+		mockSetup       func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx)
 		expectedError   error
 	}{
 		{
@@ -171,7 +266,7 @@ func TestLeaveApprovalService_ApproveLeave(t *testing.T) {
 			approverID:      2,
 			requestID:       10,
 			approvalComment: "Approved by manager",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.LeaveRequest{
 					ID:         10,
@@ -180,6 +275,8 @@ func TestLeaveApprovalService_ApproveLeave(t *testing.T) {
 					FromDate:   time.Now(),
 					ToDate:     time.Now().AddDate(0, 0, 1),
 				}, nil)
+				c.EXPECT().Decide(ctx, tx, "LEAVE", int64(10), int64(2), constants.RoleManager, constants.StatusApproved, "Approved by manager").Return(models.ChainDecision{}, nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
 				u.EXPECT().GetRole(ctx, tx, int64(1)).Return(constants.RoleEmployee, nil)
 				b.EXPECT().DeductLeaveBalance(ctx, tx, int64(1), 2).Return(nil)
 				l.EXPECT().UpdateStatus(ctx, tx, int64(10), "APPROVED", int64(2), "Approved by manager").Return(nil)
@@ -188,13 +285,128 @@ func TestLeaveApprovalService_ApproveLeave(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:            "Delegate Approves For An Away Admin",
+			role:            constants.RoleManager,
+			approverID:      6,
+			requestID:       10,
+			approvalComment: "Covering for admin",
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.LeaveRequest{
+					ID:         10,
+					EmployeeID: 4,
+					Status:     constants.StatusPending,
+					FromDate:   time.Now(),
+					ToDate:     time.Now(),
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "LEAVE", int64(10), int64(6), constants.RoleManager, constants.StatusApproved, "Covering for admin").Return(models.ChainDecision{}, nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(6), constants.RoleManager, int64(4)).Return(&models.Approver{ID: 6, Role: constants.RoleAdmin, OnBehalfOf: int64Ptr(9)}, nil)
+				// the requester is a manager, so only the admin's authority lets the delegate decide
+				u.EXPECT().GetRole(ctx, tx, int64(4)).Return(constants.RoleManager, nil)
+				b.EXPECT().DeductLeaveBalance(ctx, tx, int64(4), 1).Return(nil)
+				l.EXPECT().UpdateStatus(ctx, tx, int64(10), "APPROVED", int64(6), "Covering for admin").Return(nil)
+				l.EXPECT().SetOnBehalfOf(ctx, tx, int64(10), int64(9)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:            "Approved Leave Hands Over To The Delegate",
+			role:            constants.RoleAdmin,
+			approverID:      9,
+			requestID:       10,
+			approvalComment: "Enjoy",
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				leave := &models.LeaveRequest{
+					ID:         10,
+					EmployeeID: 4,
+					Status:     constants.StatusPending,
+					FromDate:   time.Now(),
+					ToDate:     time.Now(),
+					DelegateID: int64Ptr(6),
+				}
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(leave, nil)
+				c.EXPECT().Decide(ctx, tx, "LEAVE", int64(10), int64(9), constants.RoleAdmin, constants.StatusApproved, "Enjoy").Return(models.ChainDecision{}, nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(9), constants.RoleAdmin, int64(4)).Return(&models.Approver{ID: 9, Role: constants.RoleAdmin}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(4)).Return(constants.RoleManager, nil)
+				b.EXPECT().DeductLeaveBalance(ctx, tx, int64(4), 1).Return(nil)
+				l.EXPECT().UpdateStatus(ctx, tx, int64(10), "APPROVED", int64(9), "Enjoy").Return(nil)
+				d.EXPECT().DelegateForLeave(ctx, tx, leave).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:            "Chain Step Approved - Request Stays Pending",
+			role:            constants.RoleManager,
+			approverID:      2,
+			requestID:       10,
+			approvalComment: "Fine by me",
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.LeaveRequest{
+					ID:         10,
+					EmployeeID: 1,
+					Status:     constants.StatusPending,
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "LEAVE", int64(10), int64(2), constants.RoleManager, constants.StatusApproved, "Fine by me").Return(models.ChainDecision{Chained: true}, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:            "Last Chain Step Approves The Request",
+			role:            constants.RoleAdmin,
+			approverID:      3,
+			requestID:       10,
+			approvalComment: "Approved",
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.LeaveRequest{
+					ID:         10,
+					EmployeeID: 1,
+					Status:     constants.StatusPending,
+					FromDate:   time.Now(),
+					ToDate:     time.Now(),
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "LEAVE", int64(10), int64(3), constants.RoleAdmin, constants.StatusApproved, "Approved").Return(models.ChainDecision{Chained: true, Final: true}, nil)
+				b.EXPECT().DeductLeaveBalance(ctx, tx, int64(1), 1).Return(nil)
+				l.EXPECT().UpdateStatus(ctx, tx, int64(10), "APPROVED", int64(3), "Approved").Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedError: nil,
+		},
+		{
+			name:            "Not The Current Step's Approver",
+			role:            constants.RoleManager,
+			approverID:      5,
+			requestID:       10,
+			approvalComment: "Approved",
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.LeaveRequest{
+					ID:         10,
+					EmployeeID: 1,
+					Status:     constants.StatusPending,
+				}, nil)
+				c.EXPECT().Decide(ctx, tx, "LEAVE", int64(10), int64(5), constants.RoleManager, constants.StatusApproved, "Approved").Return(models.ChainDecision{}, apperrors.ErrNotStepApprover)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrNotStepApprover,
+		},
 		{
 			name:            "Self Approval Not Allowed",
 			role:            constants.RoleManager,
 			approverID:      1,
 			requestID:       10,
 			approvalComment: "Self approve",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(&models.LeaveRequest{
 					ID:         10,
@@ -211,7 +423,7 @@ func TestLeaveApprovalService_ApproveLeave(t *testing.T) {
 			approverID:      2,
 			requestID:       10,
 			approvalComment: "OK",
-			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, db *mocks.DB, tx *mocks.Tx) {
+			mockSetup: func(l *mocks.LeaveRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
 				db.EXPECT().Begin(ctx).Return(tx, nil)
 				l.EXPECT().GetByID(ctx, tx, int64(10)).Return(nil, apperrors.ErrDatabase)
 				tx.EXPECT().Rollback(ctx).Return(nil).Once()
@@ -225,12 +437,14 @@ func TestLeaveApprovalService_ApproveLeave(t *testing.T) {
 			mockL := mocks.NewLeaveRequestRepository(t)
 			mockB := mocks.NewBalanceRepository(t)
 			mockU := mocks.NewUserRepository(t)
+			mockC := mocks.NewApprovalChainService(t)
+			mockD := mocks.NewDelegationService(t)
 			mockDB := mocks.NewDB(t)
 			mockTx := mocks.NewTx(t)
 
-			tt.mockSetup(mockL, mockB, mockU, mockDB, mockTx)
+			tt.mockSetup(mockL, mockB, mockU, mockC, mockD, mockDB, mockTx)
 
-			service := leave_service.NewLeaveApprovalService(ctx, mockL, mockB, mockU, mockDB)
+			service := leave_service.NewLeaveApprovalService(ctx, mockL, mockB, mockU, mockC, mockD, nil, nil, nil, mockDB)
 			err := service.ApproveLeave(ctx, tt.role, tt.approverID, tt.requestID, tt.approvalComment)
 
 			if tt.expectedError != nil {
@@ -248,6 +462,8 @@ func TestLeaveApprovalService_RejectLeave(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockL := mocks.NewLeaveRequestRepository(t)
 		mockU := mocks.NewUserRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockD := mocks.NewDelegationService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -257,19 +473,46 @@ func TestLeaveApprovalService_RejectLeave(t *testing.T) {
 			EmployeeID: 1,
 			Status:     constants.StatusPending,
 		}, nil)
+		mockC.EXPECT().Decide(ctx, mockTx, "LEAVE", int64(10), int64(2), constants.RoleManager, constants.StatusRejected, "No").Return(models.ChainDecision{}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
 		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleEmployee, nil)
 		mockL.EXPECT().UpdateStatus(ctx, mockTx, int64(10), "REJECTED", int64(2), "No").Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, mockU, mockDB)
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, mockU, mockC, mockD, nil, nil, nil, mockDB)
 		err := service.RejectLeave(ctx, constants.RoleManager, 2, 10, "No")
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("Chain Step Rejects The Request", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockD := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.LeaveRequest{
+			ID:         10,
+			EmployeeID: 1,
+			Status:     constants.StatusPending,
+		}, nil)
+		mockC.EXPECT().Decide(ctx, mockTx, "LEAVE", int64(10), int64(3), constants.RoleAdmin, constants.StatusRejected, "No").Return(models.ChainDecision{Chained: true, Final: true}, nil)
+		mockL.EXPECT().UpdateStatus(ctx, mockTx, int64(10), "REJECTED", int64(3), "No").Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		// the requester's role is not checked: the chain decides who may reject
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, nil, mockC, mockD, nil, nil, nil, mockDB)
+		err := service.RejectLeave(ctx, constants.RoleAdmin, 3, 10, "No")
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("Unauthorized Role", func(t *testing.T) {
-		service := leave_service.NewLeaveApprovalService(ctx, nil, nil, nil, nil)
+		service := leave_service.NewLeaveApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		err := service.RejectLeave(ctx, constants.RoleEmployee, 1, 10, "No")
 
 		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
@@ -281,6 +524,7 @@ func TestLeaveService_CancelLeave(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockL := mocks.NewLeaveRequestRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
 		mockDB := mocks.NewDB(t)
 		mockTx := mocks.NewTx(t)
 
@@ -291,10 +535,39 @@ func TestLeaveService_CancelLeave(t *testing.T) {
 			Status:     constants.StatusPending,
 		}, nil)
 		mockL.EXPECT().Cancel(ctx, mockTx, int64(10)).Return(nil)
+		mockC.EXPECT().Close(ctx, mockTx, "LEAVE", int64(10)).Return(nil)
 		mockTx.EXPECT().Commit(ctx).Return(nil)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := leave_service.NewLeaveService(ctx, mockL, nil, nil, nil, mockDB)
+		service := leave_service.NewLeaveService(ctx, mockL, nil, nil, nil, nil, nil, mockC, nil, nil, nil, mockDB)
+		err := service.CancelLeave(ctx, 1, 10)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Auto Approved Leave Ends Its Delegation", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockD := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		from := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.LeaveRequest{
+			ID:         10,
+			EmployeeID: 1,
+			FromDate:   from,
+			ToDate:     from.AddDate(0, 0, 1),
+			Status:     constants.StatusAutoApproved,
+		}, nil)
+		mockL.EXPECT().Cancel(ctx, mockTx, int64(10)).Return(nil)
+		mockB.EXPECT().RestoreLeaveBalance(ctx, mockTx, int64(1), utils.CalculateLeaveDays(from, from.AddDate(0, 0, 1))).Return(nil)
+		mockD.EXPECT().RevokeForLeave(ctx, mockTx, int64(10)).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := leave_service.NewLeaveService(ctx, mockL, mockB, nil, nil, nil, nil, nil, mockD, nil, nil, mockDB)
 		err := service.CancelLeave(ctx, 1, 10)
 
 		assert.NoError(t, err)
@@ -313,7 +586,7 @@ func TestLeaveService_CancelLeave(t *testing.T) {
 		}, nil)
 		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
 
-		service := leave_service.NewLeaveService(ctx, mockL, nil, nil, nil, mockDB)
+		service := leave_service.NewLeaveService(ctx, mockL, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockDB)
 		err := service.CancelLeave(ctx, 1, 10)
 
 		assert.ErrorIs(t, err, apperrors.ErrLeaveRequestNotFound)
@@ -325,18 +598,344 @@ func TestLeaveApprovalService_GetPendingLeaveRequests(t *testing.T) {
 
 	t.Run("Success - Manager", func(t *testing.T) {
 		mockL := mocks.NewLeaveRequestRepository(t)
-		mockL.EXPECT().GetPendingForManager(ctx, int64(2)).Return([]map[string]interface{}{}, nil)
-
-		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, nil, nil)
+		mockD := mocks.NewDelegationService(t)
+		mockX := mocks.NewExpiryPolicyService(t)
+		queue := []map[string]interface{}{}
+		mockL.EXPECT().GetPendingForManager(ctx, int64(2)).Return(queue, nil)
+		mockD.EXPECT().AddDelegatedQueues(ctx, int64(2), queue, mockL).Return(queue, nil)
+		mockX.EXPECT().AddDeadlines(ctx, "LEAVE", queue).Return(queue, nil)
+
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, nil, nil, mockD, mockX, nil, nil, nil)
 		_, err := service.GetPendingLeaveRequests(ctx, constants.RoleManager, 2)
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("Success - Admin with deadlines", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockX := mocks.NewExpiryPolicyService(t)
+		queue := []map[string]interface{}{{"id": int64(7)}}
+		withDeadlines := []map[string]interface{}{{"id": int64(7), "deadline": "2026-10-20T09:00:00Z"}}
+		mockL.EXPECT().GetPendingForAdmin(ctx).Return(queue, nil)
+		mockX.EXPECT().AddDeadlines(ctx, "LEAVE", queue).Return(withDeadlines, nil)
+
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, nil, nil, nil, mockX, nil, nil, nil)
+		result, err := service.GetPendingLeaveRequests(ctx, constants.RoleAdmin, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, withDeadlines, result)
+	})
+
 	t.Run("Unauthorized Role", func(t *testing.T) {
-		service := leave_service.NewLeaveApprovalService(ctx, nil, nil, nil, nil)
+		service := leave_service.NewLeaveApprovalService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		_, err := service.GetPendingLeaveRequests(ctx, constants.RoleEmployee, 1)
 
 		assert.ErrorIs(t, err, apperrors.ErrUnauthorizedRole)
 	})
 }
+
+func TestLeaveService_SimulateLeave(t *testing.T) {
+	ctx := context.Background()
+	today := utils.DateOf(time.Now())
+	from := today.AddDate(0, 0, 10)
+	to := from.AddDate(0, 0, 3)
+
+	t.Run("Success - Nothing Persisted", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockR := mocks.NewRuleService(t)
+		mockU := mocks.NewUserRepository(t)
+		mockUsage := mocks.NewUsageRepository(t)
+		mockH := mocks.NewHolidayRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockB.EXPECT().GetLeaveBalance(ctx, mockTx, int64(1)).Return(10, nil)
+		mockU.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 2, Role: "EMPLOYEE"}, nil)
+		mockUsage.EXPECT().GetLeaveRequestCount(ctx, mockTx, int64(1), mock.Anything, int64(0)).Return(3, nil)
+		// the first day of leave is a holiday
+		mockH.EXPECT().GetHolidayDates(ctx, today, to.AddDate(0, 0, 1)).Return([]time.Time{from}, nil)
+		mockR.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 2, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusAutoApproved,
+			Message: "LEAVE approved by system",
+			Rule:    &models.Rule{ID: 4},
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
+
+		service := leave_service.NewLeaveService(ctx, mockL, mockB, mockR, mockU, mockUsage, mockH, nil, nil, nil, nil, mockDB)
+		result, err := service.SimulateLeave(ctx, 1, from, to, 4, "SICK")
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.StatusAutoApproved, result.Status)
+		assert.Equal(t, int64(4), *result.RuleID())
+		assert.Equal(t, 4, result.Facts[utils.AttrDays])
+		assert.Equal(t, 3, result.Facts[utils.AttrLeaveCount30d])
+		assert.Equal(t, true, result.Facts[utils.AttrTouchesHoliday])
+		assert.Equal(t, utils.CountWorkingDays(from, to, func(d time.Time) bool { return d.Equal(from) }), result.Facts[utils.AttrWorkingDays])
+		assert.Equal(t, 10.0, *result.RemainingBalance)
+	})
+
+	t.Run("Balance Exceeded", func(t *testing.T) {
+		mockB := mocks.NewBalanceRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockB.EXPECT().GetLeaveBalance(ctx, mockTx, int64(1)).Return(2, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Once()
+
+		service := leave_service.NewLeaveService(ctx, nil, mockB, nil, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		_, err := service.SimulateLeave(ctx, 1, from, to, 4, "SICK")
+
+		assert.ErrorIs(t, err, apperrors.ErrLeaveBalanceExceeded)
+	})
+
+	t.Run("Invalid Date Range", func(t *testing.T) {
+		service := leave_service.NewLeaveService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := service.SimulateLeave(ctx, 1, to, from, 4, "SICK")
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidDateRange)
+	})
+}
+
+func TestLeaveService_AmendLeave(t *testing.T) {
+	ctx := context.Background()
+	tomorrow := time.Now().AddDate(0, 0, 1).Truncate(24 * time.Hour)
+	dayAfter := tomorrow.AddDate(0, 0, 1)
+	pending := func() *models.LeaveRequest {
+		return &models.LeaveRequest{ID: 10, EmployeeID: 1, FromDate: tomorrow, ToDate: tomorrow, LeaveType: "SICK", Reason: "Feeling unwell", Status: constants.StatusPending}
+	}
+
+	t.Run("Longer Leave Still Pending Restarts Approvals", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockR := mocks.NewRuleService(t)
+		mockU := mocks.NewUserRepository(t)
+		mockUsage := mocks.NewUsageRepository(t)
+		mockH := mocks.NewHolidayRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockA := mocks.NewAmendmentService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(pending(), nil)
+		// the request being amended never overlaps itself
+		mockL.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(10)).Return(false, nil)
+		mockB.EXPECT().GetLeaveBalance(ctx, mockTx, int64(1)).Return(10, nil)
+		mockU.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsage.EXPECT().GetLeaveRequestCount(ctx, mockTx, int64(1), mock.Anything, int64(10)).Return(0, nil)
+		mockH.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
+		mockR.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusPending,
+			Message: "LEAVE submitted for approval",
+			Rule:    &models.Rule{ID: 1},
+		}, nil)
+		mockL.EXPECT().Amend(ctx, mockTx, mock.MatchedBy(func(req *models.LeaveRequest) bool {
+			return req.ID == 10 && req.ToDate.Equal(dayAfter) && req.Status == constants.StatusPending
+		})).Return(nil)
+		mockC.EXPECT().Restart(ctx, mockTx, "LEAVE", int64(10), int64(1), mock.MatchedBy(func(facts utils.Facts) bool {
+			return facts[utils.AttrDays] == 2
+		})).Return(nil)
+		mockA.EXPECT().Record(ctx, mockTx, mock.MatchedBy(func(am *models.RequestAmendment) bool {
+			return am.RequestType == "LEAVE" && am.RequestID == 10 &&
+				am.Before["to_date"] == tomorrow.Format("2006-01-02") &&
+				am.After["to_date"] == dayAfter.Format("2006-01-02")
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := leave_service.NewLeaveService(ctx, mockL, mockB, mockR, mockU, mockUsage, mockH, mockC, nil, nil, mockA, mockDB)
+		_, status, err := service.AmendLeave(ctx, 1, 10, tomorrow, dayAfter, 2, "SICK", "Feeling unwell")
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.StatusPending, status)
+	})
+
+	t.Run("Decided Since Read", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockR := mocks.NewRuleService(t)
+		mockU := mocks.NewUserRepository(t)
+		mockUsage := mocks.NewUsageRepository(t)
+		mockH := mocks.NewHolidayRepository(t)
+		mockC := mocks.NewApprovalChainService(t)
+		mockA := mocks.NewAmendmentService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(pending(), nil)
+		mockL.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(10)).Return(false, nil)
+		mockB.EXPECT().GetLeaveBalance(ctx, mockTx, int64(1)).Return(10, nil)
+		mockU.EXPECT().GetRuleSubject(ctx, mockTx, int64(1)).Return(&models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, nil)
+		mockUsage.EXPECT().GetLeaveRequestCount(ctx, mockTx, int64(1), mock.Anything, int64(10)).Return(0, nil)
+		mockH.EXPECT().GetHolidayDates(ctx, mock.Anything, mock.Anything).Return(nil, nil)
+		mockR.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 1, GradeID: 1, Role: "EMPLOYEE"}, mock.Anything).Return(&utils.DecisionResult{
+			Status:  constants.StatusAutoApproved,
+			Message: "LEAVE approved by system",
+			Rule:    &models.Rule{ID: 1},
+		}, nil)
+		// nothing is closed or deducted for a request no longer pending
+		mockL.EXPECT().Amend(ctx, mockTx, mock.Anything).Return(apperrors.ErrRequestNotPending)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := leave_service.NewLeaveService(ctx, mockL, mockB, mockR, mockU, mockUsage, mockH, mockC, nil, nil, mockA, mockDB)
+		_, _, err := service.AmendLeave(ctx, 1, 10, tomorrow, dayAfter, 2, "SICK", "Feeling unwell")
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotPending)
+	})
+
+	t.Run("Overlaps Another Leave", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(pending(), nil)
+		mockL.EXPECT().CheckOverlap(ctx, int64(1), tomorrow, dayAfter, int64(10)).Return(true, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := leave_service.NewLeaveService(ctx, mockL, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		_, _, err := service.AmendLeave(ctx, 1, 10, tomorrow, dayAfter, 2, "SICK", "Feeling unwell")
+
+		assert.ErrorIs(t, err, apperrors.ErrLeaveOverlap)
+	})
+
+	t.Run("Nothing Changed", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(pending(), nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := leave_service.NewLeaveService(ctx, mockL, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		_, _, err := service.AmendLeave(ctx, 1, 10, tomorrow, tomorrow, 1, "SICK", "Feeling unwell")
+
+		assert.ErrorIs(t, err, apperrors.ErrAmendmentUnchanged)
+	})
+
+	t.Run("Past Date", func(t *testing.T) {
+		yesterday := tomorrow.AddDate(0, 0, -2)
+		service := leave_service.NewLeaveService(ctx, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, _, err := service.AmendLeave(ctx, 1, 10, yesterday, tomorrow, 3, "SICK", "Feeling unwell")
+
+		assert.ErrorIs(t, err, apperrors.ErrPastDate)
+	})
+}
+
+func TestLeaveApprovalService_RevokeLeave(t *testing.T) {
+	ctx := context.Background()
+	today := utils.DateOf(time.Now())
+
+	t.Run("Success - Days Restored", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockB := mocks.NewBalanceRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockD := mocks.NewDelegationService(t)
+		mockRv := mocks.NewRevocationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		from := today.AddDate(0, 0, 5)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.LeaveRequest{
+			ID:         10,
+			EmployeeID: 1,
+			FromDate:   from,
+			ToDate:     from.AddDate(0, 0, 2),
+			Status:     constants.StatusAutoApproved,
+		}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(9), constants.RoleAdmin, int64(1)).Return(&models.Approver{ID: 9, Role: constants.RoleAdmin}, nil)
+		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleManager, nil)
+		mockL.EXPECT().Revoke(ctx, mockTx, int64(10), "Project deadline").Return(nil)
+		mockB.EXPECT().RestoreLeaveBalance(ctx, mockTx, int64(1), utils.CalculateLeaveDays(from, from.AddDate(0, 0, 2))).Return(nil)
+		mockD.EXPECT().RevokeForLeave(ctx, mockTx, int64(10)).Return(nil)
+		mockRv.EXPECT().Record(ctx, mockTx, mock.MatchedBy(func(v *models.RequestRevocation) bool {
+			return v.RequestType == "LEAVE" && v.RequestID == 10 && v.RevokedBy == 9 &&
+				v.PreviousStatus == constants.StatusAutoApproved && v.Reason == "Project deadline"
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, mockB, mockU, nil, mockD, nil, nil, mockRv, mockDB)
+		err := service.RevokeLeave(ctx, constants.RoleAdmin, 9, 10, "Project deadline")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Revoked Concurrently - Nothing Restored", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockD := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.LeaveRequest{
+			ID:         10,
+			EmployeeID: 1,
+			FromDate:   today,
+			ToDate:     today.AddDate(0, 0, 1),
+			Status:     constants.StatusApproved,
+		}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(9), constants.RoleAdmin, int64(1)).Return(&models.Approver{ID: 9, Role: constants.RoleAdmin}, nil)
+		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleEmployee, nil)
+		mockL.EXPECT().Revoke(ctx, mockTx, int64(10), "Project deadline").Return(apperrors.ErrRequestNotApproved)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, mockU, nil, mockD, nil, nil, nil, mockDB)
+		err := service.RevokeLeave(ctx, constants.RoleAdmin, 9, 10, "Project deadline")
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotApproved)
+	})
+
+	t.Run("Leave Already Started", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.LeaveRequest{
+			ID:         10,
+			EmployeeID: 1,
+			FromDate:   today.AddDate(0, 0, -2),
+			ToDate:     today.AddDate(0, 0, 1),
+			Status:     constants.StatusApproved,
+		}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, nil, nil, nil, nil, nil, nil, mockDB)
+		err := service.RevokeLeave(ctx, constants.RoleAdmin, 9, 10, "Project deadline")
+
+		assert.ErrorIs(t, err, apperrors.ErrLeaveAlreadyStarted)
+	})
+
+	t.Run("Manager Cannot Revoke Manager's Leave", func(t *testing.T) {
+		mockL := mocks.NewLeaveRequestRepository(t)
+		mockU := mocks.NewUserRepository(t)
+		mockD := mocks.NewDelegationService(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockL.EXPECT().GetByID(ctx, mockTx, int64(10)).Return(&models.LeaveRequest{
+			ID:         10,
+			EmployeeID: 1,
+			FromDate:   today.AddDate(0, 0, 5),
+			ToDate:     today.AddDate(0, 0, 5),
+			Status:     constants.StatusApproved,
+		}, nil)
+		mockD.EXPECT().ResolveApprover(ctx, mockTx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
+		mockU.EXPECT().GetRole(ctx, mockTx, int64(1)).Return(constants.RoleManager, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := leave_service.NewLeaveApprovalService(ctx, mockL, nil, mockU, nil, mockD, nil, nil, nil, mockDB)
+		err := service.RevokeLeave(ctx, constants.RoleManager, 2, 10, "Project deadline")
+
+		assert.ErrorIs(t, err, apperrors.ErrManagerNeedsAdmin)
+	})
+}
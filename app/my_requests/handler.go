@@ -73,15 +73,39 @@ func (h *MyRequestsHandler) GetMyAllRequests(c *gin.Context) {
 	response.Success(c, "requests fetched successfully", data)
 }
 
+func (h *MyRequestsHandler) GetMyRequest(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		handleRequestError(c, apperrors.ErrUnauthorizedUser, "failed to fetch request")
+		return
+	}
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRequestError(c, apperrors.ErrInvalidID, "invalid request id")
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.myRequestsService.GetMyRequest(ctx, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleRequestError(c, err, "failed to fetch request")
+		return
+	}
+
+	response.Success(c, "request fetched successfully", data)
+}
+
 func handleRequestError(c *gin.Context, err error, message string) {
 	status := http.StatusInternalServerError
 
 	switch err {
-	case apperrors.ErrUserNotFound:
+	case apperrors.ErrUserNotFound, apperrors.ErrLeaveRequestNotFound,
+		apperrors.ErrExpenseRequestNotFound, apperrors.ErrDiscountRequestNotFound:
 		status = http.StatusNotFound
 	case apperrors.ErrUnauthorizedUser:
 		status = http.StatusUnauthorized
-	case apperrors.ErrInvalidRequestPayload:
+	case apperrors.ErrInvalidRequestPayload, apperrors.ErrInvalidID, apperrors.ErrUnknownRequestType:
 		status = http.StatusBadRequest
 	}
 
@@ -2,6 +2,7 @@ package my_requests
 
 import (
 	"context"
+	"strings"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 )
@@ -44,3 +45,8 @@ func (s *MyRequestsService) GetMyAllRequests(ctx context.Context, userID int64,
 
 	return result, nil
 }
+
+// GetMyRequest returns a single request of the user, including how it was decided
+func (s *MyRequestsService) GetMyRequest(ctx context.Context, userID int64, reqType string, requestID int64) (map[string]interface{}, error) {
+	return s.myRequestsRepo.GetMyRequest(ctx, userID, strings.ToUpper(reqType), requestID)
+}
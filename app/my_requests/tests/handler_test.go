@@ -8,6 +8,8 @@ import (
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/my_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/my_requests/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -93,3 +95,58 @@ func TestMyRequestsHandler_GetMyAllRequests(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 }
+
+func TestMyRequestsHandler_GetMyRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(s *mocks.MyRequestsService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			path: "/my-requests/LEAVE/5",
+			mockSetup: func(s *mocks.MyRequestsService) {
+				s.EXPECT().GetMyRequest(mock.Anything, int64(1), "LEAVE", int64(5)).
+					Return(map[string]interface{}{"id": 5, "decision_trace": &models.DecisionTrace{Outcome: "PENDING"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid ID",
+			path:           "/my-requests/LEAVE/abc",
+			mockSetup:      func(s *mocks.MyRequestsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			path: "/my-requests/EXPENSE/9",
+			mockSetup: func(s *mocks.MyRequestsService) {
+				s.EXPECT().GetMyRequest(mock.Anything, int64(1), "EXPENSE", int64(9)).Return(nil, apperrors.ErrExpenseRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockS := mocks.NewMyRequestsService(t)
+			tt.mockSetup(mockS)
+
+			handler := my_requests.NewMyRequestsHandler(context.Background(), mockS)
+			r := gin.New()
+			r.GET("/my-requests/:type/:id", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+				handler.GetMyRequest(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -56,4 +56,16 @@ func TestMyRequestsService(t *testing.T) {
 
 		assert.Error(t, err)
 	})
+
+	t.Run("GetMyRequest - Normalises Type", func(t *testing.T) {
+		mockRepo := mocks.NewMyRequestsRepository(t)
+		mockRepo.EXPECT().GetMyRequest(ctx, userID, "LEAVE", int64(4)).
+			Return(map[string]interface{}{"id": 4, "decision_trace": nil}, nil)
+
+		service := my_requests.NewMyRequestsService(ctx, mockRepo)
+		result, err := service.GetMyRequest(ctx, userID, "leave", 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result["id"])
+	})
 }
@@ -0,0 +1,71 @@
+package request_history
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RequestHistoryReader guards the histories kept on requests: questions, amendments, revocations
+// and escalations
+type RequestHistoryReader struct {
+	ownerRepo         interfaces.RequestOwnerRepository
+	userRepo          interfaces.UserRepository
+	delegationService interfaces.DelegationService
+	db                interfaces.DB
+}
+
+// NewRequestHistoryReader creates a new instance of RequestHistoryReader
+func NewRequestHistoryReader(
+	ctx context.Context,
+	ownerRepo interfaces.RequestOwnerRepository,
+	userRepo interfaces.UserRepository,
+	delegationService interfaces.DelegationService,
+	db interfaces.DB,
+) interfaces.RequestHistoryReader {
+	return &RequestHistoryReader{
+		ownerRepo:         ownerRepo,
+		userRepo:          userRepo,
+		delegationService: delegationService,
+		db:                db,
+	}
+}
+
+// Authorize normalises a request type and checks that the user filed the request or may approve
+// it, the way an approver is checked before deciding it. It returns the normalised type, and
+// ErrRequestNotFound to anyone else so they cannot tell which requests exist.
+func (r *RequestHistoryReader) Authorize(ctx context.Context, role string, userID int64, requestType string, requestID int64) (string, error) {
+	requestType = strings.ToUpper(strings.TrimSpace(requestType))
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return "", apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	ownerID, err := r.ownerRepo.GetOwner(ctx, tx, requestType, requestID)
+	if err != nil {
+		return "", err
+	}
+	if ownerID == userID {
+		return requestType, nil
+	}
+
+	approver, err := r.delegationService.ResolveApprover(ctx, tx, userID, role, ownerID)
+	if err != nil {
+		return "", err
+	}
+
+	requesterRole, err := r.userRepo.GetRole(ctx, tx, ownerID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+		return "", apperrors.ErrRequestNotFound
+	}
+	return requestType, nil
+}
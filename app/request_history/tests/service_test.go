@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/request_history"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/request_history/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestHistoryReader_Authorize(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		role          string
+		userID        int64
+		requestType   string
+		mockSetup     func(*mocks.RequestOwnerRepository, *mocks.UserRepository, *mocks.DelegationService, *mocks.Tx)
+		expectedType  string
+		expectedError error
+	}{
+		{
+			name:        "Requester",
+			role:        constants.RoleEmployee,
+			userID:      1,
+			requestType: " leave ",
+			mockSetup: func(o *mocks.RequestOwnerRepository, u *mocks.UserRepository, d *mocks.DelegationService, tx *mocks.Tx) {
+				o.EXPECT().GetOwner(ctx, tx, "LEAVE", int64(10)).Return(int64(1), nil)
+			},
+			expectedType: "LEAVE",
+		},
+		{
+			name:        "Manager Of An Employee",
+			role:        constants.RoleManager,
+			userID:      2,
+			requestType: "expense",
+			mockSetup: func(o *mocks.RequestOwnerRepository, u *mocks.UserRepository, d *mocks.DelegationService, tx *mocks.Tx) {
+				o.EXPECT().GetOwner(ctx, tx, "EXPENSE", int64(10)).Return(int64(1), nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(2), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(1)).Return(constants.RoleEmployee, nil)
+			},
+			expectedType: "EXPENSE",
+		},
+		{
+			name:        "Delegate Standing In For An Admin",
+			role:        constants.RoleEmployee,
+			userID:      6,
+			requestType: "DISCOUNT",
+			mockSetup: func(o *mocks.RequestOwnerRepository, u *mocks.UserRepository, d *mocks.DelegationService, tx *mocks.Tx) {
+				o.EXPECT().GetOwner(ctx, tx, "DISCOUNT", int64(10)).Return(int64(4), nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(6), constants.RoleEmployee, int64(4)).Return(&models.Approver{ID: 6, Role: constants.RoleAdmin}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(4)).Return(constants.RoleManager, nil)
+			},
+			expectedType: "DISCOUNT",
+		},
+		{
+			name:        "Another Employee",
+			role:        constants.RoleEmployee,
+			userID:      3,
+			requestType: "LEAVE",
+			mockSetup: func(o *mocks.RequestOwnerRepository, u *mocks.UserRepository, d *mocks.DelegationService, tx *mocks.Tx) {
+				o.EXPECT().GetOwner(ctx, tx, "LEAVE", int64(10)).Return(int64(1), nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(3), constants.RoleEmployee, int64(1)).Return(&models.Approver{ID: 3, Role: constants.RoleEmployee}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(1)).Return(constants.RoleEmployee, nil)
+			},
+			expectedError: apperrors.ErrRequestNotFound,
+		},
+		{
+			name:        "Manager Of Another Manager",
+			role:        constants.RoleManager,
+			userID:      2,
+			requestType: "LEAVE",
+			mockSetup: func(o *mocks.RequestOwnerRepository, u *mocks.UserRepository, d *mocks.DelegationService, tx *mocks.Tx) {
+				o.EXPECT().GetOwner(ctx, tx, "LEAVE", int64(10)).Return(int64(5), nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(2), constants.RoleManager, int64(5)).Return(&models.Approver{ID: 2, Role: constants.RoleManager}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(5)).Return(constants.RoleManager, nil)
+			},
+			expectedError: apperrors.ErrRequestNotFound,
+		},
+		{
+			name:        "Unknown Request",
+			role:        constants.RoleAdmin,
+			userID:      9,
+			requestType: "TRAVEL",
+			mockSetup: func(o *mocks.RequestOwnerRepository, u *mocks.UserRepository, d *mocks.DelegationService, tx *mocks.Tx) {
+				o.EXPECT().GetOwner(ctx, tx, "TRAVEL", int64(10)).Return(0, apperrors.ErrRequestNotFound)
+			},
+			expectedError: apperrors.ErrRequestNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOwnerRepo := mocks.NewRequestOwnerRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockDelegationService := mocks.NewDelegationService(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+			mockTx.EXPECT().Rollback(ctx).Return(nil)
+			tt.mockSetup(mockOwnerRepo, mockUserRepo, mockDelegationService, mockTx)
+
+			reader := request_history.NewRequestHistoryReader(ctx, mockOwnerRepo, mockUserRepo, mockDelegationService, mockDB)
+			requestType, err := reader.Authorize(ctx, tt.role, tt.userID, tt.requestType, 10)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedType, requestType)
+		})
+	}
+}
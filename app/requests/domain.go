@@ -0,0 +1,7 @@
+package requests
+
+// BulkDecisionRequest lists the requests a bulk approval or rejection decides, with one comment for all
+type BulkDecisionRequest struct {
+	RequestIDs []int64 `json:"request_ids"`
+	Comment    string  `json:"comment"`
+}
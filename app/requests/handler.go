@@ -0,0 +1,248 @@
+package requests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for the registered request types
+type RequestHandler struct {
+	requestService interfaces.RequestService
+}
+
+// creates a new RequestHandler instance
+func NewRequestHandler(ctx context.Context, requestService interfaces.RequestService) *RequestHandler {
+	return &RequestHandler{requestService: requestService}
+}
+
+func (h *RequestHandler) GetRequestTypes(c *gin.Context) {
+	ctx := c.Request.Context()
+	defs, err := h.requestService.GetRequestTypes(ctx)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	response.Success(c, "request types fetched successfully", defs)
+}
+
+func (h *RequestHandler) Apply(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+	if userID == 0 {
+		handleRequestError(c, apperrors.ErrUnauthorizedUser)
+		return
+	}
+
+	// the body is the payload, checked against the request type's fields by the service
+	var payload map[string]interface{}
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		handleRequestError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	message, status, err := h.requestService.Apply(ctx, userID, c.Param("type"), payload)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	response.Created(
+		c,
+		message,
+		gin.H{
+			"status": status,
+		},
+	)
+}
+
+func (h *RequestHandler) Cancel(c *gin.Context) {
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRequestError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.requestService.Cancel(ctx, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	response.Success(c, "request cancelled successfully", nil)
+}
+
+// handles approval HTTP requests for the registered request types
+type RequestApprovalHandler struct {
+	requestApprovalService interfaces.RequestApprovalService
+}
+
+// creates a new RequestApprovalHandler instance
+func NewRequestApprovalHandler(ctx context.Context, requestApprovalService interfaces.RequestApprovalService) *RequestApprovalHandler {
+	return &RequestApprovalHandler{requestApprovalService: requestApprovalService}
+}
+
+func (h *RequestApprovalHandler) GetPending(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	ctx := c.Request.Context()
+	pending, err := h.requestApprovalService.GetPendingRequests(ctx, role, userID, c.Param("type"))
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	response.Success(c, "pending requests fetched successfully", pending)
+}
+
+func (h *RequestApprovalHandler) Approve(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRequestError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var body map[string]interface{}
+	if err := c.ShouldBindJSON(&body); err != nil && err.Error() != "EOF" {
+		handleRequestError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	comment, _ := body["comment"].(string)
+
+	ctx := c.Request.Context()
+	err = h.requestApprovalService.Approve(ctx, role, approverID, c.Param("type"), requestID, comment)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	response.Success(c, "request approved successfully", nil)
+}
+
+func (h *RequestApprovalHandler) Reject(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRequestError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	var body map[string]interface{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		handleRequestError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	comment, ok := body["comment"].(string)
+	if !ok || comment == "" {
+		handleRequestError(c, apperrors.ErrCommentMissing)
+		return
+	}
+
+	ctx := c.Request.Context()
+	err = h.requestApprovalService.Reject(ctx, role, approverID, c.Param("type"), requestID, comment)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	response.Success(c, "request rejected successfully", nil)
+}
+
+// BulkApprove approves the listed requests of a type one by one and reports each outcome
+func (h *RequestApprovalHandler) BulkApprove(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRequestError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.requestApprovalService.BulkApprove(ctx, role, approverID, c.Param("type"), req.RequestIDs, req.Comment)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, requestErrorStatus)
+	response.Success(c, "bulk approval processed", result)
+}
+
+// BulkReject rejects the listed requests of a type one by one and reports each outcome
+func (h *RequestApprovalHandler) BulkReject(c *gin.Context) {
+	role := c.GetString("role")
+	approverID := c.GetInt64("user_id")
+
+	var req BulkDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRequestError(c, apperrors.ErrInvalidRequestPayload)
+		return
+	}
+
+	if req.Comment == "" {
+		handleRequestError(c, apperrors.ErrCommentMissing)
+		return
+	}
+
+	ctx := c.Request.Context()
+	result, err := h.requestApprovalService.BulkReject(ctx, role, approverID, c.Param("type"), req.RequestIDs, req.Comment)
+	if err != nil {
+		handleRequestError(c, err)
+		return
+	}
+
+	utils.DescribeBulkFailures(result, requestErrorStatus)
+	response.Success(c, "bulk rejection processed", result)
+}
+
+func requestErrorStatus(err error) int {
+	status := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidRequestPayload), errors.Is(err, apperrors.ErrInvalidID),
+		errors.Is(err, apperrors.ErrRequestNotPending), errors.Is(err, apperrors.ErrRequestCannotCancel),
+		errors.Is(err, apperrors.ErrCommentRequired), errors.Is(err, apperrors.ErrCommentMissing),
+		errors.Is(err, apperrors.ErrInvalidLeaveDays), errors.Is(err, apperrors.ErrLeaveBalanceExceeded),
+		errors.Is(err, apperrors.ErrInvalidExpenseAmount), errors.Is(err, apperrors.ErrExpenseLimitExceeded),
+		errors.Is(err, apperrors.ErrInvalidDiscountPercent), errors.Is(err, apperrors.ErrDiscountLimitExceeded),
+		errors.Is(err, apperrors.ErrBulkRequestIDsMissing), errors.Is(err, apperrors.ErrBulkTooManyRequests):
+		status = http.StatusBadRequest
+	case errors.Is(err, apperrors.ErrUnknownRequestType), errors.Is(err, apperrors.ErrRequestNotFound),
+		errors.Is(err, apperrors.ErrUserNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, apperrors.ErrUnauthorizedUser):
+		status = http.StatusUnauthorized
+	case errors.Is(err, apperrors.ErrEmployeeCannotApprove), errors.Is(err, apperrors.ErrApproverRoleNotAllowed),
+		errors.Is(err, apperrors.ErrSelfApprovalNotAllowed), errors.Is(err, apperrors.ErrManagerNeedsAdmin),
+		errors.Is(err, apperrors.ErrAdminRequestNotAllowed), errors.Is(err, apperrors.ErrUnauthorizedApproval),
+		errors.Is(err, apperrors.ErrNotStepApprover), errors.Is(err, apperrors.ErrApproverAlreadyDecided):
+		status = http.StatusForbidden
+	}
+	return status
+}
+
+func handleRequestError(c *gin.Context, err error) {
+	response.Error(c, requestErrorStatus(err), err.Error(), nil)
+}
@@ -0,0 +1,415 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// GenericRequestRepository is an autogenerated mock type for the GenericRequestRepository type
+type GenericRequestRepository struct {
+	mock.Mock
+}
+
+type GenericRequestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GenericRequestRepository) EXPECT() *GenericRequestRepository_Expecter {
+	return &GenericRequestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Cancel provides a mock function with given fields: ctx, tx, requestID
+func (_m *GenericRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GenericRequestRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type GenericRequestRepository_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *GenericRequestRepository_Expecter) Cancel(ctx interface{}, tx interface{}, requestID interface{}) *GenericRequestRepository_Cancel_Call {
+	return &GenericRequestRepository_Cancel_Call{Call: _e.mock.On("Cancel", ctx, tx, requestID)}
+}
+
+func (_c *GenericRequestRepository_Cancel_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *GenericRequestRepository_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_Cancel_Call) Return(_a0 error) *GenericRequestRepository_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GenericRequestRepository_Cancel_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *GenericRequestRepository_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, tx, req
+func (_m *GenericRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.GenericRequest) error {
+	ret := _m.Called(ctx, tx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.GenericRequest) error); ok {
+		r0 = rf(ctx, tx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GenericRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type GenericRequestRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - req *models.GenericRequest
+func (_e *GenericRequestRepository_Expecter) Create(ctx interface{}, tx interface{}, req interface{}) *GenericRequestRepository_Create_Call {
+	return &GenericRequestRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, req)}
+}
+
+func (_c *GenericRequestRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, req *models.GenericRequest)) *GenericRequestRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.GenericRequest))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_Create_Call) Return(_a0 error) *GenericRequestRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GenericRequestRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.GenericRequest) error) *GenericRequestRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *GenericRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (*models.GenericRequest, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.GenericRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) (*models.GenericRequest, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) *models.GenericRequest); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.GenericRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenericRequestRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type GenericRequestRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *GenericRequestRepository_Expecter) GetByID(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *GenericRequestRepository_GetByID_Call {
+	return &GenericRequestRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, tx, requestType, requestID)}
+}
+
+func (_c *GenericRequestRepository_GetByID_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *GenericRequestRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_GetByID_Call) Return(_a0 *models.GenericRequest, _a1 error) *GenericRequestRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GenericRequestRepository_GetByID_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) (*models.GenericRequest, error)) *GenericRequestRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingForAdmin provides a mock function with given fields: ctx, requestType
+func (_m *GenericRequestRepository) GetPendingForAdmin(ctx context.Context, requestType string) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingForAdmin")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, requestType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []map[string]interface{}); ok {
+		r0 = rf(ctx, requestType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenericRequestRepository_GetPendingForAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForAdmin'
+type GenericRequestRepository_GetPendingForAdmin_Call struct {
+	*mock.Call
+}
+
+// GetPendingForAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+func (_e *GenericRequestRepository_Expecter) GetPendingForAdmin(ctx interface{}, requestType interface{}) *GenericRequestRepository_GetPendingForAdmin_Call {
+	return &GenericRequestRepository_GetPendingForAdmin_Call{Call: _e.mock.On("GetPendingForAdmin", ctx, requestType)}
+}
+
+func (_c *GenericRequestRepository_GetPendingForAdmin_Call) Run(run func(ctx context.Context, requestType string)) *GenericRequestRepository_GetPendingForAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_GetPendingForAdmin_Call) Return(_a0 []map[string]interface{}, _a1 error) *GenericRequestRepository_GetPendingForAdmin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GenericRequestRepository_GetPendingForAdmin_Call) RunAndReturn(run func(context.Context, string) ([]map[string]interface{}, error)) *GenericRequestRepository_GetPendingForAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingForManager provides a mock function with given fields: ctx, requestType, managerID
+func (_m *GenericRequestRepository) GetPendingForManager(ctx context.Context, requestType string, managerID int64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, requestType, managerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingForManager")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, requestType, managerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []map[string]interface{}); ok {
+		r0 = rf(ctx, requestType, managerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, managerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GenericRequestRepository_GetPendingForManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForManager'
+type GenericRequestRepository_GetPendingForManager_Call struct {
+	*mock.Call
+}
+
+// GetPendingForManager is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - managerID int64
+func (_e *GenericRequestRepository_Expecter) GetPendingForManager(ctx interface{}, requestType interface{}, managerID interface{}) *GenericRequestRepository_GetPendingForManager_Call {
+	return &GenericRequestRepository_GetPendingForManager_Call{Call: _e.mock.On("GetPendingForManager", ctx, requestType, managerID)}
+}
+
+func (_c *GenericRequestRepository_GetPendingForManager_Call) Run(run func(ctx context.Context, requestType string, managerID int64)) *GenericRequestRepository_GetPendingForManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_GetPendingForManager_Call) Return(_a0 []map[string]interface{}, _a1 error) *GenericRequestRepository_GetPendingForManager_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GenericRequestRepository_GetPendingForManager_Call) RunAndReturn(run func(context.Context, string, int64) ([]map[string]interface{}, error)) *GenericRequestRepository_GetPendingForManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOnBehalfOf provides a mock function with given fields: ctx, tx, requestID, delegatorID
+func (_m *GenericRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64) error {
+	ret := _m.Called(ctx, tx, requestID, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOnBehalfOf")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int64) error); ok {
+		r0 = rf(ctx, tx, requestID, delegatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GenericRequestRepository_SetOnBehalfOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOnBehalfOf'
+type GenericRequestRepository_SetOnBehalfOf_Call struct {
+	*mock.Call
+}
+
+// SetOnBehalfOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - delegatorID int64
+func (_e *GenericRequestRepository_Expecter) SetOnBehalfOf(ctx interface{}, tx interface{}, requestID interface{}, delegatorID interface{}) *GenericRequestRepository_SetOnBehalfOf_Call {
+	return &GenericRequestRepository_SetOnBehalfOf_Call{Call: _e.mock.On("SetOnBehalfOf", ctx, tx, requestID, delegatorID)}
+}
+
+func (_c *GenericRequestRepository_SetOnBehalfOf_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, delegatorID int64)) *GenericRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_SetOnBehalfOf_Call) Return(_a0 error) *GenericRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GenericRequestRepository_SetOnBehalfOf_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int64) error) *GenericRequestRepository_SetOnBehalfOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, tx, requestID, status, approverID, comment
+func (_m *GenericRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
+	ret := _m.Called(ctx, tx, requestID, status, approverID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, status, approverID, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GenericRequestRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type GenericRequestRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - status string
+//   - approverID int64
+//   - comment string
+func (_e *GenericRequestRepository_Expecter) UpdateStatus(ctx interface{}, tx interface{}, requestID interface{}, status interface{}, approverID interface{}, comment interface{}) *GenericRequestRepository_UpdateStatus_Call {
+	return &GenericRequestRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, tx, requestID, status, approverID, comment)}
+}
+
+func (_c *GenericRequestRepository_UpdateStatus_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string)) *GenericRequestRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *GenericRequestRepository_UpdateStatus_Call) Return(_a0 error) *GenericRequestRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GenericRequestRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, int64, string) error) *GenericRequestRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGenericRequestRepository creates a new instance of GenericRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGenericRequestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GenericRequestRepository {
+	mock := &GenericRequestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
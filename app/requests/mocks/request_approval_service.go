@@ -0,0 +1,327 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RequestApprovalService is an autogenerated mock type for the RequestApprovalService type
+type RequestApprovalService struct {
+	mock.Mock
+}
+
+type RequestApprovalService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RequestApprovalService) EXPECT() *RequestApprovalService_Expecter {
+	return &RequestApprovalService_Expecter{mock: &_m.Mock}
+}
+
+// Approve provides a mock function with given fields: ctx, role, approverID, requestType, requestID, comment
+func (_m *RequestApprovalService) Approve(ctx context.Context, role string, approverID int64, requestType string, requestID int64, comment string) error {
+	ret := _m.Called(ctx, role, approverID, requestType, requestID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Approve")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestType, requestID, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestApprovalService_Approve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Approve'
+type RequestApprovalService_Approve_Call struct {
+	*mock.Call
+}
+
+// Approve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestType string
+//   - requestID int64
+//   - comment string
+func (_e *RequestApprovalService_Expecter) Approve(ctx interface{}, role interface{}, approverID interface{}, requestType interface{}, requestID interface{}, comment interface{}) *RequestApprovalService_Approve_Call {
+	return &RequestApprovalService_Approve_Call{Call: _e.mock.On("Approve", ctx, role, approverID, requestType, requestID, comment)}
+}
+
+func (_c *RequestApprovalService_Approve_Call) Run(run func(ctx context.Context, role string, approverID int64, requestType string, requestID int64, comment string)) *RequestApprovalService_Approve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *RequestApprovalService_Approve_Call) Return(_a0 error) *RequestApprovalService_Approve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RequestApprovalService_Approve_Call) RunAndReturn(run func(context.Context, string, int64, string, int64, string) error) *RequestApprovalService_Approve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkApprove provides a mock function with given fields: ctx, role, approverID, requestType, requestIDs, comment
+func (_m *RequestApprovalService) BulkApprove(ctx context.Context, role string, approverID int64, requestType string, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestType, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkApprove")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestType, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestType, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestType, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestApprovalService_BulkApprove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkApprove'
+type RequestApprovalService_BulkApprove_Call struct {
+	*mock.Call
+}
+
+// BulkApprove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestType string
+//   - requestIDs []int64
+//   - comment string
+func (_e *RequestApprovalService_Expecter) BulkApprove(ctx interface{}, role interface{}, approverID interface{}, requestType interface{}, requestIDs interface{}, comment interface{}) *RequestApprovalService_BulkApprove_Call {
+	return &RequestApprovalService_BulkApprove_Call{Call: _e.mock.On("BulkApprove", ctx, role, approverID, requestType, requestIDs, comment)}
+}
+
+func (_c *RequestApprovalService_BulkApprove_Call) Run(run func(ctx context.Context, role string, approverID int64, requestType string, requestIDs []int64, comment string)) *RequestApprovalService_BulkApprove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].([]int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *RequestApprovalService_BulkApprove_Call) Return(_a0 *models.BulkDecision, _a1 error) *RequestApprovalService_BulkApprove_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestApprovalService_BulkApprove_Call) RunAndReturn(run func(context.Context, string, int64, string, []int64, string) (*models.BulkDecision, error)) *RequestApprovalService_BulkApprove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkReject provides a mock function with given fields: ctx, role, approverID, requestType, requestIDs, comment
+func (_m *RequestApprovalService) BulkReject(ctx context.Context, role string, approverID int64, requestType string, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestType, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkReject")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestType, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestType, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestType, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestApprovalService_BulkReject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkReject'
+type RequestApprovalService_BulkReject_Call struct {
+	*mock.Call
+}
+
+// BulkReject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestType string
+//   - requestIDs []int64
+//   - comment string
+func (_e *RequestApprovalService_Expecter) BulkReject(ctx interface{}, role interface{}, approverID interface{}, requestType interface{}, requestIDs interface{}, comment interface{}) *RequestApprovalService_BulkReject_Call {
+	return &RequestApprovalService_BulkReject_Call{Call: _e.mock.On("BulkReject", ctx, role, approverID, requestType, requestIDs, comment)}
+}
+
+func (_c *RequestApprovalService_BulkReject_Call) Run(run func(ctx context.Context, role string, approverID int64, requestType string, requestIDs []int64, comment string)) *RequestApprovalService_BulkReject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].([]int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *RequestApprovalService_BulkReject_Call) Return(_a0 *models.BulkDecision, _a1 error) *RequestApprovalService_BulkReject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestApprovalService_BulkReject_Call) RunAndReturn(run func(context.Context, string, int64, string, []int64, string) (*models.BulkDecision, error)) *RequestApprovalService_BulkReject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingRequests provides a mock function with given fields: ctx, role, approverID, requestType
+func (_m *RequestApprovalService) GetPendingRequests(ctx context.Context, role string, approverID int64, requestType string) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, role, approverID, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingRequests")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, role, approverID, requestType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) []map[string]interface{}); ok {
+		r0 = rf(ctx, role, approverID, requestType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestApprovalService_GetPendingRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingRequests'
+type RequestApprovalService_GetPendingRequests_Call struct {
+	*mock.Call
+}
+
+// GetPendingRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestType string
+func (_e *RequestApprovalService_Expecter) GetPendingRequests(ctx interface{}, role interface{}, approverID interface{}, requestType interface{}) *RequestApprovalService_GetPendingRequests_Call {
+	return &RequestApprovalService_GetPendingRequests_Call{Call: _e.mock.On("GetPendingRequests", ctx, role, approverID, requestType)}
+}
+
+func (_c *RequestApprovalService_GetPendingRequests_Call) Run(run func(ctx context.Context, role string, approverID int64, requestType string)) *RequestApprovalService_GetPendingRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *RequestApprovalService_GetPendingRequests_Call) Return(_a0 []map[string]interface{}, _a1 error) *RequestApprovalService_GetPendingRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestApprovalService_GetPendingRequests_Call) RunAndReturn(run func(context.Context, string, int64, string) ([]map[string]interface{}, error)) *RequestApprovalService_GetPendingRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reject provides a mock function with given fields: ctx, role, approverID, requestType, requestID, comment
+func (_m *RequestApprovalService) Reject(ctx context.Context, role string, approverID int64, requestType string, requestID int64, comment string) error {
+	ret := _m.Called(ctx, role, approverID, requestType, requestID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestType, requestID, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestApprovalService_Reject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reject'
+type RequestApprovalService_Reject_Call struct {
+	*mock.Call
+}
+
+// Reject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestType string
+//   - requestID int64
+//   - comment string
+func (_e *RequestApprovalService_Expecter) Reject(ctx interface{}, role interface{}, approverID interface{}, requestType interface{}, requestID interface{}, comment interface{}) *RequestApprovalService_Reject_Call {
+	return &RequestApprovalService_Reject_Call{Call: _e.mock.On("Reject", ctx, role, approverID, requestType, requestID, comment)}
+}
+
+func (_c *RequestApprovalService_Reject_Call) Run(run func(ctx context.Context, role string, approverID int64, requestType string, requestID int64, comment string)) *RequestApprovalService_Reject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *RequestApprovalService_Reject_Call) Return(_a0 error) *RequestApprovalService_Reject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RequestApprovalService_Reject_Call) RunAndReturn(run func(context.Context, string, int64, string, int64, string) error) *RequestApprovalService_Reject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequestApprovalService creates a new instance of RequestApprovalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestApprovalService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestApprovalService {
+	mock := &RequestApprovalService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
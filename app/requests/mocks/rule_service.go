@@ -0,0 +1,924 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+	ruleengine "github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+
+	utils "github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RuleService is an autogenerated mock type for the RuleService type
+type RuleService struct {
+	mock.Mock
+}
+
+type RuleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RuleService) EXPECT() *RuleService_Expecter {
+	return &RuleService_Expecter{mock: &_m.Mock}
+}
+
+// ApproveRuleProposal provides a mock function with given fields: ctx, role, reviewerID, proposalID, comment
+func (_m *RuleService) ApproveRuleProposal(ctx context.Context, role string, reviewerID int64, proposalID int64, comment string) (*models.RuleProposal, error) {
+	ret := _m.Called(ctx, role, reviewerID, proposalID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveRuleProposal")
+	}
+
+	var r0 *models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) (*models.RuleProposal, error)); ok {
+		return rf(ctx, role, reviewerID, proposalID, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) *models.RuleProposal); ok {
+		r0 = rf(ctx, role, reviewerID, proposalID, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, string) error); ok {
+		r1 = rf(ctx, role, reviewerID, proposalID, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_ApproveRuleProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveRuleProposal'
+type RuleService_ApproveRuleProposal_Call struct {
+	*mock.Call
+}
+
+// ApproveRuleProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - reviewerID int64
+//   - proposalID int64
+//   - comment string
+func (_e *RuleService_Expecter) ApproveRuleProposal(ctx interface{}, role interface{}, reviewerID interface{}, proposalID interface{}, comment interface{}) *RuleService_ApproveRuleProposal_Call {
+	return &RuleService_ApproveRuleProposal_Call{Call: _e.mock.On("ApproveRuleProposal", ctx, role, reviewerID, proposalID, comment)}
+}
+
+func (_c *RuleService_ApproveRuleProposal_Call) Run(run func(ctx context.Context, role string, reviewerID int64, proposalID int64, comment string)) *RuleService_ApproveRuleProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_ApproveRuleProposal_Call) Return(_a0 *models.RuleProposal, _a1 error) *RuleService_ApproveRuleProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_ApproveRuleProposal_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) (*models.RuleProposal, error)) *RuleService_ApproveRuleProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AuditRules provides a mock function with given fields: ctx, role
+func (_m *RuleService) AuditRules(ctx context.Context, role string) (*models.RuleAuditReport, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditRules")
+	}
+
+	var r0 *models.RuleAuditReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.RuleAuditReport, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.RuleAuditReport); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleAuditReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_AuditRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditRules'
+type RuleService_AuditRules_Call struct {
+	*mock.Call
+}
+
+// AuditRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *RuleService_Expecter) AuditRules(ctx interface{}, role interface{}) *RuleService_AuditRules_Call {
+	return &RuleService_AuditRules_Call{Call: _e.mock.On("AuditRules", ctx, role)}
+}
+
+func (_c *RuleService_AuditRules_Call) Run(run func(ctx context.Context, role string)) *RuleService_AuditRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_AuditRules_Call) Return(_a0 *models.RuleAuditReport, _a1 error) *RuleService_AuditRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_AuditRules_Call) RunAndReturn(run func(context.Context, string) (*models.RuleAuditReport, error)) *RuleService_AuditRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRule provides a mock function with given fields: ctx, role, userID, rule
+func (_m *RuleService) CreateRule(ctx context.Context, role string, userID int64, rule models.Rule) (*models.RuleProposal, error) {
+	ret := _m.Called(ctx, role, userID, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 *models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.Rule) (*models.RuleProposal, error)); ok {
+		return rf(ctx, role, userID, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.Rule) *models.RuleProposal); ok {
+		r0 = rf(ctx, role, userID, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.Rule) error); ok {
+		r1 = rf(ctx, role, userID, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type RuleService_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - rule models.Rule
+func (_e *RuleService_Expecter) CreateRule(ctx interface{}, role interface{}, userID interface{}, rule interface{}) *RuleService_CreateRule_Call {
+	return &RuleService_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, role, userID, rule)}
+}
+
+func (_c *RuleService_CreateRule_Call) Run(run func(ctx context.Context, role string, userID int64, rule models.Rule)) *RuleService_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.Rule))
+	})
+	return _c
+}
+
+func (_c *RuleService_CreateRule_Call) Return(_a0 *models.RuleProposal, _a1 error) *RuleService_CreateRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_CreateRule_Call) RunAndReturn(run func(context.Context, string, int64, models.Rule) (*models.RuleProposal, error)) *RuleService_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRule provides a mock function with given fields: ctx, role, userID, ruleID
+func (_m *RuleService) DeleteRule(ctx context.Context, role string, userID int64, ruleID int64) (*models.RuleProposal, error) {
+	ret := _m.Called(ctx, role, userID, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 *models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) (*models.RuleProposal, error)); ok {
+		return rf(ctx, role, userID, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) *models.RuleProposal); ok {
+		r0 = rf(ctx, role, userID, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
+		r1 = rf(ctx, role, userID, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type RuleService_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - ruleID int64
+func (_e *RuleService_Expecter) DeleteRule(ctx interface{}, role interface{}, userID interface{}, ruleID interface{}) *RuleService_DeleteRule_Call {
+	return &RuleService_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, role, userID, ruleID)}
+}
+
+func (_c *RuleService_DeleteRule_Call) Run(run func(ctx context.Context, role string, userID int64, ruleID int64)) *RuleService_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleService_DeleteRule_Call) Return(_a0 *models.RuleProposal, _a1 error) *RuleService_DeleteRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_DeleteRule_Call) RunAndReturn(run func(context.Context, string, int64, int64) (*models.RuleProposal, error)) *RuleService_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffRuleVersions provides a mock function with given fields: ctx, role, ruleID, fromVersion, toVersion
+func (_m *RuleService) DiffRuleVersions(ctx context.Context, role string, ruleID int64, fromVersion int, toVersion int) (*models.RuleVersionDiff, error) {
+	ret := _m.Called(ctx, role, ruleID, fromVersion, toVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRuleVersions")
+	}
+
+	var r0 *models.RuleVersionDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, int) (*models.RuleVersionDiff, error)); ok {
+		return rf(ctx, role, ruleID, fromVersion, toVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int, int) *models.RuleVersionDiff); ok {
+		r0 = rf(ctx, role, ruleID, fromVersion, toVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleVersionDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int, int) error); ok {
+		r1 = rf(ctx, role, ruleID, fromVersion, toVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_DiffRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRuleVersions'
+type RuleService_DiffRuleVersions_Call struct {
+	*mock.Call
+}
+
+// DiffRuleVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - ruleID int64
+//   - fromVersion int
+//   - toVersion int
+func (_e *RuleService_Expecter) DiffRuleVersions(ctx interface{}, role interface{}, ruleID interface{}, fromVersion interface{}, toVersion interface{}) *RuleService_DiffRuleVersions_Call {
+	return &RuleService_DiffRuleVersions_Call{Call: _e.mock.On("DiffRuleVersions", ctx, role, ruleID, fromVersion, toVersion)}
+}
+
+func (_c *RuleService_DiffRuleVersions_Call) Run(run func(ctx context.Context, role string, ruleID int64, fromVersion int, toVersion int)) *RuleService_DiffRuleVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *RuleService_DiffRuleVersions_Call) Return(_a0 *models.RuleVersionDiff, _a1 error) *RuleService_DiffRuleVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_DiffRuleVersions_Call) RunAndReturn(run func(context.Context, string, int64, int, int) (*models.RuleVersionDiff, error)) *RuleService_DiffRuleVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Evaluate provides a mock function with given fields: ctx, requestType, subject, facts
+func (_m *RuleService) Evaluate(ctx context.Context, requestType string, subject models.RuleSubject, facts ruleengine.Facts) (*utils.DecisionResult, error) {
+	ret := _m.Called(ctx, requestType, subject, facts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 *utils.DecisionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.RuleSubject, ruleengine.Facts) (*utils.DecisionResult, error)); ok {
+		return rf(ctx, requestType, subject, facts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.RuleSubject, ruleengine.Facts) *utils.DecisionResult); ok {
+		r0 = rf(ctx, requestType, subject, facts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.DecisionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.RuleSubject, ruleengine.Facts) error); ok {
+		r1 = rf(ctx, requestType, subject, facts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type RuleService_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - subject models.RuleSubject
+//   - facts ruleengine.Facts
+func (_e *RuleService_Expecter) Evaluate(ctx interface{}, requestType interface{}, subject interface{}, facts interface{}) *RuleService_Evaluate_Call {
+	return &RuleService_Evaluate_Call{Call: _e.mock.On("Evaluate", ctx, requestType, subject, facts)}
+}
+
+func (_c *RuleService_Evaluate_Call) Run(run func(ctx context.Context, requestType string, subject models.RuleSubject, facts ruleengine.Facts)) *RuleService_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.RuleSubject), args[3].(ruleengine.Facts))
+	})
+	return _c
+}
+
+func (_c *RuleService_Evaluate_Call) Return(_a0 *utils.DecisionResult, _a1 error) *RuleService_Evaluate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_Evaluate_Call) RunAndReturn(run func(context.Context, string, models.RuleSubject, ruleengine.Facts) (*utils.DecisionResult, error)) *RuleService_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportRules provides a mock function with given fields: ctx, role
+func (_m *RuleService) ExportRules(ctx context.Context, role string) (*models.RuleBundle, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRules")
+	}
+
+	var r0 *models.RuleBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.RuleBundle, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.RuleBundle); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_ExportRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportRules'
+type RuleService_ExportRules_Call struct {
+	*mock.Call
+}
+
+// ExportRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *RuleService_Expecter) ExportRules(ctx interface{}, role interface{}) *RuleService_ExportRules_Call {
+	return &RuleService_ExportRules_Call{Call: _e.mock.On("ExportRules", ctx, role)}
+}
+
+func (_c *RuleService_ExportRules_Call) Run(run func(ctx context.Context, role string)) *RuleService_ExportRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_ExportRules_Call) Return(_a0 *models.RuleBundle, _a1 error) *RuleService_ExportRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_ExportRules_Call) RunAndReturn(run func(context.Context, string) (*models.RuleBundle, error)) *RuleService_ExportRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleCacheStats provides a mock function with given fields: ctx, role
+func (_m *RuleService) GetRuleCacheStats(ctx context.Context, role string) (*models.RuleCacheStats, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleCacheStats")
+	}
+
+	var r0 *models.RuleCacheStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.RuleCacheStats, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.RuleCacheStats); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleCacheStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_GetRuleCacheStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleCacheStats'
+type RuleService_GetRuleCacheStats_Call struct {
+	*mock.Call
+}
+
+// GetRuleCacheStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *RuleService_Expecter) GetRuleCacheStats(ctx interface{}, role interface{}) *RuleService_GetRuleCacheStats_Call {
+	return &RuleService_GetRuleCacheStats_Call{Call: _e.mock.On("GetRuleCacheStats", ctx, role)}
+}
+
+func (_c *RuleService_GetRuleCacheStats_Call) Run(run func(ctx context.Context, role string)) *RuleService_GetRuleCacheStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_GetRuleCacheStats_Call) Return(_a0 *models.RuleCacheStats, _a1 error) *RuleService_GetRuleCacheStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_GetRuleCacheStats_Call) RunAndReturn(run func(context.Context, string) (*models.RuleCacheStats, error)) *RuleService_GetRuleCacheStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleProposals provides a mock function with given fields: ctx, role, status
+func (_m *RuleService) GetRuleProposals(ctx context.Context, role string, status string) ([]models.RuleProposal, error) {
+	ret := _m.Called(ctx, role, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleProposals")
+	}
+
+	var r0 []models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.RuleProposal, error)); ok {
+		return rf(ctx, role, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.RuleProposal); ok {
+		r0 = rf(ctx, role, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, role, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_GetRuleProposals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleProposals'
+type RuleService_GetRuleProposals_Call struct {
+	*mock.Call
+}
+
+// GetRuleProposals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - status string
+func (_e *RuleService_Expecter) GetRuleProposals(ctx interface{}, role interface{}, status interface{}) *RuleService_GetRuleProposals_Call {
+	return &RuleService_GetRuleProposals_Call{Call: _e.mock.On("GetRuleProposals", ctx, role, status)}
+}
+
+func (_c *RuleService_GetRuleProposals_Call) Run(run func(ctx context.Context, role string, status string)) *RuleService_GetRuleProposals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_GetRuleProposals_Call) Return(_a0 []models.RuleProposal, _a1 error) *RuleService_GetRuleProposals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_GetRuleProposals_Call) RunAndReturn(run func(context.Context, string, string) ([]models.RuleProposal, error)) *RuleService_GetRuleProposals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleVersions provides a mock function with given fields: ctx, role, ruleID
+func (_m *RuleService) GetRuleVersions(ctx context.Context, role string, ruleID int64) ([]models.RuleVersion, error) {
+	ret := _m.Called(ctx, role, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleVersions")
+	}
+
+	var r0 []models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RuleVersion, error)); ok {
+		return rf(ctx, role, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RuleVersion); ok {
+		r0 = rf(ctx, role, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, role, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_GetRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersions'
+type RuleService_GetRuleVersions_Call struct {
+	*mock.Call
+}
+
+// GetRuleVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - ruleID int64
+func (_e *RuleService_Expecter) GetRuleVersions(ctx interface{}, role interface{}, ruleID interface{}) *RuleService_GetRuleVersions_Call {
+	return &RuleService_GetRuleVersions_Call{Call: _e.mock.On("GetRuleVersions", ctx, role, ruleID)}
+}
+
+func (_c *RuleService_GetRuleVersions_Call) Run(run func(ctx context.Context, role string, ruleID int64)) *RuleService_GetRuleVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleService_GetRuleVersions_Call) Return(_a0 []models.RuleVersion, _a1 error) *RuleService_GetRuleVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_GetRuleVersions_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RuleVersion, error)) *RuleService_GetRuleVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRules provides a mock function with given fields: ctx, role
+func (_m *RuleService) GetRules(ctx context.Context, role string) ([]models.Rule, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRules")
+	}
+
+	var r0 []models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Rule, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Rule); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_GetRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRules'
+type RuleService_GetRules_Call struct {
+	*mock.Call
+}
+
+// GetRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *RuleService_Expecter) GetRules(ctx interface{}, role interface{}) *RuleService_GetRules_Call {
+	return &RuleService_GetRules_Call{Call: _e.mock.On("GetRules", ctx, role)}
+}
+
+func (_c *RuleService_GetRules_Call) Run(run func(ctx context.Context, role string)) *RuleService_GetRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_GetRules_Call) Return(_a0 []models.Rule, _a1 error) *RuleService_GetRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_GetRules_Call) RunAndReturn(run func(context.Context, string) ([]models.Rule, error)) *RuleService_GetRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportRules provides a mock function with given fields: ctx, role, userID, bundle, preview
+func (_m *RuleService) ImportRules(ctx context.Context, role string, userID int64, bundle models.RuleBundle, preview bool) (*models.RuleImportPlan, error) {
+	ret := _m.Called(ctx, role, userID, bundle, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportRules")
+	}
+
+	var r0 *models.RuleImportPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.RuleBundle, bool) (*models.RuleImportPlan, error)); ok {
+		return rf(ctx, role, userID, bundle, preview)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.RuleBundle, bool) *models.RuleImportPlan); ok {
+		r0 = rf(ctx, role, userID, bundle, preview)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleImportPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.RuleBundle, bool) error); ok {
+		r1 = rf(ctx, role, userID, bundle, preview)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_ImportRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportRules'
+type RuleService_ImportRules_Call struct {
+	*mock.Call
+}
+
+// ImportRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - bundle models.RuleBundle
+//   - preview bool
+func (_e *RuleService_Expecter) ImportRules(ctx interface{}, role interface{}, userID interface{}, bundle interface{}, preview interface{}) *RuleService_ImportRules_Call {
+	return &RuleService_ImportRules_Call{Call: _e.mock.On("ImportRules", ctx, role, userID, bundle, preview)}
+}
+
+func (_c *RuleService_ImportRules_Call) Run(run func(ctx context.Context, role string, userID int64, bundle models.RuleBundle, preview bool)) *RuleService_ImportRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.RuleBundle), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *RuleService_ImportRules_Call) Return(_a0 *models.RuleImportPlan, _a1 error) *RuleService_ImportRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_ImportRules_Call) RunAndReturn(run func(context.Context, string, int64, models.RuleBundle, bool) (*models.RuleImportPlan, error)) *RuleService_ImportRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateRules provides a mock function with given fields: requestType
+func (_m *RuleService) InvalidateRules(requestType string) {
+	_m.Called(requestType)
+}
+
+// RuleService_InvalidateRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateRules'
+type RuleService_InvalidateRules_Call struct {
+	*mock.Call
+}
+
+// InvalidateRules is a helper method to define mock.On call
+//   - requestType string
+func (_e *RuleService_Expecter) InvalidateRules(requestType interface{}) *RuleService_InvalidateRules_Call {
+	return &RuleService_InvalidateRules_Call{Call: _e.mock.On("InvalidateRules", requestType)}
+}
+
+func (_c *RuleService_InvalidateRules_Call) Run(run func(requestType string)) *RuleService_InvalidateRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_InvalidateRules_Call) Return() *RuleService_InvalidateRules_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RuleService_InvalidateRules_Call) RunAndReturn(run func(string)) *RuleService_InvalidateRules_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RejectRuleProposal provides a mock function with given fields: ctx, role, reviewerID, proposalID, comment
+func (_m *RuleService) RejectRuleProposal(ctx context.Context, role string, reviewerID int64, proposalID int64, comment string) (*models.RuleProposal, error) {
+	ret := _m.Called(ctx, role, reviewerID, proposalID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectRuleProposal")
+	}
+
+	var r0 *models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) (*models.RuleProposal, error)); ok {
+		return rf(ctx, role, reviewerID, proposalID, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) *models.RuleProposal); ok {
+		r0 = rf(ctx, role, reviewerID, proposalID, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, string) error); ok {
+		r1 = rf(ctx, role, reviewerID, proposalID, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_RejectRuleProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectRuleProposal'
+type RuleService_RejectRuleProposal_Call struct {
+	*mock.Call
+}
+
+// RejectRuleProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - reviewerID int64
+//   - proposalID int64
+//   - comment string
+func (_e *RuleService_Expecter) RejectRuleProposal(ctx interface{}, role interface{}, reviewerID interface{}, proposalID interface{}, comment interface{}) *RuleService_RejectRuleProposal_Call {
+	return &RuleService_RejectRuleProposal_Call{Call: _e.mock.On("RejectRuleProposal", ctx, role, reviewerID, proposalID, comment)}
+}
+
+func (_c *RuleService_RejectRuleProposal_Call) Run(run func(ctx context.Context, role string, reviewerID int64, proposalID int64, comment string)) *RuleService_RejectRuleProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *RuleService_RejectRuleProposal_Call) Return(_a0 *models.RuleProposal, _a1 error) *RuleService_RejectRuleProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_RejectRuleProposal_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) (*models.RuleProposal, error)) *RuleService_RejectRuleProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRule provides a mock function with given fields: ctx, role, userID, ruleID, rule
+func (_m *RuleService) UpdateRule(ctx context.Context, role string, userID int64, ruleID int64, rule models.Rule) (*models.RuleProposal, error) {
+	ret := _m.Called(ctx, role, userID, ruleID, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRule")
+	}
+
+	var r0 *models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, models.Rule) (*models.RuleProposal, error)); ok {
+		return rf(ctx, role, userID, ruleID, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, models.Rule) *models.RuleProposal); ok {
+		r0 = rf(ctx, role, userID, ruleID, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, models.Rule) error); ok {
+		r1 = rf(ctx, role, userID, ruleID, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleService_UpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRule'
+type RuleService_UpdateRule_Call struct {
+	*mock.Call
+}
+
+// UpdateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - ruleID int64
+//   - rule models.Rule
+func (_e *RuleService_Expecter) UpdateRule(ctx interface{}, role interface{}, userID interface{}, ruleID interface{}, rule interface{}) *RuleService_UpdateRule_Call {
+	return &RuleService_UpdateRule_Call{Call: _e.mock.On("UpdateRule", ctx, role, userID, ruleID, rule)}
+}
+
+func (_c *RuleService_UpdateRule_Call) Run(run func(ctx context.Context, role string, userID int64, ruleID int64, rule models.Rule)) *RuleService_UpdateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(models.Rule))
+	})
+	return _c
+}
+
+func (_c *RuleService_UpdateRule_Call) Return(_a0 *models.RuleProposal, _a1 error) *RuleService_UpdateRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleService_UpdateRule_Call) RunAndReturn(run func(context.Context, string, int64, int64, models.Rule) (*models.RuleProposal, error)) *RuleService_UpdateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRuleService creates a new instance of RuleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRuleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RuleService {
+	mock := &RuleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,491 @@
+package requests
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RequestService applies and cancels requests of the registered request types
+type RequestService struct {
+	requestRepo  interfaces.GenericRequestRepository
+	balanceRepo  interfaces.BalanceRepository
+	ruleService  interfaces.RuleService
+	userRepo     interfaces.UserRepository
+	chainService interfaces.ApprovalChainService
+	db           interfaces.DB
+}
+
+// NewRequestService creates a new instance of RequestService
+func NewRequestService(
+	ctx context.Context,
+	requestRepo interfaces.GenericRequestRepository,
+	balanceRepo interfaces.BalanceRepository,
+	ruleService interfaces.RuleService,
+	userRepo interfaces.UserRepository,
+	chainService interfaces.ApprovalChainService,
+	db interfaces.DB,
+) interfaces.RequestService {
+	return &RequestService{
+		requestRepo:  requestRepo,
+		balanceRepo:  balanceRepo,
+		ruleService:  ruleService,
+		userRepo:     userRepo,
+		chainService: chainService,
+		db:           db,
+	}
+}
+
+// GetRequestTypes lists the request types served under /api/requests/{type}
+func (s *RequestService) GetRequestTypes(ctx context.Context) ([]models.RequestType, error) {
+	return utils.RegisteredRequestTypes(), nil
+}
+
+// Apply validates the payload against its request type, runs the type's rules and stores the request
+func (s *RequestService) Apply(
+	ctx context.Context,
+	userID int64,
+	requestType string,
+	payload map[string]interface{},
+) (string, string, error) {
+	if userID <= 0 {
+		return "", "", apperrors.ErrInvalidUser
+	}
+
+	def, ok := utils.LookupRequestType(requestType)
+	if !ok {
+		return "", "", apperrors.ErrUnknownRequestType
+	}
+
+	payload, err := utils.ValidatePayload(def, payload)
+	if err != nil {
+		return "", "", err
+	}
+	charge := utils.ChargeOf(def, payload)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", "", apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	// balance check
+	if def.Balance != nil {
+		remaining, err := remainingBalance(ctx, s.balanceRepo, tx, def.Balance.Kind, userID)
+		if err != nil {
+			return "", "", err
+		}
+		if err := checkCharge(def.Balance.Kind, charge, remaining); err != nil {
+			return "", "", err
+		}
+	}
+
+	// requester grade, role, manager and department
+	subject, err := s.userRepo.GetRuleSubject(ctx, tx, userID)
+	if err != nil {
+		return "", "", err
+	}
+
+	// evaluate rule set
+	facts := utils.RequestFacts(def, payload, subject.GradeID, subject.Role, time.Now())
+	result, err := s.ruleService.Evaluate(ctx, def.Name, *subject, facts)
+	if err != nil {
+		return "", "", apperrors.ErrRuleNotFound
+	}
+
+	req := &models.GenericRequest{
+		RequestType:   def.Name,
+		EmployeeID:    userID,
+		Payload:       payload,
+		Status:        result.Status,
+		RuleID:        result.RuleID(),
+		RuleVersionID: result.RuleVersionID(),
+		DecisionTrace: result.Trace,
+	}
+
+	// rejecting rules record their reason on the request
+	if result.Status == constants.StatusAutoRejected {
+		req.ApprovalComment = result.Message
+	}
+
+	if err := s.requestRepo.Create(ctx, tx, req); err != nil {
+		return "", "", apperrors.ErrInsertFailed
+	}
+
+	// pending requests go through the approval chain their facts select, if any
+	if result.Status == constants.StatusPending {
+		if err := s.chainService.Start(ctx, tx, def.Name, req.ID, userID, facts); err != nil {
+			return "", "", err
+		}
+	}
+
+	// deduct if auto-approved
+	if result.Status == constants.StatusAutoApproved && def.Balance != nil {
+		if err := deductBalance(ctx, s.balanceRepo, tx, def.Balance.Kind, userID, charge); err != nil {
+			return "", "", err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", "", apperrors.ErrTransactionCommit
+	}
+
+	return result.Message, result.Status, nil
+}
+
+// Cancel withdraws the requester's own request, restoring the balance an auto-approval drew on
+func (s *RequestService) Cancel(ctx context.Context, userID int64, requestType string, requestID int64) error {
+	def, ok := utils.LookupRequestType(requestType)
+	if !ok {
+		return apperrors.ErrUnknownRequestType
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	req, err := s.requestRepo.GetByID(ctx, tx, def.Name, requestID)
+	if err != nil {
+		return err
+	}
+
+	// Verify ownership
+	if req.EmployeeID != userID {
+		return apperrors.ErrRequestNotFound
+	}
+
+	if err := utils.CanCancel(req.Status); err != nil {
+		return err
+	}
+
+	if err := s.requestRepo.Cancel(ctx, tx, requestID); err != nil {
+		return err
+	}
+
+	if req.Status == constants.StatusPending {
+		if err := s.chainService.Close(ctx, tx, def.Name, requestID); err != nil {
+			return err
+		}
+	}
+
+	if req.Status == constants.StatusAutoApproved && def.Balance != nil {
+		err = restoreBalance(ctx, s.balanceRepo, tx, def.Balance.Kind, userID, utils.ChargeOf(def, req.Payload))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RequestApprovalService lets managers and admins decide pending requests of the registered request types
+type RequestApprovalService struct {
+	requestRepo       interfaces.GenericRequestRepository
+	balanceRepo       interfaces.BalanceRepository
+	userRepo          interfaces.UserRepository
+	chainService      interfaces.ApprovalChainService
+	delegationService interfaces.DelegationService
+	db                interfaces.DB
+}
+
+// NewRequestApprovalService creates a new instance of RequestApprovalService
+func NewRequestApprovalService(
+	ctx context.Context,
+	requestRepo interfaces.GenericRequestRepository,
+	balanceRepo interfaces.BalanceRepository,
+	userRepo interfaces.UserRepository,
+	chainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	db interfaces.DB,
+) interfaces.RequestApprovalService {
+	return &RequestApprovalService{
+		requestRepo:       requestRepo,
+		balanceRepo:       balanceRepo,
+		userRepo:          userRepo,
+		chainService:      chainService,
+		delegationService: delegationService,
+		db:                db,
+	}
+}
+
+// GetPendingRequests lists the pending requests of a type the approver may decide
+func (s *RequestApprovalService) GetPendingRequests(ctx context.Context, role string, approverID int64, requestType string) ([]map[string]interface{}, error) {
+	def, ok := utils.LookupRequestType(requestType)
+	if !ok {
+		return nil, apperrors.ErrUnknownRequestType
+	}
+	if err := checkPolicyRole(def, role); err != nil {
+		return nil, err
+	}
+
+	if role == constants.RoleManager {
+		queue, err := s.requestRepo.GetPendingForManager(ctx, def.Name, approverID)
+		if err != nil {
+			return nil, err
+		}
+		return s.delegationService.AddDelegatedQueues(ctx, approverID, queue, typeQueue{repo: s.requestRepo, requestType: def.Name})
+	}
+	return s.requestRepo.GetPendingForAdmin(ctx, def.Name)
+}
+
+// Approve approves a pending request and charges its balance
+func (s *RequestApprovalService) Approve(
+	ctx context.Context,
+	role string,
+	approverID int64,
+	requestType string,
+	requestID int64,
+	comment string,
+) error {
+	def, ok := utils.LookupRequestType(requestType)
+	if !ok {
+		return apperrors.ErrUnknownRequestType
+	}
+	if def.Policy.CommentRequired && comment == "" {
+		return apperrors.ErrCommentRequired
+	}
+
+	return s.decide(ctx, def, role, approverID, requestID, constants.StatusApproved, comment)
+}
+
+// Reject rejects a pending request; a comment is always required
+func (s *RequestApprovalService) Reject(
+	ctx context.Context,
+	role string,
+	approverID int64,
+	requestType string,
+	requestID int64,
+	comment string,
+) error {
+	def, ok := utils.LookupRequestType(requestType)
+	if !ok {
+		return apperrors.ErrUnknownRequestType
+	}
+	if comment == "" {
+		return apperrors.ErrCommentRequired
+	}
+
+	return s.decide(ctx, def, role, approverID, requestID, constants.StatusRejected, comment)
+}
+
+// BulkApprove approves each of the requests of a type in its own transaction, with the same checks
+// as Approve, and reports how each went
+func (s *RequestApprovalService) BulkApprove(
+	ctx context.Context,
+	role string,
+	approverID int64,
+	requestType string,
+	requestIDs []int64,
+	comment string,
+) (*models.BulkDecision, error) {
+	if _, ok := utils.LookupRequestType(requestType); !ok {
+		return nil, apperrors.ErrUnknownRequestType
+	}
+
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.Approve(ctx, role, approverID, requestType, requestID, comment)
+	})
+}
+
+// BulkReject rejects each of the requests of a type in its own transaction, with the same checks
+// as Reject, and reports how each went
+func (s *RequestApprovalService) BulkReject(
+	ctx context.Context,
+	role string,
+	approverID int64,
+	requestType string,
+	requestIDs []int64,
+	comment string,
+) (*models.BulkDecision, error) {
+	if _, ok := utils.LookupRequestType(requestType); !ok {
+		return nil, apperrors.ErrUnknownRequestType
+	}
+
+	return utils.RunBulk(role, requestIDs, func(requestID int64) error {
+		return s.Reject(ctx, role, approverID, requestType, requestID, comment)
+	})
+}
+
+func (s *RequestApprovalService) decide(
+	ctx context.Context,
+	def models.RequestType,
+	role string,
+	approverID, requestID int64,
+	status, comment string,
+) error {
+	if err := checkPolicyRole(def, role); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	req, err := s.requestRepo.GetByID(ctx, tx, def.Name, requestID)
+	if err != nil {
+		return err
+	}
+
+	if approverID == req.EmployeeID {
+		return apperrors.ErrSelfApprovalNotAllowed
+	}
+
+	if err := utils.ValidatePendingStatus(req.Status); err != nil {
+		return err
+	}
+
+	// requests on an approval chain are decided one step at a time; a rejection ends the chain
+	decision, err := s.chainService.Decide(ctx, tx, def.Name, requestID, approverID, role, status, comment)
+	if err != nil {
+		return err
+	}
+
+	onBehalfOf := decision.OnBehalfOf
+	if !decision.Chained {
+		// a delegate decides with the authority of the approver they stand in for
+		approver, err := s.delegationService.ResolveApprover(ctx, tx, approverID, role, req.EmployeeID)
+		if err != nil {
+			return err
+		}
+		if err := checkPolicyRole(def, approver.Role); err != nil {
+			return err
+		}
+		onBehalfOf = approver.OnBehalfOf
+
+		requesterRole, err := s.userRepo.GetRole(ctx, tx, req.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := utils.ValidateApproverRole(approver.Role, requesterRole); err != nil {
+			return err
+		}
+	}
+
+	// the request stays pending until the last step approves
+	if decision.Chained && !decision.Final {
+		return tx.Commit(ctx)
+	}
+
+	if status == constants.StatusApproved && def.Balance != nil {
+		err = deductBalance(ctx, s.balanceRepo, tx, def.Balance.Kind, req.EmployeeID, utils.ChargeOf(def, req.Payload))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.requestRepo.UpdateStatus(ctx, tx, requestID, status, approverID, comment); err != nil {
+		return err
+	}
+
+	if onBehalfOf != nil {
+		if err := s.requestRepo.SetOnBehalfOf(ctx, tx, requestID, *onBehalfOf); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// typeQueue is the pending queue of one request type
+type typeQueue struct {
+	repo        interfaces.GenericRequestRepository
+	requestType string
+}
+
+func (q typeQueue) GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error) {
+	return q.repo.GetPendingForManager(ctx, q.requestType, managerID)
+}
+
+func (q typeQueue) GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error) {
+	return q.repo.GetPendingForAdmin(ctx, q.requestType)
+}
+
+// checkPolicyRole applies the request type's approval policy on top of the usual approver checks
+func checkPolicyRole(def models.RequestType, role string) error {
+	if role == constants.RoleEmployee {
+		return apperrors.ErrEmployeeCannotApprove
+	}
+	for _, allowed := range def.Policy.ApproverRoles {
+		if allowed == role {
+			return nil
+		}
+	}
+	return apperrors.ErrApproverRoleNotAllowed
+}
+
+// the balance helpers map a request type's charge onto the existing balances
+
+func remainingBalance(ctx context.Context, repo interfaces.BalanceRepository, tx interfaces.Tx, kind string, userID int64) (float64, error) {
+	switch kind {
+	case "LEAVE":
+		days, err := repo.GetLeaveBalance(ctx, tx, userID)
+		return float64(days), err
+	case "EXPENSE":
+		return repo.GetExpenseBalance(ctx, tx, userID)
+	case "DISCOUNT":
+		return repo.GetDiscountBalance(ctx, tx, userID)
+	}
+	return 0, apperrors.ErrInvalidRequestTypeDefinition
+}
+
+func checkCharge(kind string, charge, remaining float64) error {
+	switch kind {
+	case "LEAVE":
+		if charge <= 0 || charge != math.Trunc(charge) {
+			return apperrors.ErrInvalidLeaveDays
+		}
+		if charge > remaining {
+			return apperrors.ErrLeaveBalanceExceeded
+		}
+	case "EXPENSE":
+		if charge <= 0 {
+			return apperrors.ErrInvalidExpenseAmount
+		}
+		if charge > remaining {
+			return apperrors.ErrExpenseLimitExceeded
+		}
+	case "DISCOUNT":
+		if charge <= 0 {
+			return apperrors.ErrInvalidDiscountPercent
+		}
+		if charge > remaining {
+			return apperrors.ErrDiscountLimitExceeded
+		}
+	}
+	return nil
+}
+
+func deductBalance(ctx context.Context, repo interfaces.BalanceRepository, tx interfaces.Tx, kind string, userID int64, charge float64) error {
+	switch kind {
+	case "LEAVE":
+		return repo.DeductLeaveBalance(ctx, tx, userID, int(charge))
+	case "EXPENSE":
+		return repo.DeductExpenseBalance(ctx, tx, userID, charge)
+	case "DISCOUNT":
+		return repo.DeductDiscountBalance(ctx, tx, userID, charge)
+	}
+	return apperrors.ErrInvalidRequestTypeDefinition
+}
+
+func restoreBalance(ctx context.Context, repo interfaces.BalanceRepository, tx interfaces.Tx, kind string, userID int64, charge float64) error {
+	switch kind {
+	case "LEAVE":
+		return repo.RestoreLeaveBalance(ctx, tx, userID, int(charge))
+	case "EXPENSE":
+		return repo.RestoreExpenseBalance(ctx, tx, userID, charge)
+	case "DISCOUNT":
+		return repo.RestoreDiscountBalance(ctx, tx, userID, charge)
+	}
+	return apperrors.ErrInvalidRequestTypeDefinition
+}
@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/requests"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/requests/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestHandler_Apply(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestType    string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.RequestService)
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestType: "travel",
+			reqBody:     map[string]interface{}{"destination": "Pune", "amount": 800},
+			mockSetup: func(s *mocks.RequestService) {
+				s.EXPECT().Apply(mock.Anything, int64(1), "travel", map[string]interface{}{"destination": "Pune", "amount": 800.0}).
+					Return("TRAVEL submitted for approval", "PENDING", nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "Invalid Payload",
+			requestType: "TRAVEL",
+			reqBody:     map[string]interface{}{"amount": 800},
+			mockSetup: func(s *mocks.RequestService) {
+				s.EXPECT().Apply(mock.Anything, int64(1), "TRAVEL", mock.Anything).
+					Return("", "", &utils.PayloadError{Field: "destination", Message: "is required"})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Unknown Type",
+			requestType: "BOGUS",
+			reqBody:     map[string]interface{}{},
+			mockSetup: func(s *mocks.RequestService) {
+				s.EXPECT().Apply(mock.Anything, int64(1), "BOGUS", mock.Anything).Return("", "", apperrors.ErrUnknownRequestType)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid JSON",
+			requestType:    "TRAVEL",
+			reqBody:        "{ invalid",
+			mockSetup:      func(s *mocks.RequestService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRequestService(t)
+			tt.mockSetup(mockService)
+
+			handler := requests.NewRequestHandler(nil, mockService)
+			r := gin.New()
+			r.POST("/requests/:type/apply", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+				handler.Apply(c)
+			})
+
+			var body []byte
+			if s, ok := tt.reqBody.(string); ok {
+				body = []byte(s)
+			} else {
+				body, _ = json.Marshal(tt.reqBody)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/requests/"+tt.requestType+"/apply", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRequestApprovalHandler_Approve(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		id             string
+		mockSetup      func(s *mocks.RequestApprovalService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			id:   "5",
+			mockSetup: func(s *mocks.RequestApprovalService) {
+				s.EXPECT().Approve(mock.Anything, "MANAGER", int64(9), "TRAVEL", int64(5), "fine").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Role Outside Policy",
+			id:   "5",
+			mockSetup: func(s *mocks.RequestApprovalService) {
+				s.EXPECT().Approve(mock.Anything, "MANAGER", int64(9), "TRAVEL", int64(5), "fine").Return(apperrors.ErrApproverRoleNotAllowed)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			mockSetup:      func(s *mocks.RequestApprovalService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRequestApprovalService(t)
+			tt.mockSetup(mockService)
+
+			handler := requests.NewRequestApprovalHandler(nil, mockService)
+			r := gin.New()
+			r.POST("/requests/:type/approve/:id", func(c *gin.Context) {
+				c.Set("role", "MANAGER")
+				c.Set("user_id", int64(9))
+				handler.Approve(c)
+			})
+
+			body, _ := json.Marshal(map[string]string{"comment": "fine"})
+			req := httptest.NewRequest(http.MethodPost, "/requests/TRAVEL/approve/"+tt.id, bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,479 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/requests"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/requests/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TRAVEL draws on the expense balance; ASSET has no balance and only admins decide it
+func init() {
+	for _, def := range []models.RequestType{
+		{
+			Name: "TRAVEL",
+			Fields: []models.RequestField{
+				{Name: "destination", Type: constants.FieldString, Required: true},
+				{Name: "amount", Type: constants.FieldNumber, Required: true},
+			},
+			Balance:    &models.RequestCharge{Kind: "EXPENSE", Field: "amount"},
+			Attributes: []string{"amount"},
+		},
+		{
+			Name:   "ASSET",
+			Fields: []models.RequestField{{Name: "item", Type: constants.FieldString, Required: true}},
+			Policy: models.ApprovalPolicy{ApproverRoles: []string{constants.RoleAdmin}, CommentRequired: true},
+		},
+	} {
+		if err := utils.RegisterRequestType(def); err != nil && !errors.Is(err, apperrors.ErrRequestTypeExists) {
+			panic(err)
+		}
+	}
+}
+
+func TestRequestService_Apply(t *testing.T) {
+	ctx := context.Background()
+	subject := models.RuleSubject{UserID: 1, GradeID: 2, Role: constants.RoleEmployee}
+
+	tests := []struct {
+		name           string
+		requestType    string
+		payload        map[string]interface{}
+		mockSetup      func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx)
+		expectedStatus string
+		expectedError  error
+	}{
+		{
+			name:        "Auto Approved Charges Balance",
+			requestType: "travel",
+			payload:     map[string]interface{}{"destination": "Pune", "amount": 800.0},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&subject, nil)
+				r.EXPECT().Evaluate(ctx, "TRAVEL", subject, mock.MatchedBy(func(facts utils.Facts) bool {
+					_, exposed := facts["destination"]
+					return facts["amount"] == 800.0 && facts[utils.AttrGrade] == int64(2) && !exposed
+				})).Return(&utils.DecisionResult{
+					Status:  constants.StatusAutoApproved,
+					Message: "TRAVEL approved by system",
+					Rule:    &models.Rule{ID: 4, VersionID: 40},
+				}, nil)
+				g.EXPECT().Create(ctx, tx, mock.MatchedBy(func(req *models.GenericRequest) bool {
+					return req.RequestType == "TRAVEL" && req.Status == constants.StatusAutoApproved &&
+						*req.RuleVersionID == 40 && req.Payload["destination"] == "Pune"
+				})).Return(nil)
+				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 800.0).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedStatus: constants.StatusAutoApproved,
+		},
+		{
+			name:        "Pending Without Balance",
+			requestType: "ASSET",
+			payload:     map[string]interface{}{"item": "Monitor"},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&subject, nil)
+				r.EXPECT().Evaluate(ctx, "ASSET", subject, mock.Anything).Return(&utils.DecisionResult{
+					Status:  constants.StatusPending,
+					Message: "ASSET submitted for approval",
+				}, nil)
+				g.EXPECT().Create(ctx, tx, mock.AnythingOfType("*models.GenericRequest")).Run(func(_ context.Context, _ interfaces.Tx, req *models.GenericRequest) {
+					req.ID = 6
+				}).Return(nil)
+				c.EXPECT().Start(ctx, tx, "ASSET", int64(6), int64(1), mock.Anything).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+			expectedStatus: constants.StatusPending,
+		},
+		{
+			name:        "Balance Exceeded",
+			requestType: "TRAVEL",
+			payload:     map[string]interface{}{"destination": "Pune", "amount": 1800.0},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				b.EXPECT().GetExpenseBalance(ctx, tx, int64(1)).Return(1000.0, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrExpenseLimitExceeded,
+		},
+		{
+			name:        "Invalid Payload",
+			requestType: "TRAVEL",
+			payload:     map[string]interface{}{"amount": 100.0},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrInvalidRequestPayload,
+		},
+		{
+			name:        "Unknown Type",
+			requestType: "LEAVE",
+			payload:     map[string]interface{}{"days": 2},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrUnknownRequestType,
+		},
+		{
+			name:        "No Rules",
+			requestType: "ASSET",
+			payload:     map[string]interface{}{"item": "Monitor"},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, r *mocks.RuleService, u *mocks.UserRepository, c *mocks.ApprovalChainService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				u.EXPECT().GetRuleSubject(ctx, tx, int64(1)).Return(&subject, nil)
+				r.EXPECT().Evaluate(ctx, "ASSET", subject, mock.Anything).Return(nil, apperrors.ErrNoRuleFound)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrRuleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRequestRepo := mocks.NewGenericRequestRepository(t)
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			mockRuleService := mocks.NewRuleService(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockChainService := mocks.NewApprovalChainService(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockRequestRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockChainService, mockDB, mockTx)
+
+			service := requests.NewRequestService(ctx, mockRequestRepo, mockBalanceRepo, mockRuleService, mockUserRepo, mockChainService, mockDB)
+			_, status, err := service.Apply(ctx, 1, tt.requestType, tt.payload)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, status)
+		})
+	}
+}
+
+func TestRequestService_Cancel(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		request       *models.GenericRequest
+		mockSetup     func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, c *mocks.ApprovalChainService, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:    "Auto Approved Restores Balance",
+			request: &models.GenericRequest{ID: 5, EmployeeID: 1, Status: constants.StatusAutoApproved, Payload: map[string]interface{}{"amount": 800.0}},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, c *mocks.ApprovalChainService, tx *mocks.Tx) {
+				g.EXPECT().Cancel(ctx, tx, int64(5)).Return(nil)
+				b.EXPECT().RestoreExpenseBalance(ctx, tx, int64(1), 800.0).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:    "Pending",
+			request: &models.GenericRequest{ID: 5, EmployeeID: 1, Status: constants.StatusPending, Payload: map[string]interface{}{"amount": 800.0}},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, c *mocks.ApprovalChainService, tx *mocks.Tx) {
+				g.EXPECT().Cancel(ctx, tx, int64(5)).Return(nil)
+				c.EXPECT().Close(ctx, tx, "TRAVEL", int64(5)).Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+			},
+		},
+		{
+			name:    "Someone Else's Request",
+			request: &models.GenericRequest{ID: 5, EmployeeID: 2, Status: constants.StatusPending},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, c *mocks.ApprovalChainService, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrRequestNotFound,
+		},
+		{
+			name:    "Already Decided",
+			request: &models.GenericRequest{ID: 5, EmployeeID: 1, Status: constants.StatusRejected},
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, c *mocks.ApprovalChainService, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrRequestCannotCancel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRequestRepo := mocks.NewGenericRequestRepository(t)
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			mockChainService := mocks.NewApprovalChainService(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+			mockRequestRepo.EXPECT().GetByID(ctx, mockTx, "TRAVEL", int64(5)).Return(tt.request, nil)
+			mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			tt.mockSetup(mockRequestRepo, mockBalanceRepo, mockChainService, mockTx)
+
+			service := requests.NewRequestService(ctx, mockRequestRepo, mockBalanceRepo, nil, nil, mockChainService, mockDB)
+			err := service.Cancel(ctx, 1, "TRAVEL", 5)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRequestApprovalService_Decide(t *testing.T) {
+	ctx := context.Background()
+	pending := func(payload map[string]interface{}) *models.GenericRequest {
+		return &models.GenericRequest{ID: 5, EmployeeID: 1, Status: constants.StatusPending, Payload: payload}
+	}
+
+	tests := []struct {
+		name          string
+		approve       bool
+		requestType   string
+		role          string
+		comment       string
+		mockSetup     func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx)
+		expectedError error
+	}{
+		{
+			name:        "Approve Charges Balance",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleManager,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(pending(map[string]interface{}{"amount": 800.0}), nil)
+				c.EXPECT().Decide(ctx, tx, "TRAVEL", int64(5), int64(9), constants.RoleManager, constants.StatusApproved, "").Return(models.ChainDecision{}, nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(9), constants.RoleManager, int64(1)).Return(&models.Approver{ID: 9, Role: constants.RoleManager}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(1)).Return(constants.RoleEmployee, nil)
+				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 800.0).Return(nil)
+				g.EXPECT().UpdateStatus(ctx, tx, int64(5), constants.StatusApproved, int64(9), "").Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+		},
+		{
+			name:        "Reject Leaves Balance",
+			requestType: "TRAVEL",
+			role:        constants.RoleAdmin,
+			comment:     "Over budget",
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(pending(map[string]interface{}{"amount": 800.0}), nil)
+				c.EXPECT().Decide(ctx, tx, "TRAVEL", int64(5), int64(9), constants.RoleAdmin, constants.StatusRejected, "Over budget").Return(models.ChainDecision{}, nil)
+				d.EXPECT().ResolveApprover(ctx, tx, int64(9), constants.RoleAdmin, int64(1)).Return(&models.Approver{ID: 9, Role: constants.RoleAdmin}, nil)
+				u.EXPECT().GetRole(ctx, tx, int64(1)).Return(constants.RoleEmployee, nil)
+				g.EXPECT().UpdateStatus(ctx, tx, int64(5), constants.StatusRejected, int64(9), "Over budget").Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+		},
+		{
+			name:        "Chain Step Approved Keeps Request Pending",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleManager,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(pending(map[string]interface{}{"amount": 800.0}), nil)
+				c.EXPECT().Decide(ctx, tx, "TRAVEL", int64(5), int64(9), constants.RoleManager, constants.StatusApproved, "").Return(models.ChainDecision{Chained: true}, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+		},
+		{
+			name:        "Last Chain Step Charges Balance",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleAdmin,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(pending(map[string]interface{}{"amount": 800.0}), nil)
+				c.EXPECT().Decide(ctx, tx, "TRAVEL", int64(5), int64(9), constants.RoleAdmin, constants.StatusApproved, "").Return(models.ChainDecision{Chained: true, Final: true}, nil)
+				b.EXPECT().DeductExpenseBalance(ctx, tx, int64(1), 800.0).Return(nil)
+				g.EXPECT().UpdateStatus(ctx, tx, int64(5), constants.StatusApproved, int64(9), "").Return(nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+			},
+		},
+		{
+			name:        "Not The Step Approver",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleManager,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(pending(map[string]interface{}{"amount": 800.0}), nil)
+				c.EXPECT().Decide(ctx, tx, "TRAVEL", int64(5), int64(9), constants.RoleManager, constants.StatusApproved, "").Return(models.ChainDecision{}, apperrors.ErrNotStepApprover)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrNotStepApprover,
+		},
+		{
+			name:        "Policy Excludes Managers",
+			approve:     true,
+			requestType: "ASSET",
+			role:        constants.RoleManager,
+			comment:     "ok",
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrApproverRoleNotAllowed,
+		},
+		{
+			name:        "Policy Requires Comment",
+			approve:     true,
+			requestType: "ASSET",
+			role:        constants.RoleAdmin,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrCommentRequired,
+		},
+		{
+			name:        "Employee",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleEmployee,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+			},
+			expectedError: apperrors.ErrEmployeeCannotApprove,
+		},
+		{
+			name:        "Self Approval",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleManager,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(&models.GenericRequest{ID: 5, EmployeeID: 9, Status: constants.StatusPending}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrSelfApprovalNotAllowed,
+		},
+		{
+			name:        "Not Pending",
+			approve:     true,
+			requestType: "TRAVEL",
+			role:        constants.RoleManager,
+			mockSetup: func(g *mocks.GenericRequestRepository, b *mocks.BalanceRepository, u *mocks.UserRepository, c *mocks.ApprovalChainService, d *mocks.DelegationService, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				g.EXPECT().GetByID(ctx, tx, "TRAVEL", int64(5)).Return(&models.GenericRequest{ID: 5, EmployeeID: 1, Status: constants.StatusApproved}, nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Once()
+			},
+			expectedError: apperrors.ErrRequestNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRequestRepo := mocks.NewGenericRequestRepository(t)
+			mockBalanceRepo := mocks.NewBalanceRepository(t)
+			mockUserRepo := mocks.NewUserRepository(t)
+			mockChainService := mocks.NewApprovalChainService(t)
+			mockDelegationService := mocks.NewDelegationService(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+
+			tt.mockSetup(mockRequestRepo, mockBalanceRepo, mockUserRepo, mockChainService, mockDelegationService, mockDB, mockTx)
+
+			service := requests.NewRequestApprovalService(ctx, mockRequestRepo, mockBalanceRepo, mockUserRepo, mockChainService, mockDelegationService, mockDB)
+			var err error
+			if tt.approve {
+				err = service.Approve(ctx, tt.role, 9, tt.requestType, 5, tt.comment)
+			} else {
+				err = service.Reject(ctx, tt.role, 9, tt.requestType, 5, tt.comment)
+			}
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRequestApprovalService_GetPendingRequests(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Manager Sees Team", func(t *testing.T) {
+		mockRequestRepo := mocks.NewGenericRequestRepository(t)
+		mockDelegationService := mocks.NewDelegationService(t)
+		queue := []map[string]interface{}{{"id": int64(5)}}
+		mockRequestRepo.EXPECT().GetPendingForManager(ctx, "TRAVEL", int64(9)).Return(queue, nil)
+		mockDelegationService.EXPECT().AddDelegatedQueues(ctx, int64(9), queue, mock.Anything).Return(queue, nil)
+
+		service := requests.NewRequestApprovalService(ctx, mockRequestRepo, nil, nil, nil, mockDelegationService, nil)
+		result, err := service.GetPendingRequests(ctx, constants.RoleManager, 9, "travel")
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("Delegate Sees The Delegator's Requests Of The Type", func(t *testing.T) {
+		mockRequestRepo := mocks.NewGenericRequestRepository(t)
+		mockDelegationService := mocks.NewDelegationService(t)
+		mockRequestRepo.EXPECT().GetPendingForManager(ctx, "TRAVEL", int64(9)).Return(nil, nil)
+		mockRequestRepo.EXPECT().GetPendingForManager(ctx, "TRAVEL", int64(4)).Return([]map[string]interface{}{{"id": int64(6)}}, nil)
+		mockDelegationService.EXPECT().AddDelegatedQueues(ctx, int64(9), []map[string]interface{}(nil), mock.Anything).
+			RunAndReturn(func(ctx context.Context, _ int64, queue []map[string]interface{}, pending interfaces.PendingQueue) ([]map[string]interface{}, error) {
+				return pending.GetPendingForManager(ctx, 4)
+			})
+
+		service := requests.NewRequestApprovalService(ctx, mockRequestRepo, nil, nil, nil, mockDelegationService, nil)
+		result, err := service.GetPendingRequests(ctx, constants.RoleManager, 9, "TRAVEL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), result[0]["id"])
+	})
+
+	t.Run("Admin Sees All", func(t *testing.T) {
+		mockRequestRepo := mocks.NewGenericRequestRepository(t)
+		mockRequestRepo.EXPECT().GetPendingForAdmin(ctx, "ASSET").Return(nil, nil)
+
+		service := requests.NewRequestApprovalService(ctx, mockRequestRepo, nil, nil, nil, nil, nil)
+		_, err := service.GetPendingRequests(ctx, constants.RoleAdmin, 1, "ASSET")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Role Outside Policy", func(t *testing.T) {
+		service := requests.NewRequestApprovalService(ctx, mocks.NewGenericRequestRepository(t), nil, nil, nil, nil, nil)
+		_, err := service.GetPendingRequests(ctx, constants.RoleManager, 9, "ASSET")
+
+		assert.ErrorIs(t, err, apperrors.ErrApproverRoleNotAllowed)
+	})
+}
+
+func TestRequestApprovalService_BulkReject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Unknown Type", func(t *testing.T) {
+		service := requests.NewRequestApprovalService(ctx, nil, nil, nil, nil, nil, nil)
+		_, err := service.BulkReject(ctx, constants.RoleAdmin, 1, "PARKING", []int64{5}, "No")
+
+		assert.ErrorIs(t, err, apperrors.ErrUnknownRequestType)
+	})
+
+	t.Run("Each Request Is Checked On Its Own", func(t *testing.T) {
+		// managers may not decide ASSET requests; the check fails every request, not the batch
+		service := requests.NewRequestApprovalService(ctx, nil, nil, nil, nil, nil, nil)
+		result, err := service.BulkReject(ctx, constants.RoleManager, 9, "ASSET", []int64{5, 6}, "No")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Failed)
+		assert.ErrorIs(t, result.Results[1].Err, apperrors.ErrApproverRoleNotAllowed)
+	})
+}
@@ -0,0 +1,56 @@
+package revocations
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handles HTTP requests for the revocations of requests
+type RevocationHandler struct {
+	revocationService interfaces.RevocationService
+}
+
+// creates a new RevocationHandler instance
+func NewRevocationHandler(ctx context.Context, revocationService interfaces.RevocationService) *RevocationHandler {
+	return &RevocationHandler{revocationService: revocationService}
+}
+
+func (h *RevocationHandler) GetRevocations(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	requestID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRevocationError(c, apperrors.ErrInvalidID)
+		return
+	}
+
+	ctx := c.Request.Context()
+	revocations, err := h.revocationService.GetRevocations(ctx, role, userID, c.Param("type"), requestID)
+	if err != nil {
+		handleRevocationError(c, err)
+		return
+	}
+
+	response.Success(c, "revocations fetched successfully", revocations)
+}
+
+func handleRevocationError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+
+	switch err {
+	case apperrors.ErrRequestNotFound:
+		status = http.StatusNotFound
+	case apperrors.ErrInvalidID:
+		status = http.StatusBadRequest
+	}
+
+	response.Error(c, status, err.Error(), nil)
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestHistoryReader is an autogenerated mock type for the RequestHistoryReader type
+type RequestHistoryReader struct {
+	mock.Mock
+}
+
+type RequestHistoryReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RequestHistoryReader) EXPECT() *RequestHistoryReader_Expecter {
+	return &RequestHistoryReader_Expecter{mock: &_m.Mock}
+}
+
+// Authorize provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *RequestHistoryReader) Authorize(ctx context.Context, role string, userID int64, requestType string, requestID int64) (string, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) (string, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) string); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestHistoryReader_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type RequestHistoryReader_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *RequestHistoryReader_Expecter) Authorize(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *RequestHistoryReader_Authorize_Call {
+	return &RequestHistoryReader_Authorize_Call{Call: _e.mock.On("Authorize", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *RequestHistoryReader_Authorize_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *RequestHistoryReader_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *RequestHistoryReader_Authorize_Call) Return(_a0 string, _a1 error) *RequestHistoryReader_Authorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestHistoryReader_Authorize_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) (string, error)) *RequestHistoryReader_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequestHistoryReader creates a new instance of RequestHistoryReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestHistoryReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestHistoryReader {
+	mock := &RequestHistoryReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,37 @@
+package revocations
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RevocationService keeps who revoked an approved request and why
+type RevocationService struct {
+	revocationRepo interfaces.RevocationRepository
+	historyReader  interfaces.RequestHistoryReader
+}
+
+// NewRevocationService creates a new instance of RevocationService
+func NewRevocationService(
+	ctx context.Context,
+	revocationRepo interfaces.RevocationRepository,
+	historyReader interfaces.RequestHistoryReader,
+) interfaces.RevocationService {
+	return &RevocationService{revocationRepo: revocationRepo, historyReader: historyReader}
+}
+
+// Record stores the revocation of a request
+func (s *RevocationService) Record(ctx context.Context, tx interfaces.Tx, revocation *models.RequestRevocation) error {
+	return s.revocationRepo.Create(ctx, tx, revocation)
+}
+
+// GetRevocations returns the revocation of a request, if it was revoked
+func (s *RevocationService) GetRevocations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestRevocation, error) {
+	requestType, err := s.historyReader.Authorize(ctx, role, userID, requestType, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return s.revocationRepo.GetForRequest(ctx, requestType, requestID)
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/revocations"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/revocations/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevocationHandler_GetRevocations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		mockSetup      func(s *mocks.RevocationService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			path: "/revocations/expense/10",
+			mockSetup: func(s *mocks.RevocationService) {
+				s.EXPECT().GetRevocations(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return([]models.RequestRevocation{{ID: 4}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone Else's Request",
+			path: "/revocations/expense/10",
+			mockSetup: func(s *mocks.RevocationService) {
+				s.EXPECT().GetRevocations(mock.Anything, "EMPLOYEE", int64(1), "expense", int64(10)).Return(nil, apperrors.ErrRequestNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid ID",
+			path:           "/revocations/expense/abc",
+			mockSetup:      func(s *mocks.RevocationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRevocationService(t)
+			tt.mockSetup(mockService)
+
+			handler := revocations.NewRevocationHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/revocations/:type/:id", func(c *gin.Context) {
+				c.Set("user_id", int64(1))
+				c.Set("role", "EMPLOYEE")
+			}, handler.GetRevocations)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/app/revocations"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/revocations/mocks"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevocationService_Record(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewRevocationRepository(t)
+	mockTx := mocks.NewTx(t)
+
+	revocation := &models.RequestRevocation{
+		RequestType:    "EXPENSE",
+		RequestID:      10,
+		EmployeeID:     1,
+		RevokedBy:      2,
+		PreviousStatus: constants.StatusApproved,
+		Reason:         "Duplicate claim",
+	}
+	mockRepo.EXPECT().Create(ctx, mockTx, revocation).Return(nil)
+
+	service := revocations.NewRevocationService(ctx, mockRepo, nil)
+	err := service.Record(ctx, mockTx, revocation)
+
+	assert.NoError(t, err)
+}
+
+func TestRevocationService_GetRevocations(t *testing.T) {
+	ctx := context.Background()
+	history := []models.RequestRevocation{{ID: 4, RequestType: "EXPENSE", RequestID: 10, EmployeeID: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := mocks.NewRevocationRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleManager, int64(2), "expense", int64(10)).Return("EXPENSE", nil)
+		mockRepo.EXPECT().GetForRequest(ctx, "EXPENSE", int64(10)).Return(history, nil)
+
+		service := revocations.NewRevocationService(ctx, mockRepo, mockReader)
+		result, err := service.GetRevocations(ctx, constants.RoleManager, 2, "expense", 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, history, result)
+	})
+
+	t.Run("Not Allowed", func(t *testing.T) {
+		mockRepo := mocks.NewRevocationRepository(t)
+		mockReader := mocks.NewRequestHistoryReader(t)
+		mockReader.EXPECT().Authorize(ctx, constants.RoleEmployee, int64(3), "expense", int64(10)).Return("", apperrors.ErrRequestNotFound)
+
+		service := revocations.NewRevocationService(ctx, mockRepo, mockReader)
+		_, err := service.GetRevocations(ctx, constants.RoleEmployee, 3, "expense", 10)
+
+		assert.ErrorIs(t, err, apperrors.ErrRequestNotFound)
+	})
+}
@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RuleAnalyzerService cross-checks the rule set against the grades it has to cover
+type RuleAnalyzerService struct {
+	ruleRepo      interfaces.RuleRepository
+	gradeRepo     interfaces.GradeRepository
+	defaultAction string
+}
+
+// NewRuleAnalyzerService creates a new instance of RuleAnalyzerService
+func NewRuleAnalyzerService(
+	ctx context.Context,
+	ruleRepo interfaces.RuleRepository,
+	gradeRepo interfaces.GradeRepository,
+	defaultAction string,
+) interfaces.RuleAnalyzerService {
+	return &RuleAnalyzerService{
+		ruleRepo:      ruleRepo,
+		gradeRepo:     gradeRepo,
+		defaultAction: normalizeDefaultAction(defaultAction),
+	}
+}
+
+// the grade limit a rule threshold is checked against, per request type
+type gradeLimit struct {
+	attr  string
+	name  string
+	value func(models.Grade) float64
+}
+
+var gradeLimits = map[string]gradeLimit{
+	"LEAVE": {attr: utils.AttrDays, name: "annual leave limit",
+		value: func(g models.Grade) float64 { return float64(g.AnnualLeaveLimit) }},
+	"EXPENSE": {attr: utils.AttrAmount, name: "annual expense limit",
+		value: func(g models.Grade) float64 { return g.AnnualExpenseLimit }},
+	"DISCOUNT": {attr: utils.AttrPercent, name: "discount limit",
+		value: func(g models.Grade) float64 { return g.DiscountLimitPercent }},
+}
+
+// Analyze reports, per request type (admin only): grades no active rule covers, rules that never decide because
+// an earlier rule matches every request they would, equal-priority rules that disagree on the same requests,
+// and thresholds above a grade's limits. Conditions using any, not or != are not checked for shadowing or limits.
+func (s *RuleAnalyzerService) Analyze(ctx context.Context, role string) (*models.RuleAnalysisReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	existing, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := s.gradeRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &ruleAnalysis{
+		report: &models.RuleAnalysisReport{Grades: len(grades), Rules: len(existing), Findings: []models.RuleAnalysisFinding{}},
+		seen:   map[models.RuleAnalysisFinding]bool{},
+		bounds: map[int64]ruleengine.ConditionBounds{},
+	}
+	for _, rule := range existing {
+		parsed, err := utils.ParseCondition(rule.Condition)
+		if err != nil {
+			continue
+		}
+		if bounds, ok := ruleengine.BoundsOf(parsed); ok && bounds.Satisfiable() {
+			a.bounds[rule.ID] = bounds
+		}
+	}
+
+	active := groupRuleSets(existing, true)
+	all := groupRuleSets(existing, false)
+
+	for _, requestType := range utils.RequestTypes() {
+		globalKey := ruleSetKey{requestType: requestType, scope: constants.ScopeGlobal}
+		for _, grade := range grades {
+			gradeKey := ruleSetKey{requestType: requestType, scope: constants.ScopeGrade, targetID: grade.ID}
+			rules := append(append([]models.Rule{}, active[gradeKey]...), active[globalKey]...)
+
+			if len(rules) == 0 {
+				kind, what := constants.FindingMissingCoverage, "no"
+				if len(all[gradeKey])+len(all[globalKey]) > 0 {
+					kind, what = constants.FindingInactiveOnly, "only inactive"
+				}
+				a.add(models.RuleAnalysisFinding{
+					Kind:        kind,
+					RequestType: requestType,
+					GradeID:     grade.ID,
+					Message: fmt.Sprintf("grade %s has %s %s rules; its requests fall back to %s",
+						grade.Name, what, requestType, s.defaultAction),
+				})
+				continue
+			}
+
+			a.checkOrder(requestType, grade.ID, rules)
+			a.checkLimits(requestType, grade, rules)
+		}
+
+		// user, team and department rules only reach some members of a grade, so each set is checked on its own
+		var specific []ruleSetKey
+		for key := range active {
+			if key.requestType == requestType && key.scope != constants.ScopeGrade && key.scope != constants.ScopeGlobal {
+				specific = append(specific, key)
+			}
+		}
+		sort.Slice(specific, func(i, j int) bool {
+			if specific[i].scope != specific[j].scope {
+				return specific[i].scope < specific[j].scope
+			}
+			if specific[i].targetID != specific[j].targetID {
+				return specific[i].targetID < specific[j].targetID
+			}
+			return specific[i].department < specific[j].department
+		})
+		for _, key := range specific {
+			a.checkOrder(requestType, 0, active[key])
+		}
+	}
+
+	return a.report, nil
+}
+
+type ruleAnalysis struct {
+	report *models.RuleAnalysisReport
+	// findings about global rules alone would otherwise repeat for every grade
+	seen map[models.RuleAnalysisFinding]bool
+	// only rules whose condition can be summarised are checked for shadowing and limits
+	bounds map[int64]ruleengine.ConditionBounds
+}
+
+func (a *ruleAnalysis) add(finding models.RuleAnalysisFinding) {
+	if a.seen[finding] {
+		return
+	}
+	a.seen[finding] = true
+	a.report.Findings = append(a.report.Findings, finding)
+}
+
+// checkOrder walks rules in evaluation order; gradeID is only kept on findings involving a grade rule
+func (a *ruleAnalysis) checkOrder(requestType string, gradeID int64, rules []models.Rule) {
+	for j, later := range rules {
+		laterBounds, ok := a.bounds[later.ID]
+		if !ok {
+			continue
+		}
+
+		for _, earlier := range rules[:j] {
+			earlierBounds, ok := a.bounds[earlier.ID]
+			if !ok {
+				continue
+			}
+
+			finding := models.RuleAnalysisFinding{RequestType: requestType, RuleID: later.ID, OtherRuleID: earlier.ID}
+			if earlier.Scope == constants.ScopeGrade || later.Scope == constants.ScopeGrade {
+				finding.GradeID = gradeID
+			}
+
+			if earlierBounds.Covers(laterBounds) {
+				finding.Kind = constants.FindingShadowed
+				finding.Message = fmt.Sprintf("rule %d never decides: rule %d is tried first and matches every request it would",
+					later.ID, earlier.ID)
+				a.add(finding)
+				break
+			}
+
+			if earlier.Scope == later.Scope && earlier.Priority == later.Priority &&
+				earlier.Action != later.Action && earlierBounds.Overlaps(laterBounds) {
+				finding.Kind = constants.FindingOverlap
+				finding.Message = fmt.Sprintf("rules %d and %d share priority %d but take different actions on some requests; the lower rule id wins",
+					earlier.ID, later.ID, later.Priority)
+				a.add(finding)
+			}
+		}
+	}
+}
+
+func (a *ruleAnalysis) checkLimits(requestType string, grade models.Grade, rules []models.Rule) {
+	limit, ok := gradeLimits[requestType]
+	if !ok {
+		return
+	}
+
+	for _, rule := range rules {
+		bounds, ok := a.bounds[rule.ID]
+		if !ok {
+			continue
+		}
+		threshold, capped := bounds.Max(limit.attr)
+		if !capped || threshold <= limit.value(grade) {
+			continue
+		}
+
+		a.add(models.RuleAnalysisFinding{
+			Kind:        constants.FindingLimitExceeded,
+			RequestType: requestType,
+			GradeID:     grade.ID,
+			RuleID:      rule.ID,
+			Message: fmt.Sprintf("rule %d allows %s up to %v, above the %s of %v for grade %s",
+				rule.ID, limit.attr, threshold, limit.name, limit.value(grade), grade.Name),
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package rules
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RuleBacktestService replays past requests against a candidate rule set
+type RuleBacktestService struct {
+	ruleRepo      interfaces.RuleRepository
+	backtestRepo  interfaces.BacktestRepository
+	holidayRepo   interfaces.HolidayRepository
+	defaultAction string
+}
+
+// NewRuleBacktestService creates a new instance of RuleBacktestService
+func NewRuleBacktestService(
+	ctx context.Context,
+	ruleRepo interfaces.RuleRepository,
+	backtestRepo interfaces.BacktestRepository,
+	holidayRepo interfaces.HolidayRepository,
+	defaultAction string,
+) interfaces.RuleBacktestService {
+	return &RuleBacktestService{
+		ruleRepo:      ruleRepo,
+		backtestRepo:  backtestRepo,
+		holidayRepo:   holidayRepo,
+		defaultAction: normalizeDefaultAction(defaultAction),
+	}
+}
+
+// Backtest decides every historical request under the current and the candidate rules and diffs the outcomes (admin only).
+// Candidate rules replace the current set only for the request type and scope targets they cover.
+// The current rules for a request are the versions that were in force when it was submitted.
+func (s *RuleBacktestService) Backtest(ctx context.Context, role string, candidate []models.Rule) (*models.BacktestReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if len(candidate) == 0 {
+		return nil, apperrors.ErrCandidateRulesRequired
+	}
+
+	for i := range candidate {
+		candidate[i] = normalizeScope(candidate[i])
+		if err := validateRule(candidate[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.backtestRepo.GetHistoricalRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.historicalCalendar(ctx, history)
+	if err != nil {
+		return nil, err
+	}
+
+	// compiled once, since every historical request is decided with them
+	currentSets := compileRuleSets(groupRuleSets(current, true))
+	candidateSets := compileRuleSets(groupRuleSets(candidate, false))
+
+	report := &models.BacktestReport{}
+	breakdown := map[breakdownKey]*models.BacktestBreakdown{}
+
+	// earlier requests per employee, for cumulative conditions
+	earlier := map[int64][]models.HistoricalRequest{}
+
+	for _, req := range history {
+		var currentRules, candidateRules []utils.CompiledRule
+		for _, key := range subjectRuleSetKeys(req.RequestType, historicalSubject(req)) {
+			inForceRules := inForceAt(currentSets[key], req.CreatedAt)
+			currentRules = append(currentRules, inForceRules...)
+			if rules, ok := candidateSets[key]; ok {
+				candidateRules = append(candidateRules, rules...)
+			} else {
+				candidateRules = append(candidateRules, inForceRules...)
+			}
+		}
+
+		facts := historicalFacts(req)
+		if req.RequestType == "LEAVE" && req.FromDate != nil && req.ToDate != nil {
+			utils.AddLeaveCalendarFacts(facts, calendar, req.CreatedAt, *req.FromDate, *req.ToDate)
+		}
+		addHistoricalUsage(facts, req, earlier[req.EmployeeID])
+		earlier[req.EmployeeID] = append(earlier[req.EmployeeID], req)
+		before := utils.Decide(req.RequestType, currentRules, facts, s.defaultAction)
+		after := utils.Decide(req.RequestType, candidateRules, facts, s.defaultAction)
+
+		key := breakdownKey{requestType: req.RequestType, gradeID: req.GradeID}
+		row, ok := breakdown[key]
+		if !ok {
+			row = &models.BacktestBreakdown{RequestType: req.RequestType, GradeID: req.GradeID}
+			breakdown[key] = row
+		}
+
+		row.TotalRequests++
+		if before.Status != after.Status {
+			row.Changed++
+		}
+		if before.Status == constants.StatusPending {
+			row.CurrentManual++
+			if after.Status == constants.StatusAutoApproved {
+				row.ManualToAuto++
+			}
+		}
+		if after.Status == constants.StatusPending {
+			row.CandidateManual++
+			if before.Status == constants.StatusAutoApproved {
+				row.AutoToManual++
+			}
+		}
+	}
+
+	for _, row := range breakdown {
+		report.TotalRequests += row.TotalRequests
+		report.Changed += row.Changed
+		report.ManualToAuto += row.ManualToAuto
+		report.AutoToManual += row.AutoToManual
+		report.CurrentManual += row.CurrentManual
+		report.CandidateManual += row.CandidateManual
+		report.Breakdown = append(report.Breakdown, *row)
+	}
+
+	sort.Slice(report.Breakdown, func(i, j int) bool {
+		if report.Breakdown[i].RequestType != report.Breakdown[j].RequestType {
+			return report.Breakdown[i].RequestType < report.Breakdown[j].RequestType
+		}
+		return report.Breakdown[i].GradeID < report.Breakdown[j].GradeID
+	})
+
+	// manager workload is the number of requests left for manual review
+	report.WorkloadReduction = report.CurrentManual - report.CandidateManual
+	if report.CurrentManual > 0 {
+		percent := float64(report.WorkloadReduction) / float64(report.CurrentManual) * 100
+		report.WorkloadReductionPercent = math.Round(percent*100) / 100
+	}
+
+	return report, nil
+}
+
+type breakdownKey struct {
+	requestType string
+	gradeID     int64
+}
+
+// identifies who a set of rules applies to; targetID is the grade, user or manager depending on the scope
+type ruleSetKey struct {
+	requestType string
+	scope       string
+	targetID    int64
+	department  string
+}
+
+func ruleSetKeyOf(rule models.Rule) ruleSetKey {
+	key := ruleSetKey{requestType: rule.RequestType, scope: rule.Scope}
+	switch rule.Scope {
+	case constants.ScopeUser, constants.ScopeTeam:
+		key.targetID = rule.TargetID
+	case constants.ScopeDepartment:
+		key.department = rule.Department
+	case constants.ScopeGrade:
+		key.targetID = rule.GradeID
+	}
+	return key
+}
+
+// the rule sets that apply to a subject, most specific first
+func subjectRuleSetKeys(requestType string, subject models.RuleSubject) []ruleSetKey {
+	keys := []ruleSetKey{{requestType: requestType, scope: constants.ScopeUser, targetID: subject.UserID}}
+	if subject.ManagerID != nil {
+		keys = append(keys, ruleSetKey{requestType: requestType, scope: constants.ScopeTeam, targetID: *subject.ManagerID})
+	}
+	if subject.Department != "" {
+		keys = append(keys, ruleSetKey{requestType: requestType, scope: constants.ScopeDepartment, department: subject.Department})
+	}
+	return append(keys,
+		ruleSetKey{requestType: requestType, scope: constants.ScopeGrade, targetID: subject.GradeID},
+		ruleSetKey{requestType: requestType, scope: constants.ScopeGlobal},
+	)
+}
+
+// groups rules per request type and scope target in evaluation order
+func groupRuleSets(rules []models.Rule, activeOnly bool) map[ruleSetKey][]models.Rule {
+	ordered := make([]models.Rule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	sets := map[ruleSetKey][]models.Rule{}
+	for _, rule := range ordered {
+		if activeOnly && !rule.Active {
+			continue
+		}
+		key := ruleSetKeyOf(normalizeScope(rule))
+		sets[key] = append(sets[key], rule)
+	}
+	return sets
+}
+
+func compileRuleSets(sets map[ruleSetKey][]models.Rule) map[ruleSetKey][]utils.CompiledRule {
+	compiled := make(map[ruleSetKey][]utils.CompiledRule, len(sets))
+	for key, rules := range sets {
+		compiled[key] = utils.CompileRules(rules)
+	}
+	return compiled
+}
+
+// the rules of a set that were in force at a moment, leaving out versions scheduled after it or retired before it
+func inForceAt(rules []utils.CompiledRule, at time.Time) []utils.CompiledRule {
+	var kept []utils.CompiledRule
+	for _, rule := range rules {
+		if inForce(rule.Rule, at) {
+			kept = append(kept, rule)
+		}
+	}
+	return kept
+}
+
+func historicalSubject(req models.HistoricalRequest) models.RuleSubject {
+	return models.RuleSubject{
+		UserID:     req.EmployeeID,
+		GradeID:    req.GradeID,
+		ManagerID:  req.ManagerID,
+		Department: req.Department,
+		Role:       req.Role,
+	}
+}
+
+// rebuilds the facts a historical request was submitted with
+func historicalFacts(req models.HistoricalRequest) utils.Facts {
+	switch req.RequestType {
+	case "LEAVE":
+		days := 0
+		if req.FromDate != nil && req.ToDate != nil {
+			days = utils.CalculateLeaveDays(*req.FromDate, *req.ToDate)
+		}
+		return utils.LeaveFacts(days, req.LeaveType, req.GradeID, req.Role, req.CreatedAt)
+	case "EXPENSE":
+		return utils.ExpenseFacts(req.Amount, req.Category, req.GradeID, req.Role, req.CreatedAt)
+	default:
+		return utils.DiscountFacts(req.DiscountPercentage, req.GradeID, req.Role, req.CreatedAt)
+	}
+}
+
+// historicalCalendar loads the holidays around every historical leave in one query
+func (s *RuleBacktestService) historicalCalendar(ctx context.Context, history []models.HistoricalRequest) (utils.Calendar, error) {
+	var start, end time.Time
+	for _, req := range history {
+		if req.RequestType != "LEAVE" || req.FromDate == nil || req.ToDate == nil {
+			continue
+		}
+		from, to := utils.LeaveCalendarWindow(req.CreatedAt, *req.FromDate, *req.ToDate)
+		if start.IsZero() || from.Before(start) {
+			start = from
+		}
+		if to.After(end) {
+			end = to
+		}
+	}
+	if start.IsZero() {
+		return utils.NewCalendar(nil), nil
+	}
+
+	holidays, err := s.holidayRepo.GetHolidayDates(ctx, start, end)
+	if err != nil {
+		return utils.Calendar{}, err
+	}
+	return utils.NewCalendar(holidays), nil
+}
+
+// addHistoricalUsage replays the cumulative attributes from the requester's earlier requests.
+// Earlier requests count with the status they actually ended with, not the one a candidate rule would give.
+func addHistoricalUsage(facts utils.Facts, req models.HistoricalRequest, earlier []models.HistoricalRequest) {
+	var since time.Time
+	statuses := utils.ApprovedStatuses
+	switch req.RequestType {
+	case "LEAVE":
+		since = utils.LeaveCountWindowStart(req.CreatedAt)
+		statuses = utils.ActiveLeaveStatuses
+	case "EXPENSE":
+		since = utils.MonthStart(req.CreatedAt)
+	default:
+		since = utils.QuarterStart(req.CreatedAt)
+	}
+
+	count, total := 0, 0.0
+	for _, prev := range earlier {
+		if prev.RequestType != req.RequestType || prev.CreatedAt.Before(since) || !slices.Contains(statuses, prev.Status) {
+			continue
+		}
+		count++
+		if req.RequestType == "EXPENSE" {
+			total += prev.Amount
+		} else {
+			total += prev.DiscountPercentage
+		}
+	}
+
+	switch req.RequestType {
+	case "LEAVE":
+		facts[utils.AttrLeaveCount30d] = count
+	case "EXPENSE":
+		facts[utils.AttrMonthExpenseTotal] = total
+	default:
+		facts[utils.AttrQuarterDiscountTotal] = total
+	}
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// how long cached rules are trusted without hearing of a change, in case a notification was missed
+const ruleCacheTTL = 5 * time.Minute
+
+// ruleCache keeps the rules of each request type in memory, compiled and grouped per scope target in evaluation order.
+// Entries are dropped when rules change on this instance or, through NOTIFY, on another one.
+//
+// An entry is keyed by request type alone but holds the rule set of every scope target of that type;
+// rulesFor resolves the subject's scopes against it on each lookup, so one entry serves every subject.
+// Keying by resolved scope would not save a load, since GetByType reads all scopes of a type anyway,
+// and would leave an entry per user and team for invalidation, which NOTIFY only names by type.
+type ruleCache struct {
+	mu      sync.RWMutex
+	entries map[string]cachedRuleSets
+	// bumped by every invalidation so a load that raced with one is not stored
+	generation uint64
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+}
+
+type cachedRuleSets struct {
+	sets     map[ruleSetKey][]utils.CompiledRule
+	loadedAt time.Time
+}
+
+func newRuleCache() *ruleCache {
+	return &ruleCache{entries: map[string]cachedRuleSets{}}
+}
+
+// get returns the rule sets of a request type, loading them on a miss
+func (c *ruleCache) get(
+	ctx context.Context,
+	requestType string,
+	load func(ctx context.Context, requestType string) ([]models.Rule, error),
+) (map[ruleSetKey][]utils.CompiledRule, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[requestType]
+	generation := c.generation
+	c.mu.RUnlock()
+
+	if ok && time.Since(entry.loadedAt) < ruleCacheTTL {
+		c.hits.Add(1)
+		return entry.sets, nil
+	}
+	c.misses.Add(1)
+
+	rules, err := load(ctx, requestType)
+	if err != nil {
+		return nil, err
+	}
+	sets := compileRuleSets(groupRuleSets(rules, true))
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.entries[requestType] = cachedRuleSets{sets: sets, loadedAt: time.Now()}
+	}
+	c.mu.Unlock()
+
+	return sets, nil
+}
+
+// invalidate drops the rules of a request type, of every request type when it is empty
+func (c *ruleCache) invalidate(requestType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	if requestType == "" {
+		c.entries = map[string]cachedRuleSets{}
+	} else {
+		delete(c.entries, requestType)
+	}
+	c.invalidations.Add(1)
+}
+
+func (c *ruleCache) stats() *models.RuleCacheStats {
+	c.mu.RLock()
+	cached := len(c.entries)
+	c.mu.RUnlock()
+
+	stats := &models.RuleCacheStats{
+		Hits:          c.hits.Load(),
+		Misses:        c.misses.Load(),
+		Invalidations: c.invalidations.Load(),
+		RequestTypes:  cached,
+	}
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		stats.HitRate = math.Round(float64(stats.Hits)/float64(lookups)*10000) / 10000
+	}
+	return stats
+}
+
+// rulesFor picks the versions in force at now that apply to the subject, most specific scope first
+func rulesFor(sets map[ruleSetKey][]utils.CompiledRule, requestType string, subject models.RuleSubject, now time.Time) []utils.CompiledRule {
+	var rules []utils.CompiledRule
+	for _, key := range subjectRuleSetKeys(requestType, subject) {
+		for _, rule := range sets[key] {
+			if inForce(rule.Rule, now) {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+func inForce(rule models.Rule, now time.Time) bool {
+	return (rule.EffectiveFrom == nil || !rule.EffectiveFrom.After(now)) &&
+		(rule.EffectiveTo == nil || rule.EffectiveTo.After(now))
+}
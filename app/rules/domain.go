@@ -0,0 +1,28 @@
+package rules
+
+import "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+type SimulateRuleRequest struct {
+	RequestType string          `json:"request_type"`
+	UserID      int64           `json:"user_id"`
+	GradeID     int64           `json:"grade_id"`
+	Payload     SimulatePayload `json:"payload"`
+}
+
+// fields of the request being simulated; only those of the request type are read
+type SimulatePayload struct {
+	FromDate           string  `json:"from_date"`
+	ToDate             string  `json:"to_date"`
+	LeaveType          string  `json:"leave_type"`
+	Amount             float64 `json:"amount"`
+	Category           string  `json:"category"`
+	DiscountPercentage float64 `json:"discount_percentage"`
+}
+
+type BacktestRequest struct {
+	Rules []models.Rule `json:"rules"`
+}
+
+type ReviewProposalRequest struct {
+	Comment string `json:"comment"`
+}
@@ -2,14 +2,19 @@ package rules
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/response"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,8 +29,10 @@ func NewRuleHandler(ctx context.Context, ruleService interfaces.RuleService) *Ru
 	return &RuleHandler{ruleService: ruleService}
 }
 
+// CreateRule proposes a new rule; it goes live once another admin approves the proposal
 func (h *RuleHandler) CreateRule(c *gin.Context) {
 	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
 
 	if role != constants.RoleAdmin {
 		handleRuleError(c, apperrors.ErrAdminOnly, nil)
@@ -39,12 +46,13 @@ func (h *RuleHandler) CreateRule(c *gin.Context) {
 	}
 
 	ctx := c.Request.Context()
-	if err := h.ruleService.CreateRule(ctx, role, rule); err != nil {
+	proposal, err := h.ruleService.CreateRule(ctx, role, userID, rule)
+	if err != nil {
 		handleRuleError(c, err, nil)
 		return
 	}
 
-	response.Created(c, "Rule created successfully", nil)
+	response.Created(c, "Rule creation proposed; awaiting approval by another admin", proposal)
 }
 
 func (h *RuleHandler) GetRules(c *gin.Context) {
@@ -64,8 +72,95 @@ func (h *RuleHandler) GetRules(c *gin.Context) {
 	response.Success(c, "Rules fetched successfully", rules)
 }
 
+// AuditRules reports stored rules whose conditions no longer satisfy their request type's schema
+func (h *RuleHandler) AuditRules(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	report, err := h.ruleService.AuditRules(ctx, role)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule audit completed", report)
+}
+
+// ExportRules downloads the rule set as a bundle, ?format=json (default) or yaml.
+// The bundle is sent as-is, without the response envelope, so it can be committed and imported elsewhere.
+func (h *RuleHandler) ExportRules(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	format := strings.ToLower(c.DefaultQuery("format", "json"))
+	if format != "json" && format != "yaml" {
+		handleRuleError(c, apperrors.ErrUnsupportedBundleFormat, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	bundle, err := h.ruleService.ExportRules(ctx, role)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="rules.%s"`, format))
+	if format == "yaml" {
+		c.YAML(http.StatusOK, bundle)
+		return
+	}
+	c.JSON(http.StatusOK, bundle)
+}
+
+// ImportRules proposes a JSON or YAML bundle (by Content-Type); ?preview=true only lists the changes
+func (h *RuleHandler) ImportRules(c *gin.Context) {
+	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var bundle models.RuleBundle
+	var err error
+	if strings.Contains(c.ContentType(), "yaml") {
+		err = c.ShouldBindYAML(&bundle)
+	} else {
+		err = c.ShouldBindJSON(&bundle)
+	}
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	preview := c.Query("preview") == "true"
+
+	ctx := c.Request.Context()
+	plan, err := h.ruleService.ImportRules(ctx, role, userID, bundle, preview)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	if preview {
+		response.Success(c, "Rule import previewed", plan)
+		return
+	}
+	response.Created(c, "Rule import proposed; awaiting approval by another admin", plan)
+}
+
+// UpdateRule proposes a new version of a rule
 func (h *RuleHandler) UpdateRule(c *gin.Context) {
 	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
 
 	if role != constants.RoleAdmin {
 		handleRuleError(c, apperrors.ErrAdminOnly, nil)
@@ -85,16 +180,148 @@ func (h *RuleHandler) UpdateRule(c *gin.Context) {
 	}
 
 	ctx := c.Request.Context()
-	if err := h.ruleService.UpdateRule(ctx, role, ruleID, rule); err != nil {
+	proposal, err := h.ruleService.UpdateRule(ctx, role, userID, ruleID, rule)
+	if err != nil {
 		handleRuleError(c, err, nil)
 		return
 	}
 
-	response.Success(c, "Rule updated successfully", nil)
+	response.Created(c, "Rule update proposed; awaiting approval by another admin", proposal)
 }
 
+// DeleteRule proposes retiring a rule
 func (h *RuleHandler) DeleteRule(c *gin.Context) {
 	role := c.GetString("role")
+	userID := c.GetInt64("user_id")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ruleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	proposal, err := h.ruleService.DeleteRule(ctx, role, userID, ruleID)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Created(c, "Rule deletion proposed; awaiting approval by another admin", proposal)
+}
+
+// GetRuleProposals lists rule change proposals, ?status=PENDING|APPROVED|REJECTED to filter
+func (h *RuleHandler) GetRuleProposals(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	proposals, err := h.ruleService.GetRuleProposals(ctx, role, c.Query("status"))
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule proposals fetched successfully", proposals)
+}
+
+// ApproveRuleProposal applies a proposed change; the comment is optional
+func (h *RuleHandler) ApproveRuleProposal(c *gin.Context) {
+	role := c.GetString("role")
+	reviewerID := c.GetInt64("user_id")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	var req ReviewProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err.Error() != "EOF" {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	proposal, err := h.ruleService.ApproveRuleProposal(ctx, role, reviewerID, proposalID, req.Comment)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule change approved and applied", proposal)
+}
+
+// RejectRuleProposal closes a proposed change; a comment saying why is required
+func (h *RuleHandler) RejectRuleProposal(c *gin.Context) {
+	role := c.GetString("role")
+	reviewerID := c.GetInt64("user_id")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	var req ReviewProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	if strings.TrimSpace(req.Comment) == "" {
+		handleRuleError(c, apperrors.ErrCommentMissing, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	proposal, err := h.ruleService.RejectRuleProposal(ctx, role, reviewerID, proposalID, req.Comment)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule change rejected", proposal)
+}
+
+// GetRuleCacheStats reports the rule cache hit rate of this instance
+func (h *RuleHandler) GetRuleCacheStats(c *gin.Context) {
+	role := c.GetString("role")
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	stats, err := h.ruleService.GetRuleCacheStats(ctx, role)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule cache stats fetched successfully", stats)
+}
+
+func (h *RuleHandler) GetRuleVersions(c *gin.Context) {
+	role := c.GetString("role")
 
 	if role != constants.RoleAdmin {
 		handleRuleError(c, apperrors.ErrAdminOnly, nil)
@@ -108,30 +335,223 @@ func (h *RuleHandler) DeleteRule(c *gin.Context) {
 	}
 
 	ctx := c.Request.Context()
-	if err := h.ruleService.DeleteRule(ctx, role, ruleID); err != nil {
+	versions, err := h.ruleService.GetRuleVersions(ctx, role, ruleID)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule versions fetched successfully", versions)
+}
+
+// DiffRuleVersions compares ?from=<version>&to=<version> of a rule
+func (h *RuleHandler) DiffRuleVersions(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ruleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	fromVersion, err := strconv.Atoi(c.Query("from"))
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	toVersion, err := strconv.Atoi(c.Query("to"))
+	if err != nil {
+		handleRuleError(c, apperrors.ErrInvalidID, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	diff, err := h.ruleService.DiffRuleVersions(ctx, role, ruleID, fromVersion, toVersion)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule versions compared successfully", diff)
+}
+
+// handles dry-run rule evaluation
+type RuleSimulationHandler struct {
+	simulationService interfaces.RuleSimulationService
+}
+
+// creates a new RuleSimulationHandler instance
+func NewRuleSimulationHandler(ctx context.Context, simulationService interfaces.RuleSimulationService) *RuleSimulationHandler {
+	return &RuleSimulationHandler{simulationService: simulationService}
+}
+
+func (h *RuleSimulationHandler) Simulate(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var req SimulateRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	input := models.RuleSimulation{
+		RequestType:        strings.ToUpper(strings.TrimSpace(req.RequestType)),
+		UserID:             req.UserID,
+		GradeID:            req.GradeID,
+		LeaveType:          req.Payload.LeaveType,
+		Amount:             req.Payload.Amount,
+		Category:           req.Payload.Category,
+		DiscountPercentage: req.Payload.DiscountPercentage,
+	}
+
+	if input.RequestType == "LEAVE" {
+		from, err := time.Parse("2006-01-02", req.Payload.FromDate)
+		if err != nil {
+			handleRuleError(c, apperrors.ErrInvalidDateFormat, err)
+			return
+		}
+
+		to, err := time.Parse("2006-01-02", req.Payload.ToDate)
+		if err != nil {
+			handleRuleError(c, apperrors.ErrInvalidDateFormat, err)
+			return
+		}
+
+		input.FromDate, input.ToDate = from, to
+		input.Days = utils.CalculateLeaveDays(from, to)
+	}
+
+	ctx := c.Request.Context()
+	evaluation, err := h.simulationService.Simulate(ctx, role, input)
+	if err != nil {
 		handleRuleError(c, err, nil)
 		return
 	}
 
-	response.Success(c, "Rule deleted successfully", nil)
+	response.Success(c, "Simulation completed", evaluation)
+}
+
+// handles replaying past requests against candidate rules
+type RuleBacktestHandler struct {
+	backtestService interfaces.RuleBacktestService
+}
+
+// creates a new RuleBacktestHandler instance
+func NewRuleBacktestHandler(ctx context.Context, backtestService interfaces.RuleBacktestService) *RuleBacktestHandler {
+	return &RuleBacktestHandler{backtestService: backtestService}
+}
+
+func (h *RuleBacktestHandler) Backtest(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	var req BacktestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleRuleError(c, apperrors.ErrInvalidRequestPayload, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	report, err := h.backtestService.Backtest(ctx, role, req.Rules)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Backtest completed", report)
+}
+
+// handles reporting gaps and conflicts in the rule set
+type RuleAnalyzerHandler struct {
+	analyzerService interfaces.RuleAnalyzerService
+}
+
+// creates a new RuleAnalyzerHandler instance
+func NewRuleAnalyzerHandler(ctx context.Context, analyzerService interfaces.RuleAnalyzerService) *RuleAnalyzerHandler {
+	return &RuleAnalyzerHandler{analyzerService: analyzerService}
+}
+
+func (h *RuleAnalyzerHandler) Analyze(c *gin.Context) {
+	role := c.GetString("role")
+
+	if role != constants.RoleAdmin {
+		handleRuleError(c, apperrors.ErrAdminOnly, nil)
+		return
+	}
+
+	ctx := c.Request.Context()
+	report, err := h.analyzerService.Analyze(ctx, role)
+	if err != nil {
+		handleRuleError(c, err, nil)
+		return
+	}
+
+	response.Success(c, "Rule analysis completed", report)
 }
 
 func handleRuleError(c *gin.Context, err error, detail error) {
+	// condition errors carry the offending fields as detail
+	var errDetail interface{}
+	var condErrs utils.ConditionErrors
+	var condErr *utils.ConditionError
+	var bundleErr *BundleRuleError
+	if errors.As(err, &bundleErr) {
+		err = bundleErr.Err
+	}
+	if errors.As(err, &condErrs) {
+		err, errDetail = apperrors.ErrInvalidCondition, condErrs
+	} else if errors.As(err, &condErr) {
+		err, detail = apperrors.ErrInvalidCondition, condErr
+	}
+
 	status := http.StatusInternalServerError
 	switch err {
 	case apperrors.ErrUnauthorized, apperrors.ErrAdminOnly:
 		status = http.StatusForbidden
-	case apperrors.ErrNoRuleFound, apperrors.ErrRuleNotFoundForDelete:
+	case apperrors.ErrNoRuleFound, apperrors.ErrRuleNotFoundForDelete, apperrors.ErrRuleNotFound,
+		apperrors.ErrRuleVersionNotFound, apperrors.ErrProposalNotFound,
+		apperrors.ErrUserNotFound:
 		status = http.StatusNotFound
 	case apperrors.ErrRequestTypeRequired, apperrors.ErrActionRequired,
 		apperrors.ErrGradeIDRequired, apperrors.ErrConditionRequired,
-		apperrors.ErrInvalidConditionJSON, apperrors.ErrInvalidID,
+		apperrors.ErrInvalidScope, apperrors.ErrScopeTargetRequired, apperrors.ErrDepartmentRequired,
+		apperrors.ErrUnsupportedBundleVersion, apperrors.ErrUnsupportedBundleFormat,
+		apperrors.ErrBundleKeyRequired, apperrors.ErrDuplicateBundleKey,
+		apperrors.ErrInvalidConditionJSON, apperrors.ErrInvalidCondition, apperrors.ErrInvalidPriority,
+		apperrors.ErrInvalidAction, apperrors.ErrRejectReasonRequired, apperrors.ErrEffectiveDateInPast,
+		apperrors.ErrCandidateRulesRequired, apperrors.ErrSimulationTarget, apperrors.ErrUnknownRequestType, apperrors.ErrInvalidDateFormat,
+		apperrors.ErrInvalidLeaveDays, apperrors.ErrInvalidExpenseAmount, apperrors.ErrInvalidExpenseCategory,
+		apperrors.ErrInvalidDiscountPercent, apperrors.ErrInvalidUser,
+		apperrors.ErrLeaveBalanceExceeded, apperrors.ErrExpenseLimitExceeded, apperrors.ErrDiscountLimitExceeded,
+		apperrors.ErrInvalidID, apperrors.ErrCommentMissing,
 		apperrors.ErrInvalidRequestPayload:
 		status = http.StatusBadRequest
+	case apperrors.ErrDuplicateRuleKey, apperrors.ErrProposalNotPending, apperrors.ErrProposalOutdated:
+		status = http.StatusConflict
+	case apperrors.ErrSelfApproval:
+		status = http.StatusForbidden
 	}
 
 	message := err.Error()
-	var errDetail interface{}
+	// a bundle error says which bundled rule failed
+	if bundleErr != nil {
+		detail = bundleErr
+	}
 	if detail != nil {
 		errDetail = detail.Error()
 	}
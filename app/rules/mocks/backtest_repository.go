@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// BacktestRepository is an autogenerated mock type for the BacktestRepository type
+type BacktestRepository struct {
+	mock.Mock
+}
+
+type BacktestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BacktestRepository) EXPECT() *BacktestRepository_Expecter {
+	return &BacktestRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetHistoricalRequests provides a mock function with given fields: ctx
+func (_m *BacktestRepository) GetHistoricalRequests(ctx context.Context) ([]models.HistoricalRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricalRequests")
+	}
+
+	var r0 []models.HistoricalRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.HistoricalRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.HistoricalRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.HistoricalRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BacktestRepository_GetHistoricalRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistoricalRequests'
+type BacktestRepository_GetHistoricalRequests_Call struct {
+	*mock.Call
+}
+
+// GetHistoricalRequests is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BacktestRepository_Expecter) GetHistoricalRequests(ctx interface{}) *BacktestRepository_GetHistoricalRequests_Call {
+	return &BacktestRepository_GetHistoricalRequests_Call{Call: _e.mock.On("GetHistoricalRequests", ctx)}
+}
+
+func (_c *BacktestRepository_GetHistoricalRequests_Call) Run(run func(ctx context.Context)) *BacktestRepository_GetHistoricalRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BacktestRepository_GetHistoricalRequests_Call) Return(_a0 []models.HistoricalRequest, _a1 error) *BacktestRepository_GetHistoricalRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BacktestRepository_GetHistoricalRequests_Call) RunAndReturn(run func(context.Context) ([]models.HistoricalRequest, error)) *BacktestRepository_GetHistoricalRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBacktestRepository creates a new instance of BacktestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBacktestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BacktestRepository {
+	mock := &BacktestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,254 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RuleProposalRepository is an autogenerated mock type for the RuleProposalRepository type
+type RuleProposalRepository struct {
+	mock.Mock
+}
+
+type RuleProposalRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RuleProposalRepository) EXPECT() *RuleProposalRepository_Expecter {
+	return &RuleProposalRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tx, proposal
+func (_m *RuleProposalRepository) Create(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal) error {
+	ret := _m.Called(ctx, tx, proposal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RuleProposal) error); ok {
+		r0 = rf(ctx, tx, proposal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleProposalRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type RuleProposalRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - proposal *models.RuleProposal
+func (_e *RuleProposalRepository_Expecter) Create(ctx interface{}, tx interface{}, proposal interface{}) *RuleProposalRepository_Create_Call {
+	return &RuleProposalRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, proposal)}
+}
+
+func (_c *RuleProposalRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal)) *RuleProposalRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RuleProposal))
+	})
+	return _c
+}
+
+func (_c *RuleProposalRepository_Create_Call) Return(_a0 error) *RuleProposalRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RuleProposalRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RuleProposal) error) *RuleProposalRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, status
+func (_m *RuleProposalRepository) GetAll(ctx context.Context, status string) ([]models.RuleProposal, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.RuleProposal, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.RuleProposal); ok {
+		r0 = rf(ctx, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleProposalRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type RuleProposalRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status string
+func (_e *RuleProposalRepository_Expecter) GetAll(ctx interface{}, status interface{}) *RuleProposalRepository_GetAll_Call {
+	return &RuleProposalRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, status)}
+}
+
+func (_c *RuleProposalRepository_GetAll_Call) Run(run func(ctx context.Context, status string)) *RuleProposalRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RuleProposalRepository_GetAll_Call) Return(_a0 []models.RuleProposal, _a1 error) *RuleProposalRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleProposalRepository_GetAll_Call) RunAndReturn(run func(context.Context, string) ([]models.RuleProposal, error)) *RuleProposalRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, tx, proposalID
+func (_m *RuleProposalRepository) GetByID(ctx context.Context, tx interfaces.Tx, proposalID int64) (*models.RuleProposal, error) {
+	ret := _m.Called(ctx, tx, proposalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *models.RuleProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (*models.RuleProposal, error)); ok {
+		return rf(ctx, tx, proposalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) *models.RuleProposal); ok {
+		r0 = rf(ctx, tx, proposalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, proposalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleProposalRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type RuleProposalRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - proposalID int64
+func (_e *RuleProposalRepository_Expecter) GetByID(ctx interface{}, tx interface{}, proposalID interface{}) *RuleProposalRepository_GetByID_Call {
+	return &RuleProposalRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, tx, proposalID)}
+}
+
+func (_c *RuleProposalRepository_GetByID_Call) Run(run func(ctx context.Context, tx interfaces.Tx, proposalID int64)) *RuleProposalRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleProposalRepository_GetByID_Call) Return(_a0 *models.RuleProposal, _a1 error) *RuleProposalRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleProposalRepository_GetByID_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (*models.RuleProposal, error)) *RuleProposalRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Review provides a mock function with given fields: ctx, tx, proposal
+func (_m *RuleProposalRepository) Review(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal) error {
+	ret := _m.Called(ctx, tx, proposal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Review")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RuleProposal) error); ok {
+		r0 = rf(ctx, tx, proposal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleProposalRepository_Review_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Review'
+type RuleProposalRepository_Review_Call struct {
+	*mock.Call
+}
+
+// Review is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - proposal *models.RuleProposal
+func (_e *RuleProposalRepository_Expecter) Review(ctx interface{}, tx interface{}, proposal interface{}) *RuleProposalRepository_Review_Call {
+	return &RuleProposalRepository_Review_Call{Call: _e.mock.On("Review", ctx, tx, proposal)}
+}
+
+func (_c *RuleProposalRepository_Review_Call) Run(run func(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal)) *RuleProposalRepository_Review_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RuleProposal))
+	})
+	return _c
+}
+
+func (_c *RuleProposalRepository_Review_Call) Return(_a0 error) *RuleProposalRepository_Review_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RuleProposalRepository_Review_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RuleProposal) error) *RuleProposalRepository_Review_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRuleProposalRepository creates a new instance of RuleProposalRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRuleProposalRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RuleProposalRepository {
+	mock := &RuleProposalRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+
+	utils "github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RuleSimulationService is an autogenerated mock type for the RuleSimulationService type
+type RuleSimulationService struct {
+	mock.Mock
+}
+
+type RuleSimulationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RuleSimulationService) EXPECT() *RuleSimulationService_Expecter {
+	return &RuleSimulationService_Expecter{mock: &_m.Mock}
+}
+
+// Simulate provides a mock function with given fields: ctx, role, input
+func (_m *RuleSimulationService) Simulate(ctx context.Context, role string, input models.RuleSimulation) (*utils.Evaluation, error) {
+	ret := _m.Called(ctx, role, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Simulate")
+	}
+
+	var r0 *utils.Evaluation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.RuleSimulation) (*utils.Evaluation, error)); ok {
+		return rf(ctx, role, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, models.RuleSimulation) *utils.Evaluation); ok {
+		r0 = rf(ctx, role, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.Evaluation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, models.RuleSimulation) error); ok {
+		r1 = rf(ctx, role, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleSimulationService_Simulate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Simulate'
+type RuleSimulationService_Simulate_Call struct {
+	*mock.Call
+}
+
+// Simulate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - input models.RuleSimulation
+func (_e *RuleSimulationService_Expecter) Simulate(ctx interface{}, role interface{}, input interface{}) *RuleSimulationService_Simulate_Call {
+	return &RuleSimulationService_Simulate_Call{Call: _e.mock.On("Simulate", ctx, role, input)}
+}
+
+func (_c *RuleSimulationService_Simulate_Call) Run(run func(ctx context.Context, role string, input models.RuleSimulation)) *RuleSimulationService_Simulate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(models.RuleSimulation))
+	})
+	return _c
+}
+
+func (_c *RuleSimulationService_Simulate_Call) Return(_a0 *utils.Evaluation, _a1 error) *RuleSimulationService_Simulate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleSimulationService_Simulate_Call) RunAndReturn(run func(context.Context, string, models.RuleSimulation) (*utils.Evaluation, error)) *RuleSimulationService_Simulate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRuleSimulationService creates a new instance of RuleSimulationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRuleSimulationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RuleSimulationService {
+	mock := &RuleSimulationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
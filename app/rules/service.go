@@ -2,58 +2,99 @@ package rules
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
-// RuleService handles business logic for rule management
+// RuleService handles business logic for rule management.
+// Rule changes are proposed by one admin and only applied once a different admin approves them.
 type RuleService struct {
-	ruleRepo interfaces.RuleRepository
+	ruleRepo      interfaces.RuleRepository
+	proposalRepo  interfaces.RuleProposalRepository
+	db            interfaces.DB
+	defaultAction string
+	cache         *ruleCache
 }
 
-// NewRuleService creates a new instance of RuleService
-func NewRuleService(ctx context.Context, ruleRepo interfaces.RuleRepository) interfaces.RuleService {
+// NewRuleService creates a new instance of RuleService.
+// defaultAction applies when none of the applicable rules match; anything unknown falls back to MANUAL.
+func NewRuleService(
+	ctx context.Context,
+	ruleRepo interfaces.RuleRepository,
+	proposalRepo interfaces.RuleProposalRepository,
+	db interfaces.DB,
+	defaultAction string,
+) interfaces.RuleService {
 	return &RuleService{
-		ruleRepo: ruleRepo,
+		ruleRepo:      ruleRepo,
+		proposalRepo:  proposalRepo,
+		db:            db,
+		defaultAction: normalizeDefaultAction(defaultAction),
+		cache:         newRuleCache(),
 	}
 }
 
-// GetRule retrieves a rule by request type and grade ID
-func (s *RuleService) GetRule(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error) {
-	return s.ruleRepo.GetByTypeAndGrade(ctx, requestType, gradeID)
+func normalizeDefaultAction(action string) string {
+	if action != constants.ActionAutoApprove {
+		return constants.ActionManual
+	}
+	return action
 }
 
-// CreateRule creates or updates a rule (admin only)
-func (s *RuleService) CreateRule(ctx context.Context, role string, rule models.Rule) error {
-	if role != constants.RoleAdmin {
-		return apperrors.ErrUnauthorized
+// Evaluate decides a request with the rules that apply to the subject.
+// The most specific applicable rule wins: rules scoped to the user are tried first, then the manager's team,
+// the department, the grade and finally global rules; within a scope by priority.
+// Rules are read from the in-process cache and only loaded from the database on a miss.
+func (s *RuleService) Evaluate(ctx context.Context, requestType string, subject models.RuleSubject, facts utils.Facts) (*utils.DecisionResult, error) {
+	sets, err := s.cache.get(ctx, requestType, s.ruleRepo.GetByType)
+	if err != nil {
+		return nil, err
 	}
 
-	if rule.RequestType == "" {
-		return apperrors.ErrRequestTypeRequired
+	rules := rulesFor(sets, requestType, subject, time.Now())
+	if len(rules) == 0 {
+		return nil, apperrors.ErrNoRuleFound
 	}
 
-	if rule.Action == "" {
-		return apperrors.ErrActionRequired
+	result := utils.Decide(requestType, rules, facts, s.defaultAction)
+	return &result, nil
+}
+
+// CreateRule proposes a rule for a user, team, department, grade or everyone (admin only); the scope defaults to GRADE.
+// A future effective_from schedules the rule instead of activating it on approval.
+func (s *RuleService) CreateRule(ctx context.Context, role string, userID int64, rule models.Rule) (*models.RuleProposal, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
 	}
 
-	if rule.GradeID == 0 {
-		return apperrors.ErrGradeIDRequired
+	rule = normalizeScope(rule)
+	if err := validateRule(rule); err != nil {
+		return nil, err
 	}
 
-	if rule.Condition == nil || len(rule.Condition) == 0 {
-		return apperrors.ErrConditionRequired
+	if err := validateEffectiveFrom(rule); err != nil {
+		return nil, err
 	}
 
-	err := s.ruleRepo.Create(ctx, &rule)
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return apperrors.ErrDatabase
+		return nil, apperrors.ErrTransactionBegin
 	}
+	defer tx.Rollback(ctx)
 
-	return nil
+	proposal := &models.RuleProposal{Action: constants.ProposalCreate, After: &rule, ProposedBy: userID}
+	return s.propose(ctx, tx, proposal)
 }
 
 // GetRules retrieves all rules (admin only)
@@ -65,20 +106,807 @@ func (s *RuleService) GetRules(ctx context.Context, role string) ([]models.Rule,
 	return s.ruleRepo.GetAll(ctx)
 }
 
-// updates an existing rule (admin only)
-func (s *RuleService) UpdateRule(ctx context.Context, role string, ruleID int64, rule models.Rule) error {
+// proposes a new version of an existing rule (admin only).
+// Request type, scope and key default to the rule's current ones; a future effective_from schedules the change.
+func (s *RuleService) UpdateRule(ctx context.Context, role string, userID, ruleID int64, rule models.Rule) (*models.RuleProposal, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if rule.Priority < 0 {
+		return nil, apperrors.ErrInvalidPriority
+	}
+
+	if err := validateAction(rule); err != nil {
+		return nil, err
+	}
+
+	// without a request type only the structure can be checked until the existing rule is loaded
+	if rule.RequestType != "" {
+		if err := utils.ValidateCondition(rule.RequestType, rule.Condition); err != nil {
+			return nil, err
+		}
+	} else if _, err := utils.ParseCondition(rule.Condition); err != nil {
+		return nil, err
+	}
+
+	if err := validateEffectiveFrom(rule); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	existing, err := s.ruleRepo.GetByID(ctx, tx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.RequestType == "" {
+		rule.RequestType = existing.RequestType
+		if err := utils.ValidateCondition(rule.RequestType, rule.Condition); err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Key == "" {
+		rule.Key = existing.Key
+	}
+
+	if rule.Scope == "" {
+		rule.Scope = existing.Scope
+		if rule.GradeID == 0 {
+			rule.GradeID = existing.GradeID
+		}
+		if rule.TargetID == 0 {
+			rule.TargetID = existing.TargetID
+		}
+		if rule.Department == "" {
+			rule.Department = existing.Department
+		}
+	}
+
+	rule = normalizeScope(rule)
+	if err := validateScope(rule); err != nil {
+		return nil, err
+	}
+
+	rule.ID = ruleID
+	proposal := &models.RuleProposal{
+		Action:     constants.ProposalUpdate,
+		RuleID:     &ruleID,
+		Before:     existing,
+		After:      &rule,
+		ProposedBy: userID,
+	}
+	return s.propose(ctx, tx, proposal)
+}
+
+// proposes retiring a rule (admin only); once approved its versions stay for the requests they decided
+func (s *RuleService) DeleteRule(ctx context.Context, role string, userID, ruleID int64) (*models.RuleProposal, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	existing, err := s.ruleRepo.GetByID(ctx, tx, ruleID)
+	if errors.Is(err, apperrors.ErrNoRuleFound) {
+		return nil, apperrors.ErrRuleNotFoundForDelete
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &models.RuleProposal{
+		Action:     constants.ProposalDelete,
+		RuleID:     &ruleID,
+		Before:     existing,
+		ProposedBy: userID,
+	}
+	return s.propose(ctx, tx, proposal)
+}
+
+// stores a pending proposal and commits the transaction
+func (s *RuleService) propose(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal) (*models.RuleProposal, error) {
+	if err := s.proposalRepo.Create(ctx, tx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return proposal, nil
+}
+
+// GetRuleProposals lists proposals, optionally only those with a status (admin only)
+func (s *RuleService) GetRuleProposals(ctx context.Context, role string, status string) ([]models.RuleProposal, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	return s.proposalRepo.GetAll(ctx, strings.ToUpper(strings.TrimSpace(status)))
+}
+
+// ApproveRuleProposal applies a pending change (admin only); the proposer cannot approve their own change.
+// Updates and deletes are refused when the rule changed since the proposal, imports when their plan no longer holds.
+func (s *RuleService) ApproveRuleProposal(ctx context.Context, role string, reviewerID, proposalID int64, comment string) (*models.RuleProposal, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	proposal, err := s.proposalRepo.GetByID(ctx, tx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != constants.StatusPending {
+		return nil, apperrors.ErrProposalNotPending
+	}
+
+	if proposal.ProposedBy == reviewerID {
+		return nil, apperrors.ErrSelfApproval
+	}
+
+	if err := s.applyProposal(ctx, tx, proposal); err != nil {
+		return nil, err
+	}
+
+	changed := changedRequestTypes(proposal)
+	for _, requestType := range changed {
+		if err := s.ruleRepo.NotifyChange(ctx, tx, requestType); err != nil {
+			return nil, err
+		}
+	}
+
+	reviewed, err := s.review(ctx, tx, proposal, constants.StatusApproved, reviewerID, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	// other instances drop theirs when the notification arrives
+	for _, requestType := range changed {
+		s.cache.invalidate(requestType)
+	}
+
+	return reviewed, nil
+}
+
+// request types whose rules an approved proposal changed; an empty one stands for all of them
+func changedRequestTypes(proposal *models.RuleProposal) []string {
+	if proposal.Action == constants.ProposalImport {
+		return []string{""}
+	}
+
+	var types []string
+	for _, rule := range []*models.Rule{proposal.Before, proposal.After} {
+		if rule != nil && !slices.Contains(types, rule.RequestType) {
+			types = append(types, rule.RequestType)
+		}
+	}
+	return types
+}
+
+// InvalidateRules drops the cached rules of a request type, of every request type when it is empty
+func (s *RuleService) InvalidateRules(requestType string) {
+	s.cache.invalidate(requestType)
+}
+
+// GetRuleCacheStats reports how often rule lookups were served from memory (admin only)
+func (s *RuleService) GetRuleCacheStats(ctx context.Context, role string) (*models.RuleCacheStats, error) {
 	if role != constants.RoleAdmin {
-		return apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
 	}
 
-	return s.ruleRepo.Update(ctx, ruleID, &rule)
+	return s.cache.stats(), nil
 }
 
-// deletes a rule by ID (admin only)
-func (s *RuleService) DeleteRule(ctx context.Context, role string, ruleID int64) error {
+// RejectRuleProposal closes a pending change without applying it (admin only); proposers may withdraw their own
+func (s *RuleService) RejectRuleProposal(ctx context.Context, role string, reviewerID, proposalID int64, comment string) (*models.RuleProposal, error) {
 	if role != constants.RoleAdmin {
-		return apperrors.ErrUnauthorized
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	proposal, err := s.proposalRepo.GetByID(ctx, tx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != constants.StatusPending {
+		return nil, apperrors.ErrProposalNotPending
+	}
+
+	return s.review(ctx, tx, proposal, constants.StatusRejected, reviewerID, comment)
+}
+
+func (s *RuleService) review(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal, status string, reviewerID int64, comment string) (*models.RuleProposal, error) {
+	proposal.Status = status
+	proposal.ReviewedBy = &reviewerID
+	proposal.ReviewComment = comment
+
+	if err := s.proposalRepo.Review(ctx, tx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apperrors.ErrTransactionCommit
+	}
+
+	return proposal, nil
+}
+
+// makes the proposed change in the reviewer's transaction
+func (s *RuleService) applyProposal(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal) error {
+	switch proposal.Action {
+	case constants.ProposalCreate:
+		rule := *proposal.After
+		clearPassedSchedule(&rule)
+		if err := s.ruleRepo.Create(ctx, tx, &rule); err != nil {
+			return mapRuleWriteError(err)
+		}
+		proposal.RuleID = &rule.ID
+		proposal.After = &rule
+
+	case constants.ProposalUpdate, constants.ProposalDelete:
+		current, err := s.ruleRepo.GetByID(ctx, tx, *proposal.RuleID)
+		if err != nil {
+			return err
+		}
+		if current.VersionID != proposal.Before.VersionID {
+			return apperrors.ErrProposalOutdated
+		}
+
+		if proposal.Action == constants.ProposalDelete {
+			return s.ruleRepo.Delete(ctx, tx, *proposal.RuleID)
+		}
+
+		rule := *proposal.After
+		clearPassedSchedule(&rule)
+		if err := s.ruleRepo.Update(ctx, tx, *proposal.RuleID, &rule); err != nil {
+			return mapRuleWriteError(err)
+		}
+		proposal.After = &rule
+
+	case constants.ProposalImport:
+		incoming, err := bundleRules(*proposal.Bundle)
+		if err != nil {
+			return err
+		}
+
+		// lock the rules so nothing changes between checking the plan and applying it
+		existing, err := s.ruleRepo.GetAllForUpdate(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		plan, ops := planImport(existing, incoming)
+		if !samePlan(plan, proposal.Plan) {
+			return apperrors.ErrProposalOutdated
+		}
+		return s.applyImport(ctx, tx, ops)
+
+	default:
+		return apperrors.ErrInvalidRuleProposal
+	}
+
+	return nil
+}
+
+// a schedule that passed while the proposal waited takes effect on approval instead
+func clearPassedSchedule(rule *models.Rule) {
+	if rule.EffectiveFrom != nil && rule.EffectiveFrom.Before(time.Now()) {
+		rule.EffectiveFrom = nil
+	}
+}
+
+func mapRuleWriteError(err error) error {
+	if errors.Is(err, apperrors.ErrDuplicateEntry) {
+		return apperrors.ErrDuplicateRuleKey
+	}
+	return err
+}
+
+// plans are compared as stored, so numbers read back from JSON match freshly computed ones
+func samePlan(current, proposed *models.RuleImportPlan) bool {
+	if proposed == nil {
+		return false
+	}
+
+	a, errA := json.Marshal(current)
+	b, errB := json.Marshal(proposed)
+	return errA == nil && errB == nil && string(a) == string(b)
+}
+
+// GetRuleVersions lists a rule's version history (admin only)
+func (s *RuleService) GetRuleVersions(ctx context.Context, role string, ruleID int64) ([]models.RuleVersion, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	versions, err := s.ruleRepo.GetVersions(ctx, ruleID)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.ruleRepo.Delete(ctx, ruleID)
+	if len(versions) == 0 {
+		return nil, apperrors.ErrNoRuleFound
+	}
+
+	return versions, nil
+}
+
+// DiffRuleVersions lists the fields that differ between two versions of a rule (admin only)
+func (s *RuleService) DiffRuleVersions(ctx context.Context, role string, ruleID int64, fromVersion, toVersion int) (*models.RuleVersionDiff, error) {
+	versions, err := s.GetRuleVersions(ctx, role, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to *models.RuleVersion
+	for i := range versions {
+		if versions[i].Version == fromVersion {
+			from = &versions[i]
+		}
+		if versions[i].Version == toVersion {
+			to = &versions[i]
+		}
+	}
+
+	if from == nil || to == nil {
+		return nil, apperrors.ErrRuleVersionNotFound
+	}
+
+	return &models.RuleVersionDiff{
+		RuleID:      ruleID,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes:     diffRuleVersions(*from, *to),
+	}, nil
+}
+
+// AuditRules checks the latest version of every rule against the condition schema of its request type
+func (s *RuleService) AuditRules(ctx context.Context, role string) (*models.RuleAuditReport, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	existing, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.RuleAuditReport{Checked: len(existing), Findings: []models.RuleAuditFinding{}}
+	for _, rule := range existing {
+		fieldErrors := auditCondition(rule)
+		if len(fieldErrors) == 0 {
+			continue
+		}
+
+		report.Findings = append(report.Findings, models.RuleAuditFinding{
+			RuleID:      rule.ID,
+			Version:     rule.Version,
+			RequestType: rule.RequestType,
+			Scope:       rule.Scope,
+			GradeID:     rule.GradeID,
+			Errors:      fieldErrors,
+		})
+	}
+	report.Invalid = len(report.Findings)
+
+	return report, nil
+}
+
+// ExportRules returns the latest version of every rule as a bundle (admin only)
+func (s *RuleService) ExportRules(ctx context.Context, role string) (*models.RuleBundle, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	existing, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &models.RuleBundle{
+		Version:    models.RuleBundleVersion,
+		ExportedAt: time.Now().UTC(),
+		Rules:      make([]models.BundleRule, 0, len(existing)),
+	}
+	for _, rule := range existing {
+		bundle.Rules = append(bundle.Rules, models.BundleRule{
+			Key:         rule.Key,
+			RequestType: rule.RequestType,
+			Scope:       rule.Scope,
+			GradeID:     rule.GradeID,
+			TargetID:    rule.TargetID,
+			Department:  rule.Department,
+			Priority:    rule.Priority,
+			Condition:   rule.Condition,
+			Action:      rule.Action,
+			Reason:      rule.Reason,
+			Active:      rule.Active,
+		})
+	}
+
+	return bundle, nil
+}
+
+// ImportRules plans making the rule set match the bundle (admin only).
+// Rules are matched by key: new keys are created, changed rules get a version taking effect on approval,
+// and rules missing from the bundle are retired. A preview only returns the plan; otherwise the import is
+// proposed and applied in one transaction once another admin approves it.
+func (s *RuleService) ImportRules(ctx context.Context, role string, userID int64, bundle models.RuleBundle, preview bool) (*models.RuleImportPlan, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if bundle.Version != models.RuleBundleVersion {
+		return nil, apperrors.ErrUnsupportedBundleVersion
+	}
+
+	incoming, err := bundleRules(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, _ := planImport(existing, incoming)
+	if preview {
+		return plan, nil
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, apperrors.ErrTransactionBegin
+	}
+	defer tx.Rollback(ctx)
+
+	proposedPlan := *plan
+	proposal, err := s.propose(ctx, tx, &models.RuleProposal{
+		Action:     constants.ProposalImport,
+		Bundle:     &bundle,
+		Plan:       &proposedPlan,
+		ProposedBy: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plan.ProposalID = proposal.ID
+	return plan, nil
+}
+
+func (s *RuleService) applyImport(ctx context.Context, tx interfaces.Tx, ops importOps) error {
+	for _, ruleID := range ops.deletes {
+		if err := s.ruleRepo.Delete(ctx, tx, ruleID); err != nil {
+			return err
+		}
+	}
+
+	for i := range ops.updates {
+		if err := s.ruleRepo.Update(ctx, tx, ops.updates[i].ID, &ops.updates[i]); err != nil {
+			return mapRuleWriteError(err)
+		}
+	}
+
+	for i := range ops.creates {
+		if err := s.ruleRepo.Create(ctx, tx, &ops.creates[i]); err != nil {
+			return mapRuleWriteError(err)
+		}
+	}
+
+	return nil
+}
+
+// BundleRuleError points at the bundled rule that failed validation
+type BundleRuleError struct {
+	Index int
+	Key   string
+	Err   error
+}
+
+func (e *BundleRuleError) Error() string {
+	return fmt.Sprintf("rules[%d] %q: %v", e.Index, e.Key, e.Err)
+}
+
+func (e *BundleRuleError) Unwrap() error {
+	return e.Err
+}
+
+// validates the bundled rules and converts them; conditions go through JSON so YAML numbers compare like stored ones
+func bundleRules(bundle models.RuleBundle) ([]models.Rule, error) {
+	rules := make([]models.Rule, 0, len(bundle.Rules))
+	seen := map[string]bool{}
+
+	for i, b := range bundle.Rules {
+		key := strings.TrimSpace(b.Key)
+		if key == "" {
+			return nil, &BundleRuleError{Index: i, Err: apperrors.ErrBundleKeyRequired}
+		}
+		if seen[key] {
+			return nil, &BundleRuleError{Index: i, Key: key, Err: apperrors.ErrDuplicateBundleKey}
+		}
+		seen[key] = true
+
+		var condition map[string]interface{}
+		raw, err := json.Marshal(b.Condition)
+		if err == nil {
+			err = json.Unmarshal(raw, &condition)
+		}
+		if err != nil {
+			return nil, &BundleRuleError{Index: i, Key: key, Err: apperrors.ErrInvalidConditionJSON}
+		}
+
+		rule := normalizeScope(models.Rule{
+			Key:         key,
+			RequestType: strings.ToUpper(strings.TrimSpace(b.RequestType)),
+			Scope:       b.Scope,
+			GradeID:     b.GradeID,
+			TargetID:    b.TargetID,
+			Department:  b.Department,
+			Priority:    b.Priority,
+			Condition:   condition,
+			Action:      b.Action,
+			Reason:      b.Reason,
+			Active:      b.Active,
+		})
+		if err := validateRule(rule); err != nil {
+			return nil, &BundleRuleError{Index: i, Key: key, Err: err}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+type importOps struct {
+	creates []models.Rule
+	updates []models.Rule
+	deletes []int64
+}
+
+// matches incoming rules to existing ones by key
+func planImport(existing, incoming []models.Rule) (*models.RuleImportPlan, importOps) {
+	plan := &models.RuleImportPlan{
+		Creates: []models.RuleImportChange{},
+		Updates: []models.RuleImportChange{},
+		Deletes: []models.RuleImportChange{},
+	}
+	var ops importOps
+
+	byKey := make(map[string]models.Rule, len(existing))
+	for _, rule := range existing {
+		byKey[rule.Key] = rule
+	}
+
+	for _, rule := range incoming {
+		current, ok := byKey[rule.Key]
+		if !ok {
+			plan.Creates = append(plan.Creates, models.RuleImportChange{Key: rule.Key})
+			ops.creates = append(ops.creates, rule)
+			continue
+		}
+		delete(byKey, rule.Key)
+
+		changes := diffRules(current, rule)
+		if len(changes) == 0 {
+			plan.Unchanged++
+			continue
+		}
+
+		rule.ID = current.ID
+		plan.Updates = append(plan.Updates, models.RuleImportChange{Key: rule.Key, RuleID: current.ID, Changes: changes})
+		ops.updates = append(ops.updates, rule)
+	}
+
+	// what is left is not in the bundle
+	for _, rule := range existing {
+		if _, ok := byKey[rule.Key]; ok {
+			plan.Deletes = append(plan.Deletes, models.RuleImportChange{Key: rule.Key, RuleID: rule.ID})
+			ops.deletes = append(ops.deletes, rule.ID)
+		}
+	}
+
+	return plan, ops
+}
+
+func diffRules(from, to models.Rule) []models.RuleFieldChange {
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"request_type", from.RequestType, to.RequestType},
+		{"scope", from.Scope, to.Scope},
+		{"grade_id", from.GradeID, to.GradeID},
+		{"target_id", from.TargetID, to.TargetID},
+		{"department", from.Department, to.Department},
+		{"condition", from.Condition, to.Condition},
+		{"action", from.Action, to.Action},
+		{"priority", from.Priority, to.Priority},
+		{"reason", from.Reason, to.Reason},
+		{"active", from.Active, to.Active},
+	}
+
+	changes := []models.RuleFieldChange{}
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.from, f.to) {
+			changes = append(changes, models.RuleFieldChange{Field: f.name, From: f.from, To: f.to})
+		}
+	}
+	return changes
+}
+
+func auditCondition(rule models.Rule) []models.RuleFieldError {
+	err := utils.ValidateCondition(rule.RequestType, rule.Condition)
+	if err == nil {
+		return nil
+	}
+
+	var condErrs utils.ConditionErrors
+	if errors.As(err, &condErrs) {
+		fieldErrors := make([]models.RuleFieldError, 0, len(condErrs))
+		for _, e := range condErrs {
+			fieldErrors = append(fieldErrors, models.RuleFieldError{Field: e.Field, Message: e.Message})
+		}
+		return fieldErrors
+	}
+
+	var condErr *utils.ConditionError
+	if errors.As(err, &condErr) {
+		return []models.RuleFieldError{{Field: condErr.Field, Message: condErr.Message}}
+	}
+
+	field := "condition"
+	if errors.Is(err, apperrors.ErrUnknownRequestType) {
+		field = "request_type"
+	}
+	return []models.RuleFieldError{{Field: field, Message: err.Error()}}
+}
+
+func diffRuleVersions(from, to models.RuleVersion) []models.RuleFieldChange {
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"request_type", from.RequestType, to.RequestType},
+		{"scope", from.Scope, to.Scope},
+		{"grade_id", from.GradeID, to.GradeID},
+		{"target_id", from.TargetID, to.TargetID},
+		{"department", from.Department, to.Department},
+		{"condition", from.Condition, to.Condition},
+		{"action", from.Action, to.Action},
+		{"priority", from.Priority, to.Priority},
+		{"reason", from.Reason, to.Reason},
+		{"active", from.Active, to.Active},
+		{"effective_from", from.EffectiveFrom, to.EffectiveFrom},
+		{"effective_to", from.EffectiveTo, to.EffectiveTo},
+	}
+
+	changes := []models.RuleFieldChange{}
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.from, f.to) {
+			changes = append(changes, models.RuleFieldChange{Field: f.name, From: f.from, To: f.to})
+		}
+	}
+	return changes
+}
+
+// scheduling into the past would rewrite decisions already made
+func validateEffectiveFrom(rule models.Rule) error {
+	if rule.EffectiveFrom != nil && rule.EffectiveFrom.Before(time.Now()) {
+		return apperrors.ErrEffectiveDateInPast
+	}
+	return nil
+}
+
+// checks a complete rule before it is stored or backtested
+func validateRule(rule models.Rule) error {
+	if rule.RequestType == "" {
+		return apperrors.ErrRequestTypeRequired
+	}
+
+	if rule.Action == "" {
+		return apperrors.ErrActionRequired
+	}
+
+	if err := validateScope(rule); err != nil {
+		return err
+	}
+
+	if rule.Condition == nil || len(rule.Condition) == 0 {
+		return apperrors.ErrConditionRequired
+	}
+
+	if rule.Priority < 0 {
+		return apperrors.ErrInvalidPriority
+	}
+
+	if err := validateAction(rule); err != nil {
+		return err
+	}
+
+	return utils.ValidateCondition(rule.RequestType, rule.Condition)
+}
+
+// fills in the default scope and drops the targets of other scopes
+func normalizeScope(rule models.Rule) models.Rule {
+	rule.Scope = strings.ToUpper(strings.TrimSpace(rule.Scope))
+	if rule.Scope == "" {
+		rule.Scope = constants.ScopeGrade
+	}
+	rule.Department = strings.TrimSpace(rule.Department)
+
+	switch rule.Scope {
+	case constants.ScopeUser, constants.ScopeTeam:
+		rule.GradeID, rule.Department = 0, ""
+	case constants.ScopeDepartment:
+		rule.GradeID, rule.TargetID = 0, 0
+	case constants.ScopeGrade:
+		rule.TargetID, rule.Department = 0, ""
+	case constants.ScopeGlobal:
+		rule.GradeID, rule.TargetID, rule.Department = 0, 0, ""
+	}
+	return rule
+}
+
+// checks the scope names who the rule applies to
+func validateScope(rule models.Rule) error {
+	switch rule.Scope {
+	case constants.ScopeUser, constants.ScopeTeam:
+		if rule.TargetID <= 0 {
+			return apperrors.ErrScopeTargetRequired
+		}
+	case constants.ScopeDepartment:
+		if rule.Department == "" {
+			return apperrors.ErrDepartmentRequired
+		}
+	case constants.ScopeGrade:
+		if rule.GradeID == 0 {
+			return apperrors.ErrGradeIDRequired
+		}
+	case constants.ScopeGlobal:
+	default:
+		return apperrors.ErrInvalidScope
+	}
+	return nil
+}
+
+// checks the rule action; rejecting rules must say why
+func validateAction(rule models.Rule) error {
+	switch rule.Action {
+	case constants.ActionAutoApprove, constants.ActionManual:
+		return nil
+	case constants.ActionAutoReject:
+		if strings.TrimSpace(rule.Reason) == "" {
+			return apperrors.ErrRejectReasonRequired
+		}
+		return nil
+	default:
+		return apperrors.ErrInvalidAction
+	}
 }
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// RuleSimulationService answers "what would the rules decide" without creating a request
+type RuleSimulationService struct {
+	ruleService     interfaces.RuleService
+	leaveService    interfaces.LeaveService
+	expenseService  interfaces.ExpenseService
+	discountService interfaces.DiscountService
+	holidayRepo     interfaces.HolidayRepository
+}
+
+// NewRuleSimulationService creates a new instance of RuleSimulationService
+func NewRuleSimulationService(
+	ctx context.Context,
+	ruleService interfaces.RuleService,
+	leaveService interfaces.LeaveService,
+	expenseService interfaces.ExpenseService,
+	discountService interfaces.DiscountService,
+	holidayRepo interfaces.HolidayRepository,
+) interfaces.RuleSimulationService {
+	return &RuleSimulationService{
+		ruleService:     ruleService,
+		leaveService:    leaveService,
+		expenseService:  expenseService,
+		discountService: discountService,
+		holidayRepo:     holidayRepo,
+	}
+}
+
+// Simulate dry-runs a request (admin only).
+// With a user it follows the apply path including the balance check; with only a grade it evaluates the grade and global rules.
+func (s *RuleSimulationService) Simulate(ctx context.Context, role string, input models.RuleSimulation) (*utils.Evaluation, error) {
+	if role != constants.RoleAdmin {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if input.UserID <= 0 && input.GradeID <= 0 {
+		return nil, apperrors.ErrSimulationTarget
+	}
+
+	var facts utils.Facts
+	switch input.RequestType {
+	case "LEAVE":
+		if input.UserID > 0 {
+			return s.leaveService.SimulateLeave(ctx, input.UserID, input.FromDate, input.ToDate, input.Days, input.LeaveType)
+		}
+		if input.Days <= 0 {
+			return nil, apperrors.ErrInvalidLeaveDays
+		}
+		now := time.Now()
+		start, end := utils.LeaveCalendarWindow(now, input.FromDate, input.ToDate)
+		holidays, err := s.holidayRepo.GetHolidayDates(ctx, start, end)
+		if err != nil {
+			return nil, err
+		}
+		facts = utils.LeaveFacts(input.Days, input.LeaveType, input.GradeID, "", now)
+		utils.AddLeaveCalendarFacts(facts, utils.NewCalendar(holidays), now, input.FromDate, input.ToDate)
+	case "EXPENSE":
+		if input.UserID > 0 {
+			return s.expenseService.SimulateExpense(ctx, input.UserID, input.Amount, input.Category)
+		}
+		if input.Amount <= 0 {
+			return nil, apperrors.ErrInvalidExpenseAmount
+		}
+		facts = utils.ExpenseFacts(input.Amount, input.Category, input.GradeID, "", time.Now())
+	case "DISCOUNT":
+		if input.UserID > 0 {
+			return s.discountService.SimulateDiscount(ctx, input.UserID, input.DiscountPercentage)
+		}
+		if input.DiscountPercentage <= 0 {
+			return nil, apperrors.ErrInvalidDiscountPercent
+		}
+		facts = utils.DiscountFacts(input.DiscountPercentage, input.GradeID, "", time.Now())
+	case "":
+		return nil, apperrors.ErrRequestTypeRequired
+	default:
+		return nil, apperrors.ErrUnknownRequestType
+	}
+
+	// without a requester, role conditions have nothing to compare against
+	delete(facts, utils.AttrRole)
+
+	result, err := s.ruleService.Evaluate(ctx, input.RequestType, models.RuleSubject{GradeID: input.GradeID}, facts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &utils.Evaluation{DecisionResult: *result, Facts: facts}, nil
+}
@@ -6,11 +6,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules/mocks"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -36,7 +38,7 @@ func TestRuleHandler_CreateRule(t *testing.T) {
 				Condition:   map[string]interface{}{"max_days": 5.0},
 			},
 			mockSetup: func(s *mocks.RuleService) {
-				s.EXPECT().CreateRule(mock.Anything, "ADMIN", mock.AnythingOfType("models.Rule")).Return(nil)
+				s.EXPECT().CreateRule(mock.Anything, "ADMIN", int64(7), mock.AnythingOfType("models.Rule")).Return(&models.RuleProposal{ID: 3}, nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -63,10 +65,59 @@ func TestRuleHandler_CreateRule(t *testing.T) {
 				GradeID:     1,
 			},
 			mockSetup: func(s *mocks.RuleService) {
-				s.EXPECT().CreateRule(mock.Anything, "ADMIN", mock.Anything).Return(apperrors.ErrDatabase)
+				s.EXPECT().CreateRule(mock.Anything, "ADMIN", int64(7), mock.Anything).Return(nil, apperrors.ErrDatabase)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
+		{
+			name: "Invalid Condition",
+			role: "ADMIN",
+			reqBody: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				GradeID:     1,
+				Condition:   map[string]interface{}{"max_day": 5.0},
+			},
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().CreateRule(mock.Anything, "ADMIN", int64(7), mock.Anything).
+					Return(nil, &utils.ConditionError{Field: "condition.max_day", Message: "unknown key"})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Condition Schema Violations",
+			role: "ADMIN",
+			reqBody: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				GradeID:     1,
+				Condition:   map[string]interface{}{"max_days": -1.0, "max_amount": 5.0},
+			},
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().CreateRule(mock.Anything, "ADMIN", int64(7), mock.Anything).
+					Return(nil, utils.ConditionErrors{
+						{Field: "condition.max_amount", Message: "max_amount is not available for LEAVE rules"},
+						{Field: "condition.max_days", Message: "must not be negative"},
+					})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Team Rule Without Manager",
+			role: "ADMIN",
+			reqBody: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				Scope:       "TEAM",
+				Condition:   map[string]interface{}{"max_days": 5.0},
+			},
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().CreateRule(mock.Anything, "ADMIN", int64(7), mock.MatchedBy(func(rule models.Rule) bool {
+					return rule.Scope == "TEAM"
+				})).Return(nil, apperrors.ErrScopeTargetRequired)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -78,6 +129,7 @@ func TestRuleHandler_CreateRule(t *testing.T) {
 			r := gin.New()
 			r.POST("/rules", func(c *gin.Context) {
 				c.Set("role", tt.role)
+				c.Set("user_id", int64(7))
 				handler.CreateRule(c)
 			})
 
@@ -117,9 +169,9 @@ func TestRuleHandler_UpdateRule(t *testing.T) {
 				Action: "MANUAL",
 			},
 			mockSetup: func(s *mocks.RuleService) {
-				s.EXPECT().UpdateRule(mock.Anything, "ADMIN", int64(1), mock.Anything).Return(nil)
+				s.EXPECT().UpdateRule(mock.Anything, "ADMIN", int64(7), int64(1), mock.Anything).Return(&models.RuleProposal{ID: 4}, nil)
 			},
-			expectedStatus: http.StatusOK,
+			expectedStatus: http.StatusCreated,
 		},
 		{
 			name:           "Invalid ID",
@@ -140,6 +192,7 @@ func TestRuleHandler_UpdateRule(t *testing.T) {
 			r := gin.New()
 			r.PUT("/rules/:id", func(c *gin.Context) {
 				c.Set("role", tt.role)
+				c.Set("user_id", int64(7))
 				handler.UpdateRule(c)
 			})
 
@@ -202,6 +255,229 @@ func TestRuleHandler_GetRules(t *testing.T) {
 	}
 }
 
+func TestRuleHandler_AuditRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(s *mocks.RuleService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			role: "ADMIN",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().AuditRules(mock.Anything, "ADMIN").Return(&models.RuleAuditReport{Checked: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unauthorized",
+			role:           "EMPLOYEE",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/rules/audit", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.AuditRules(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/rules/audit", nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRuleHandler_ExportRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	bundle := &models.RuleBundle{Version: models.RuleBundleVersion, Rules: []models.BundleRule{
+		{Key: "short-leave", RequestType: "LEAVE", Scope: "GRADE", GradeID: 1, Action: "AUTO_APPROVE", Active: true,
+			Condition: map[string]interface{}{"max_days": 2.0}},
+	}}
+
+	tests := []struct {
+		name           string
+		role           string
+		query          string
+		mockSetup      func(s *mocks.RuleService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "JSON By Default",
+			role:  "ADMIN",
+			query: "",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ExportRules(mock.Anything, "ADMIN").Return(bundle, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"key":"short-leave"`,
+		},
+		{
+			name:  "YAML",
+			role:  "ADMIN",
+			query: "?format=yaml",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ExportRules(mock.Anything, "ADMIN").Return(bundle, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "key: short-leave",
+		},
+		{
+			name:           "Unknown Format",
+			role:           "ADMIN",
+			query:          "?format=xml",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unauthorized",
+			role:           "EMPLOYEE",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/rules/export", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.ExportRules(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/rules/export"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestRuleHandler_ImportRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	yamlBundle := `version: 1
+rules:
+  - key: short-leave
+    request_type: LEAVE
+    scope: GRADE
+    grade_id: 1
+    action: AUTO_APPROVE
+    active: true
+    condition:
+      max_days: 2
+`
+
+	tests := []struct {
+		name           string
+		role           string
+		url            string
+		contentType    string
+		body           string
+		mockSetup      func(s *mocks.RuleService)
+		expectedStatus int
+	}{
+		{
+			name:        "YAML Preview",
+			role:        "ADMIN",
+			url:         "/rules/import?preview=true",
+			contentType: "application/x-yaml",
+			body:        yamlBundle,
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ImportRules(mock.Anything, "ADMIN", int64(7), mock.MatchedBy(func(b models.RuleBundle) bool {
+					return b.Version == 1 && len(b.Rules) == 1 && b.Rules[0].Key == "short-leave" && b.Rules[0].GradeID == 1
+				}), true).Return(&models.RuleImportPlan{Unchanged: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "JSON Propose",
+			role:        "ADMIN",
+			url:         "/rules/import",
+			contentType: "application/json",
+			body:        `{"version":1,"rules":[]}`,
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ImportRules(mock.Anything, "ADMIN", int64(7), mock.Anything, false).Return(&models.RuleImportPlan{ProposalID: 6}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "Invalid Bundled Rule",
+			role:        "ADMIN",
+			url:         "/rules/import",
+			contentType: "application/json",
+			body:        `{"version":1,"rules":[{"key":"team-leave"}]}`,
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ImportRules(mock.Anything, "ADMIN", int64(7), mock.Anything, false).
+					Return(nil, &rules.BundleRuleError{Index: 0, Key: "team-leave", Err: apperrors.ErrScopeTargetRequired})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Malformed Body",
+			role:           "ADMIN",
+			url:            "/rules/import",
+			contentType:    "application/json",
+			body:           `{"version":`,
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unauthorized",
+			role:           "MANAGER",
+			url:            "/rules/import",
+			contentType:    "application/json",
+			body:           `{"version":1}`,
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleHandler(nil, mockService)
+			r := gin.New()
+			r.POST("/rules/import", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				c.Set("user_id", int64(7))
+				handler.ImportRules(c)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
 func TestRuleHandler_DeleteRule(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -217,16 +493,16 @@ func TestRuleHandler_DeleteRule(t *testing.T) {
 			role:   "ADMIN",
 			ruleID: "1",
 			mockSetup: func(s *mocks.RuleService) {
-				s.EXPECT().DeleteRule(mock.Anything, "ADMIN", int64(1)).Return(nil)
+				s.EXPECT().DeleteRule(mock.Anything, "ADMIN", int64(7), int64(1)).Return(&models.RuleProposal{ID: 5}, nil)
 			},
-			expectedStatus: http.StatusOK,
+			expectedStatus: http.StatusCreated,
 		},
 		{
 			name:   "Rule Not Found",
 			role:   "ADMIN",
 			ruleID: "99",
 			mockSetup: func(s *mocks.RuleService) {
-				s.EXPECT().DeleteRule(mock.Anything, "ADMIN", int64(99)).Return(apperrors.ErrRuleNotFoundForDelete)
+				s.EXPECT().DeleteRule(mock.Anything, "ADMIN", int64(7), int64(99)).Return(nil, apperrors.ErrRuleNotFoundForDelete)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -241,6 +517,7 @@ func TestRuleHandler_DeleteRule(t *testing.T) {
 			r := gin.New()
 			r.DELETE("/rules/:id", func(c *gin.Context) {
 				c.Set("role", tt.role)
+				c.Set("user_id", int64(7))
 				handler.DeleteRule(c)
 			})
 
@@ -253,3 +530,430 @@ func TestRuleHandler_DeleteRule(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleHandler_ReviewRuleProposal(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		url            string
+		body           string
+		mockSetup      func(s *mocks.RuleService)
+		expectedStatus int
+	}{
+		{
+			name: "Approve Without Comment",
+			role: "ADMIN",
+			url:  "/rules/proposals/5/approve",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ApproveRuleProposal(mock.Anything, "ADMIN", int64(7), int64(5), "").
+					Return(&models.RuleProposal{ID: 5, Status: "APPROVED"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Approve Own Proposal",
+			role: "ADMIN",
+			url:  "/rules/proposals/5/approve",
+			body: `{"comment":"lgtm"}`,
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ApproveRuleProposal(mock.Anything, "ADMIN", int64(7), int64(5), "lgtm").
+					Return(nil, apperrors.ErrSelfApproval)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Approve Outdated Proposal",
+			role: "ADMIN",
+			url:  "/rules/proposals/5/approve",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().ApproveRuleProposal(mock.Anything, "ADMIN", int64(7), int64(5), "").
+					Return(nil, apperrors.ErrProposalOutdated)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "Reject",
+			role: "ADMIN",
+			url:  "/rules/proposals/5/reject",
+			body: `{"comment":"limit too high"}`,
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().RejectRuleProposal(mock.Anything, "ADMIN", int64(7), int64(5), "limit too high").
+					Return(&models.RuleProposal{ID: 5, Status: "REJECTED"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Reject Without Comment",
+			role:           "ADMIN",
+			url:            "/rules/proposals/5/reject",
+			body:           `{}`,
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Proposal Not Found",
+			role: "ADMIN",
+			url:  "/rules/proposals/99/reject",
+			body: `{"comment":"no"}`,
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().RejectRuleProposal(mock.Anything, "ADMIN", int64(7), int64(99), "no").
+					Return(nil, apperrors.ErrProposalNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Unauthorized",
+			role:           "MANAGER",
+			url:            "/rules/proposals/5/approve",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleHandler(nil, mockService)
+			r := gin.New()
+			r.Use(func(c *gin.Context) {
+				c.Set("role", tt.role)
+				c.Set("user_id", int64(7))
+			})
+			r.POST("/rules/proposals/:id/approve", handler.ApproveRuleProposal)
+			r.POST("/rules/proposals/:id/reject", handler.RejectRuleProposal)
+
+			req := httptest.NewRequest(http.MethodPost, tt.url, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRuleSimulationHandler_Simulate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.RuleSimulationService)
+		expectedStatus int
+	}{
+		{
+			name: "Success - Leave",
+			role: "ADMIN",
+			reqBody: rules.SimulateRuleRequest{
+				RequestType: "leave",
+				UserID:      5,
+				Payload:     rules.SimulatePayload{FromDate: "2026-03-02", ToDate: "2026-03-05", LeaveType: "SICK"},
+			},
+			mockSetup: func(s *mocks.RuleSimulationService) {
+				s.EXPECT().Simulate(mock.Anything, "ADMIN", models.RuleSimulation{
+					RequestType: "LEAVE",
+					UserID:      5,
+					FromDate:    time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+					ToDate:      time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+					Days:        4,
+					LeaveType:   "SICK",
+				}).Return(&utils.Evaluation{DecisionResult: utils.DecisionResult{Status: "PENDING"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unauthorized - Not Admin",
+			role:           "MANAGER",
+			reqBody:        rules.SimulateRuleRequest{},
+			mockSetup:      func(s *mocks.RuleSimulationService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Invalid Date",
+			role: "ADMIN",
+			reqBody: rules.SimulateRuleRequest{
+				RequestType: "LEAVE",
+				GradeID:     1,
+				Payload:     rules.SimulatePayload{FromDate: "02-03-2026", ToDate: "2026-03-05"},
+			},
+			mockSetup:      func(s *mocks.RuleSimulationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Balance Exceeded",
+			role: "ADMIN",
+			reqBody: rules.SimulateRuleRequest{
+				RequestType: "EXPENSE",
+				UserID:      5,
+				Payload:     rules.SimulatePayload{Amount: 9000, Category: "TRAVEL"},
+			},
+			mockSetup: func(s *mocks.RuleSimulationService) {
+				s.EXPECT().Simulate(mock.Anything, "ADMIN", mock.Anything).Return(nil, apperrors.ErrExpenseLimitExceeded)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleSimulationService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleSimulationHandler(nil, mockService)
+			r := gin.New()
+			r.POST("/rules/simulate", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.Simulate(c)
+			})
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, "/rules/simulate", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRuleBacktestHandler_Backtest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		reqBody        interface{}
+		mockSetup      func(s *mocks.RuleBacktestService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			role: "ADMIN",
+			reqBody: rules.BacktestRequest{Rules: []models.Rule{
+				{RequestType: "LEAVE", GradeID: 1, Action: "AUTO_APPROVE", Condition: map[string]interface{}{"max_days": 5.0}},
+			}},
+			mockSetup: func(s *mocks.RuleBacktestService) {
+				s.EXPECT().Backtest(mock.Anything, "ADMIN", mock.AnythingOfType("[]models.Rule")).
+					Return(&models.BacktestReport{TotalRequests: 10, ManualToAuto: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unauthorized - Not Admin",
+			role:           "EMPLOYEE",
+			reqBody:        rules.BacktestRequest{},
+			mockSetup:      func(s *mocks.RuleBacktestService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "No Candidate Rules",
+			role:    "ADMIN",
+			reqBody: rules.BacktestRequest{},
+			mockSetup: func(s *mocks.RuleBacktestService) {
+				s.EXPECT().Backtest(mock.Anything, "ADMIN", mock.Anything).Return(nil, apperrors.ErrCandidateRulesRequired)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleBacktestService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleBacktestHandler(nil, mockService)
+			r := gin.New()
+			r.POST("/rules/backtest", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.Backtest(c)
+			})
+
+			body, _ := json.Marshal(tt.reqBody)
+			req := httptest.NewRequest(http.MethodPost, "/rules/backtest", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRuleHandler_DiffRuleVersions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		url            string
+		mockSetup      func(s *mocks.RuleService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			role: "ADMIN",
+			url:  "/rules/1/versions/diff?from=1&to=2",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().DiffRuleVersions(mock.Anything, "ADMIN", int64(1), 1, 2).Return(&models.RuleVersionDiff{
+					RuleID: 1, FromVersion: 1, ToVersion: 2,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing Version",
+			role:           "ADMIN",
+			url:            "/rules/1/versions/diff?from=1",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Version Not Found",
+			role: "ADMIN",
+			url:  "/rules/1/versions/diff?from=1&to=9",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().DiffRuleVersions(mock.Anything, "ADMIN", int64(1), 1, 9).Return(nil, apperrors.ErrRuleVersionNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Unauthorized - Not Admin",
+			role:           "MANAGER",
+			url:            "/rules/1/versions/diff?from=1&to=2",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/rules/:id/versions/diff", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.DiffRuleVersions(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRuleAnalyzerHandler_Analyze(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(s *mocks.RuleAnalyzerService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			role: "ADMIN",
+			mockSetup: func(s *mocks.RuleAnalyzerService) {
+				s.EXPECT().Analyze(mock.Anything, "ADMIN").Return(&models.RuleAnalysisReport{Grades: 2, Rules: 4}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Repository Error",
+			role: "ADMIN",
+			mockSetup: func(s *mocks.RuleAnalyzerService) {
+				s.EXPECT().Analyze(mock.Anything, "ADMIN").Return(nil, apperrors.ErrDatabase)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "Unauthorized",
+			role:           "MANAGER",
+			mockSetup:      func(s *mocks.RuleAnalyzerService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleAnalyzerService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleAnalyzerHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/rules/analysis", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.Analyze(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/rules/analysis", nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRuleHandler_GetRuleCacheStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(s *mocks.RuleService)
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			role: "ADMIN",
+			mockSetup: func(s *mocks.RuleService) {
+				s.EXPECT().GetRuleCacheStats(mock.Anything, "ADMIN").Return(&models.RuleCacheStats{Hits: 3, Misses: 1, HitRate: 0.75}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unauthorized",
+			role:           "EMPLOYEE",
+			mockSetup:      func(s *mocks.RuleService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mocks.NewRuleService(t)
+			tt.mockSetup(mockService)
+
+			handler := rules.NewRuleHandler(nil, mockService)
+			r := gin.New()
+			r.GET("/rules/cache/stats", func(c *gin.Context) {
+				c.Set("role", tt.role)
+				handler.GetRuleCacheStats(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/rules/cache/stats", nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
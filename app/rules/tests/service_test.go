@@ -2,25 +2,31 @@ package tests
 
 import (
 	"context"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules/mocks"
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestRuleService_CreateRule(t *testing.T) {
 	ctx := context.Background()
+	yesterday := time.Now().AddDate(0, 0, -1)
 
 	tests := []struct {
 		name          string
 		role          string
 		rule          models.Rule
-		mockSetup     func(r *mocks.RuleRepository)
+		mockSetup     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx)
 		expectedError error
 	}{
 		{
@@ -28,16 +34,24 @@ func TestRuleService_CreateRule(t *testing.T) {
 			role: constants.RoleAdmin,
 			rule: models.Rule{
 				RequestType: "LEAVE",
-				Action:      "APPROVE",
+				Action:      "AUTO_APPROVE",
 				GradeID:     1,
-				Condition:   map[string]interface{}{"days": 3},
+				Condition:   map[string]interface{}{"max_days": 3},
 			},
-			mockSetup: func(r *mocks.RuleRepository) {
-				r.EXPECT().Create(ctx, &models.Rule{
-					RequestType: "LEAVE",
-					Action:      "APPROVE",
-					GradeID:     1,
-					Condition:   map[string]interface{}{"days": 3},
+			mockSetup: func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+				p.EXPECT().Create(ctx, tx, &models.RuleProposal{
+					Action:     constants.ProposalCreate,
+					ProposedBy: 9,
+					After: &models.Rule{
+						RequestType: "LEAVE",
+						Action:      "AUTO_APPROVE",
+						Scope:       constants.ScopeGrade,
+						GradeID:     1,
+						Condition:   map[string]interface{}{"max_days": 3},
+					},
 				}).Return(nil)
 			},
 			expectedError: nil,
@@ -47,22 +61,22 @@ func TestRuleService_CreateRule(t *testing.T) {
 			role: constants.RoleEmployee,
 			rule: models.Rule{
 				RequestType: "LEAVE",
-				Action:      "APPROVE",
+				Action:      "AUTO_APPROVE",
 				GradeID:     1,
-				Condition:   map[string]interface{}{"days": 3},
+				Condition:   map[string]interface{}{"max_days": 3},
 			},
-			mockSetup:     func(r *mocks.RuleRepository) {},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
 			expectedError: apperrors.ErrUnauthorized,
 		},
 		{
 			name: "Missing RequestType",
 			role: constants.RoleAdmin,
 			rule: models.Rule{
-				Action:    "APPROVE",
+				Action:    "AUTO_APPROVE",
 				GradeID:   1,
-				Condition: map[string]interface{}{"days": 3},
+				Condition: map[string]interface{}{"max_days": 3},
 			},
-			mockSetup:     func(r *mocks.RuleRepository) {},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
 			expectedError: apperrors.ErrRequestTypeRequired,
 		},
 		{
@@ -71,9 +85,9 @@ func TestRuleService_CreateRule(t *testing.T) {
 			rule: models.Rule{
 				RequestType: "LEAVE",
 				GradeID:     1,
-				Condition:   map[string]interface{}{"days": 3},
+				Condition:   map[string]interface{}{"max_days": 3},
 			},
-			mockSetup:     func(r *mocks.RuleRepository) {},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
 			expectedError: apperrors.ErrActionRequired,
 		},
 		{
@@ -81,32 +95,208 @@ func TestRuleService_CreateRule(t *testing.T) {
 			role: constants.RoleAdmin,
 			rule: models.Rule{
 				RequestType: "LEAVE",
-				Action:      "APPROVE",
-				Condition:   map[string]interface{}{"days": 3},
+				Action:      "AUTO_APPROVE",
+				Condition:   map[string]interface{}{"max_days": 3},
 			},
-			mockSetup:     func(r *mocks.RuleRepository) {},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
 			expectedError: apperrors.ErrGradeIDRequired,
 		},
 		{
-			name: "Missing Condition",
+			name: "Invalid Condition",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "EXPENSE",
+				Action:      "AUTO_APPROVE",
+				GradeID:     1,
+				Condition:   map[string]interface{}{"attr": "amount", "op": "<", "value": "3000"},
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidCondition,
+		},
+		{
+			name: "Negative Priority",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				GradeID:     1,
+				Priority:    -1,
+				Condition:   map[string]interface{}{"max_days": 3},
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidPriority,
+		},
+		{
+			name: "Unknown Action",
 			role: constants.RoleAdmin,
 			rule: models.Rule{
 				RequestType: "LEAVE",
 				Action:      "APPROVE",
 				GradeID:     1,
+				Condition:   map[string]interface{}{"max_days": 3},
 			},
-			mockSetup:     func(r *mocks.RuleRepository) {},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidAction,
+		},
+		{
+			name: "Auto Reject Without Reason",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "DISCOUNT",
+				Action:      constants.ActionAutoReject,
+				GradeID:     1,
+				Condition:   map[string]interface{}{"attr": "percent", "op": ">", "value": 50},
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrRejectReasonRequired,
+		},
+		{
+			name: "Auto Reject With Reason",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "DISCOUNT",
+				Action:      constants.ActionAutoReject,
+				GradeID:     1,
+				Reason:      "Discounts above 50% are not allowed",
+				Condition:   map[string]interface{}{"attr": "percent", "op": ">", "value": 50},
+			},
+			mockSetup: func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+				p.EXPECT().Create(ctx, tx, &models.RuleProposal{
+					Action:     constants.ProposalCreate,
+					ProposedBy: 9,
+					After: &models.Rule{
+						RequestType: "DISCOUNT",
+						Action:      constants.ActionAutoReject,
+						Scope:       constants.ScopeGrade,
+						GradeID:     1,
+						Reason:      "Discounts above 50% are not allowed",
+						Condition:   map[string]interface{}{"attr": "percent", "op": ">", "value": 50},
+					},
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Effective Date In Past",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType:   "LEAVE",
+				Action:        "AUTO_APPROVE",
+				GradeID:       1,
+				Condition:     map[string]interface{}{"max_days": 3},
+				EffectiveFrom: &yesterday,
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrEffectiveDateInPast,
+		},
+		{
+			name: "Missing Condition",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				GradeID:     1,
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
 			expectedError: apperrors.ErrConditionRequired,
 		},
+		{
+			name: "Team Rule Drops Grade",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				Scope:       "team",
+				TargetID:    4,
+				GradeID:     1,
+				Condition:   map[string]interface{}{"max_days": 3},
+			},
+			mockSetup: func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+				p.EXPECT().Create(ctx, tx, &models.RuleProposal{
+					Action:     constants.ProposalCreate,
+					ProposedBy: 9,
+					After: &models.Rule{
+						RequestType: "LEAVE",
+						Action:      "AUTO_APPROVE",
+						Scope:       constants.ScopeTeam,
+						TargetID:    4,
+						Condition:   map[string]interface{}{"max_days": 3},
+					},
+				}).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Global Rule Needs No Target",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "EXPENSE",
+				Action:      "MANUAL",
+				Scope:       constants.ScopeGlobal,
+				Condition:   map[string]interface{}{"attr": "amount", "op": ">", "value": 10000},
+			},
+			mockSetup: func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {
+				db.EXPECT().Begin(ctx).Return(tx, nil)
+				tx.EXPECT().Commit(ctx).Return(nil)
+				tx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+				p.EXPECT().Create(ctx, tx, mock.AnythingOfType("*models.RuleProposal")).Return(nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name: "User Rule Without Target",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				Scope:       constants.ScopeUser,
+				Condition:   map[string]interface{}{"max_days": 3},
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrScopeTargetRequired,
+		},
+		{
+			name: "Department Rule Without Department",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				Scope:       constants.ScopeDepartment,
+				Department:  "  ",
+				Condition:   map[string]interface{}{"max_days": 3},
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrDepartmentRequired,
+		},
+		{
+			name: "Unknown Scope",
+			role: constants.RoleAdmin,
+			rule: models.Rule{
+				RequestType: "LEAVE",
+				Action:      "AUTO_APPROVE",
+				Scope:       "COMPANY",
+				Condition:   map[string]interface{}{"max_days": 3},
+			},
+			mockSetup:     func(p *mocks.RuleProposalRepository, db *mocks.DB, tx *mocks.Tx) {},
+			expectedError: apperrors.ErrInvalidScope,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo := mocks.NewRuleRepository(t)
-			tt.mockSetup(mockRepo)
+			mockProposalRepo := mocks.NewRuleProposalRepository(t)
+			mockDB := mocks.NewDB(t)
+			mockTx := mocks.NewTx(t)
+			tt.mockSetup(mockProposalRepo, mockDB, mockTx)
 
-			service := rules.NewRuleService(ctx, mockRepo)
-			err := service.CreateRule(ctx, tt.role, tt.rule)
+			service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mockProposalRepo, mockDB, constants.ActionManual)
+			_, err := service.CreateRule(ctx, tt.role, 9, tt.rule)
 
 			if tt.expectedError != nil {
 				assert.ErrorIs(t, err, tt.expectedError)
@@ -147,7 +337,7 @@ func TestRuleService_GetRules(t *testing.T) {
 			mockRepo := mocks.NewRuleRepository(t)
 			tt.mockSetup(mockRepo)
 
-			service := rules.NewRuleService(ctx, mockRepo)
+			service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
 			_, err := service.GetRules(ctx, tt.role)
 
 			if tt.expectedError != nil {
@@ -162,21 +352,202 @@ func TestRuleService_GetRules(t *testing.T) {
 func TestRuleService_UpdateRule(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Success - Keeps Type And Grade", func(t *testing.T) {
+		ruleID := int64(1)
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+		rule := models.Rule{
+			Action:    "MANUAL",
+			Condition: map[string]interface{}{"attr": "amount", "op": "between", "value": []interface{}{100, 500}},
+		}
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		existing := &models.Rule{ID: 1, Key: "expense-review", VersionID: 4, RequestType: "EXPENSE", GradeID: 2}
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(existing, nil)
+		mockProposalRepo.EXPECT().Create(ctx, mockTx, &models.RuleProposal{
+			Action:     constants.ProposalUpdate,
+			RuleID:     &ruleID,
+			Before:     existing,
+			ProposedBy: 9,
+			After: &models.Rule{
+				ID:          1,
+				Key:         "expense-review",
+				RequestType: "EXPENSE",
+				Scope:       constants.ScopeGrade,
+				GradeID:     2,
+				Action:      "MANUAL",
+				Condition:   rule.Condition,
+			},
+		}).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, rule)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Scheduled Change", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+		nextMonth := time.Now().AddDate(0, 1, 0)
+		rule := models.Rule{
+			RequestType:   "LEAVE",
+			GradeID:       1,
+			Action:        "AUTO_APPROVE",
+			Condition:     map[string]interface{}{"max_days": 5},
+			EffectiveFrom: &nextMonth,
+		}
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, RequestType: "LEAVE", GradeID: 1}, nil)
+		expected := rule
+		expected.ID = 1
+		expected.Scope = constants.ScopeGrade
+		mockProposalRepo.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(p *models.RuleProposal) bool {
+			return assert.ObjectsAreEqual(&expected, p.After)
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, rule)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Keeps User Scope", func(t *testing.T) {
 		mockRepo := mocks.NewRuleRepository(t)
-		rule := models.Rule{Action: "MANUAL"}
-		mockRepo.EXPECT().Update(ctx, int64(1), &rule).Return(nil)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+		rule := models.Rule{
+			Action:    "AUTO_APPROVE",
+			Condition: map[string]interface{}{"max_days": 2},
+		}
 
-		service := rules.NewRuleService(ctx, mockRepo)
-		err := service.UpdateRule(ctx, constants.RoleAdmin, 1, rule)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, RequestType: "LEAVE", Scope: constants.ScopeUser, TargetID: 7}, nil)
+		mockProposalRepo.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(p *models.RuleProposal) bool {
+			return assert.ObjectsAreEqual(&models.Rule{
+				ID:          1,
+				RequestType: "LEAVE",
+				Scope:       constants.ScopeUser,
+				TargetID:    7,
+				Action:      "AUTO_APPROVE",
+				Condition:   rule.Condition,
+			}, p.After)
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, rule)
 
 		assert.NoError(t, err)
 	})
 
+	t.Run("New Scope Without Target", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, RequestType: "LEAVE", GradeID: 1}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, mockDB, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, models.Rule{
+			Action:    "AUTO_APPROVE",
+			Scope:     constants.ScopeDepartment,
+			Condition: map[string]interface{}{"max_days": 2},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrDepartmentRequired)
+	})
+
+	t.Run("Effective Date In Past", func(t *testing.T) {
+		lastWeek := time.Now().AddDate(0, 0, -7)
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), nil, nil, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, models.Rule{
+			Action:        "MANUAL",
+			Condition:     map[string]interface{}{"max_days": 3},
+			EffectiveFrom: &lastWeek,
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrEffectiveDateInPast)
+	})
+
+	t.Run("Rule Not Found", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(9)).Return(nil, apperrors.ErrNoRuleFound)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, mockDB, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 9, models.Rule{
+			Action:    "MANUAL",
+			Condition: map[string]interface{}{"max_days": 3},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrNoRuleFound)
+	})
+
+	t.Run("Invalid Condition", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, models.Rule{
+			Action:    "MANUAL",
+			Condition: map[string]interface{}{"max_day": 3},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidCondition)
+	})
+
+	t.Run("Condition Not In Existing Type Schema", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, RequestType: "EXPENSE", GradeID: 2}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, mockDB, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, models.Rule{
+			Action:    "MANUAL",
+			Condition: map[string]interface{}{"max_days": 3},
+		})
+
+		var condErrs utils.ConditionErrors
+		assert.ErrorAs(t, err, &condErrs)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidCondition)
+	})
+
+	t.Run("Negative Limit", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleAdmin, 9, 1, models.Rule{
+			RequestType: "LEAVE",
+			Action:      "MANUAL",
+			Condition:   map[string]interface{}{"max_days": -3},
+		})
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidCondition)
+	})
+
 	t.Run("Unauthorized", func(t *testing.T) {
 		mockRepo := mocks.NewRuleRepository(t)
-		service := rules.NewRuleService(ctx, mockRepo)
-		err := service.UpdateRule(ctx, constants.RoleEmployee, 1, models.Rule{})
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		_, err := service.UpdateRule(ctx, constants.RoleEmployee, 9, 1, models.Rule{})
 
 		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
 	})
@@ -185,31 +556,1330 @@ func TestRuleService_UpdateRule(t *testing.T) {
 func TestRuleService_DeleteRule(t *testing.T) {
 	ctx := context.Background()
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Success - Proposes Retirement", func(t *testing.T) {
 		mockRepo := mocks.NewRuleRepository(t)
-		mockRepo.EXPECT().Delete(ctx, int64(1)).Return(nil)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+		ruleID := int64(1)
+		existing := &models.Rule{ID: 1, VersionID: 3, RequestType: "LEAVE", GradeID: 1}
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(existing, nil)
+		mockProposalRepo.EXPECT().Create(ctx, mockTx, &models.RuleProposal{
+			Action:     constants.ProposalDelete,
+			RuleID:     &ruleID,
+			Before:     existing,
+			ProposedBy: 9,
+		}).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
 
-		service := rules.NewRuleService(ctx, mockRepo)
-		err := service.DeleteRule(ctx, constants.RoleAdmin, 1)
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.DeleteRule(ctx, constants.RoleAdmin, 9, 1)
 
 		assert.NoError(t, err)
 	})
 
 	t.Run("Unauthorized", func(t *testing.T) {
 		mockRepo := mocks.NewRuleRepository(t)
-		service := rules.NewRuleService(ctx, mockRepo)
-		err := service.DeleteRule(ctx, constants.RoleEmployee, 1)
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		_, err := service.DeleteRule(ctx, constants.RoleEmployee, 9, 1)
 
 		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
 	})
 
 	t.Run("Repository Error", func(t *testing.T) {
 		mockRepo := mocks.NewRuleRepository(t)
-		mockRepo.EXPECT().Delete(ctx, int64(99)).Return(apperrors.ErrDatabase)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
 
-		service := rules.NewRuleService(ctx, mockRepo)
-		err := service.DeleteRule(ctx, constants.RoleAdmin, 99)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(99)).Return(nil, apperrors.ErrDatabase)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, mockDB, constants.ActionManual)
+		_, err := service.DeleteRule(ctx, constants.RoleAdmin, 9, 99)
 
 		assert.ErrorIs(t, err, apperrors.ErrDatabase)
 	})
+
+	t.Run("Rule Not Found", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(99)).Return(nil, apperrors.ErrNoRuleFound)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, mockDB, constants.ActionManual)
+		_, err := service.DeleteRule(ctx, constants.RoleAdmin, 9, 99)
+
+		assert.ErrorIs(t, err, apperrors.ErrRuleNotFoundForDelete)
+	})
+}
+
+func TestRuleService_AuditRules(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Reports Rules Violating Schema", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return([]models.Rule{
+			{ID: 1, Version: 1, RequestType: "LEAVE", GradeID: 1, Condition: map[string]interface{}{"max_days": 3}},
+			{ID: 2, Version: 4, RequestType: "LEAVE", GradeID: 1, Condition: map[string]interface{}{"max_day": 3}},
+			{ID: 3, Version: 1, RequestType: "TRAVEL", GradeID: 2, Condition: map[string]interface{}{"max_days": 3}},
+		}, nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		report, err := service.AuditRules(ctx, constants.RoleAdmin)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.RuleAuditReport{
+			Checked: 3,
+			Invalid: 2,
+			Findings: []models.RuleAuditFinding{
+				{
+					RuleID: 2, Version: 4, RequestType: "LEAVE", GradeID: 1,
+					Errors: []models.RuleFieldError{{Field: "condition.max_day", Message: "unknown key"}},
+				},
+				{
+					RuleID: 3, Version: 1, RequestType: "TRAVEL", GradeID: 2,
+					Errors: []models.RuleFieldError{{Field: "request_type", Message: apperrors.ErrUnknownRequestType.Error()}},
+				},
+			},
+		}, report)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), nil, nil, constants.ActionManual)
+		_, err := service.AuditRules(ctx, constants.RoleManager)
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+}
+
+func TestRuleService_ExportRules(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Bundles Latest Versions", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return([]models.Rule{
+			{ID: 1, Key: "short-leave", Version: 3, RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+				Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_days": 2.0}},
+		}, nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		bundle, err := service.ExportRules(ctx, constants.RoleAdmin)
+
+		assert.NoError(t, err)
+		assert.Equal(t, models.RuleBundleVersion, bundle.Version)
+		assert.Equal(t, []models.BundleRule{
+			{Key: "short-leave", RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+				Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_days": 2.0}},
+		}, bundle.Rules)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), nil, nil, constants.ActionManual)
+		_, err := service.ExportRules(ctx, constants.RoleManager)
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+}
+
+func TestRuleService_ImportRules(t *testing.T) {
+	ctx := context.Background()
+	existing := []models.Rule{
+		{ID: 1, Key: "short-leave", RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+			Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_days": 2.0}},
+		{ID: 2, Key: "small-expense", RequestType: "EXPENSE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+			Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_amount": 500.0}},
+		{ID: 3, Key: "old-discount", RequestType: "DISCOUNT", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+			Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_percent": 5.0}},
+	}
+	// unchanged short-leave (YAML integers included), raised expense cap, new global rule; old-discount is dropped
+	bundle := models.RuleBundle{
+		Version: models.RuleBundleVersion,
+		Rules: []models.BundleRule{
+			{Key: "short-leave", RequestType: "LEAVE", GradeID: 1, Priority: 10,
+				Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_days": uint64(2)}},
+			{Key: "small-expense", RequestType: "EXPENSE", Scope: "grade", GradeID: 1, Priority: 10,
+				Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_amount": 800}},
+			{Key: "big-expense", RequestType: "EXPENSE", Scope: constants.ScopeGlobal, Priority: 1,
+				Action: constants.ActionManual, Active: true, Condition: map[string]interface{}{"attr": "amount", "op": ">", "value": 10000}},
+		},
+	}
+	expectedPlan := func(proposalID int64) *models.RuleImportPlan {
+		return &models.RuleImportPlan{
+			ProposalID: proposalID,
+			Creates:    []models.RuleImportChange{{Key: "big-expense"}},
+			Updates: []models.RuleImportChange{{Key: "small-expense", RuleID: 2, Changes: []models.RuleFieldChange{
+				{Field: "condition", From: map[string]interface{}{"max_amount": 500.0}, To: map[string]interface{}{"max_amount": 800.0}},
+			}}},
+			Deletes:   []models.RuleImportChange{{Key: "old-discount", RuleID: 3}},
+			Unchanged: 1,
+		}
+	}
+
+	t.Run("Preview Does Not Write", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetAll(ctx).Return(existing, nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, mocks.NewDB(t), constants.ActionManual)
+		plan, err := service.ImportRules(ctx, constants.RoleAdmin, 9, bundle, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPlan(0), plan)
+	})
+
+	t.Run("Proposes Without Writing Rules", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetAll(ctx).Return(existing, nil)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(p *models.RuleProposal) bool {
+			return p.Action == constants.ProposalImport && p.ProposedBy == 9 &&
+				p.Bundle != nil && len(p.Bundle.Rules) == 3 && assert.ObjectsAreEqual(expectedPlan(0), p.Plan)
+		})).Run(func(_ context.Context, _ interfaces.Tx, p *models.RuleProposal) {
+			p.ID = 21
+		}).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		plan, err := service.ImportRules(ctx, constants.RoleAdmin, 9, bundle, false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPlan(21), plan)
+	})
+
+	invalid := []struct {
+		name          string
+		bundle        models.RuleBundle
+		expectedError error
+	}{
+		{
+			name:          "Unsupported Version",
+			bundle:        models.RuleBundle{Version: 99},
+			expectedError: apperrors.ErrUnsupportedBundleVersion,
+		},
+		{
+			name: "Missing Key",
+			bundle: models.RuleBundle{Version: models.RuleBundleVersion, Rules: []models.BundleRule{
+				{RequestType: "LEAVE", GradeID: 1, Action: constants.ActionManual, Condition: map[string]interface{}{"max_days": 2}},
+			}},
+			expectedError: apperrors.ErrBundleKeyRequired,
+		},
+		{
+			name: "Duplicate Key",
+			bundle: models.RuleBundle{Version: models.RuleBundleVersion, Rules: []models.BundleRule{
+				{Key: "a", RequestType: "LEAVE", GradeID: 1, Action: constants.ActionManual, Condition: map[string]interface{}{"max_days": 2}},
+				{Key: "a", RequestType: "LEAVE", GradeID: 2, Action: constants.ActionManual, Condition: map[string]interface{}{"max_days": 2}},
+			}},
+			expectedError: apperrors.ErrDuplicateBundleKey,
+		},
+		{
+			name: "Invalid Rule",
+			bundle: models.RuleBundle{Version: models.RuleBundleVersion, Rules: []models.BundleRule{
+				{Key: "a", RequestType: "LEAVE", GradeID: 1, Action: constants.ActionManual, Condition: map[string]interface{}{"max_amount": 2}},
+			}},
+			expectedError: apperrors.ErrInvalidCondition,
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), nil, mocks.NewDB(t), constants.ActionManual)
+			_, err := service.ImportRules(ctx, constants.RoleAdmin, 9, tt.bundle, true)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+
+	t.Run("Error Names The Bundled Rule", func(t *testing.T) {
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), nil, mocks.NewDB(t), constants.ActionManual)
+		_, err := service.ImportRules(ctx, constants.RoleAdmin, 9, models.RuleBundle{Version: models.RuleBundleVersion, Rules: []models.BundleRule{
+			{Key: "team-leave", RequestType: "LEAVE", Scope: constants.ScopeTeam, Action: constants.ActionManual, Condition: map[string]interface{}{"max_days": 2}},
+		}}, true)
+
+		var bundleErr *rules.BundleRuleError
+		assert.ErrorAs(t, err, &bundleErr)
+		assert.Equal(t, "team-leave", bundleErr.Key)
+		assert.ErrorIs(t, err, apperrors.ErrScopeTargetRequired)
+	})
+}
+
+func TestRuleService_ApproveRuleProposal(t *testing.T) {
+	ctx := context.Background()
+	ruleID := int64(1)
+	pending := func(p models.RuleProposal) *models.RuleProposal {
+		p.ID = 5
+		p.Status = constants.StatusPending
+		p.ProposedBy = 9
+		return &p
+	}
+
+	t.Run("Create Applies Rule", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(pending(models.RuleProposal{
+			Action: constants.ProposalCreate,
+			After:  &models.Rule{RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Action: "AUTO_APPROVE"},
+		}), nil)
+		mockRepo.EXPECT().Create(ctx, mockTx, mock.AnythingOfType("*models.Rule")).Run(func(_ context.Context, _ interfaces.Tx, rule *models.Rule) {
+			rule.ID = 42
+		}).Return(nil)
+		mockRepo.EXPECT().NotifyChange(ctx, mockTx, "LEAVE").Return(nil)
+		mockProposalRepo.EXPECT().Review(ctx, mockTx, mock.MatchedBy(func(p *models.RuleProposal) bool {
+			return p.Status == constants.StatusApproved && *p.ReviewedBy == 11 && *p.RuleID == 42 && p.ReviewComment == "ok"
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		proposal, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "ok")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), proposal.After.ID)
+	})
+
+	t.Run("Update Applies New Version", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+		after := &models.Rule{ID: 1, RequestType: "EXPENSE", Scope: constants.ScopeGrade, GradeID: 1, Action: "MANUAL"}
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(pending(models.RuleProposal{
+			Action: constants.ProposalUpdate,
+			RuleID: &ruleID,
+			Before: &models.Rule{ID: 1, VersionID: 3, RequestType: "LEAVE"},
+			After:  after,
+		}), nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, VersionID: 3}, nil)
+		mockRepo.EXPECT().Update(ctx, mockTx, int64(1), after).Return(nil)
+		// moving a rule to another request type changes both
+		mockRepo.EXPECT().NotifyChange(ctx, mockTx, "LEAVE").Return(nil)
+		mockRepo.EXPECT().NotifyChange(ctx, mockTx, "EXPENSE").Return(nil)
+		mockProposalRepo.EXPECT().Review(ctx, mockTx, mock.AnythingOfType("*models.RuleProposal")).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Rule Changed Since Proposal", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(pending(models.RuleProposal{
+			Action: constants.ProposalDelete,
+			RuleID: &ruleID,
+			Before: &models.Rule{ID: 1, VersionID: 3},
+		}), nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, VersionID: 4}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrProposalOutdated)
+	})
+
+	t.Run("Delete Retires Rule", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(pending(models.RuleProposal{
+			Action: constants.ProposalDelete,
+			RuleID: &ruleID,
+			Before: &models.Rule{ID: 1, VersionID: 3, RequestType: "LEAVE"},
+		}), nil)
+		mockRepo.EXPECT().GetByID(ctx, mockTx, int64(1)).Return(&models.Rule{ID: 1, VersionID: 3}, nil)
+		mockRepo.EXPECT().Delete(ctx, mockTx, int64(1)).Return(nil)
+		mockRepo.EXPECT().NotifyChange(ctx, mockTx, "LEAVE").Return(nil)
+		mockProposalRepo.EXPECT().Review(ctx, mockTx, mock.AnythingOfType("*models.RuleProposal")).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Own Proposal", func(t *testing.T) {
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(pending(models.RuleProposal{Action: constants.ProposalCreate}), nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 9, 5, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrSelfApproval)
+	})
+
+	t.Run("Already Reviewed", func(t *testing.T) {
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).
+			Return(&models.RuleProposal{ID: 5, Status: constants.StatusRejected, ProposedBy: 9}, nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrProposalNotPending)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mocks.NewRuleProposalRepository(t), nil, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleManager, 11, 5, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+}
+
+func TestRuleService_ApproveRuleProposal_Import(t *testing.T) {
+	ctx := context.Background()
+	existing := []models.Rule{
+		{ID: 2, Key: "small-expense", RequestType: "EXPENSE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+			Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_amount": 500.0}},
+		{ID: 3, Key: "old-discount", RequestType: "DISCOUNT", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10,
+			Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_percent": 5.0}},
+	}
+	bundle := &models.RuleBundle{
+		Version: models.RuleBundleVersion,
+		Rules: []models.BundleRule{
+			{Key: "small-expense", RequestType: "EXPENSE", GradeID: 1, Priority: 10,
+				Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_amount": 800.0}},
+			{Key: "big-expense", RequestType: "EXPENSE", Scope: constants.ScopeGlobal, Priority: 1,
+				Action: constants.ActionManual, Active: true, Condition: map[string]interface{}{"attr": "amount", "op": ">", "value": 10000.0}},
+		},
+	}
+	plan := &models.RuleImportPlan{
+		Creates: []models.RuleImportChange{{Key: "big-expense"}},
+		Updates: []models.RuleImportChange{{Key: "small-expense", RuleID: 2, Changes: []models.RuleFieldChange{
+			{Field: "condition", From: map[string]interface{}{"max_amount": 500.0}, To: map[string]interface{}{"max_amount": 800.0}},
+		}}},
+		Deletes: []models.RuleImportChange{{Key: "old-discount", RuleID: 3}},
+	}
+	proposal := func() *models.RuleProposal {
+		return &models.RuleProposal{ID: 5, Action: constants.ProposalImport, Bundle: bundle, Plan: plan,
+			Status: constants.StatusPending, ProposedBy: 9}
+	}
+
+	t.Run("Applies In One Transaction", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(proposal(), nil)
+		mockRepo.EXPECT().GetAllForUpdate(ctx, mockTx).Return(existing, nil)
+		mockRepo.EXPECT().Delete(ctx, mockTx, int64(3)).Return(nil)
+		mockRepo.EXPECT().Update(ctx, mockTx, int64(2), mock.MatchedBy(func(rule *models.Rule) bool {
+			return rule.Key == "small-expense" && rule.Condition["max_amount"] == 800.0
+		})).Return(nil)
+		mockRepo.EXPECT().Create(ctx, mockTx, mock.MatchedBy(func(rule *models.Rule) bool {
+			return rule.Key == "big-expense" && rule.Scope == constants.ScopeGlobal
+		})).Return(nil)
+		mockRepo.EXPECT().NotifyChange(ctx, mockTx, "").Return(nil)
+		mockProposalRepo.EXPECT().Review(ctx, mockTx, mock.AnythingOfType("*models.RuleProposal")).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure Rolls Back", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(proposal(), nil)
+		mockRepo.EXPECT().GetAllForUpdate(ctx, mockTx).Return(existing, nil)
+		mockRepo.EXPECT().Delete(ctx, mockTx, int64(3)).Return(nil)
+		mockRepo.EXPECT().Update(ctx, mockTx, int64(2), mock.Anything).Return(apperrors.ErrDatabase)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrDatabase)
+	})
+
+	t.Run("Rule Set Changed Since Proposal", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(proposal(), nil)
+		mockRepo.EXPECT().GetAllForUpdate(ctx, mockTx).Return(existing[:1], nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrProposalOutdated)
+	})
+}
+
+func TestRuleService_RejectRuleProposal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Proposer Withdraws", func(t *testing.T) {
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).
+			Return(&models.RuleProposal{ID: 5, Action: constants.ProposalCreate, Status: constants.StatusPending, ProposedBy: 9}, nil)
+		mockProposalRepo.EXPECT().Review(ctx, mockTx, mock.MatchedBy(func(p *models.RuleProposal) bool {
+			return p.Status == constants.StatusRejected && *p.ReviewedBy == 9 && p.ReviewComment == "typo in limit"
+		})).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.RejectRuleProposal(ctx, constants.RoleAdmin, 9, 5, "typo in limit")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Proposal Not Found", func(t *testing.T) {
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(nil, apperrors.ErrProposalNotFound)
+		mockTx.EXPECT().Rollback(ctx).Return(nil)
+
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.RejectRuleProposal(ctx, constants.RoleAdmin, 11, 5, "no")
+
+		assert.ErrorIs(t, err, apperrors.ErrProposalNotFound)
+	})
+}
+
+func TestRuleService_GetRuleProposals(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Filters By Status", func(t *testing.T) {
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockProposalRepo.EXPECT().GetAll(ctx, constants.StatusPending).Return([]models.RuleProposal{{ID: 5}}, nil)
+
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mockProposalRepo, nil, constants.ActionManual)
+		proposals, err := service.GetRuleProposals(ctx, constants.RoleAdmin, " pending ")
+
+		assert.NoError(t, err)
+		assert.Len(t, proposals, 1)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), mocks.NewRuleProposalRepository(t), nil, constants.ActionManual)
+		_, err := service.GetRuleProposals(ctx, constants.RoleEmployee, "")
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+}
+
+func TestRuleService_DiffRuleVersions(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	switchover := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	versions := []models.RuleVersion{
+		{ID: 11, RuleID: 1, Version: 1, RequestType: "LEAVE", GradeID: 1, Action: "AUTO_APPROVE", Priority: 100, Active: true,
+			Condition: map[string]interface{}{"max_days": 3.0}, EffectiveFrom: start, EffectiveTo: &switchover},
+		{ID: 12, RuleID: 1, Version: 2, RequestType: "LEAVE", GradeID: 1, Action: "AUTO_APPROVE", Priority: 50, Active: true,
+			Condition: map[string]interface{}{"max_days": 5.0}, EffectiveFrom: switchover},
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		from, to      int
+		mockSetup     func(r *mocks.RuleRepository)
+		expected      []models.RuleFieldChange
+		expectedError error
+	}{
+		{
+			name: "Changed Fields Only",
+			role: constants.RoleAdmin,
+			from: 1,
+			to:   2,
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetVersions(ctx, int64(1)).Return(versions, nil)
+			},
+			expected: []models.RuleFieldChange{
+				{Field: "condition", From: map[string]interface{}{"max_days": 3.0}, To: map[string]interface{}{"max_days": 5.0}},
+				{Field: "priority", From: 100, To: 50},
+				{Field: "effective_from", From: start, To: switchover},
+				{Field: "effective_to", From: &switchover, To: (*time.Time)(nil)},
+			},
+		},
+		{
+			name: "Same Version",
+			role: constants.RoleAdmin,
+			from: 2,
+			to:   2,
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetVersions(ctx, int64(1)).Return(versions, nil)
+			},
+			expected: []models.RuleFieldChange{},
+		},
+		{
+			name: "Unknown Version",
+			role: constants.RoleAdmin,
+			from: 1,
+			to:   7,
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetVersions(ctx, int64(1)).Return(versions, nil)
+			},
+			expectedError: apperrors.ErrRuleVersionNotFound,
+		},
+		{
+			name: "Unknown Rule",
+			role: constants.RoleAdmin,
+			from: 1,
+			to:   2,
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetVersions(ctx, int64(1)).Return(nil, nil)
+			},
+			expectedError: apperrors.ErrNoRuleFound,
+		},
+		{
+			name:          "Unauthorized",
+			role:          constants.RoleManager,
+			from:          1,
+			to:            2,
+			mockSetup:     func(r *mocks.RuleRepository) {},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewRuleRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+			diff, err := service.DiffRuleVersions(ctx, tt.role, 1, tt.from, tt.to)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, diff.Changes)
+		})
+	}
+}
+
+func TestRuleService_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	managerID := int64(4)
+	subject := models.RuleSubject{UserID: 7, GradeID: 1, ManagerID: &managerID, Department: "SALES", Role: constants.RoleEmployee}
+	ruleSet := []models.Rule{
+		{ID: 2, RequestType: "LEAVE", Active: true, Scope: constants.ScopeGrade, GradeID: 1, Action: constants.ActionManual, Priority: 1, Condition: map[string]interface{}{"attr": "leave_type", "op": "==", "value": "UNPAID"}},
+		{ID: 1, RequestType: "LEAVE", Active: true, Scope: constants.ScopeGrade, GradeID: 1, Action: constants.ActionAutoApprove, Priority: 10, Condition: map[string]interface{}{"max_days": 3}},
+	}
+	// by priority as loaded; the user rule still outranks the team rule
+	scopedSet := []models.Rule{
+		{ID: 5, RequestType: "LEAVE", Active: true, Scope: constants.ScopeUser, TargetID: 7, Action: constants.ActionAutoApprove, Priority: 50, Condition: map[string]interface{}{"max_days": 1}},
+		{ID: 6, RequestType: "LEAVE", Active: true, Scope: constants.ScopeTeam, TargetID: 4, Action: constants.ActionManual, Priority: 1, Condition: map[string]interface{}{"max_days": 5}},
+		{ID: 7, RequestType: "LEAVE", Active: true, Scope: constants.ScopeGlobal, Action: constants.ActionAutoApprove, Priority: 1, Condition: map[string]interface{}{"max_days": 10}},
+	}
+
+	tests := []struct {
+		name           string
+		defaultAction  string
+		facts          utils.Facts
+		mockSetup      func(r *mocks.RuleRepository)
+		expectedStatus string
+		expectedRuleID *int64
+		expectedError  error
+	}{
+		{
+			name:          "First Matching Rule Decides",
+			defaultAction: constants.ActionManual,
+			facts:         utils.Facts{utils.AttrDays: 2, utils.AttrLeaveType: "UNPAID"},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(ruleSet, nil)
+			},
+			expectedStatus: constants.StatusPending,
+			expectedRuleID: &ruleSet[0].ID,
+		},
+		{
+			name:          "Lower Priority Rule Matches",
+			defaultAction: constants.ActionManual,
+			facts:         utils.Facts{utils.AttrDays: 2, utils.AttrLeaveType: "SICK"},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(ruleSet, nil)
+			},
+			expectedStatus: constants.StatusAutoApproved,
+			expectedRuleID: &ruleSet[1].ID,
+		},
+		{
+			name:          "No Match Falls Back To Default",
+			defaultAction: constants.ActionAutoApprove,
+			facts:         utils.Facts{utils.AttrDays: 9, utils.AttrLeaveType: "SICK"},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(ruleSet, nil)
+			},
+			expectedStatus: constants.StatusAutoApproved,
+		},
+		{
+			name:          "Unknown Default Treated As Manual",
+			defaultAction: "SOMETHING",
+			facts:         utils.Facts{utils.AttrDays: 9, utils.AttrLeaveType: "SICK"},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(ruleSet, nil)
+			},
+			expectedStatus: constants.StatusPending,
+		},
+		{
+			name:          "User Rule Outranks Team Rule",
+			defaultAction: constants.ActionManual,
+			facts:         utils.Facts{utils.AttrDays: 1},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(scopedSet, nil)
+			},
+			expectedStatus: constants.StatusAutoApproved,
+			expectedRuleID: &scopedSet[0].ID,
+		},
+		{
+			name:          "Falls Through To Less Specific Scope",
+			defaultAction: constants.ActionManual,
+			facts:         utils.Facts{utils.AttrDays: 4},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(scopedSet, nil)
+			},
+			expectedStatus: constants.StatusPending,
+			expectedRuleID: &scopedSet[1].ID,
+		},
+		{
+			name:          "No Rules Configured",
+			defaultAction: constants.ActionManual,
+			facts:         utils.Facts{utils.AttrDays: 2},
+			mockSetup: func(r *mocks.RuleRepository) {
+				r.EXPECT().GetByType(ctx, "LEAVE").Return(nil, nil)
+			},
+			expectedError: apperrors.ErrNoRuleFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewRuleRepository(t)
+			tt.mockSetup(mockRepo)
+
+			service := rules.NewRuleService(ctx, mockRepo, nil, nil, tt.defaultAction)
+			result, err := service.Evaluate(ctx, "LEAVE", subject, tt.facts)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			assert.Equal(t, tt.expectedRuleID, result.RuleID())
+		})
+	}
+}
+
+func TestRuleService_RuleCache(t *testing.T) {
+	ctx := context.Background()
+	subject := models.RuleSubject{UserID: 7, GradeID: 1, Role: constants.RoleEmployee}
+	facts := utils.Facts{utils.AttrDays: 2}
+	global := []models.Rule{
+		{ID: 1, VersionID: 11, RequestType: "LEAVE", Scope: constants.ScopeGlobal, Active: true,
+			Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_days": 3}},
+	}
+
+	t.Run("Repeated Lookups Served From Memory", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetByType(ctx, "LEAVE").Return(global, nil).Once()
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		for i := 0; i < 4; i++ {
+			result, err := service.Evaluate(ctx, "LEAVE", subject, facts)
+			assert.NoError(t, err)
+			assert.Equal(t, constants.StatusAutoApproved, result.Status)
+		}
+
+		stats, err := service.GetRuleCacheStats(ctx, constants.RoleAdmin)
+		assert.NoError(t, err)
+		assert.Equal(t, &models.RuleCacheStats{Hits: 3, Misses: 1, HitRate: 0.75, RequestTypes: 1}, stats)
+	})
+
+	t.Run("Invalidation Reloads Only That Request Type", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetByType(ctx, "LEAVE").Return(global, nil).Times(2)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		_, err := service.Evaluate(ctx, "LEAVE", subject, facts)
+		assert.NoError(t, err)
+
+		service.InvalidateRules("EXPENSE")
+		_, err = service.Evaluate(ctx, "LEAVE", subject, facts)
+		assert.NoError(t, err)
+
+		service.InvalidateRules("LEAVE")
+		_, err = service.Evaluate(ctx, "LEAVE", subject, facts)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Approved Change Reloads", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockProposalRepo := mocks.NewRuleProposalRepository(t)
+		mockDB := mocks.NewDB(t)
+		mockTx := mocks.NewTx(t)
+
+		mockRepo.EXPECT().GetByType(ctx, "LEAVE").Return(global, nil).Times(2)
+		mockDB.EXPECT().Begin(ctx).Return(mockTx, nil)
+		mockProposalRepo.EXPECT().GetByID(ctx, mockTx, int64(5)).Return(&models.RuleProposal{
+			ID: 5, Action: constants.ProposalCreate, Status: constants.StatusPending, ProposedBy: 9,
+			After: &models.Rule{RequestType: "LEAVE", Scope: constants.ScopeGlobal, Action: constants.ActionManual},
+		}, nil)
+		mockRepo.EXPECT().Create(ctx, mockTx, mock.AnythingOfType("*models.Rule")).Return(nil)
+		mockRepo.EXPECT().NotifyChange(ctx, mockTx, "LEAVE").Return(nil)
+		mockProposalRepo.EXPECT().Review(ctx, mockTx, mock.AnythingOfType("*models.RuleProposal")).Return(nil)
+		mockTx.EXPECT().Commit(ctx).Return(nil)
+		mockTx.EXPECT().Rollback(ctx).Return(nil).Maybe()
+
+		service := rules.NewRuleService(ctx, mockRepo, mockProposalRepo, mockDB, constants.ActionManual)
+		_, err := service.Evaluate(ctx, "LEAVE", subject, facts)
+		assert.NoError(t, err)
+
+		_, err = service.ApproveRuleProposal(ctx, constants.RoleAdmin, 11, 5, "")
+		assert.NoError(t, err)
+
+		_, err = service.Evaluate(ctx, "LEAVE", subject, facts)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Picks Versions In Force For The Subject", func(t *testing.T) {
+		now := time.Now()
+		lastWeek, nextWeek := now.AddDate(0, 0, -7), now.AddDate(0, 0, 7)
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetByType(ctx, "LEAVE").Return([]models.Rule{
+			// another user's rule
+			{ID: 2, VersionID: 21, RequestType: "LEAVE", Scope: constants.ScopeUser, TargetID: 99, Active: true,
+				Action: constants.ActionAutoReject, Condition: map[string]interface{}{"max_days": 5}},
+			// current version, replaced next week
+			{ID: 3, VersionID: 31, RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Active: true, EffectiveFrom: &lastWeek, EffectiveTo: &nextWeek,
+				Action: constants.ActionManual, Condition: map[string]interface{}{"max_days": 5}},
+			{ID: 3, VersionID: 32, RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Active: true, EffectiveFrom: &nextWeek,
+				Action: constants.ActionAutoReject, Condition: map[string]interface{}{"max_days": 5}},
+		}, nil)
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		result, err := service.Evaluate(ctx, "LEAVE", subject, facts)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(31), *result.RuleVersionID())
+	})
+
+	t.Run("One Entry Serves Subjects Of Different Scopes", func(t *testing.T) {
+		mockRepo := mocks.NewRuleRepository(t)
+		mockRepo.EXPECT().GetByType(ctx, "LEAVE").Return([]models.Rule{
+			{ID: 2, VersionID: 21, RequestType: "LEAVE", Scope: constants.ScopeUser, TargetID: 8, Active: true,
+				Action: constants.ActionAutoReject, Condition: map[string]interface{}{"max_days": 5}},
+			global[0],
+		}, nil).Once()
+
+		service := rules.NewRuleService(ctx, mockRepo, nil, nil, constants.ActionManual)
+		result, err := service.Evaluate(ctx, "LEAVE", subject, facts)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), *result.RuleVersionID())
+
+		result, err = service.Evaluate(ctx, "LEAVE", models.RuleSubject{UserID: 8, GradeID: 1, Role: constants.RoleEmployee}, facts)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(21), *result.RuleVersionID())
+
+		stats, err := service.GetRuleCacheStats(ctx, constants.RoleAdmin)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, stats.RequestTypes)
+	})
+
+	t.Run("Stats Unauthorized", func(t *testing.T) {
+		service := rules.NewRuleService(ctx, mocks.NewRuleRepository(t), nil, nil, constants.ActionManual)
+		_, err := service.GetRuleCacheStats(ctx, constants.RoleManager)
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
+}
+
+func TestRuleSimulationService_Simulate(t *testing.T) {
+	ctx := context.Background()
+
+	type deps struct {
+		rules    *mocks.RuleService
+		leave    *mocks.LeaveService
+		expense  *mocks.ExpenseService
+		discount *mocks.DiscountService
+		holidays *mocks.HolidayRepository
+	}
+
+	today := utils.DateOf(time.Now())
+	from := today.AddDate(0, 0, 14)
+	to := from.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name           string
+		role           string
+		input          models.RuleSimulation
+		mockSetup      func(d deps)
+		expectedStatus string
+		expectedError  error
+	}{
+		{
+			name:  "User Follows Apply Path",
+			role:  constants.RoleAdmin,
+			input: models.RuleSimulation{RequestType: "LEAVE", UserID: 5, FromDate: from, ToDate: to, Days: 4, LeaveType: "SICK"},
+			mockSetup: func(d deps) {
+				d.leave.EXPECT().SimulateLeave(ctx, int64(5), from, to, 4, "SICK").Return(&utils.Evaluation{
+					DecisionResult: utils.DecisionResult{Status: constants.StatusPending},
+				}, nil)
+			},
+			expectedStatus: constants.StatusPending,
+		},
+		{
+			name:  "User Balance Exceeded",
+			role:  constants.RoleAdmin,
+			input: models.RuleSimulation{RequestType: "DISCOUNT", UserID: 5, DiscountPercentage: 40},
+			mockSetup: func(d deps) {
+				d.discount.EXPECT().SimulateDiscount(ctx, int64(5), 40.0).Return(nil, apperrors.ErrDiscountLimitExceeded)
+			},
+			expectedError: apperrors.ErrDiscountLimitExceeded,
+		},
+		{
+			name:  "Grade Only Evaluates Rules",
+			role:  constants.RoleAdmin,
+			input: models.RuleSimulation{RequestType: "EXPENSE", GradeID: 2, Amount: 120, Category: "FOOD"},
+			mockSetup: func(d deps) {
+				d.rules.EXPECT().Evaluate(ctx, "EXPENSE", models.RuleSubject{GradeID: 2}, mock.MatchedBy(func(f utils.Facts) bool {
+					_, hasRole := f[utils.AttrRole]
+					return f[utils.AttrAmount] == 120.0 && f[utils.AttrCategory] == "FOOD" && !hasRole
+				})).Return(&utils.DecisionResult{Status: constants.StatusAutoApproved, Rule: &models.Rule{ID: 3}}, nil)
+			},
+			expectedStatus: constants.StatusAutoApproved,
+		},
+		{
+			name:  "Grade Only Leave Gets Calendar Facts",
+			role:  constants.RoleAdmin,
+			input: models.RuleSimulation{RequestType: "LEAVE", GradeID: 2, FromDate: from, ToDate: to, Days: 2, LeaveType: "SICK"},
+			mockSetup: func(d deps) {
+				d.holidays.EXPECT().GetHolidayDates(ctx, today, to.AddDate(0, 0, 1)).Return([]time.Time{to.AddDate(0, 0, 1)}, nil)
+				d.rules.EXPECT().Evaluate(ctx, "LEAVE", models.RuleSubject{GradeID: 2}, mock.MatchedBy(func(f utils.Facts) bool {
+					return f[utils.AttrTouchesHoliday] == true && f[utils.AttrNoticeWorkingDays].(int) >= 8
+				})).Return(&utils.DecisionResult{Status: constants.StatusAutoApproved, Rule: &models.Rule{ID: 6}}, nil)
+			},
+			expectedStatus: constants.StatusAutoApproved,
+		},
+		{
+			name:          "Grade Only Invalid Amount",
+			role:          constants.RoleAdmin,
+			input:         models.RuleSimulation{RequestType: "EXPENSE", GradeID: 2},
+			mockSetup:     func(d deps) {},
+			expectedError: apperrors.ErrInvalidExpenseAmount,
+		},
+		{
+			name:          "Not Admin",
+			role:          constants.RoleManager,
+			input:         models.RuleSimulation{RequestType: "LEAVE", UserID: 5, Days: 1},
+			mockSetup:     func(d deps) {},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+		{
+			name:          "Missing Target",
+			role:          constants.RoleAdmin,
+			input:         models.RuleSimulation{RequestType: "LEAVE", Days: 1},
+			mockSetup:     func(d deps) {},
+			expectedError: apperrors.ErrSimulationTarget,
+		},
+		{
+			name:          "Unknown Request Type",
+			role:          constants.RoleAdmin,
+			input:         models.RuleSimulation{RequestType: "TRAVEL", GradeID: 1},
+			mockSetup:     func(d deps) {},
+			expectedError: apperrors.ErrUnknownRequestType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := deps{
+				rules:    mocks.NewRuleService(t),
+				leave:    mocks.NewLeaveService(t),
+				expense:  mocks.NewExpenseService(t),
+				discount: mocks.NewDiscountService(t),
+				holidays: mocks.NewHolidayRepository(t),
+			}
+			tt.mockSetup(d)
+
+			service := rules.NewRuleSimulationService(ctx, d.rules, d.leave, d.expense, d.discount, d.holidays)
+			result, err := service.Simulate(ctx, tt.role, tt.input)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+		})
+	}
+}
+
+func TestRuleBacktestService_Backtest(t *testing.T) {
+	ctx := context.Background()
+	submitted := time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC)
+	from := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 3)
+
+	current := []models.Rule{
+		{ID: 1, RequestType: "LEAVE", GradeID: 1, Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_days": 2}},
+		{ID: 2, RequestType: "EXPENSE", GradeID: 1, Action: constants.ActionAutoApprove, Active: true, Condition: map[string]interface{}{"max_amount": 500}},
+		{ID: 3, RequestType: "EXPENSE", GradeID: 2, Action: constants.ActionAutoApprove, Active: false, Condition: map[string]interface{}{"max_amount": 9999}},
+	}
+	history := []models.HistoricalRequest{
+		{ID: 10, RequestType: "LEAVE", GradeID: 1, Role: "EMPLOYEE", FromDate: &from, ToDate: &to, LeaveType: "SICK", CreatedAt: submitted},
+		{ID: 11, RequestType: "EXPENSE", GradeID: 1, Role: "EMPLOYEE", Amount: 300, Category: "FOOD", CreatedAt: submitted},
+		{ID: 12, RequestType: "EXPENSE", GradeID: 1, Role: "EMPLOYEE", Amount: 800, Category: "TRAVEL", CreatedAt: submitted},
+		{ID: 13, RequestType: "EXPENSE", GradeID: 2, Role: "EMPLOYEE", Amount: 100, Category: "FOOD", CreatedAt: submitted},
+	}
+
+	tests := []struct {
+		name          string
+		role          string
+		candidate     []models.Rule
+		holidays      []time.Time
+		mockSetup     func(r *mocks.RuleRepository, b *mocks.BacktestRepository)
+		expected      *models.BacktestReport
+		expectedError error
+	}{
+		{
+			name: "Raising Leave Threshold",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "LEAVE", GradeID: 1, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_days": 5}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {
+				r.EXPECT().GetAll(ctx).Return(current, nil)
+				b.EXPECT().GetHistoricalRequests(ctx).Return(history, nil)
+			},
+			expected: &models.BacktestReport{
+				TotalRequests:            4,
+				Changed:                  1,
+				ManualToAuto:             1,
+				CurrentManual:            3,
+				CandidateManual:          2,
+				WorkloadReduction:        1,
+				WorkloadReductionPercent: 33.33,
+				Breakdown: []models.BacktestBreakdown{
+					{RequestType: "EXPENSE", GradeID: 1, TotalRequests: 2, CurrentManual: 1, CandidateManual: 1},
+					{RequestType: "EXPENSE", GradeID: 2, TotalRequests: 1, CurrentManual: 1, CandidateManual: 1},
+					{RequestType: "LEAVE", GradeID: 1, TotalRequests: 1, Changed: 1, ManualToAuto: 1, CurrentManual: 1},
+				},
+			},
+		},
+		{
+			name: "Future Version Not In The Baseline",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "EXPENSE", GradeID: 1, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_amount": 500}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {
+				// a version raising the leave threshold only takes over after the requests were submitted
+				scheduled := submitted.AddDate(1, 0, 0)
+				withFuture := append(slices.Clone(current), models.Rule{
+					ID: 4, RequestType: "LEAVE", GradeID: 1, Action: constants.ActionAutoApprove, Active: true,
+					Condition: map[string]interface{}{"max_days": 10}, EffectiveFrom: &scheduled,
+				})
+				r.EXPECT().GetAll(ctx).Return(withFuture, nil)
+				b.EXPECT().GetHistoricalRequests(ctx).Return(history, nil)
+			},
+			expected: &models.BacktestReport{
+				TotalRequests:   4,
+				CurrentManual:   3,
+				CandidateManual: 3,
+				Breakdown: []models.BacktestBreakdown{
+					{RequestType: "EXPENSE", GradeID: 1, TotalRequests: 2, CurrentManual: 1, CandidateManual: 1},
+					{RequestType: "EXPENSE", GradeID: 2, TotalRequests: 1, CurrentManual: 1, CandidateManual: 1},
+					{RequestType: "LEAVE", GradeID: 1, TotalRequests: 1, CurrentManual: 1, CandidateManual: 1},
+				},
+			},
+		},
+		{
+			name: "Exception Takes Precedence",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "EXPENSE", GradeID: 1, Priority: 1, Action: constants.ActionManual, Condition: map[string]interface{}{"attr": "category", "op": "==", "value": "food"}},
+				{RequestType: "EXPENSE", GradeID: 1, Priority: 2, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_amount": 1000}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {
+				r.EXPECT().GetAll(ctx).Return(current, nil)
+				b.EXPECT().GetHistoricalRequests(ctx).Return(history, nil)
+			},
+			expected: &models.BacktestReport{
+				TotalRequests:            4,
+				Changed:                  2,
+				ManualToAuto:             1,
+				AutoToManual:             1,
+				CurrentManual:            3,
+				CandidateManual:          3,
+				WorkloadReduction:        0,
+				WorkloadReductionPercent: 0,
+				Breakdown: []models.BacktestBreakdown{
+					{RequestType: "EXPENSE", GradeID: 1, TotalRequests: 2, Changed: 2, ManualToAuto: 1, AutoToManual: 1, CurrentManual: 1, CandidateManual: 1},
+					{RequestType: "EXPENSE", GradeID: 2, TotalRequests: 1, CurrentManual: 1, CandidateManual: 1},
+					{RequestType: "LEAVE", GradeID: 1, TotalRequests: 1, CurrentManual: 1, CandidateManual: 1},
+				},
+			},
+		},
+		{
+			name: "Monthly Expense Cap Replays Usage",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "EXPENSE", GradeID: 1, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{
+					"all": []interface{}{
+						map[string]interface{}{"attr": "amount", "op": "<=", "value": 500},
+						map[string]interface{}{"attr": "month_expense_total", "op": "<", "value": 500},
+					},
+				}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {
+				r.EXPECT().GetAll(ctx).Return(current, nil)
+				b.EXPECT().GetHistoricalRequests(ctx).Return([]models.HistoricalRequest{
+					{ID: 20, EmployeeID: 7, RequestType: "EXPENSE", GradeID: 1, Amount: 300, Status: constants.StatusAutoApproved, CreatedAt: submitted.AddDate(0, 0, -7)},
+					{ID: 21, EmployeeID: 7, RequestType: "EXPENSE", GradeID: 1, Amount: 250, Status: constants.StatusRejected, CreatedAt: submitted.AddDate(0, 0, -3)},
+					{ID: 22, EmployeeID: 7, RequestType: "EXPENSE", GradeID: 1, Amount: 400, Status: constants.StatusApproved, CreatedAt: submitted.AddDate(0, 0, -2)},
+					{ID: 23, EmployeeID: 7, RequestType: "EXPENSE", GradeID: 1, Amount: 100, Status: constants.StatusPending, CreatedAt: submitted},
+					{ID: 24, EmployeeID: 8, RequestType: "EXPENSE", GradeID: 1, Amount: 200, Status: constants.StatusPending, CreatedAt: submitted},
+				}, nil)
+			},
+			// only the approved 300 + 400 count towards request 23; the rejected expense does not
+			expected: &models.BacktestReport{
+				TotalRequests:     5,
+				Changed:           1,
+				AutoToManual:      1,
+				CandidateManual:   1,
+				WorkloadReduction: -1,
+				Breakdown: []models.BacktestBreakdown{
+					{RequestType: "EXPENSE", GradeID: 1, TotalRequests: 5, Changed: 1, AutoToManual: 1, CandidateManual: 1},
+				},
+			},
+		},
+		{
+			name: "Notice Period Skips Holidays",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "LEAVE", GradeID: 1, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{
+					"all": []interface{}{
+						map[string]interface{}{"attr": "notice_working_days", "op": "==", "value": 4},
+						map[string]interface{}{"attr": "touches_holiday", "op": "==", "value": true},
+					},
+				}},
+			},
+			// the Monday before the leave is a holiday, leaving four working days of notice
+			holidays: []time.Time{from.AddDate(0, 0, -1)},
+			mockSetup: func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {
+				r.EXPECT().GetAll(ctx).Return(current, nil)
+				b.EXPECT().GetHistoricalRequests(ctx).Return(history[:1], nil)
+			},
+			expected: &models.BacktestReport{
+				TotalRequests:            1,
+				Changed:                  1,
+				ManualToAuto:             1,
+				CurrentManual:            1,
+				WorkloadReduction:        1,
+				WorkloadReductionPercent: 100,
+				Breakdown: []models.BacktestBreakdown{
+					{RequestType: "LEAVE", GradeID: 1, TotalRequests: 1, Changed: 1, ManualToAuto: 1, CurrentManual: 1},
+				},
+			},
+		},
+		{
+			name: "Team Rule Applies Only To The Team",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "EXPENSE", Scope: constants.ScopeTeam, TargetID: 4, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_amount": 1000}},
+			},
+			mockSetup: func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {
+				teamManager, otherManager := int64(4), int64(5)
+				r.EXPECT().GetAll(ctx).Return(current, nil)
+				b.EXPECT().GetHistoricalRequests(ctx).Return([]models.HistoricalRequest{
+					{ID: 30, EmployeeID: 7, ManagerID: &teamManager, RequestType: "EXPENSE", GradeID: 1, Amount: 800, Status: constants.StatusPending, CreatedAt: submitted},
+					{ID: 31, EmployeeID: 8, ManagerID: &otherManager, RequestType: "EXPENSE", GradeID: 1, Amount: 800, Status: constants.StatusPending, CreatedAt: submitted},
+				}, nil)
+			},
+			expected: &models.BacktestReport{
+				TotalRequests:            2,
+				Changed:                  1,
+				ManualToAuto:             1,
+				CurrentManual:            2,
+				CandidateManual:          1,
+				WorkloadReduction:        1,
+				WorkloadReductionPercent: 50,
+				Breakdown: []models.BacktestBreakdown{
+					{RequestType: "EXPENSE", GradeID: 1, TotalRequests: 2, Changed: 1, ManualToAuto: 1, CurrentManual: 2, CandidateManual: 1},
+				},
+			},
+		},
+		{
+			name:          "Not Admin",
+			role:          constants.RoleManager,
+			candidate:     []models.Rule{{RequestType: "LEAVE"}},
+			mockSetup:     func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {},
+			expectedError: apperrors.ErrUnauthorized,
+		},
+		{
+			name:          "No Candidate Rules",
+			role:          constants.RoleAdmin,
+			mockSetup:     func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {},
+			expectedError: apperrors.ErrCandidateRulesRequired,
+		},
+		{
+			name: "Invalid Candidate Rule",
+			role: constants.RoleAdmin,
+			candidate: []models.Rule{
+				{RequestType: "LEAVE", GradeID: 1, Action: "APPROVE", Condition: map[string]interface{}{"max_days": 5}},
+			},
+			mockSetup:     func(r *mocks.RuleRepository, b *mocks.BacktestRepository) {},
+			expectedError: apperrors.ErrInvalidAction,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := mocks.NewRuleRepository(t)
+			mockBacktestRepo := mocks.NewBacktestRepository(t)
+			mockHolidayRepo := mocks.NewHolidayRepository(t)
+			tt.mockSetup(mockRuleRepo, mockBacktestRepo)
+			// holidays are looked up once, around the historical leaves
+			mockHolidayRepo.EXPECT().GetHolidayDates(ctx, utils.DateOf(submitted), to.AddDate(0, 0, 1)).Return(tt.holidays, nil).Maybe()
+
+			service := rules.NewRuleBacktestService(ctx, mockRuleRepo, mockBacktestRepo, mockHolidayRepo, constants.ActionManual)
+			report, err := service.Backtest(ctx, tt.role, tt.candidate)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, report)
+		})
+	}
+}
+
+func TestRuleAnalyzerService_Analyze(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Reports Gaps And Conflicts", func(t *testing.T) {
+		mockRuleRepo := mocks.NewRuleRepository(t)
+		mockGradeRepo := mocks.NewGradeRepository(t)
+
+		mockGradeRepo.EXPECT().GetAll(ctx).Return([]models.Grade{
+			{ID: 1, Name: "G1", AnnualLeaveLimit: 10, AnnualExpenseLimit: 1000, DiscountLimitPercent: 5},
+			{ID: 2, Name: "G2", AnnualLeaveLimit: 20, AnnualExpenseLimit: 5000, DiscountLimitPercent: 10},
+			{ID: 3, Name: "G3", AnnualLeaveLimit: 5, AnnualExpenseLimit: 300, DiscountLimitPercent: 0},
+		}, nil)
+		mockRuleRepo.EXPECT().GetAll(ctx).Return([]models.Rule{
+			{ID: 1, RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10, Active: true,
+				Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_days": 3.0}},
+			{ID: 2, RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 1, Priority: 20, Active: true,
+				Action: constants.ActionManual, Condition: map[string]interface{}{"max_days": 2.0}},
+			{ID: 3, RequestType: "LEAVE", Scope: constants.ScopeGrade, GradeID: 2, Priority: 10, Active: true,
+				Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"attr": "days", "op": "<", "value": 25.0}},
+			{ID: 4, RequestType: "EXPENSE", Scope: constants.ScopeGlobal, Priority: 10, Active: true,
+				Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_amount": 500.0}},
+			{ID: 5, RequestType: "EXPENSE", Scope: constants.ScopeGlobal, Priority: 10, Active: true,
+				Action: constants.ActionManual, Condition: map[string]interface{}{"attr": "category", "op": "in", "value": []interface{}{"TRAVEL"}}},
+			{ID: 6, RequestType: "DISCOUNT", Scope: constants.ScopeGrade, GradeID: 1, Priority: 10, Active: false,
+				Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_percent": 2.0}},
+			{ID: 7, RequestType: "LEAVE", Scope: constants.ScopeUser, TargetID: 9, Priority: 10, Active: true,
+				Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"any": []interface{}{
+					map[string]interface{}{"max_days": 1.0},
+					map[string]interface{}{"attr": "leave_type", "op": "==", "value": "SICK"},
+				}}},
+		}, nil)
+
+		service := rules.NewRuleAnalyzerService(ctx, mockRuleRepo, mockGradeRepo, constants.ActionManual)
+		report, err := service.Analyze(ctx, constants.RoleAdmin)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Grades)
+		assert.Equal(t, 7, report.Rules)
+		for i := range report.Findings {
+			assert.NotEmpty(t, report.Findings[i].Message)
+			report.Findings[i].Message = ""
+		}
+		assert.Equal(t, []models.RuleAnalysisFinding{
+			{Kind: constants.FindingInactiveOnly, RequestType: "DISCOUNT", GradeID: 1},
+			{Kind: constants.FindingMissingCoverage, RequestType: "DISCOUNT", GradeID: 2},
+			{Kind: constants.FindingMissingCoverage, RequestType: "DISCOUNT", GradeID: 3},
+			{Kind: constants.FindingOverlap, RequestType: "EXPENSE", RuleID: 5, OtherRuleID: 4},
+			{Kind: constants.FindingLimitExceeded, RequestType: "EXPENSE", GradeID: 3, RuleID: 4},
+			{Kind: constants.FindingShadowed, RequestType: "LEAVE", GradeID: 1, RuleID: 2, OtherRuleID: 1},
+			{Kind: constants.FindingLimitExceeded, RequestType: "LEAVE", GradeID: 2, RuleID: 3},
+			{Kind: constants.FindingMissingCoverage, RequestType: "LEAVE", GradeID: 3},
+		}, report.Findings)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRuleRepo := mocks.NewRuleRepository(t)
+		mockRuleRepo.EXPECT().GetAll(ctx).Return(nil, apperrors.ErrDatabase)
+
+		service := rules.NewRuleAnalyzerService(ctx, mockRuleRepo, mocks.NewGradeRepository(t), constants.ActionManual)
+		_, err := service.Analyze(ctx, constants.RoleAdmin)
+
+		assert.ErrorIs(t, err, apperrors.ErrDatabase)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		service := rules.NewRuleAnalyzerService(ctx, mocks.NewRuleRepository(t), mocks.NewGradeRepository(t), constants.ActionManual)
+		_, err := service.Analyze(ctx, constants.RoleManager)
+
+		assert.ErrorIs(t, err, apperrors.ErrUnauthorized)
+	})
 }
@@ -2,22 +2,34 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"os"
 	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/app/amendments"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auth"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/info_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/my_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/reports"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/request_history"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/requests"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/revocations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
 	"github.com/ankita-advitot/rule_based_approval_engine/config"
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	jobs "github.com/ankita-advitot/rule_based_approval_engine/cron-jobs"
 	"github.com/ankita-advitot/rule_based_approval_engine/database"
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/ankita-advitot/rule_based_approval_engine/repositories"
 	"github.com/ankita-advitot/rule_based_approval_engine/repositories/migrations"
 	"github.com/ankita-advitot/rule_based_approval_engine/routes"
@@ -48,31 +60,84 @@ func main() {
 	holidayRepo := repositories.NewHolidayRepository(ctx, database.DB)
 	reportRepo := repositories.NewReportRepository(ctx, database.DB)
 	myRequestsRepo := repositories.NewAggregatedRepository(ctx, database.DB)
+	backtestRepo := repositories.NewBacktestRepository(ctx, database.DB)
+	usageRepo := repositories.NewUsageRepository(ctx, database.DB)
+	ruleProposalRepo := repositories.NewRuleProposalRepository(ctx, database.DB)
+	gradeRepo := repositories.NewGradeRepository(ctx, database.DB)
+	requestTypeRepo := repositories.NewRequestTypeRepository(ctx, database.DB)
+	genericRequestRepo := repositories.NewGenericRequestRepository(ctx, database.DB)
+	approvalChainRepo := repositories.NewApprovalChainRepository(ctx, database.DB)
+	delegationRepo := repositories.NewDelegationRepository(ctx, database.DB)
+	expiryPolicyRepo := repositories.NewExpiryPolicyRepository(ctx, database.DB)
+	escalationRepo := repositories.NewRequestEscalationRepository(ctx, database.DB)
+	infoRequestRepo := repositories.NewInfoRequestRepository(ctx, database.DB)
+	amendmentRepo := repositories.NewAmendmentRepository(ctx, database.DB)
+	revocationRepo := repositories.NewRevocationRepository(ctx, database.DB)
+	requestOwnerRepo := repositories.NewRequestOwnerRepository(ctx, database.DB)
+
+	// request types must be known before rules are validated or analysed
+	registerRequestTypes(ctx, requestTypeRepo)
 
 	// 2. Services
 	authService := auth.NewAuthService(ctx, userRepo, balanceRepo, database.DB)
-	ruleService := rules.NewRuleService(ctx, ruleRepo)
+	ruleService := rules.NewRuleService(ctx, ruleRepo, ruleProposalRepo, database.DB, cfg.Rules.DefaultAction)
+	delegationService := delegations.NewDelegationService(ctx, delegationRepo, userRepo, database.DB)
+	historyReader := request_history.NewRequestHistoryReader(ctx, requestOwnerRepo, userRepo, delegationService, database.DB)
+	approvalChainService := approval_chains.NewApprovalChainService(ctx, approvalChainRepo, userRepo, delegationRepo, historyReader)
+	expiryPolicyService := auto_reject.NewExpiryPolicyService(ctx, expiryPolicyRepo, escalationRepo, holidayRepo, historyReader)
+	infoRequestService := info_requests.NewInfoRequestService(ctx, infoRequestRepo, historyReader)
+	amendmentService := amendments.NewAmendmentService(ctx, amendmentRepo, historyReader)
+	revocationService := revocations.NewRevocationService(ctx, revocationRepo, historyReader)
 	leaveService := leave_service.NewLeaveService(
-		ctx, leaveRepo, balanceRepo, ruleService, userRepo, database.DB,
+		ctx, leaveRepo, balanceRepo, ruleService, userRepo, usageRepo, holidayRepo, approvalChainService, delegationService, infoRequestService, amendmentService, database.DB,
 	)
 	leaveApprovalService := leave_service.NewLeaveApprovalService(
-		ctx, leaveRepo, balanceRepo, userRepo, database.DB,
+		ctx, leaveRepo, balanceRepo, userRepo, approvalChainService, delegationService, expiryPolicyService, infoRequestService, revocationService, database.DB,
 	)
 	expenseService := expense_service.NewExpenseService(
-		ctx, expenseRepo, balanceRepo, ruleService, userRepo, database.DB,
+		ctx, expenseRepo, balanceRepo, ruleService, userRepo, usageRepo, approvalChainService, infoRequestService, amendmentService, database.DB,
 	)
 	expenseApprovalService := expense_service.NewExpenseApprovalService(
-		ctx, expenseRepo, balanceRepo, userRepo, database.DB,
+		ctx, expenseRepo, balanceRepo, userRepo, approvalChainService, delegationService, expiryPolicyService, infoRequestService, revocationService, database.DB,
 	)
 	holidayService := holidays.NewHolidayService(ctx, holidayRepo)
 	reportService := reports.NewReportService(ctx, reportRepo)
 	balanceService := domain_service.NewBalanceService(ctx, balanceRepo, database.DB)
-	discountService := domain_service.NewDiscountService(ctx, discountRepo, balanceRepo, ruleService, userRepo, database.DB)
-	discountApprovalService := domain_service.NewDiscountApprovalService(ctx, discountRepo, balanceRepo, userRepo, database.DB)
+	discountService := domain_service.NewDiscountService(
+		ctx, discountRepo, balanceRepo, ruleService, userRepo, usageRepo, approvalChainService, infoRequestService, database.DB,
+	)
+	discountApprovalService := domain_service.NewDiscountApprovalService(
+		ctx, discountRepo, balanceRepo, userRepo, approvalChainService, delegationService, expiryPolicyService, infoRequestService, revocationService, database.DB,
+	)
 	autoRejectService := auto_reject.NewAutoRejectService(
-		ctx, leaveRepo, expenseRepo, discountRepo, holidayRepo, database.DB,
+		ctx, leaveRepo, expenseRepo, discountRepo, holidayRepo, expiryPolicyRepo, escalationRepo,
+		userRepo, balanceRepo, approvalChainService, delegationService, database.DB,
 	)
 	myRequestsService := my_requests.NewMyRequestsService(ctx, myRequestsRepo)
+	requestService := requests.NewRequestService(
+		ctx, genericRequestRepo, balanceRepo, ruleService, userRepo, approvalChainService, database.DB,
+	)
+	requestApprovalService := requests.NewRequestApprovalService(
+		ctx, genericRequestRepo, balanceRepo, userRepo, approvalChainService, delegationService, database.DB,
+	)
+	ruleSimulationService := rules.NewRuleSimulationService(
+		ctx, ruleService, leaveService, expenseService, discountService, holidayRepo,
+	)
+	ruleBacktestService := rules.NewRuleBacktestService(ctx, ruleRepo, backtestRepo, holidayRepo, cfg.Rules.DefaultAction)
+	ruleAnalyzerService := rules.NewRuleAnalyzerService(ctx, ruleRepo, gradeRepo, cfg.Rules.DefaultAction)
+
+	if len(os.Args) > 1 && os.Args[1] == "backtest" {
+		runBacktest(ctx, ruleBacktestService)
+		return
+	}
+
+	warnRuleFindings(ctx, ruleAnalyzerService)
+
+	// keep the rule cache in step with changes approved on other instances
+	go database.Listen(ctx, constants.RuleChangeChannel,
+		func() { ruleService.InvalidateRules("") },
+		ruleService.InvalidateRules,
+	)
 
 	// 3. Router & CORS
 	router := gin.Default()
@@ -101,6 +166,17 @@ func main() {
 		autoRejectService,
 		discountService,
 		discountApprovalService,
+		ruleSimulationService,
+		ruleBacktestService,
+		ruleAnalyzerService,
+		requestService,
+		requestApprovalService,
+		approvalChainService,
+		delegationService,
+		expiryPolicyService,
+		infoRequestService,
+		amendmentService,
+		revocationService,
 	)
 
 	// 5. Cron Jobs
@@ -114,3 +190,57 @@ func main() {
 	log.Println(" Server started on port", cfg.AppPort)
 	router.Run(":" + cfg.AppPort)
 }
+
+// backtest <candidate.json> replays history against the candidate rules and prints the report.
+// The file has the same shape as the POST /api/rules/backtest body.
+func runBacktest(ctx context.Context, service interfaces.RuleBacktestService) {
+	if len(os.Args) < 3 {
+		log.Fatal("usage: backtest <candidate_rules.json>")
+	}
+
+	data, err := os.ReadFile(os.Args[2])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var req rules.BacktestRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		log.Fatal(err)
+	}
+
+	report, err := service.Backtest(ctx, constants.RoleAdmin, req.Rules)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out, _ := json.MarshalIndent(report, "", "  ")
+	fmt.Println(string(out))
+}
+
+// logs gaps and conflicts in the rule set; they do not stop the server
+func warnRuleFindings(ctx context.Context, service interfaces.RuleAnalyzerService) {
+	report, err := service.Analyze(ctx, constants.RoleAdmin)
+	if err != nil {
+		log.Println("WARNING: rule analysis failed:", err)
+		return
+	}
+
+	for _, finding := range report.Findings {
+		log.Printf("WARNING: %s %s rule check: %s", finding.RequestType, finding.Kind, finding.Message)
+	}
+}
+
+// registers the request types stored in request_types; a bad definition is skipped, not fatal
+func registerRequestTypes(ctx context.Context, repo interfaces.RequestTypeRepository) {
+	defs, err := repo.GetAll(ctx)
+	if err != nil {
+		log.Println("WARNING: loading request types failed:", err)
+		return
+	}
+
+	for _, def := range defs {
+		if err := utils.RegisterRequestType(def); err != nil {
+			log.Printf("WARNING: request type %s not registered: %v", def.Name, err)
+		}
+	}
+}
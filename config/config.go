@@ -10,6 +10,12 @@ import (
 type Config struct {
 	AppPort string
 	DB      DBConfig
+	Rules   RulesConfig
+}
+
+type RulesConfig struct {
+	// DefaultAction applies when no rule in a grade's rule set matches
+	DefaultAction string
 }
 
 type DBConfig struct {
@@ -44,6 +50,9 @@ func Load() *Config {
 			Name:     getEnv("DB_NAME", "approval_engine"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
+		Rules: RulesConfig{
+			DefaultAction: getEnv("RULE_DEFAULT_ACTION", "MANUAL"),
+		},
 	}
 }
 
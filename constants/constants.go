@@ -12,6 +12,61 @@ const (
 	StatusCancelled    = "CANCELLED"
 	StatusAutoApproved = "AUTO_APPROVED"
 	StatusAutoApprove  = "AUTO_APPROVE"
+	StatusSkipped      = "SKIPPED"
+	StatusNeedsInfo    = "NEEDS_INFO" // paused until the requester answers the approver's question
+	StatusRevoked      = "REVOKED"    // approved, then withdrawn by an approver or admin
+
+	ActionAutoApprove = "AUTO_APPROVE"
+	ActionManual      = "MANUAL"
+	ActionAutoReject  = "AUTO_REJECT"
+
+	// rule scopes, most specific first
+	ScopeUser       = "USER"
+	ScopeTeam       = "TEAM"
+	ScopeDepartment = "DEPARTMENT"
+	ScopeGrade      = "GRADE"
+	ScopeGlobal     = "GLOBAL"
+
+	// who decides a step of an approval chain
+	StepManager     = "MANAGER"
+	StepSkipManager = "SKIP_MANAGER"
+	StepDepartment  = "DEPARTMENT"
+	StepRole        = "ROLE"
+
+	// what the expiry job does with a request pending past its policy's expiry days
+	ExpiryEscalate    = "ESCALATE"
+	ExpiryAutoApprove = "AUTO_APPROVE"
+	ExpiryAutoReject  = "AUTO_REJECT"
+
+	// policy of request types without one
+	DefaultExpiryAction = ExpiryAutoReject
+	DefaultExpiryDays   = 7
+
+	// events recorded on a request by the expiry job
+	EscalationReminded     = "REMINDED"
+	EscalationEscalated    = "ESCALATED"
+	EscalationAutoApproved = "AUTO_APPROVED"
+	EscalationAutoRejected = "AUTO_REJECTED"
+
+	ProposalCreate = "CREATE"
+	ProposalUpdate = "UPDATE"
+	ProposalDelete = "DELETE"
+	ProposalImport = "IMPORT"
+
+	// Postgres channel rule changes are announced on; the payload is the request type, empty for all
+	RuleChangeChannel = "rule_changes"
+
+	// payload field types of a registered request type
+	FieldNumber = "number"
+	FieldString = "string"
+	FieldDate   = "date"
+
+	// kinds of rule analysis findings
+	FindingMissingCoverage = "MISSING_COVERAGE"
+	FindingInactiveOnly    = "INACTIVE_ONLY"
+	FindingShadowed        = "SHADOWED"
+	FindingOverlap         = "OVERLAP"
+	FindingLimitExceeded   = "LIMIT_EXCEEDED"
 
 	AdminEmail   = "admin@company.com"
 	ManagerEmail = "manager@company.com"
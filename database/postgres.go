@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/config"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
@@ -15,6 +16,9 @@ import (
 
 var DB interfaces.DB
 
+// pool backs DB; Listen holds one of its connections for LISTEN
+var pool *pgxpool.Pool
+
 type pgxDB struct {
 	pool *pgxpool.Pool
 }
@@ -74,7 +78,8 @@ func Connect(cfg *config.Config) {
 		cfg.DB.SSLMode,
 	)
 
-	pool, err := pgxpool.New(context.Background(), dsn)
+	var err error
+	pool, err = pgxpool.New(context.Background(), dsn)
 	if err != nil {
 		log.Fatalf("Unable to connect to database: %v\n", err)
 	}
@@ -87,3 +92,37 @@ func Connect(cfg *config.Config) {
 	DB = &pgxDB{pool: pool}
 	log.Println(" PostgreSQL connected")
 }
+
+// Listen passes every notification on channel to onNotify until ctx is done, reconnecting after errors.
+// onListen runs each time listening (re)starts, since notifications sent while disconnected are lost.
+func Listen(ctx context.Context, channel string, onListen func(), onNotify func(payload string)) {
+	for ctx.Err() == nil {
+		if err := listen(ctx, channel, onListen, onNotify); err != nil && ctx.Err() == nil {
+			log.Printf("LISTEN %s failed, retrying: %v\n", channel, err)
+			time.Sleep(5 * time.Second)
+		}
+	}
+}
+
+func listen(ctx context.Context, channel string, onListen func(), onNotify func(payload string)) error {
+	pooled, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// a connection left in LISTEN must not go back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		return err
+	}
+	onListen()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		onNotify(notification.Payload)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
@@ -34,6 +35,7 @@ type UserRepository interface {
 	CheckEmailExists(ctx context.Context, tx Tx, email string) (bool, error)
 	GetRole(ctx context.Context, tx Tx, userID int64) (string, error)
 	GetGrade(ctx context.Context, tx Tx, userID int64) (int64, error)
+	GetRuleSubject(ctx context.Context, tx Tx, userID int64) (*models.RuleSubject, error)
 }
 
 // BalanceRepository definitions
@@ -55,59 +57,178 @@ type BalanceRepository interface {
 
 // RuleRepository definitions
 type RuleRepository interface {
-	GetByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error)
-	Create(ctx context.Context, rule *models.Rule) error
+	GetByType(ctx context.Context, requestType string) ([]models.Rule, error)
+	GetByID(ctx context.Context, tx Tx, ruleID int64) (*models.Rule, error)
+	Create(ctx context.Context, tx Tx, rule *models.Rule) error
 	GetAll(ctx context.Context) ([]models.Rule, error)
-	Update(ctx context.Context, ruleID int64, rule *models.Rule) error
-	Delete(ctx context.Context, ruleID int64) error
+	GetAllForUpdate(ctx context.Context, tx Tx) ([]models.Rule, error)
+	Update(ctx context.Context, tx Tx, ruleID int64, rule *models.Rule) error
+	Delete(ctx context.Context, tx Tx, ruleID int64) error
+	GetVersions(ctx context.Context, ruleID int64) ([]models.RuleVersion, error)
+	NotifyChange(ctx context.Context, tx Tx, requestType string) error
+}
+
+// RuleProposalRepository handles pending rule changes
+type RuleProposalRepository interface {
+	Create(ctx context.Context, tx Tx, proposal *models.RuleProposal) error
+	GetByID(ctx context.Context, tx Tx, proposalID int64) (*models.RuleProposal, error)
+	GetAll(ctx context.Context, status string) ([]models.RuleProposal, error)
+	Review(ctx context.Context, tx Tx, proposal *models.RuleProposal) error
 }
 
 // LeaveRequestRepository definitions
 type LeaveRequestRepository interface {
 	Create(ctx context.Context, tx Tx, req *models.LeaveRequest) error
 	GetByID(ctx context.Context, tx Tx, requestID int64) (*models.LeaveRequest, error)
+	LockPending(ctx context.Context, tx Tx, requestID int64) (bool, error)
 	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
+	SetOnBehalfOf(ctx context.Context, tx Tx, requestID, delegatorID int64) error
 	GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error)
 	GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error)
-	CheckOverlap(ctx context.Context, userID int64, fromDate, toDate time.Time) (bool, error)
+	CheckOverlap(ctx context.Context, userID int64, fromDate, toDate time.Time, excludeID int64) (bool, error)
 	Cancel(ctx context.Context, tx Tx, requestID int64) error
-	GetPendingRequests(ctx context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)
+	Escalate(ctx context.Context, tx Tx, requestID int64, level int, escalatedTo *int64) error
+	GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error)
+	PauseForInfo(ctx context.Context, tx Tx, requestID int64, question string) error
+	Resubmit(ctx context.Context, tx Tx, requestID int64) error
+	Amend(ctx context.Context, tx Tx, req *models.LeaveRequest) error
+	Revoke(ctx context.Context, tx Tx, requestID int64, reason string) error
 }
 
 // ExpenseRequestRepository definitions
 type ExpenseRequestRepository interface {
 	Create(ctx context.Context, tx Tx, req *models.ExpenseRequest) error
 	GetByID(ctx context.Context, tx Tx, requestID int64) (*models.ExpenseRequest, error)
+	LockPending(ctx context.Context, tx Tx, requestID int64) (bool, error)
 	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
+	SetOnBehalfOf(ctx context.Context, tx Tx, requestID, delegatorID int64) error
 	GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error)
 	GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error)
 	Cancel(ctx context.Context, tx Tx, requestID int64) error
-	GetPendingRequests(ctx context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)
+	Escalate(ctx context.Context, tx Tx, requestID int64, level int, escalatedTo *int64) error
+	GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error)
+	PauseForInfo(ctx context.Context, tx Tx, requestID int64, question string) error
+	Resubmit(ctx context.Context, tx Tx, requestID int64) error
+	Amend(ctx context.Context, tx Tx, req *models.ExpenseRequest) error
+	Revoke(ctx context.Context, tx Tx, requestID int64, reason string) error
 }
 
 // DiscountRequestRepository definitions
 type DiscountRequestRepository interface {
 	Create(ctx context.Context, tx Tx, req *models.DiscountRequest) error
 	GetByID(ctx context.Context, tx Tx, requestID int64) (*models.DiscountRequest, error)
+	LockPending(ctx context.Context, tx Tx, requestID int64) (bool, error)
 	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
+	SetOnBehalfOf(ctx context.Context, tx Tx, requestID, delegatorID int64) error
 	GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error)
 	GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error)
 	Cancel(ctx context.Context, tx Tx, requestID int64) error
-	GetPendingRequests(ctx context.Context) ([]struct {
-		ID        int64
-		CreatedAt time.Time
-	}, error)
+	Escalate(ctx context.Context, tx Tx, requestID int64, level int, escalatedTo *int64) error
+	GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error)
+	PauseForInfo(ctx context.Context, tx Tx, requestID int64, question string) error
+	Resubmit(ctx context.Context, tx Tx, requestID int64) error
+	Revoke(ctx context.Context, tx Tx, requestID int64, reason string) error
+}
+
+// ExpiringRequestRepository is what the expiry job needs from the repository of one kind of request
+type ExpiringRequestRepository interface {
+	LockPending(ctx context.Context, tx Tx, requestID int64) (bool, error)
+	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
+	Escalate(ctx context.Context, tx Tx, requestID int64, level int, escalatedTo *int64) error
+	GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error)
+}
+
+// InfoRequestRepository stores the questions approvers ask on requests and the requesters' answers
+type InfoRequestRepository interface {
+	Create(ctx context.Context, tx Tx, info *models.InfoRequest) error
+	GetOpen(ctx context.Context, tx Tx, requestType string, requestID int64) (*models.InfoRequest, error)
+	Answer(ctx context.Context, tx Tx, infoID int64, answer string) error
+	GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.InfoRequest, error)
+}
+
+// AmendmentRepository records the amendments requesters make to their pending requests
+type AmendmentRepository interface {
+	Create(ctx context.Context, tx Tx, amendment *models.RequestAmendment) error
+	GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestAmendment, error)
+}
+
+// RevocationRepository records the approved requests approvers and admins revoke
+type RevocationRepository interface {
+	Create(ctx context.Context, tx Tx, revocation *models.RequestRevocation) error
+	GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestRevocation, error)
+}
+
+// RequestOwnerRepository finds who filed a request of one of the built-in types
+type RequestOwnerRepository interface {
+	GetOwner(ctx context.Context, tx Tx, requestType string, requestID int64) (int64, error)
+}
+
+// ExpiryPolicyRepository stores what happens to requests of each type left pending too long
+type ExpiryPolicyRepository interface {
+	GetAll(ctx context.Context) ([]models.ExpiryPolicy, error)
+	Upsert(ctx context.Context, policy *models.ExpiryPolicy) error
+	Delete(ctx context.Context, requestType string, gradeID *int64) error
+}
+
+// RequestEscalationRepository records the reminders, escalations and expiry decisions taken on requests
+type RequestEscalationRepository interface {
+	Create(ctx context.Context, tx Tx, escalation *models.RequestEscalation) error
+	GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestEscalation, error)
+	HasEvent(ctx context.Context, requestType string, requestID int64, event string, level int) (bool, error)
+}
+
+// RequestTypeRepository reads the request type definitions served by the generic pipeline
+type RequestTypeRepository interface {
+	GetAll(ctx context.Context) ([]models.RequestType, error)
+}
+
+// GenericRequestRepository stores requests of registered request types
+type GenericRequestRepository interface {
+	Create(ctx context.Context, tx Tx, req *models.GenericRequest) error
+	GetByID(ctx context.Context, tx Tx, requestType string, requestID int64) (*models.GenericRequest, error)
+	UpdateStatus(ctx context.Context, tx Tx, requestID int64, status string, approverID int64, comment string) error
+	SetOnBehalfOf(ctx context.Context, tx Tx, requestID, delegatorID int64) error
+	GetPendingForManager(ctx context.Context, requestType string, managerID int64) ([]map[string]interface{}, error)
+	GetPendingForAdmin(ctx context.Context, requestType string) ([]map[string]interface{}, error)
+	Cancel(ctx context.Context, tx Tx, requestID int64) error
+}
+
+// ApprovalChainRepository stores approval chains and the steps of the requests going through them
+type ApprovalChainRepository interface {
+	Create(ctx context.Context, chain *models.ApprovalChain) error
+	GetAll(ctx context.Context) ([]models.ApprovalChain, error)
+	GetActive(ctx context.Context, requestType string) ([]models.ApprovalChain, error)
+	Deactivate(ctx context.Context, chainID int64) error
+	CreateSteps(ctx context.Context, tx Tx, steps []models.RequestApprovalStep) error
+	GetSteps(ctx context.Context, tx Tx, requestType string, requestID int64) ([]models.RequestApprovalStep, error)
+	GetRequestSteps(ctx context.Context, requestType string, requestID int64) ([]models.RequestApprovalStep, error)
+	GetPendingStepsFor(ctx context.Context, approverID int64, role string) ([]models.RequestApprovalStep, error)
+	DecideStep(ctx context.Context, tx Tx, stepID int64, status string, approverID int64, onBehalfOf *int64, comment string) error
+	SkipPendingSteps(ctx context.Context, tx Tx, requestType string, requestID int64) error
+	DeleteSteps(ctx context.Context, tx Tx, requestType string, requestID int64) error
+	ReassignStep(ctx context.Context, tx Tx, stepID int64, assignedTo *int64, role string) error
+}
+
+// DelegationRepository stores who stands in for which approver, and when
+type DelegationRepository interface {
+	Create(ctx context.Context, tx Tx, delegation *models.Delegation) error
+	GetForUser(ctx context.Context, userID int64) ([]models.Delegation, error)
+	HasOverlap(ctx context.Context, tx Tx, delegatorID int64, start, end time.Time) (bool, error)
+	Revoke(ctx context.Context, delegationID, delegatorID int64) error
+	RevokeForLeave(ctx context.Context, tx Tx, leaveRequestID int64) error
+	GetActiveDelegators(ctx context.Context, delegateID int64, on time.Time) ([]models.RuleSubject, error)
+}
+
+// PendingQueue lists the pending requests of one kind as a manager or an admin sees them
+type PendingQueue interface {
+	GetPendingForManager(ctx context.Context, managerID int64) ([]map[string]interface{}, error)
+	GetPendingForAdmin(ctx context.Context) ([]map[string]interface{}, error)
 }
 
 // GradeRepository handles grade data access operations
 type GradeRepository interface {
 	GetLimits(ctx context.Context, tx Tx, gradeID int64) (leaveLimit int, expenseLimit float64, discountLimit float64, err error)
+	GetAll(ctx context.Context) ([]models.Grade, error)
 }
 
 // HolidayRepository handles holiday data access
@@ -116,6 +237,7 @@ type HolidayRepository interface {
 	GetHolidays(ctx context.Context) ([]map[string]interface{}, error)
 	DeleteHoliday(ctx context.Context, holidayID int64) error
 	IsHoliday(ctx context.Context, date time.Time) (bool, error)
+	GetHolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error)
 }
 
 // MyRequestsRepository handles read-only queries for a user's own requests
@@ -124,6 +246,7 @@ type MyRequestsRepository interface {
 	GetMyExpenseRequests(ctx context.Context, userID int64) ([]map[string]interface{}, error)
 	GetMyDiscountRequests(ctx context.Context, userID int64) ([]map[string]interface{}, error)
 	GetMyAllRequests(ctx context.Context, userID int64, limit, offset int) (leaves []map[string]interface{}, expenses []map[string]interface{}, discounts []map[string]interface{}, total int, err error)
+	GetMyRequest(ctx context.Context, userID int64, reqType string, requestID int64) (map[string]interface{}, error)
 }
 
 // ReportRepository handles analytical and statistical queries
@@ -132,6 +255,18 @@ type ReportRepository interface {
 	GetRequestsByTypeReport(ctx context.Context) ([]models.RequestTypeReport, error)
 }
 
+// BacktestRepository reads past requests for replay against candidate rules
+type BacktestRepository interface {
+	GetHistoricalRequests(ctx context.Context) ([]models.HistoricalRequest, error)
+}
+
+// UsageRepository computes the rolling aggregates used by cumulative rule conditions
+type UsageRepository interface {
+	GetApprovedExpenseTotal(ctx context.Context, tx Tx, userID int64, since time.Time) (float64, error)
+	GetLeaveRequestCount(ctx context.Context, tx Tx, userID int64, since time.Time, excludeID int64) (int, error)
+	GetApprovedDiscountTotal(ctx context.Context, tx Tx, userID int64, since time.Time) (float64, error)
+}
+
 // Service interfaces
 type AuthService interface {
 	RegisterUser(ctx context.Context, name, email, password string) error
@@ -139,44 +274,129 @@ type AuthService interface {
 }
 
 type LeaveService interface {
-	ApplyLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string) (string, string, error)
+	ApplyLeave(ctx context.Context, userID int64, from time.Time, to time.Time, days int, leaveType string, reason string, delegateID *int64) (string, string, error)
 	CancelLeave(ctx context.Context, userID, requestID int64) error
+	SimulateLeave(ctx context.Context, userID int64, from, to time.Time, days int, leaveType string) (*utils.Evaluation, error)
+	ResubmitLeave(ctx context.Context, userID, requestID int64, answer string) error
+	AmendLeave(ctx context.Context, userID, requestID int64, from, to time.Time, days int, leaveType, reason string) (string, string, error)
 }
 
 type LeaveApprovalService interface {
 	GetPendingLeaveRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error)
 	ApproveLeave(ctx context.Context, role string, approverID, requestID int64, approvalComment string) error
 	RejectLeave(ctx context.Context, role string, approverID, requestID int64, rejectionComment string) error
+	BulkApproveLeaves(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	BulkRejectLeaves(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	RequestLeaveInfo(ctx context.Context, role string, approverID, requestID int64, question string) error
+	RevokeLeave(ctx context.Context, role string, approverID, requestID int64, reason string) error
 }
 
 type ExpenseService interface {
 	ApplyExpense(ctx context.Context, userID int64, amount float64, category string, reason string) (string, string, error)
 	CancelExpense(ctx context.Context, userID, requestID int64) error
+	SimulateExpense(ctx context.Context, userID int64, amount float64, category string) (*utils.Evaluation, error)
+	ResubmitExpense(ctx context.Context, userID, requestID int64, answer string) error
+	AmendExpense(ctx context.Context, userID, requestID int64, amount float64, category, reason string) (string, string, error)
 }
 
 type ExpenseApprovalService interface {
 	GetPendingExpenseRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error)
 	ApproveExpense(ctx context.Context, role string, approverID, requestID int64, comment string) error
 	RejectExpense(ctx context.Context, role string, approverID, requestID int64, comment string) error
+	BulkApproveExpenses(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	BulkRejectExpenses(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	RequestExpenseInfo(ctx context.Context, role string, approverID, requestID int64, question string) error
+	RevokeExpense(ctx context.Context, role string, approverID, requestID int64, reason string) error
+}
+
+type RequestService interface {
+	GetRequestTypes(ctx context.Context) ([]models.RequestType, error)
+	Apply(ctx context.Context, userID int64, requestType string, payload map[string]interface{}) (string, string, error)
+	Cancel(ctx context.Context, userID int64, requestType string, requestID int64) error
+}
+
+type RequestApprovalService interface {
+	GetPendingRequests(ctx context.Context, role string, approverID int64, requestType string) ([]map[string]interface{}, error)
+	Approve(ctx context.Context, role string, approverID int64, requestType string, requestID int64, comment string) error
+	Reject(ctx context.Context, role string, approverID int64, requestType string, requestID int64, comment string) error
+	BulkApprove(ctx context.Context, role string, approverID int64, requestType string, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	BulkReject(ctx context.Context, role string, approverID int64, requestType string, requestIDs []int64, comment string) (*models.BulkDecision, error)
 }
 
 type RuleService interface {
-	GetRule(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error)
-	CreateRule(ctx context.Context, role string, rule models.Rule) error
+	Evaluate(ctx context.Context, requestType string, subject models.RuleSubject, facts utils.Facts) (*utils.DecisionResult, error)
+	CreateRule(ctx context.Context, role string, userID int64, rule models.Rule) (*models.RuleProposal, error)
 	GetRules(ctx context.Context, role string) ([]models.Rule, error)
-	UpdateRule(ctx context.Context, role string, ruleID int64, rule models.Rule) error
-	DeleteRule(ctx context.Context, role string, ruleID int64) error
+	UpdateRule(ctx context.Context, role string, userID, ruleID int64, rule models.Rule) (*models.RuleProposal, error)
+	DeleteRule(ctx context.Context, role string, userID, ruleID int64) (*models.RuleProposal, error)
+	GetRuleVersions(ctx context.Context, role string, ruleID int64) ([]models.RuleVersion, error)
+	DiffRuleVersions(ctx context.Context, role string, ruleID int64, fromVersion, toVersion int) (*models.RuleVersionDiff, error)
+	AuditRules(ctx context.Context, role string) (*models.RuleAuditReport, error)
+	ExportRules(ctx context.Context, role string) (*models.RuleBundle, error)
+	ImportRules(ctx context.Context, role string, userID int64, bundle models.RuleBundle, preview bool) (*models.RuleImportPlan, error)
+	GetRuleProposals(ctx context.Context, role string, status string) ([]models.RuleProposal, error)
+	ApproveRuleProposal(ctx context.Context, role string, reviewerID, proposalID int64, comment string) (*models.RuleProposal, error)
+	RejectRuleProposal(ctx context.Context, role string, reviewerID, proposalID int64, comment string) (*models.RuleProposal, error)
+	InvalidateRules(requestType string)
+	GetRuleCacheStats(ctx context.Context, role string) (*models.RuleCacheStats, error)
+}
+
+type RuleSimulationService interface {
+	Simulate(ctx context.Context, role string, input models.RuleSimulation) (*utils.Evaluation, error)
+}
+
+type RuleBacktestService interface {
+	Backtest(ctx context.Context, role string, candidate []models.Rule) (*models.BacktestReport, error)
+}
+
+type RuleAnalyzerService interface {
+	Analyze(ctx context.Context, role string) (*models.RuleAnalysisReport, error)
+}
+
+// ApprovalChainService manages approval chains and moves requests through them.
+// Start, Decide, Close, Restart and Escalate run inside the transaction of the request they act on.
+type ApprovalChainService interface {
+	CreateChain(ctx context.Context, role string, userID int64, chain models.ApprovalChain) (*models.ApprovalChain, error)
+	GetChains(ctx context.Context, role string) ([]models.ApprovalChain, error)
+	DeleteChain(ctx context.Context, role string, chainID int64) error
+	GetRequestSteps(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestApprovalStep, error)
+	GetPendingSteps(ctx context.Context, role string, approverID int64) ([]models.RequestApprovalStep, error)
+	Start(ctx context.Context, tx Tx, requestType string, requestID, employeeID int64, facts utils.Facts) error
+	Decide(ctx context.Context, tx Tx, requestType string, requestID, approverID int64, role, status, comment string) (models.ChainDecision, error)
+	CheckApprover(ctx context.Context, tx Tx, requestType string, requestID, approverID int64, role string) (models.ChainDecision, error)
+	Close(ctx context.Context, tx Tx, requestType string, requestID int64) error
+	Restart(ctx context.Context, tx Tx, requestType string, requestID, employeeID int64, facts utils.Facts) error
+	Escalate(ctx context.Context, tx Tx, requestType string, requestID int64) (models.ChainEscalation, error)
+}
+
+// DelegationService lets approvers hand their queue to a delegate while they are away.
+// ResolveApprover, DelegateForLeave and RevokeForLeave run inside the transaction of the request they act on.
+type DelegationService interface {
+	CreateDelegation(ctx context.Context, role string, delegatorID int64, delegation models.Delegation) (*models.Delegation, error)
+	GetDelegations(ctx context.Context, userID int64) ([]models.Delegation, error)
+	RevokeDelegation(ctx context.Context, delegatorID, delegationID int64) error
+	ValidateDelegate(ctx context.Context, tx Tx, delegatorID, delegateID int64) error
+	DelegateForLeave(ctx context.Context, tx Tx, leave *models.LeaveRequest) error
+	RevokeForLeave(ctx context.Context, tx Tx, leaveRequestID int64) error
+	ResolveApprover(ctx context.Context, tx Tx, approverID int64, role string, requesterID int64) (*models.Approver, error)
+	AddDelegatedQueues(ctx context.Context, delegateID int64, queue []map[string]interface{}, pending PendingQueue) ([]map[string]interface{}, error)
 }
 
 type DiscountService interface {
 	ApplyDiscount(ctx context.Context, userID int64, percent float64, reason string) (string, string, error)
 	CancelDiscount(ctx context.Context, userID, requestID int64) error
+	SimulateDiscount(ctx context.Context, userID int64, percent float64) (*utils.Evaluation, error)
+	ResubmitDiscount(ctx context.Context, userID, requestID int64, answer string) error
 }
 
 type DiscountApprovalService interface {
 	GetPendingRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error)
 	ApproveDiscount(ctx context.Context, role string, approverID, requestID int64, comment string) error
 	RejectDiscount(ctx context.Context, role string, approverID, requestID int64, comment string) error
+	BulkApproveDiscounts(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	BulkRejectDiscounts(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error)
+	RequestDiscountInfo(ctx context.Context, role string, approverID, requestID int64, question string) error
+	RevokeDiscount(ctx context.Context, role string, approverID, requestID int64, reason string) error
 }
 
 type BalanceService interface {
@@ -196,8 +416,48 @@ type ReportService interface {
 type MyRequestsService interface {
 	GetMyRequests(ctx context.Context, userID int64, reqType string) ([]map[string]interface{}, error)
 	GetMyAllRequests(ctx context.Context, userID int64, limit, offset int) (map[string]interface{}, error)
+	GetMyRequest(ctx context.Context, userID int64, reqType string, requestID int64) (map[string]interface{}, error)
 }
 
+// AutoRejectService applies each request type's expiry policy to the requests left pending
 type AutoRejectService interface {
 	AutoRejectExpiredRequests(ctx context.Context) error
 }
+
+// RequestHistoryReader decides who may read the history kept on a request: its requester, and
+// whoever may approve the requester's requests
+type RequestHistoryReader interface {
+	Authorize(ctx context.Context, role string, userID int64, requestType string, requestID int64) (string, error)
+}
+
+// InfoRequestService keeps the questions approvers ask on the requests they pause and the
+// requesters' answers. Ask and Answer run inside the transaction of the request they act on.
+type InfoRequestService interface {
+	Ask(ctx context.Context, tx Tx, requestType string, requestID, employeeID, askedBy int64, question string) error
+	Answer(ctx context.Context, tx Tx, requestType string, requestID int64, answer string) error
+	GetInfoRequests(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.InfoRequest, error)
+}
+
+// AmendmentService keeps the before and after values of each amendment of a pending request.
+// Record runs inside the transaction of the amendment.
+type AmendmentService interface {
+	Record(ctx context.Context, tx Tx, amendment *models.RequestAmendment) error
+	GetAmendments(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestAmendment, error)
+}
+
+// RevocationService keeps who revoked an approved request and why. Record runs inside the
+// transaction of the revocation.
+type RevocationService interface {
+	Record(ctx context.Context, tx Tx, revocation *models.RequestRevocation) error
+	GetRevocations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestRevocation, error)
+}
+
+// ExpiryPolicyService lets admins manage expiry policies, the SLA of each request type and grade,
+// shows the escalations of a request and the deadlines of pending queues
+type ExpiryPolicyService interface {
+	GetPolicies(ctx context.Context, role string) ([]models.ExpiryPolicy, error)
+	SetPolicy(ctx context.Context, role string, adminID int64, policy models.ExpiryPolicy) (*models.ExpiryPolicy, error)
+	DeletePolicy(ctx context.Context, role, requestType string, gradeID *int64) error
+	GetEscalations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestEscalation, error)
+	AddDeadlines(ctx context.Context, requestType string, queue []map[string]interface{}) ([]map[string]interface{}, error)
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_rules_type_grade_priority;
+
+ALTER TABLE rules DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,8 @@
+-- allow several rules per (request_type, grade_id), evaluated in priority order
+ALTER TABLE rules DROP CONSTRAINT IF EXISTS rules_request_type_grade_id_key;
+
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 100;
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_rules_type_grade_priority
+    ON rules (request_type, grade_id, priority);
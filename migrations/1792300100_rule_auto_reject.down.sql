@@ -0,0 +1,4 @@
+-- enum values cannot be dropped; demote rejecting rules to manual review instead
+UPDATE rules SET action='MANUAL' WHERE action::TEXT='AUTO_REJECT';
+
+ALTER TABLE rules DROP COLUMN IF EXISTS reason;
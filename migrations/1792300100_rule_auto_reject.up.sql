@@ -0,0 +1,4 @@
+-- rules can reject a request outright; the reason is copied to the request's approval_comment
+ALTER TYPE rule_action_enum ADD VALUE IF NOT EXISTS 'AUTO_REJECT';
+
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS reason TEXT;
@@ -0,0 +1,8 @@
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS rule_version_id;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS rule_version_id;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS rule_version_id;
+
+DROP TABLE IF EXISTS rule_versions;
+
+DELETE FROM rules WHERE deleted_at IS NOT NULL;
+ALTER TABLE rules DROP COLUMN IF EXISTS deleted_at;
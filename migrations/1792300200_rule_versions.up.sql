@@ -0,0 +1,40 @@
+-- immutable, effective-dated rule versions; rules keeps the identity and a copy of the latest version
+CREATE TABLE IF NOT EXISTS rule_versions (
+    id BIGSERIAL PRIMARY KEY,
+    rule_id BIGINT NOT NULL REFERENCES rules(id),
+    version INT NOT NULL,
+    request_type request_type_enum NOT NULL,
+    condition JSONB NOT NULL,
+    action rule_action_enum NOT NULL,
+    grade_id BIGINT NOT NULL REFERENCES grades(id),
+    priority INT NOT NULL DEFAULT 100,
+    reason TEXT,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (rule_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rule_versions_type_grade
+    ON rule_versions (request_type, grade_id, effective_from);
+
+-- deleting a rule closes its versions instead of removing the row
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+INSERT INTO rule_versions (rule_id, version, request_type, condition, action, grade_id, priority, reason, active, effective_from)
+SELECT id, 1, request_type, condition, action, grade_id, priority, reason, COALESCE(active, TRUE), COALESCE(created_at, CURRENT_TIMESTAMP)
+FROM rules
+ON CONFLICT (rule_id, version) DO NOTHING;
+
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS rule_version_id BIGINT REFERENCES rule_versions(id);
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS rule_version_id BIGINT REFERENCES rule_versions(id);
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS rule_version_id BIGINT REFERENCES rule_versions(id);
+
+-- existing requests were decided by the only version their rule had so far
+UPDATE leave_requests r SET rule_version_id = v.id
+FROM rule_versions v WHERE v.rule_id = r.rule_id AND v.version = 1 AND r.rule_version_id IS NULL;
+UPDATE expense_requests r SET rule_version_id = v.id
+FROM rule_versions v WHERE v.rule_id = r.rule_id AND v.version = 1 AND r.rule_version_id IS NULL;
+UPDATE discount_requests r SET rule_version_id = v.id
+FROM rule_versions v WHERE v.rule_id = r.rule_id AND v.version = 1 AND r.rule_version_id IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_discount_requests_employee_created;
+DROP INDEX IF EXISTS idx_expense_requests_employee_created;
+DROP INDEX IF EXISTS idx_leave_requests_employee_created;
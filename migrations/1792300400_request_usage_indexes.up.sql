@@ -0,0 +1,4 @@
+-- cumulative rule conditions aggregate a requester's recent requests
+CREATE INDEX IF NOT EXISTS idx_leave_requests_employee_created ON leave_requests (employee_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_expense_requests_employee_created ON expense_requests (employee_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_discount_requests_employee_created ON discount_requests (employee_id, created_at);
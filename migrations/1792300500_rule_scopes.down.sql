@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS idx_rule_versions_type_scope;
+
+-- only grade-scoped rules fit the old schema
+DELETE FROM rule_versions WHERE scope <> 'GRADE';
+DELETE FROM rules WHERE scope <> 'GRADE';
+
+ALTER TABLE rule_versions DROP CONSTRAINT IF EXISTS rule_versions_scope_check;
+ALTER TABLE rule_versions ALTER COLUMN grade_id SET NOT NULL;
+ALTER TABLE rule_versions DROP COLUMN IF EXISTS department;
+ALTER TABLE rule_versions DROP COLUMN IF EXISTS target_id;
+ALTER TABLE rule_versions DROP COLUMN IF EXISTS scope;
+
+ALTER TABLE rules ALTER COLUMN grade_id SET NOT NULL;
+ALTER TABLE rules DROP COLUMN IF EXISTS department;
+ALTER TABLE rules DROP COLUMN IF EXISTS target_id;
+ALTER TABLE rules DROP COLUMN IF EXISTS scope;
+
+ALTER TABLE users DROP COLUMN IF EXISTS department;
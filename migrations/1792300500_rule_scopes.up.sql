@@ -0,0 +1,23 @@
+-- rules can target a user, a manager's team, a department, a grade or everyone
+ALTER TABLE users ADD COLUMN IF NOT EXISTS department TEXT;
+
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS scope TEXT NOT NULL DEFAULT 'GRADE';
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS target_id BIGINT REFERENCES users(id);
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS department TEXT;
+ALTER TABLE rules ALTER COLUMN grade_id DROP NOT NULL;
+
+ALTER TABLE rule_versions ADD COLUMN IF NOT EXISTS scope TEXT NOT NULL DEFAULT 'GRADE';
+ALTER TABLE rule_versions ADD COLUMN IF NOT EXISTS target_id BIGINT REFERENCES users(id);
+ALTER TABLE rule_versions ADD COLUMN IF NOT EXISTS department TEXT;
+ALTER TABLE rule_versions ALTER COLUMN grade_id DROP NOT NULL;
+
+ALTER TABLE rule_versions ADD CONSTRAINT rule_versions_scope_check CHECK (
+    (scope = 'USER' AND target_id IS NOT NULL) OR
+    (scope = 'TEAM' AND target_id IS NOT NULL) OR
+    (scope = 'DEPARTMENT' AND department IS NOT NULL) OR
+    (scope = 'GRADE' AND grade_id IS NOT NULL) OR
+    scope = 'GLOBAL'
+);
+
+CREATE INDEX IF NOT EXISTS idx_rule_versions_type_scope
+    ON rule_versions (request_type, scope, effective_from);
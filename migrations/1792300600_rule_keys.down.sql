@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_rules_key_live;
+ALTER TABLE rules DROP COLUMN IF EXISTS key;
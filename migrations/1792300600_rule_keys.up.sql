@@ -0,0 +1,7 @@
+-- stable rule identity across environments, used to match rules when importing a bundle
+ALTER TABLE rules ADD COLUMN IF NOT EXISTS key TEXT;
+UPDATE rules SET key = 'rule-' || id WHERE key IS NULL;
+ALTER TABLE rules ALTER COLUMN key SET NOT NULL;
+
+-- a retired rule's key can be reused
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rules_key_live ON rules (key) WHERE deleted_at IS NULL;
@@ -0,0 +1,19 @@
+-- rule changes are proposed by one admin and applied when a different admin approves them
+CREATE TABLE IF NOT EXISTS rule_proposals (
+    id BIGSERIAL PRIMARY KEY,
+    action TEXT NOT NULL CHECK (action IN ('CREATE', 'UPDATE', 'DELETE', 'IMPORT')),
+    rule_id BIGINT REFERENCES rules(id),
+    before JSONB,
+    after JSONB,
+    bundle JSONB,
+    plan JSONB,
+    status TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')),
+    proposed_by BIGINT NOT NULL REFERENCES users(id),
+    reviewed_by BIGINT REFERENCES users(id),
+    review_comment TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMPTZ,
+    CHECK (reviewed_by IS NULL OR reviewed_by <> proposed_by OR status = 'REJECTED')
+);
+
+CREATE INDEX IF NOT EXISTS idx_rule_proposals_status ON rule_proposals (status, created_at);
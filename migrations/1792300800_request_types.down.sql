@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS generic_requests;
+
+-- only the enum's request types fit the old schema
+DELETE FROM rule_versions WHERE request_type NOT IN ('LEAVE', 'EXPENSE', 'DISCOUNT');
+DELETE FROM rules WHERE request_type NOT IN ('LEAVE', 'EXPENSE', 'DISCOUNT');
+
+ALTER TABLE rule_versions ALTER COLUMN request_type TYPE request_type_enum USING request_type::request_type_enum;
+ALTER TABLE rules ALTER COLUMN request_type TYPE request_type_enum USING request_type::request_type_enum;
+
+DROP TABLE IF EXISTS request_types;
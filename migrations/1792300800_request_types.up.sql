@@ -0,0 +1,47 @@
+-- request kinds served by the generic /api/requests/{type} pipeline; definition holds a models.RequestType
+CREATE TABLE IF NOT EXISTS request_types (
+    name VARCHAR(30) PRIMARY KEY,
+    definition JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS generic_requests (
+    id BIGSERIAL PRIMARY KEY,
+    request_type VARCHAR(30) NOT NULL REFERENCES request_types(name),
+    employee_id BIGINT NOT NULL REFERENCES users(id),
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED', 'AUTO_APPROVED', 'AUTO_REJECTED', 'CANCELLED')),
+    rule_id BIGINT REFERENCES rules(id),
+    rule_version_id BIGINT REFERENCES rule_versions(id),
+    approved_by_id BIGINT REFERENCES users(id),
+    approval_comment TEXT,
+    decision_trace JSONB,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_generic_requests_type_status ON generic_requests (request_type, status, created_at);
+CREATE INDEX IF NOT EXISTS idx_generic_requests_employee ON generic_requests (employee_id, created_at);
+
+-- rules may be written for any registered request type, not only the enum's values
+ALTER TABLE rules ALTER COLUMN request_type TYPE VARCHAR(30) USING request_type::text;
+ALTER TABLE rule_versions ALTER COLUMN request_type TYPE VARCHAR(30) USING request_type::text;
+
+INSERT INTO request_types (name, definition)
+VALUES (
+  'TRAVEL',
+  '{
+    "description": "Business travel, charged to the expense balance",
+    "fields": [
+      {"name": "destination", "type": "string", "required": true},
+      {"name": "travel_mode", "type": "string", "required": true, "allowed": ["TRAIN", "BUS", "FLIGHT", "CAB"]},
+      {"name": "from_date", "type": "date", "required": true},
+      {"name": "to_date", "type": "date", "required": true},
+      {"name": "amount", "type": "number", "required": true},
+      {"name": "reason", "type": "string"}
+    ],
+    "balance": {"kind": "EXPENSE", "field": "amount"},
+    "attributes": ["amount", "travel_mode"],
+    "policy": {"approver_roles": ["MANAGER", "ADMIN"]}
+  }'
+)
+ON CONFLICT (name) DO NOTHING;
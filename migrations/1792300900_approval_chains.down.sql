@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS request_approval_steps;
+DROP TABLE IF EXISTS approval_chains;
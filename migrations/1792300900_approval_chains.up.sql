@@ -0,0 +1,38 @@
+-- approval chains send a pending request through several approvers in turn;
+-- the first active chain whose condition holds, by priority, applies to a new request
+CREATE TABLE IF NOT EXISTS approval_chains (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    request_type VARCHAR(30) NOT NULL,
+    condition JSONB NOT NULL,
+    priority INT NOT NULL DEFAULT 0 CHECK (priority >= 0),
+    steps JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_chains_type ON approval_chains (request_type, active, priority);
+
+-- one row per step of the chain a request is going through; approvers are resolved when the request is filed
+CREATE TABLE IF NOT EXISTS request_approval_steps (
+    id BIGSERIAL PRIMARY KEY,
+    request_type VARCHAR(30) NOT NULL,
+    request_id BIGINT NOT NULL,
+    employee_id BIGINT NOT NULL REFERENCES users(id),
+    chain_id BIGINT NOT NULL REFERENCES approval_chains(id),
+    step_no INT NOT NULL CHECK (step_no > 0),
+    approver TEXT NOT NULL CHECK (approver IN ('MANAGER', 'SKIP_MANAGER', 'DEPARTMENT', 'ROLE')),
+    assigned_to BIGINT REFERENCES users(id),
+    department TEXT,
+    role TEXT,
+    status TEXT NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED', 'SKIPPED')),
+    decided_by BIGINT REFERENCES users(id),
+    comment TEXT,
+    decided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (request_type, request_id, step_no),
+    CHECK (assigned_to IS NOT NULL OR department IS NOT NULL OR role IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_approval_steps_pending ON request_approval_steps (status, assigned_to);
@@ -0,0 +1,8 @@
+ALTER TABLE request_approval_steps DROP COLUMN IF EXISTS on_behalf_of;
+ALTER TABLE generic_requests DROP COLUMN IF EXISTS on_behalf_of;
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS on_behalf_of;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS on_behalf_of;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS on_behalf_of;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS delegate_id;
+
+DROP TABLE IF EXISTS approval_delegations;
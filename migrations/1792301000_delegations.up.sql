@@ -0,0 +1,30 @@
+-- a delegate decides the delegator's approvals from start_date to end_date, inclusive;
+-- delegations created for a leave are revoked when the leave is cancelled
+CREATE TABLE IF NOT EXISTS approval_delegations (
+    id BIGSERIAL PRIMARY KEY,
+    delegator_id BIGINT NOT NULL REFERENCES users(id),
+    delegate_id BIGINT NOT NULL REFERENCES users(id),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT,
+    leave_request_id BIGINT REFERENCES leave_requests(id),
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (delegator_id <> delegate_id),
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegate ON approval_delegations (delegate_id, start_date, end_date)
+    WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_approval_delegations_delegator ON approval_delegations (delegator_id, start_date, end_date)
+    WHERE revoked_at IS NULL;
+
+-- the approver a leave applicant hands their queue to once the leave is approved
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS delegate_id BIGINT REFERENCES users(id);
+
+-- decisions taken by a delegate record the delegator next to the delegate
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS on_behalf_of BIGINT REFERENCES users(id);
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS on_behalf_of BIGINT REFERENCES users(id);
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS on_behalf_of BIGINT REFERENCES users(id);
+ALTER TABLE generic_requests ADD COLUMN IF NOT EXISTS on_behalf_of BIGINT REFERENCES users(id);
+ALTER TABLE request_approval_steps ADD COLUMN IF NOT EXISTS on_behalf_of BIGINT REFERENCES users(id);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS request_escalations;
+
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS escalated_at;
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS escalated_to;
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS escalation_level;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS escalated_at;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS escalated_to;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS escalation_level;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS escalated_at;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS escalated_to;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS escalation_level;
+
+DROP TABLE IF EXISTS expiry_policies;
@@ -0,0 +1,37 @@
+-- what happens to a request of a type left pending too long; days are working days since the
+-- request reached its current approver. Types without a policy are auto rejected after 7 days.
+CREATE TABLE IF NOT EXISTS expiry_policies (
+    request_type VARCHAR(30) PRIMARY KEY,
+    action TEXT NOT NULL CHECK (action IN ('ESCALATE', 'AUTO_APPROVE', 'AUTO_REJECT')),
+    reminder_days INT CHECK (reminder_days > 0),
+    expiry_days INT NOT NULL CHECK (expiry_days > 0),
+    updated_by BIGINT REFERENCES users(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (reminder_days IS NULL OR reminder_days < expiry_days)
+);
+
+-- an escalated request waits for escalated_to, or for the admins when that is NULL
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS escalation_level INT NOT NULL DEFAULT 0;
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS escalated_to BIGINT REFERENCES users(id);
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMPTZ;
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS escalation_level INT NOT NULL DEFAULT 0;
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS escalated_to BIGINT REFERENCES users(id);
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMPTZ;
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS escalation_level INT NOT NULL DEFAULT 0;
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS escalated_to BIGINT REFERENCES users(id);
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMPTZ;
+
+-- every reminder, escalation hop and expiry decision taken on a pending request
+CREATE TABLE IF NOT EXISTS request_escalations (
+    id BIGSERIAL PRIMARY KEY,
+    request_type VARCHAR(30) NOT NULL,
+    request_id BIGINT NOT NULL,
+    employee_id BIGINT NOT NULL REFERENCES users(id),
+    event TEXT NOT NULL CHECK (event IN ('REMINDED', 'ESCALATED', 'AUTO_APPROVED', 'AUTO_REJECTED')),
+    level INT NOT NULL CHECK (level >= 0),
+    from_approver_id BIGINT REFERENCES users(id),
+    to_approver_id BIGINT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_escalations_request ON request_escalations (request_type, request_id, created_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_expiry_policies_type_grade;
+
+DELETE FROM expiry_policies WHERE grade_id IS NOT NULL;
+ALTER TABLE expiry_policies DROP COLUMN IF EXISTS calendar_days;
+ALTER TABLE expiry_policies DROP COLUMN IF EXISTS grade_id;
+ALTER TABLE expiry_policies DROP COLUMN IF EXISTS id;
+ALTER TABLE expiry_policies ADD PRIMARY KEY (request_type);
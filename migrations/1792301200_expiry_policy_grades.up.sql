@@ -0,0 +1,8 @@
+-- expiry policies become the SLA of a request type, optionally narrowed to one grade;
+-- a grade's policy wins over the type-wide one. Calendar-day policies count weekends and holidays.
+ALTER TABLE expiry_policies DROP CONSTRAINT IF EXISTS expiry_policies_pkey;
+ALTER TABLE expiry_policies ADD COLUMN IF NOT EXISTS id BIGSERIAL PRIMARY KEY;
+ALTER TABLE expiry_policies ADD COLUMN IF NOT EXISTS grade_id BIGINT REFERENCES grades(id);
+ALTER TABLE expiry_policies ADD COLUMN IF NOT EXISTS calendar_days BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_expiry_policies_type_grade ON expiry_policies (request_type, COALESCE(grade_id, 0));
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS request_info_exchanges;
+
+ALTER TABLE discount_requests DROP COLUMN IF EXISTS resubmitted_at;
+ALTER TABLE expense_requests DROP COLUMN IF EXISTS resubmitted_at;
+ALTER TABLE leave_requests DROP COLUMN IF EXISTS resubmitted_at;
+
+-- enum values cannot be dropped; paused requests go back to their approvers
+UPDATE discount_requests SET status='PENDING' WHERE status='NEEDS_INFO';
+UPDATE expense_requests SET status='PENDING' WHERE status='NEEDS_INFO';
+UPDATE leave_requests SET status='PENDING' WHERE status='NEEDS_INFO';
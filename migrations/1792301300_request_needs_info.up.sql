@@ -0,0 +1,25 @@
+-- an approver can pause a request with a question; the requester answers and resubmits it
+ALTER TYPE leave_status ADD VALUE IF NOT EXISTS 'NEEDS_INFO';
+ALTER TYPE expense_status ADD VALUE IF NOT EXISTS 'NEEDS_INFO';
+ALTER TYPE discount_status ADD VALUE IF NOT EXISTS 'NEEDS_INFO';
+
+-- the approver's clock restarts when the request is resubmitted
+ALTER TABLE leave_requests ADD COLUMN IF NOT EXISTS resubmitted_at TIMESTAMPTZ;
+ALTER TABLE expense_requests ADD COLUMN IF NOT EXISTS resubmitted_at TIMESTAMPTZ;
+ALTER TABLE discount_requests ADD COLUMN IF NOT EXISTS resubmitted_at TIMESTAMPTZ;
+
+-- every question asked on a request and the requester's answer; at most one is open at a time
+CREATE TABLE IF NOT EXISTS request_info_exchanges (
+    id BIGSERIAL PRIMARY KEY,
+    request_type VARCHAR(30) NOT NULL,
+    request_id BIGINT NOT NULL,
+    employee_id BIGINT NOT NULL REFERENCES users(id),
+    asked_by BIGINT NOT NULL REFERENCES users(id),
+    question TEXT NOT NULL,
+    asked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    answer TEXT,
+    answered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_info_exchanges_request ON request_info_exchanges (request_type, request_id, asked_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_request_info_exchanges_open ON request_info_exchanges (request_type, request_id) WHERE answered_at IS NULL;
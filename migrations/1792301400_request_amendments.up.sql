@@ -0,0 +1,13 @@
+-- every amendment of a pending request, with its values before and after, for the approvers to see
+CREATE TABLE IF NOT EXISTS request_amendments (
+    id BIGSERIAL PRIMARY KEY,
+    request_type VARCHAR(30) NOT NULL,
+    request_id BIGINT NOT NULL,
+    employee_id BIGINT NOT NULL REFERENCES users(id),
+    before_values JSONB NOT NULL,
+    after_values JSONB NOT NULL,
+    status VARCHAR(30) NOT NULL,
+    amended_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_amendments_request ON request_amendments (request_type, request_id, amended_at);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS request_revocations;
+
+-- enum values cannot be dropped; revoked requests had their balance given back, as cancelled ones do
+UPDATE discount_requests SET status='CANCELLED' WHERE status='REVOKED';
+UPDATE expense_requests SET status='CANCELLED' WHERE status='REVOKED';
+UPDATE leave_requests SET status='CANCELLED' WHERE status='REVOKED';
@@ -0,0 +1,18 @@
+-- an approver or admin can revoke an approved request; its balance is given back
+ALTER TYPE leave_status ADD VALUE IF NOT EXISTS 'REVOKED';
+ALTER TYPE expense_status ADD VALUE IF NOT EXISTS 'REVOKED';
+ALTER TYPE discount_status ADD VALUE IF NOT EXISTS 'REVOKED';
+
+-- who revoked each request, from which status and why
+CREATE TABLE IF NOT EXISTS request_revocations (
+    id BIGSERIAL PRIMARY KEY,
+    request_type VARCHAR(30) NOT NULL,
+    request_id BIGINT NOT NULL,
+    employee_id BIGINT NOT NULL REFERENCES users(id),
+    revoked_by BIGINT NOT NULL REFERENCES users(id),
+    previous_status VARCHAR(30) NOT NULL,
+    reason TEXT NOT NULL,
+    revoked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_request_revocations_request ON request_revocations (request_type, request_id);
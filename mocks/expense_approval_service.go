@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 )
 
 // ExpenseApprovalService is an autogenerated mock type for the ExpenseApprovalService type
@@ -71,6 +73,130 @@ func (_c *ExpenseApprovalService_ApproveExpense_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// BulkApproveExpenses provides a mock function with given fields: ctx, role, approverID, requestIDs, comment
+func (_m *ExpenseApprovalService) BulkApproveExpenses(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkApproveExpenses")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpenseApprovalService_BulkApproveExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkApproveExpenses'
+type ExpenseApprovalService_BulkApproveExpenses_Call struct {
+	*mock.Call
+}
+
+// BulkApproveExpenses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestIDs []int64
+//   - comment string
+func (_e *ExpenseApprovalService_Expecter) BulkApproveExpenses(ctx interface{}, role interface{}, approverID interface{}, requestIDs interface{}, comment interface{}) *ExpenseApprovalService_BulkApproveExpenses_Call {
+	return &ExpenseApprovalService_BulkApproveExpenses_Call{Call: _e.mock.On("BulkApproveExpenses", ctx, role, approverID, requestIDs, comment)}
+}
+
+func (_c *ExpenseApprovalService_BulkApproveExpenses_Call) Run(run func(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string)) *ExpenseApprovalService_BulkApproveExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseApprovalService_BulkApproveExpenses_Call) Return(_a0 *models.BulkDecision, _a1 error) *ExpenseApprovalService_BulkApproveExpenses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpenseApprovalService_BulkApproveExpenses_Call) RunAndReturn(run func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)) *ExpenseApprovalService_BulkApproveExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkRejectExpenses provides a mock function with given fields: ctx, role, approverID, requestIDs, comment
+func (_m *ExpenseApprovalService) BulkRejectExpenses(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRejectExpenses")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpenseApprovalService_BulkRejectExpenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRejectExpenses'
+type ExpenseApprovalService_BulkRejectExpenses_Call struct {
+	*mock.Call
+}
+
+// BulkRejectExpenses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestIDs []int64
+//   - comment string
+func (_e *ExpenseApprovalService_Expecter) BulkRejectExpenses(ctx interface{}, role interface{}, approverID interface{}, requestIDs interface{}, comment interface{}) *ExpenseApprovalService_BulkRejectExpenses_Call {
+	return &ExpenseApprovalService_BulkRejectExpenses_Call{Call: _e.mock.On("BulkRejectExpenses", ctx, role, approverID, requestIDs, comment)}
+}
+
+func (_c *ExpenseApprovalService_BulkRejectExpenses_Call) Run(run func(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string)) *ExpenseApprovalService_BulkRejectExpenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseApprovalService_BulkRejectExpenses_Call) Return(_a0 *models.BulkDecision, _a1 error) *ExpenseApprovalService_BulkRejectExpenses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpenseApprovalService_BulkRejectExpenses_Call) RunAndReturn(run func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)) *ExpenseApprovalService_BulkRejectExpenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPendingExpenseRequests provides a mock function with given fields: ctx, role, approverID
 func (_m *ExpenseApprovalService) GetPendingExpenseRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error) {
 	ret := _m.Called(ctx, role, approverID)
@@ -181,6 +307,106 @@ func (_c *ExpenseApprovalService_RejectExpense_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// RequestExpenseInfo provides a mock function with given fields: ctx, role, approverID, requestID, question
+func (_m *ExpenseApprovalService) RequestExpenseInfo(ctx context.Context, role string, approverID int64, requestID int64, question string) error {
+	ret := _m.Called(ctx, role, approverID, requestID, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestExpenseInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestID, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseApprovalService_RequestExpenseInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestExpenseInfo'
+type ExpenseApprovalService_RequestExpenseInfo_Call struct {
+	*mock.Call
+}
+
+// RequestExpenseInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestID int64
+//   - question string
+func (_e *ExpenseApprovalService_Expecter) RequestExpenseInfo(ctx interface{}, role interface{}, approverID interface{}, requestID interface{}, question interface{}) *ExpenseApprovalService_RequestExpenseInfo_Call {
+	return &ExpenseApprovalService_RequestExpenseInfo_Call{Call: _e.mock.On("RequestExpenseInfo", ctx, role, approverID, requestID, question)}
+}
+
+func (_c *ExpenseApprovalService_RequestExpenseInfo_Call) Run(run func(ctx context.Context, role string, approverID int64, requestID int64, question string)) *ExpenseApprovalService_RequestExpenseInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseApprovalService_RequestExpenseInfo_Call) Return(_a0 error) *ExpenseApprovalService_RequestExpenseInfo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseApprovalService_RequestExpenseInfo_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) error) *ExpenseApprovalService_RequestExpenseInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeExpense provides a mock function with given fields: ctx, role, approverID, requestID, reason
+func (_m *ExpenseApprovalService) RevokeExpense(ctx context.Context, role string, approverID int64, requestID int64, reason string) error {
+	ret := _m.Called(ctx, role, approverID, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeExpense")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpenseApprovalService_RevokeExpense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeExpense'
+type ExpenseApprovalService_RevokeExpense_Call struct {
+	*mock.Call
+}
+
+// RevokeExpense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestID int64
+//   - reason string
+func (_e *ExpenseApprovalService_Expecter) RevokeExpense(ctx interface{}, role interface{}, approverID interface{}, requestID interface{}, reason interface{}) *ExpenseApprovalService_RevokeExpense_Call {
+	return &ExpenseApprovalService_RevokeExpense_Call{Call: _e.mock.On("RevokeExpense", ctx, role, approverID, requestID, reason)}
+}
+
+func (_c *ExpenseApprovalService_RevokeExpense_Call) Run(run func(ctx context.Context, role string, approverID int64, requestID int64, reason string)) *ExpenseApprovalService_RevokeExpense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ExpenseApprovalService_RevokeExpense_Call) Return(_a0 error) *ExpenseApprovalService_RevokeExpense_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpenseApprovalService_RevokeExpense_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) error) *ExpenseApprovalService_RevokeExpense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewExpenseApprovalService creates a new instance of ExpenseApprovalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewExpenseApprovalService(t interface {
@@ -0,0 +1,256 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// ExpiringRequestRepository is an autogenerated mock type for the ExpiringRequestRepository type
+type ExpiringRequestRepository struct {
+	mock.Mock
+}
+
+type ExpiringRequestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExpiringRequestRepository) EXPECT() *ExpiringRequestRepository_Expecter {
+	return &ExpiringRequestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Escalate provides a mock function with given fields: ctx, tx, requestID, level, escalatedTo
+func (_m *ExpiringRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	ret := _m.Called(ctx, tx, requestID, level, escalatedTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Escalate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, int, *int64) error); ok {
+		r0 = rf(ctx, tx, requestID, level, escalatedTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpiringRequestRepository_Escalate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Escalate'
+type ExpiringRequestRepository_Escalate_Call struct {
+	*mock.Call
+}
+
+// Escalate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - level int
+//   - escalatedTo *int64
+func (_e *ExpiringRequestRepository_Expecter) Escalate(ctx interface{}, tx interface{}, requestID interface{}, level interface{}, escalatedTo interface{}) *ExpiringRequestRepository_Escalate_Call {
+	return &ExpiringRequestRepository_Escalate_Call{Call: _e.mock.On("Escalate", ctx, tx, requestID, level, escalatedTo)}
+}
+
+func (_c *ExpiringRequestRepository_Escalate_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64)) *ExpiringRequestRepository_Escalate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(int), args[4].(*int64))
+	})
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_Escalate_Call) Return(_a0 error) *ExpiringRequestRepository_Escalate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_Escalate_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, int, *int64) error) *ExpiringRequestRepository_Escalate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingRequests provides a mock function with given fields: ctx
+func (_m *ExpiringRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingRequests")
+	}
+
+	var r0 []models.PendingRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.PendingRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.PendingRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PendingRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiringRequestRepository_GetPendingRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingRequests'
+type ExpiringRequestRepository_GetPendingRequests_Call struct {
+	*mock.Call
+}
+
+// GetPendingRequests is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ExpiringRequestRepository_Expecter) GetPendingRequests(ctx interface{}) *ExpiringRequestRepository_GetPendingRequests_Call {
+	return &ExpiringRequestRepository_GetPendingRequests_Call{Call: _e.mock.On("GetPendingRequests", ctx)}
+}
+
+func (_c *ExpiringRequestRepository_GetPendingRequests_Call) Run(run func(ctx context.Context)) *ExpiringRequestRepository_GetPendingRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_GetPendingRequests_Call) Return(_a0 []models.PendingRequest, _a1 error) *ExpiringRequestRepository_GetPendingRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_GetPendingRequests_Call) RunAndReturn(run func(context.Context) ([]models.PendingRequest, error)) *ExpiringRequestRepository_GetPendingRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPending provides a mock function with given fields: ctx, tx, requestID
+func (_m *ExpiringRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	ret := _m.Called(ctx, tx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPending")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (bool, error)); ok {
+		return rf(ctx, tx, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) bool); ok {
+		r0 = rf(ctx, tx, requestID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiringRequestRepository_LockPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPending'
+type ExpiringRequestRepository_LockPending_Call struct {
+	*mock.Call
+}
+
+// LockPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+func (_e *ExpiringRequestRepository_Expecter) LockPending(ctx interface{}, tx interface{}, requestID interface{}) *ExpiringRequestRepository_LockPending_Call {
+	return &ExpiringRequestRepository_LockPending_Call{Call: _e.mock.On("LockPending", ctx, tx, requestID)}
+}
+
+func (_c *ExpiringRequestRepository_LockPending_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64)) *ExpiringRequestRepository_LockPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_LockPending_Call) Return(_a0 bool, _a1 error) *ExpiringRequestRepository_LockPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_LockPending_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (bool, error)) *ExpiringRequestRepository_LockPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, tx, requestID, status, approverID, comment
+func (_m *ExpiringRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
+	ret := _m.Called(ctx, tx, requestID, status, approverID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string, int64, string) error); ok {
+		r0 = rf(ctx, tx, requestID, status, approverID, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpiringRequestRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type ExpiringRequestRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestID int64
+//   - status string
+//   - approverID int64
+//   - comment string
+func (_e *ExpiringRequestRepository_Expecter) UpdateStatus(ctx interface{}, tx interface{}, requestID interface{}, status interface{}, approverID interface{}, comment interface{}) *ExpiringRequestRepository_UpdateStatus_Call {
+	return &ExpiringRequestRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, tx, requestID, status, approverID, comment)}
+}
+
+func (_c *ExpiringRequestRepository_UpdateStatus_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string)) *ExpiringRequestRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string), args[4].(int64), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_UpdateStatus_Call) Return(_a0 error) *ExpiringRequestRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpiringRequestRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string, int64, string) error) *ExpiringRequestRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExpiringRequestRepository creates a new instance of ExpiringRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExpiringRequestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExpiringRequestRepository {
+	mock := &ExpiringRequestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// ExpiryPolicyRepository is an autogenerated mock type for the ExpiryPolicyRepository type
+type ExpiryPolicyRepository struct {
+	mock.Mock
+}
+
+type ExpiryPolicyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExpiryPolicyRepository) EXPECT() *ExpiryPolicyRepository_Expecter {
+	return &ExpiryPolicyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, requestType, gradeID
+func (_m *ExpiryPolicyRepository) Delete(ctx context.Context, requestType string, gradeID *int64) error {
+	ret := _m.Called(ctx, requestType, gradeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int64) error); ok {
+		r0 = rf(ctx, requestType, gradeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpiryPolicyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ExpiryPolicyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - gradeID *int64
+func (_e *ExpiryPolicyRepository_Expecter) Delete(ctx interface{}, requestType interface{}, gradeID interface{}) *ExpiryPolicyRepository_Delete_Call {
+	return &ExpiryPolicyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, requestType, gradeID)}
+}
+
+func (_c *ExpiryPolicyRepository_Delete_Call) Run(run func(ctx context.Context, requestType string, gradeID *int64)) *ExpiryPolicyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*int64))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyRepository_Delete_Call) Return(_a0 error) *ExpiryPolicyRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpiryPolicyRepository_Delete_Call) RunAndReturn(run func(context.Context, string, *int64) error) *ExpiryPolicyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ExpiryPolicyRepository) GetAll(ctx context.Context) ([]models.ExpiryPolicy, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.ExpiryPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ExpiryPolicy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ExpiryPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExpiryPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiryPolicyRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ExpiryPolicyRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ExpiryPolicyRepository_Expecter) GetAll(ctx interface{}) *ExpiryPolicyRepository_GetAll_Call {
+	return &ExpiryPolicyRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *ExpiryPolicyRepository_GetAll_Call) Run(run func(ctx context.Context)) *ExpiryPolicyRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyRepository_GetAll_Call) Return(_a0 []models.ExpiryPolicy, _a1 error) *ExpiryPolicyRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiryPolicyRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.ExpiryPolicy, error)) *ExpiryPolicyRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, policy
+func (_m *ExpiryPolicyRepository) Upsert(ctx context.Context, policy *models.ExpiryPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.ExpiryPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpiryPolicyRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type ExpiryPolicyRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *models.ExpiryPolicy
+func (_e *ExpiryPolicyRepository_Expecter) Upsert(ctx interface{}, policy interface{}) *ExpiryPolicyRepository_Upsert_Call {
+	return &ExpiryPolicyRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, policy)}
+}
+
+func (_c *ExpiryPolicyRepository_Upsert_Call) Run(run func(ctx context.Context, policy *models.ExpiryPolicy)) *ExpiryPolicyRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.ExpiryPolicy))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyRepository_Upsert_Call) Return(_a0 error) *ExpiryPolicyRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpiryPolicyRepository_Upsert_Call) RunAndReturn(run func(context.Context, *models.ExpiryPolicy) error) *ExpiryPolicyRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExpiryPolicyRepository creates a new instance of ExpiryPolicyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExpiryPolicyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExpiryPolicyRepository {
+	mock := &ExpiryPolicyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,329 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// ExpiryPolicyService is an autogenerated mock type for the ExpiryPolicyService type
+type ExpiryPolicyService struct {
+	mock.Mock
+}
+
+type ExpiryPolicyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExpiryPolicyService) EXPECT() *ExpiryPolicyService_Expecter {
+	return &ExpiryPolicyService_Expecter{mock: &_m.Mock}
+}
+
+// AddDeadlines provides a mock function with given fields: ctx, requestType, queue
+func (_m *ExpiryPolicyService) AddDeadlines(ctx context.Context, requestType string, queue []map[string]interface{}) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, requestType, queue)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDeadlines")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []map[string]interface{}) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, requestType, queue)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []map[string]interface{}) []map[string]interface{}); ok {
+		r0 = rf(ctx, requestType, queue)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []map[string]interface{}) error); ok {
+		r1 = rf(ctx, requestType, queue)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiryPolicyService_AddDeadlines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDeadlines'
+type ExpiryPolicyService_AddDeadlines_Call struct {
+	*mock.Call
+}
+
+// AddDeadlines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - queue []map[string]interface{}
+func (_e *ExpiryPolicyService_Expecter) AddDeadlines(ctx interface{}, requestType interface{}, queue interface{}) *ExpiryPolicyService_AddDeadlines_Call {
+	return &ExpiryPolicyService_AddDeadlines_Call{Call: _e.mock.On("AddDeadlines", ctx, requestType, queue)}
+}
+
+func (_c *ExpiryPolicyService_AddDeadlines_Call) Run(run func(ctx context.Context, requestType string, queue []map[string]interface{})) *ExpiryPolicyService_AddDeadlines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyService_AddDeadlines_Call) Return(_a0 []map[string]interface{}, _a1 error) *ExpiryPolicyService_AddDeadlines_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiryPolicyService_AddDeadlines_Call) RunAndReturn(run func(context.Context, string, []map[string]interface{}) ([]map[string]interface{}, error)) *ExpiryPolicyService_AddDeadlines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePolicy provides a mock function with given fields: ctx, role, requestType, gradeID
+func (_m *ExpiryPolicyService) DeletePolicy(ctx context.Context, role string, requestType string, gradeID *int64) error {
+	ret := _m.Called(ctx, role, requestType, gradeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int64) error); ok {
+		r0 = rf(ctx, role, requestType, gradeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpiryPolicyService_DeletePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePolicy'
+type ExpiryPolicyService_DeletePolicy_Call struct {
+	*mock.Call
+}
+
+// DeletePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - requestType string
+//   - gradeID *int64
+func (_e *ExpiryPolicyService_Expecter) DeletePolicy(ctx interface{}, role interface{}, requestType interface{}, gradeID interface{}) *ExpiryPolicyService_DeletePolicy_Call {
+	return &ExpiryPolicyService_DeletePolicy_Call{Call: _e.mock.On("DeletePolicy", ctx, role, requestType, gradeID)}
+}
+
+func (_c *ExpiryPolicyService_DeletePolicy_Call) Run(run func(ctx context.Context, role string, requestType string, gradeID *int64)) *ExpiryPolicyService_DeletePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*int64))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyService_DeletePolicy_Call) Return(_a0 error) *ExpiryPolicyService_DeletePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExpiryPolicyService_DeletePolicy_Call) RunAndReturn(run func(context.Context, string, string, *int64) error) *ExpiryPolicyService_DeletePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEscalations provides a mock function with given fields: ctx, role, userID, requestType, requestID
+func (_m *ExpiryPolicyService) GetEscalations(ctx context.Context, role string, userID int64, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	ret := _m.Called(ctx, role, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEscalations")
+	}
+
+	var r0 []models.RequestEscalation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) ([]models.RequestEscalation, error)); ok {
+		return rf(ctx, role, userID, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int64) []models.RequestEscalation); ok {
+		r0 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestEscalation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int64) error); ok {
+		r1 = rf(ctx, role, userID, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiryPolicyService_GetEscalations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEscalations'
+type ExpiryPolicyService_GetEscalations_Call struct {
+	*mock.Call
+}
+
+// GetEscalations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *ExpiryPolicyService_Expecter) GetEscalations(ctx interface{}, role interface{}, userID interface{}, requestType interface{}, requestID interface{}) *ExpiryPolicyService_GetEscalations_Call {
+	return &ExpiryPolicyService_GetEscalations_Call{Call: _e.mock.On("GetEscalations", ctx, role, userID, requestType, requestID)}
+}
+
+func (_c *ExpiryPolicyService_GetEscalations_Call) Run(run func(ctx context.Context, role string, userID int64, requestType string, requestID int64)) *ExpiryPolicyService_GetEscalations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyService_GetEscalations_Call) Return(_a0 []models.RequestEscalation, _a1 error) *ExpiryPolicyService_GetEscalations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiryPolicyService_GetEscalations_Call) RunAndReturn(run func(context.Context, string, int64, string, int64) ([]models.RequestEscalation, error)) *ExpiryPolicyService_GetEscalations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx, role
+func (_m *ExpiryPolicyService) GetPolicies(ctx context.Context, role string) ([]models.ExpiryPolicy, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 []models.ExpiryPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ExpiryPolicy, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ExpiryPolicy); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExpiryPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiryPolicyService_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type ExpiryPolicyService_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+func (_e *ExpiryPolicyService_Expecter) GetPolicies(ctx interface{}, role interface{}) *ExpiryPolicyService_GetPolicies_Call {
+	return &ExpiryPolicyService_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx, role)}
+}
+
+func (_c *ExpiryPolicyService_GetPolicies_Call) Run(run func(ctx context.Context, role string)) *ExpiryPolicyService_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyService_GetPolicies_Call) Return(_a0 []models.ExpiryPolicy, _a1 error) *ExpiryPolicyService_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiryPolicyService_GetPolicies_Call) RunAndReturn(run func(context.Context, string) ([]models.ExpiryPolicy, error)) *ExpiryPolicyService_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPolicy provides a mock function with given fields: ctx, role, adminID, policy
+func (_m *ExpiryPolicyService) SetPolicy(ctx context.Context, role string, adminID int64, policy models.ExpiryPolicy) (*models.ExpiryPolicy, error) {
+	ret := _m.Called(ctx, role, adminID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPolicy")
+	}
+
+	var r0 *models.ExpiryPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.ExpiryPolicy) (*models.ExpiryPolicy, error)); ok {
+		return rf(ctx, role, adminID, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, models.ExpiryPolicy) *models.ExpiryPolicy); ok {
+		r0 = rf(ctx, role, adminID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExpiryPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, models.ExpiryPolicy) error); ok {
+		r1 = rf(ctx, role, adminID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpiryPolicyService_SetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPolicy'
+type ExpiryPolicyService_SetPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - adminID int64
+//   - policy models.ExpiryPolicy
+func (_e *ExpiryPolicyService_Expecter) SetPolicy(ctx interface{}, role interface{}, adminID interface{}, policy interface{}) *ExpiryPolicyService_SetPolicy_Call {
+	return &ExpiryPolicyService_SetPolicy_Call{Call: _e.mock.On("SetPolicy", ctx, role, adminID, policy)}
+}
+
+func (_c *ExpiryPolicyService_SetPolicy_Call) Run(run func(ctx context.Context, role string, adminID int64, policy models.ExpiryPolicy)) *ExpiryPolicyService_SetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(models.ExpiryPolicy))
+	})
+	return _c
+}
+
+func (_c *ExpiryPolicyService_SetPolicy_Call) Return(_a0 *models.ExpiryPolicy, _a1 error) *ExpiryPolicyService_SetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExpiryPolicyService_SetPolicy_Call) RunAndReturn(run func(context.Context, string, int64, models.ExpiryPolicy) (*models.ExpiryPolicy, error)) *ExpiryPolicyService_SetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExpiryPolicyService creates a new instance of ExpiryPolicyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExpiryPolicyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExpiryPolicyService {
+	mock := &ExpiryPolicyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
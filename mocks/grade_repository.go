@@ -7,6 +7,8 @@ import (
 
 	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 )
 
 // GradeRepository is an autogenerated mock type for the GradeRepository type
@@ -22,6 +24,64 @@ func (_m *GradeRepository) EXPECT() *GradeRepository_Expecter {
 	return &GradeRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetAll provides a mock function with given fields: ctx
+func (_m *GradeRepository) GetAll(ctx context.Context) ([]models.Grade, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.Grade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Grade, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Grade); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Grade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GradeRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type GradeRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GradeRepository_Expecter) GetAll(ctx interface{}) *GradeRepository_GetAll_Call {
+	return &GradeRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *GradeRepository_GetAll_Call) Run(run func(ctx context.Context)) *GradeRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GradeRepository_GetAll_Call) Return(_a0 []models.Grade, _a1 error) *GradeRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GradeRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.Grade, error)) *GradeRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLimits provides a mock function with given fields: ctx, tx, gradeID
 func (_m *GradeRepository) GetLimits(ctx context.Context, tx interfaces.Tx, gradeID int64) (int, float64, float64, error) {
 	ret := _m.Called(ctx, tx, gradeID)
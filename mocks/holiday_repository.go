@@ -119,6 +119,66 @@ func (_c *HolidayRepository_DeleteHoliday_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetHolidayDates provides a mock function with given fields: ctx, from, to
+func (_m *HolidayRepository) GetHolidayDates(ctx context.Context, from time.Time, to time.Time) ([]time.Time, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHolidayDates")
+	}
+
+	var r0 []time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]time.Time, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []time.Time); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HolidayRepository_GetHolidayDates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHolidayDates'
+type HolidayRepository_GetHolidayDates_Call struct {
+	*mock.Call
+}
+
+// GetHolidayDates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *HolidayRepository_Expecter) GetHolidayDates(ctx interface{}, from interface{}, to interface{}) *HolidayRepository_GetHolidayDates_Call {
+	return &HolidayRepository_GetHolidayDates_Call{Call: _e.mock.On("GetHolidayDates", ctx, from, to)}
+}
+
+func (_c *HolidayRepository_GetHolidayDates_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *HolidayRepository_GetHolidayDates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *HolidayRepository_GetHolidayDates_Call) Return(_a0 []time.Time, _a1 error) *HolidayRepository_GetHolidayDates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HolidayRepository_GetHolidayDates_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]time.Time, error)) *HolidayRepository_GetHolidayDates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetHolidays provides a mock function with given fields: ctx
 func (_m *HolidayRepository) GetHolidays(ctx context.Context) ([]map[string]interface{}, error) {
 	ret := _m.Called(ctx)
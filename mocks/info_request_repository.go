@@ -0,0 +1,257 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// InfoRequestRepository is an autogenerated mock type for the InfoRequestRepository type
+type InfoRequestRepository struct {
+	mock.Mock
+}
+
+type InfoRequestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InfoRequestRepository) EXPECT() *InfoRequestRepository_Expecter {
+	return &InfoRequestRepository_Expecter{mock: &_m.Mock}
+}
+
+// Answer provides a mock function with given fields: ctx, tx, infoID, answer
+func (_m *InfoRequestRepository) Answer(ctx context.Context, tx interfaces.Tx, infoID int64, answer string) error {
+	ret := _m.Called(ctx, tx, infoID, answer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Answer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, string) error); ok {
+		r0 = rf(ctx, tx, infoID, answer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InfoRequestRepository_Answer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Answer'
+type InfoRequestRepository_Answer_Call struct {
+	*mock.Call
+}
+
+// Answer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - infoID int64
+//   - answer string
+func (_e *InfoRequestRepository_Expecter) Answer(ctx interface{}, tx interface{}, infoID interface{}, answer interface{}) *InfoRequestRepository_Answer_Call {
+	return &InfoRequestRepository_Answer_Call{Call: _e.mock.On("Answer", ctx, tx, infoID, answer)}
+}
+
+func (_c *InfoRequestRepository_Answer_Call) Run(run func(ctx context.Context, tx interfaces.Tx, infoID int64, answer string)) *InfoRequestRepository_Answer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *InfoRequestRepository_Answer_Call) Return(_a0 error) *InfoRequestRepository_Answer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InfoRequestRepository_Answer_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, string) error) *InfoRequestRepository_Answer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, tx, info
+func (_m *InfoRequestRepository) Create(ctx context.Context, tx interfaces.Tx, info *models.InfoRequest) error {
+	ret := _m.Called(ctx, tx, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.InfoRequest) error); ok {
+		r0 = rf(ctx, tx, info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InfoRequestRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type InfoRequestRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - info *models.InfoRequest
+func (_e *InfoRequestRepository_Expecter) Create(ctx interface{}, tx interface{}, info interface{}) *InfoRequestRepository_Create_Call {
+	return &InfoRequestRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, info)}
+}
+
+func (_c *InfoRequestRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, info *models.InfoRequest)) *InfoRequestRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.InfoRequest))
+	})
+	return _c
+}
+
+func (_c *InfoRequestRepository_Create_Call) Return(_a0 error) *InfoRequestRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InfoRequestRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.InfoRequest) error) *InfoRequestRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForRequest provides a mock function with given fields: ctx, requestType, requestID
+func (_m *InfoRequestRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.InfoRequest, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForRequest")
+	}
+
+	var r0 []models.InfoRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.InfoRequest, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.InfoRequest); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.InfoRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoRequestRepository_GetForRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForRequest'
+type InfoRequestRepository_GetForRequest_Call struct {
+	*mock.Call
+}
+
+// GetForRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *InfoRequestRepository_Expecter) GetForRequest(ctx interface{}, requestType interface{}, requestID interface{}) *InfoRequestRepository_GetForRequest_Call {
+	return &InfoRequestRepository_GetForRequest_Call{Call: _e.mock.On("GetForRequest", ctx, requestType, requestID)}
+}
+
+func (_c *InfoRequestRepository_GetForRequest_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *InfoRequestRepository_GetForRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *InfoRequestRepository_GetForRequest_Call) Return(_a0 []models.InfoRequest, _a1 error) *InfoRequestRepository_GetForRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoRequestRepository_GetForRequest_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.InfoRequest, error)) *InfoRequestRepository_GetForRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpen provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *InfoRequestRepository) GetOpen(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (*models.InfoRequest, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpen")
+	}
+
+	var r0 *models.InfoRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) (*models.InfoRequest, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) *models.InfoRequest); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.InfoRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InfoRequestRepository_GetOpen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpen'
+type InfoRequestRepository_GetOpen_Call struct {
+	*mock.Call
+}
+
+// GetOpen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *InfoRequestRepository_Expecter) GetOpen(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *InfoRequestRepository_GetOpen_Call {
+	return &InfoRequestRepository_GetOpen_Call{Call: _e.mock.On("GetOpen", ctx, tx, requestType, requestID)}
+}
+
+func (_c *InfoRequestRepository_GetOpen_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *InfoRequestRepository_GetOpen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *InfoRequestRepository_GetOpen_Call) Return(_a0 *models.InfoRequest, _a1 error) *InfoRequestRepository_GetOpen_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InfoRequestRepository_GetOpen_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) (*models.InfoRequest, error)) *InfoRequestRepository_GetOpen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInfoRequestRepository creates a new instance of InfoRequestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInfoRequestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InfoRequestRepository {
+	mock := &InfoRequestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
 )
 
 // LeaveApprovalService is an autogenerated mock type for the LeaveApprovalService type
@@ -71,6 +73,130 @@ func (_c *LeaveApprovalService_ApproveLeave_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// BulkApproveLeaves provides a mock function with given fields: ctx, role, approverID, requestIDs, comment
+func (_m *LeaveApprovalService) BulkApproveLeaves(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkApproveLeaves")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveApprovalService_BulkApproveLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkApproveLeaves'
+type LeaveApprovalService_BulkApproveLeaves_Call struct {
+	*mock.Call
+}
+
+// BulkApproveLeaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestIDs []int64
+//   - comment string
+func (_e *LeaveApprovalService_Expecter) BulkApproveLeaves(ctx interface{}, role interface{}, approverID interface{}, requestIDs interface{}, comment interface{}) *LeaveApprovalService_BulkApproveLeaves_Call {
+	return &LeaveApprovalService_BulkApproveLeaves_Call{Call: _e.mock.On("BulkApproveLeaves", ctx, role, approverID, requestIDs, comment)}
+}
+
+func (_c *LeaveApprovalService_BulkApproveLeaves_Call) Run(run func(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string)) *LeaveApprovalService_BulkApproveLeaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveApprovalService_BulkApproveLeaves_Call) Return(_a0 *models.BulkDecision, _a1 error) *LeaveApprovalService_BulkApproveLeaves_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveApprovalService_BulkApproveLeaves_Call) RunAndReturn(run func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)) *LeaveApprovalService_BulkApproveLeaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkRejectLeaves provides a mock function with given fields: ctx, role, approverID, requestIDs, comment
+func (_m *LeaveApprovalService) BulkRejectLeaves(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string) (*models.BulkDecision, error) {
+	ret := _m.Called(ctx, role, approverID, requestIDs, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRejectLeaves")
+	}
+
+	var r0 *models.BulkDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)); ok {
+		return rf(ctx, role, approverID, requestIDs, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, []int64, string) *models.BulkDecision); ok {
+		r0 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BulkDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, []int64, string) error); ok {
+		r1 = rf(ctx, role, approverID, requestIDs, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveApprovalService_BulkRejectLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRejectLeaves'
+type LeaveApprovalService_BulkRejectLeaves_Call struct {
+	*mock.Call
+}
+
+// BulkRejectLeaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestIDs []int64
+//   - comment string
+func (_e *LeaveApprovalService_Expecter) BulkRejectLeaves(ctx interface{}, role interface{}, approverID interface{}, requestIDs interface{}, comment interface{}) *LeaveApprovalService_BulkRejectLeaves_Call {
+	return &LeaveApprovalService_BulkRejectLeaves_Call{Call: _e.mock.On("BulkRejectLeaves", ctx, role, approverID, requestIDs, comment)}
+}
+
+func (_c *LeaveApprovalService_BulkRejectLeaves_Call) Run(run func(ctx context.Context, role string, approverID int64, requestIDs []int64, comment string)) *LeaveApprovalService_BulkRejectLeaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].([]int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveApprovalService_BulkRejectLeaves_Call) Return(_a0 *models.BulkDecision, _a1 error) *LeaveApprovalService_BulkRejectLeaves_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LeaveApprovalService_BulkRejectLeaves_Call) RunAndReturn(run func(context.Context, string, int64, []int64, string) (*models.BulkDecision, error)) *LeaveApprovalService_BulkRejectLeaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPendingLeaveRequests provides a mock function with given fields: ctx, role, approverID
 func (_m *LeaveApprovalService) GetPendingLeaveRequests(ctx context.Context, role string, approverID int64) ([]map[string]interface{}, error) {
 	ret := _m.Called(ctx, role, approverID)
@@ -181,6 +307,106 @@ func (_c *LeaveApprovalService_RejectLeave_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RequestLeaveInfo provides a mock function with given fields: ctx, role, approverID, requestID, question
+func (_m *LeaveApprovalService) RequestLeaveInfo(ctx context.Context, role string, approverID int64, requestID int64, question string) error {
+	ret := _m.Called(ctx, role, approverID, requestID, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestLeaveInfo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestID, question)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveApprovalService_RequestLeaveInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestLeaveInfo'
+type LeaveApprovalService_RequestLeaveInfo_Call struct {
+	*mock.Call
+}
+
+// RequestLeaveInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestID int64
+//   - question string
+func (_e *LeaveApprovalService_Expecter) RequestLeaveInfo(ctx interface{}, role interface{}, approverID interface{}, requestID interface{}, question interface{}) *LeaveApprovalService_RequestLeaveInfo_Call {
+	return &LeaveApprovalService_RequestLeaveInfo_Call{Call: _e.mock.On("RequestLeaveInfo", ctx, role, approverID, requestID, question)}
+}
+
+func (_c *LeaveApprovalService_RequestLeaveInfo_Call) Run(run func(ctx context.Context, role string, approverID int64, requestID int64, question string)) *LeaveApprovalService_RequestLeaveInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveApprovalService_RequestLeaveInfo_Call) Return(_a0 error) *LeaveApprovalService_RequestLeaveInfo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveApprovalService_RequestLeaveInfo_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) error) *LeaveApprovalService_RequestLeaveInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeLeave provides a mock function with given fields: ctx, role, approverID, requestID, reason
+func (_m *LeaveApprovalService) RevokeLeave(ctx context.Context, role string, approverID int64, requestID int64, reason string) error {
+	ret := _m.Called(ctx, role, approverID, requestID, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeLeave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, string) error); ok {
+		r0 = rf(ctx, role, approverID, requestID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LeaveApprovalService_RevokeLeave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeLeave'
+type LeaveApprovalService_RevokeLeave_Call struct {
+	*mock.Call
+}
+
+// RevokeLeave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - approverID int64
+//   - requestID int64
+//   - reason string
+func (_e *LeaveApprovalService_Expecter) RevokeLeave(ctx interface{}, role interface{}, approverID interface{}, requestID interface{}, reason interface{}) *LeaveApprovalService_RevokeLeave_Call {
+	return &LeaveApprovalService_RevokeLeave_Call{Call: _e.mock.On("RevokeLeave", ctx, role, approverID, requestID, reason)}
+}
+
+func (_c *LeaveApprovalService_RevokeLeave_Call) Run(run func(ctx context.Context, role string, approverID int64, requestID int64, reason string)) *LeaveApprovalService_RevokeLeave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *LeaveApprovalService_RevokeLeave_Call) Return(_a0 error) *LeaveApprovalService_RevokeLeave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LeaveApprovalService_RevokeLeave_Call) RunAndReturn(run func(context.Context, string, int64, int64, string) error) *LeaveApprovalService_RevokeLeave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLeaveApprovalService creates a new instance of LeaveApprovalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLeaveApprovalService(t interface {
@@ -0,0 +1,207 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RequestEscalationRepository is an autogenerated mock type for the RequestEscalationRepository type
+type RequestEscalationRepository struct {
+	mock.Mock
+}
+
+type RequestEscalationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RequestEscalationRepository) EXPECT() *RequestEscalationRepository_Expecter {
+	return &RequestEscalationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tx, escalation
+func (_m *RequestEscalationRepository) Create(ctx context.Context, tx interfaces.Tx, escalation *models.RequestEscalation) error {
+	ret := _m.Called(ctx, tx, escalation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestEscalation) error); ok {
+		r0 = rf(ctx, tx, escalation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestEscalationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type RequestEscalationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - escalation *models.RequestEscalation
+func (_e *RequestEscalationRepository_Expecter) Create(ctx interface{}, tx interface{}, escalation interface{}) *RequestEscalationRepository_Create_Call {
+	return &RequestEscalationRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, escalation)}
+}
+
+func (_c *RequestEscalationRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, escalation *models.RequestEscalation)) *RequestEscalationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RequestEscalation))
+	})
+	return _c
+}
+
+func (_c *RequestEscalationRepository_Create_Call) Return(_a0 error) *RequestEscalationRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RequestEscalationRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RequestEscalation) error) *RequestEscalationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForRequest provides a mock function with given fields: ctx, requestType, requestID
+func (_m *RequestEscalationRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForRequest")
+	}
+
+	var r0 []models.RequestEscalation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestEscalation, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestEscalation); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestEscalation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestEscalationRepository_GetForRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForRequest'
+type RequestEscalationRepository_GetForRequest_Call struct {
+	*mock.Call
+}
+
+// GetForRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *RequestEscalationRepository_Expecter) GetForRequest(ctx interface{}, requestType interface{}, requestID interface{}) *RequestEscalationRepository_GetForRequest_Call {
+	return &RequestEscalationRepository_GetForRequest_Call{Call: _e.mock.On("GetForRequest", ctx, requestType, requestID)}
+}
+
+func (_c *RequestEscalationRepository_GetForRequest_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *RequestEscalationRepository_GetForRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RequestEscalationRepository_GetForRequest_Call) Return(_a0 []models.RequestEscalation, _a1 error) *RequestEscalationRepository_GetForRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestEscalationRepository_GetForRequest_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestEscalation, error)) *RequestEscalationRepository_GetForRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasEvent provides a mock function with given fields: ctx, requestType, requestID, event, level
+func (_m *RequestEscalationRepository) HasEvent(ctx context.Context, requestType string, requestID int64, event string, level int) (bool, error) {
+	ret := _m.Called(ctx, requestType, requestID, event, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasEvent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int) (bool, error)); ok {
+		return rf(ctx, requestType, requestID, event, level)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, int) bool); ok {
+		r0 = rf(ctx, requestType, requestID, event, level)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, int) error); ok {
+		r1 = rf(ctx, requestType, requestID, event, level)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestEscalationRepository_HasEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasEvent'
+type RequestEscalationRepository_HasEvent_Call struct {
+	*mock.Call
+}
+
+// HasEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+//   - event string
+//   - level int
+func (_e *RequestEscalationRepository_Expecter) HasEvent(ctx interface{}, requestType interface{}, requestID interface{}, event interface{}, level interface{}) *RequestEscalationRepository_HasEvent_Call {
+	return &RequestEscalationRepository_HasEvent_Call{Call: _e.mock.On("HasEvent", ctx, requestType, requestID, event, level)}
+}
+
+func (_c *RequestEscalationRepository_HasEvent_Call) Run(run func(ctx context.Context, requestType string, requestID int64, event string, level int)) *RequestEscalationRepository_HasEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *RequestEscalationRepository_HasEvent_Call) Return(_a0 bool, _a1 error) *RequestEscalationRepository_HasEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestEscalationRepository_HasEvent_Call) RunAndReturn(run func(context.Context, string, int64, string, int) (bool, error)) *RequestEscalationRepository_HasEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequestEscalationRepository creates a new instance of RequestEscalationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestEscalationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestEscalationRepository {
+	mock := &RequestEscalationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
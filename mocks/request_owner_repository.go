@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestOwnerRepository is an autogenerated mock type for the RequestOwnerRepository type
+type RequestOwnerRepository struct {
+	mock.Mock
+}
+
+type RequestOwnerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RequestOwnerRepository) EXPECT() *RequestOwnerRepository_Expecter {
+	return &RequestOwnerRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOwner provides a mock function with given fields: ctx, tx, requestType, requestID
+func (_m *RequestOwnerRepository) GetOwner(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (int64, error) {
+	ret := _m.Called(ctx, tx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOwner")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) (int64, error)); ok {
+		return rf(ctx, tx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string, int64) int64); ok {
+		r0 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, string, int64) error); ok {
+		r1 = rf(ctx, tx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestOwnerRepository_GetOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOwner'
+type RequestOwnerRepository_GetOwner_Call struct {
+	*mock.Call
+}
+
+// GetOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+//   - requestID int64
+func (_e *RequestOwnerRepository_Expecter) GetOwner(ctx interface{}, tx interface{}, requestType interface{}, requestID interface{}) *RequestOwnerRepository_GetOwner_Call {
+	return &RequestOwnerRepository_GetOwner_Call{Call: _e.mock.On("GetOwner", ctx, tx, requestType, requestID)}
+}
+
+func (_c *RequestOwnerRepository_GetOwner_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64)) *RequestOwnerRepository_GetOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *RequestOwnerRepository_GetOwner_Call) Return(_a0 int64, _a1 error) *RequestOwnerRepository_GetOwner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestOwnerRepository_GetOwner_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string, int64) (int64, error)) *RequestOwnerRepository_GetOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequestOwnerRepository creates a new instance of RequestOwnerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestOwnerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestOwnerRepository {
+	mock := &RequestOwnerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,211 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RequestService is an autogenerated mock type for the RequestService type
+type RequestService struct {
+	mock.Mock
+}
+
+type RequestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RequestService) EXPECT() *RequestService_Expecter {
+	return &RequestService_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: ctx, userID, requestType, payload
+func (_m *RequestService) Apply(ctx context.Context, userID int64, requestType string, payload map[string]interface{}) (string, string, error) {
+	ret := _m.Called(ctx, userID, requestType, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, map[string]interface{}) (string, string, error)); ok {
+		return rf(ctx, userID, requestType, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, map[string]interface{}) string); ok {
+		r0 = rf(ctx, userID, requestType, payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, map[string]interface{}) string); ok {
+		r1 = rf(ctx, userID, requestType, payload)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, string, map[string]interface{}) error); ok {
+		r2 = rf(ctx, userID, requestType, payload)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RequestService_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type RequestService_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestType string
+//   - payload map[string]interface{}
+func (_e *RequestService_Expecter) Apply(ctx interface{}, userID interface{}, requestType interface{}, payload interface{}) *RequestService_Apply_Call {
+	return &RequestService_Apply_Call{Call: _e.mock.On("Apply", ctx, userID, requestType, payload)}
+}
+
+func (_c *RequestService_Apply_Call) Run(run func(ctx context.Context, userID int64, requestType string, payload map[string]interface{})) *RequestService_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *RequestService_Apply_Call) Return(_a0 string, _a1 string, _a2 error) *RequestService_Apply_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RequestService_Apply_Call) RunAndReturn(run func(context.Context, int64, string, map[string]interface{}) (string, string, error)) *RequestService_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function with given fields: ctx, userID, requestType, requestID
+func (_m *RequestService) Cancel(ctx context.Context, userID int64, requestType string, requestID int64) error {
+	ret := _m.Called(ctx, userID, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int64) error); ok {
+		r0 = rf(ctx, userID, requestType, requestID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestService_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type RequestService_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - requestType string
+//   - requestID int64
+func (_e *RequestService_Expecter) Cancel(ctx interface{}, userID interface{}, requestType interface{}, requestID interface{}) *RequestService_Cancel_Call {
+	return &RequestService_Cancel_Call{Call: _e.mock.On("Cancel", ctx, userID, requestType, requestID)}
+}
+
+func (_c *RequestService_Cancel_Call) Run(run func(ctx context.Context, userID int64, requestType string, requestID int64)) *RequestService_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *RequestService_Cancel_Call) Return(_a0 error) *RequestService_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RequestService_Cancel_Call) RunAndReturn(run func(context.Context, int64, string, int64) error) *RequestService_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRequestTypes provides a mock function with given fields: ctx
+func (_m *RequestService) GetRequestTypes(ctx context.Context) ([]models.RequestType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestTypes")
+	}
+
+	var r0 []models.RequestType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.RequestType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.RequestType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestService_GetRequestTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestTypes'
+type RequestService_GetRequestTypes_Call struct {
+	*mock.Call
+}
+
+// GetRequestTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RequestService_Expecter) GetRequestTypes(ctx interface{}) *RequestService_GetRequestTypes_Call {
+	return &RequestService_GetRequestTypes_Call{Call: _e.mock.On("GetRequestTypes", ctx)}
+}
+
+func (_c *RequestService_GetRequestTypes_Call) Run(run func(ctx context.Context)) *RequestService_GetRequestTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RequestService_GetRequestTypes_Call) Return(_a0 []models.RequestType, _a1 error) *RequestService_GetRequestTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestService_GetRequestTypes_Call) RunAndReturn(run func(context.Context) ([]models.RequestType, error)) *RequestService_GetRequestTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequestService creates a new instance of RequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestService {
+	mock := &RequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
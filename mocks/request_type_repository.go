@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RequestTypeRepository is an autogenerated mock type for the RequestTypeRepository type
+type RequestTypeRepository struct {
+	mock.Mock
+}
+
+type RequestTypeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RequestTypeRepository) EXPECT() *RequestTypeRepository_Expecter {
+	return &RequestTypeRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *RequestTypeRepository) GetAll(ctx context.Context) ([]models.RequestType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []models.RequestType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.RequestType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.RequestType); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestTypeRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type RequestTypeRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RequestTypeRepository_Expecter) GetAll(ctx interface{}) *RequestTypeRepository_GetAll_Call {
+	return &RequestTypeRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *RequestTypeRepository_GetAll_Call) Run(run func(ctx context.Context)) *RequestTypeRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RequestTypeRepository_GetAll_Call) Return(_a0 []models.RequestType, _a1 error) *RequestTypeRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RequestTypeRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]models.RequestType, error)) *RequestTypeRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRequestTypeRepository creates a new instance of RequestTypeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestTypeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestTypeRepository {
+	mock := &RequestTypeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
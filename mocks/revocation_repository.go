@@ -0,0 +1,147 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RevocationRepository is an autogenerated mock type for the RevocationRepository type
+type RevocationRepository struct {
+	mock.Mock
+}
+
+type RevocationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RevocationRepository) EXPECT() *RevocationRepository_Expecter {
+	return &RevocationRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tx, revocation
+func (_m *RevocationRepository) Create(ctx context.Context, tx interfaces.Tx, revocation *models.RequestRevocation) error {
+	ret := _m.Called(ctx, tx, revocation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.RequestRevocation) error); ok {
+		r0 = rf(ctx, tx, revocation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevocationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type RevocationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - revocation *models.RequestRevocation
+func (_e *RevocationRepository_Expecter) Create(ctx interface{}, tx interface{}, revocation interface{}) *RevocationRepository_Create_Call {
+	return &RevocationRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, revocation)}
+}
+
+func (_c *RevocationRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, revocation *models.RequestRevocation)) *RevocationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.RequestRevocation))
+	})
+	return _c
+}
+
+func (_c *RevocationRepository_Create_Call) Return(_a0 error) *RevocationRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RevocationRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.RequestRevocation) error) *RevocationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForRequest provides a mock function with given fields: ctx, requestType, requestID
+func (_m *RevocationRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestRevocation, error) {
+	ret := _m.Called(ctx, requestType, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForRequest")
+	}
+
+	var r0 []models.RequestRevocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]models.RequestRevocation, error)); ok {
+		return rf(ctx, requestType, requestID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []models.RequestRevocation); ok {
+		r0 = rf(ctx, requestType, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RequestRevocation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, requestType, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevocationRepository_GetForRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForRequest'
+type RevocationRepository_GetForRequest_Call struct {
+	*mock.Call
+}
+
+// GetForRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestType string
+//   - requestID int64
+func (_e *RevocationRepository_Expecter) GetForRequest(ctx interface{}, requestType interface{}, requestID interface{}) *RevocationRepository_GetForRequest_Call {
+	return &RevocationRepository_GetForRequest_Call{Call: _e.mock.On("GetForRequest", ctx, requestType, requestID)}
+}
+
+func (_c *RevocationRepository_GetForRequest_Call) Run(run func(ctx context.Context, requestType string, requestID int64)) *RevocationRepository_GetForRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RevocationRepository_GetForRequest_Call) Return(_a0 []models.RequestRevocation, _a1 error) *RevocationRepository_GetForRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RevocationRepository_GetForRequest_Call) RunAndReturn(run func(context.Context, string, int64) ([]models.RequestRevocation, error)) *RevocationRepository_GetForRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRevocationRepository creates a new instance of RevocationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRevocationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RevocationRepository {
+	mock := &RevocationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/ankita-advitot/rule_based_approval_engine/models"
+)
+
+// RuleBacktestService is an autogenerated mock type for the RuleBacktestService type
+type RuleBacktestService struct {
+	mock.Mock
+}
+
+type RuleBacktestService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RuleBacktestService) EXPECT() *RuleBacktestService_Expecter {
+	return &RuleBacktestService_Expecter{mock: &_m.Mock}
+}
+
+// Backtest provides a mock function with given fields: ctx, role, candidate
+func (_m *RuleBacktestService) Backtest(ctx context.Context, role string, candidate []models.Rule) (*models.BacktestReport, error) {
+	ret := _m.Called(ctx, role, candidate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backtest")
+	}
+
+	var r0 *models.BacktestReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.Rule) (*models.BacktestReport, error)); ok {
+		return rf(ctx, role, candidate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []models.Rule) *models.BacktestReport); ok {
+		r0 = rf(ctx, role, candidate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BacktestReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []models.Rule) error); ok {
+		r1 = rf(ctx, role, candidate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleBacktestService_Backtest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backtest'
+type RuleBacktestService_Backtest_Call struct {
+	*mock.Call
+}
+
+// Backtest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - candidate []models.Rule
+func (_e *RuleBacktestService_Expecter) Backtest(ctx interface{}, role interface{}, candidate interface{}) *RuleBacktestService_Backtest_Call {
+	return &RuleBacktestService_Backtest_Call{Call: _e.mock.On("Backtest", ctx, role, candidate)}
+}
+
+func (_c *RuleBacktestService_Backtest_Call) Run(run func(ctx context.Context, role string, candidate []models.Rule)) *RuleBacktestService_Backtest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]models.Rule))
+	})
+	return _c
+}
+
+func (_c *RuleBacktestService_Backtest_Call) Return(_a0 *models.BacktestReport, _a1 error) *RuleBacktestService_Backtest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleBacktestService_Backtest_Call) RunAndReturn(run func(context.Context, string, []models.Rule) (*models.BacktestReport, error)) *RuleBacktestService_Backtest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRuleBacktestService creates a new instance of RuleBacktestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRuleBacktestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RuleBacktestService {
+	mock := &RuleBacktestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
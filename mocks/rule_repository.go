@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	interfaces "github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/ankita-advitot/rule_based_approval_engine/models"
@@ -23,17 +24,17 @@ func (_m *RuleRepository) EXPECT() *RuleRepository_Expecter {
 	return &RuleRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, rule
-func (_m *RuleRepository) Create(ctx context.Context, rule *models.Rule) error {
-	ret := _m.Called(ctx, rule)
+// Create provides a mock function with given fields: ctx, tx, rule
+func (_m *RuleRepository) Create(ctx context.Context, tx interfaces.Tx, rule *models.Rule) error {
+	ret := _m.Called(ctx, tx, rule)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Rule) error); ok {
-		r0 = rf(ctx, rule)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, *models.Rule) error); ok {
+		r0 = rf(ctx, tx, rule)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,14 +49,15 @@ type RuleRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tx interfaces.Tx
 //   - rule *models.Rule
-func (_e *RuleRepository_Expecter) Create(ctx interface{}, rule interface{}) *RuleRepository_Create_Call {
-	return &RuleRepository_Create_Call{Call: _e.mock.On("Create", ctx, rule)}
+func (_e *RuleRepository_Expecter) Create(ctx interface{}, tx interface{}, rule interface{}) *RuleRepository_Create_Call {
+	return &RuleRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx, rule)}
 }
 
-func (_c *RuleRepository_Create_Call) Run(run func(ctx context.Context, rule *models.Rule)) *RuleRepository_Create_Call {
+func (_c *RuleRepository_Create_Call) Run(run func(ctx context.Context, tx interfaces.Tx, rule *models.Rule)) *RuleRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.Rule))
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(*models.Rule))
 	})
 	return _c
 }
@@ -65,22 +67,22 @@ func (_c *RuleRepository_Create_Call) Return(_a0 error) *RuleRepository_Create_C
 	return _c
 }
 
-func (_c *RuleRepository_Create_Call) RunAndReturn(run func(context.Context, *models.Rule) error) *RuleRepository_Create_Call {
+func (_c *RuleRepository_Create_Call) RunAndReturn(run func(context.Context, interfaces.Tx, *models.Rule) error) *RuleRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, ruleID
-func (_m *RuleRepository) Delete(ctx context.Context, ruleID int64) error {
-	ret := _m.Called(ctx, ruleID)
+// Delete provides a mock function with given fields: ctx, tx, ruleID
+func (_m *RuleRepository) Delete(ctx context.Context, tx interfaces.Tx, ruleID int64) error {
+	ret := _m.Called(ctx, tx, ruleID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, ruleID)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r0 = rf(ctx, tx, ruleID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -95,14 +97,15 @@ type RuleRepository_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tx interfaces.Tx
 //   - ruleID int64
-func (_e *RuleRepository_Expecter) Delete(ctx interface{}, ruleID interface{}) *RuleRepository_Delete_Call {
-	return &RuleRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, ruleID)}
+func (_e *RuleRepository_Expecter) Delete(ctx interface{}, tx interface{}, ruleID interface{}) *RuleRepository_Delete_Call {
+	return &RuleRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, tx, ruleID)}
 }
 
-func (_c *RuleRepository_Delete_Call) Run(run func(ctx context.Context, ruleID int64)) *RuleRepository_Delete_Call {
+func (_c *RuleRepository_Delete_Call) Run(run func(ctx context.Context, tx interfaces.Tx, ruleID int64)) *RuleRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
 	})
 	return _c
 }
@@ -112,7 +115,7 @@ func (_c *RuleRepository_Delete_Call) Return(_a0 error) *RuleRepository_Delete_C
 	return _c
 }
 
-func (_c *RuleRepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *RuleRepository_Delete_Call {
+func (_c *RuleRepository_Delete_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) error) *RuleRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -175,29 +178,88 @@ func (_c *RuleRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]
 	return _c
 }
 
-// GetByTypeAndGrade provides a mock function with given fields: ctx, requestType, gradeID
-func (_m *RuleRepository) GetByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error) {
-	ret := _m.Called(ctx, requestType, gradeID)
+// GetAllForUpdate provides a mock function with given fields: ctx, tx
+func (_m *RuleRepository) GetAllForUpdate(ctx context.Context, tx interfaces.Tx) ([]models.Rule, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllForUpdate")
+	}
+
+	var r0 []models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) ([]models.Rule, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx) []models.Rule); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetAllForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllForUpdate'
+type RuleRepository_GetAllForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetAllForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+func (_e *RuleRepository_Expecter) GetAllForUpdate(ctx interface{}, tx interface{}) *RuleRepository_GetAllForUpdate_Call {
+	return &RuleRepository_GetAllForUpdate_Call{Call: _e.mock.On("GetAllForUpdate", ctx, tx)}
+}
+
+func (_c *RuleRepository_GetAllForUpdate_Call) Run(run func(ctx context.Context, tx interfaces.Tx)) *RuleRepository_GetAllForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetAllForUpdate_Call) Return(_a0 []models.Rule, _a1 error) *RuleRepository_GetAllForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetAllForUpdate_Call) RunAndReturn(run func(context.Context, interfaces.Tx) ([]models.Rule, error)) *RuleRepository_GetAllForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, tx, ruleID
+func (_m *RuleRepository) GetByID(ctx context.Context, tx interfaces.Tx, ruleID int64) (*models.Rule, error) {
+	ret := _m.Called(ctx, tx, ruleID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByTypeAndGrade")
+		panic("no return value specified for GetByID")
 	}
 
 	var r0 *models.Rule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*models.Rule, error)); ok {
-		return rf(ctx, requestType, gradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (*models.Rule, error)); ok {
+		return rf(ctx, tx, ruleID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *models.Rule); ok {
-		r0 = rf(ctx, requestType, gradeID)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) *models.Rule); ok {
+		r0 = rf(ctx, tx, ruleID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Rule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
-		r1 = rf(ctx, requestType, gradeID)
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, ruleID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -205,47 +267,213 @@ func (_m *RuleRepository) GetByTypeAndGrade(ctx context.Context, requestType str
 	return r0, r1
 }
 
-// RuleRepository_GetByTypeAndGrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTypeAndGrade'
-type RuleRepository_GetByTypeAndGrade_Call struct {
+// RuleRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type RuleRepository_GetByID_Call struct {
 	*mock.Call
 }
 
-// GetByTypeAndGrade is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - ruleID int64
+func (_e *RuleRepository_Expecter) GetByID(ctx interface{}, tx interface{}, ruleID interface{}) *RuleRepository_GetByID_Call {
+	return &RuleRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, tx, ruleID)}
+}
+
+func (_c *RuleRepository_GetByID_Call) Run(run func(ctx context.Context, tx interfaces.Tx, ruleID int64)) *RuleRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetByID_Call) Return(_a0 *models.Rule, _a1 error) *RuleRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetByID_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (*models.Rule, error)) *RuleRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByType provides a mock function with given fields: ctx, requestType
+func (_m *RuleRepository) GetByType(ctx context.Context, requestType string) ([]models.Rule, error) {
+	ret := _m.Called(ctx, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByType")
+	}
+
+	var r0 []models.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Rule, error)); ok {
+		return rf(ctx, requestType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Rule); ok {
+		r0 = rf(ctx, requestType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, requestType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByType'
+type RuleRepository_GetByType_Call struct {
+	*mock.Call
+}
+
+// GetByType is a helper method to define mock.On call
 //   - ctx context.Context
 //   - requestType string
-//   - gradeID int64
-func (_e *RuleRepository_Expecter) GetByTypeAndGrade(ctx interface{}, requestType interface{}, gradeID interface{}) *RuleRepository_GetByTypeAndGrade_Call {
-	return &RuleRepository_GetByTypeAndGrade_Call{Call: _e.mock.On("GetByTypeAndGrade", ctx, requestType, gradeID)}
+func (_e *RuleRepository_Expecter) GetByType(ctx interface{}, requestType interface{}) *RuleRepository_GetByType_Call {
+	return &RuleRepository_GetByType_Call{Call: _e.mock.On("GetByType", ctx, requestType)}
 }
 
-func (_c *RuleRepository_GetByTypeAndGrade_Call) Run(run func(ctx context.Context, requestType string, gradeID int64)) *RuleRepository_GetByTypeAndGrade_Call {
+func (_c *RuleRepository_GetByType_Call) Run(run func(ctx context.Context, requestType string)) *RuleRepository_GetByType_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *RuleRepository_GetByTypeAndGrade_Call) Return(_a0 *models.Rule, _a1 error) *RuleRepository_GetByTypeAndGrade_Call {
+func (_c *RuleRepository_GetByType_Call) Return(_a0 []models.Rule, _a1 error) *RuleRepository_GetByType_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RuleRepository_GetByTypeAndGrade_Call) RunAndReturn(run func(context.Context, string, int64) (*models.Rule, error)) *RuleRepository_GetByTypeAndGrade_Call {
+func (_c *RuleRepository_GetByType_Call) RunAndReturn(run func(context.Context, string) ([]models.Rule, error)) *RuleRepository_GetByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVersions provides a mock function with given fields: ctx, ruleID
+func (_m *RuleRepository) GetVersions(ctx context.Context, ruleID int64) ([]models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersions")
+	}
+
+	var r0 []models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]models.RuleVersion, error)); ok {
+		return rf(ctx, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []models.RuleVersion); ok {
+		r0 = rf(ctx, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RuleRepository_GetVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersions'
+type RuleRepository_GetVersions_Call struct {
+	*mock.Call
+}
+
+// GetVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID int64
+func (_e *RuleRepository_Expecter) GetVersions(ctx interface{}, ruleID interface{}) *RuleRepository_GetVersions_Call {
+	return &RuleRepository_GetVersions_Call{Call: _e.mock.On("GetVersions", ctx, ruleID)}
+}
+
+func (_c *RuleRepository_GetVersions_Call) Run(run func(ctx context.Context, ruleID int64)) *RuleRepository_GetVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_GetVersions_Call) Return(_a0 []models.RuleVersion, _a1 error) *RuleRepository_GetVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RuleRepository_GetVersions_Call) RunAndReturn(run func(context.Context, int64) ([]models.RuleVersion, error)) *RuleRepository_GetVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyChange provides a mock function with given fields: ctx, tx, requestType
+func (_m *RuleRepository) NotifyChange(ctx context.Context, tx interfaces.Tx, requestType string) error {
+	ret := _m.Called(ctx, tx, requestType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, string) error); ok {
+		r0 = rf(ctx, tx, requestType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RuleRepository_NotifyChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyChange'
+type RuleRepository_NotifyChange_Call struct {
+	*mock.Call
+}
+
+// NotifyChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - requestType string
+func (_e *RuleRepository_Expecter) NotifyChange(ctx interface{}, tx interface{}, requestType interface{}) *RuleRepository_NotifyChange_Call {
+	return &RuleRepository_NotifyChange_Call{Call: _e.mock.On("NotifyChange", ctx, tx, requestType)}
+}
+
+func (_c *RuleRepository_NotifyChange_Call) Run(run func(ctx context.Context, tx interfaces.Tx, requestType string)) *RuleRepository_NotifyChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RuleRepository_NotifyChange_Call) Return(_a0 error) *RuleRepository_NotifyChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RuleRepository_NotifyChange_Call) RunAndReturn(run func(context.Context, interfaces.Tx, string) error) *RuleRepository_NotifyChange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, ruleID, rule
-func (_m *RuleRepository) Update(ctx context.Context, ruleID int64, rule *models.Rule) error {
-	ret := _m.Called(ctx, ruleID, rule)
+// Update provides a mock function with given fields: ctx, tx, ruleID, rule
+func (_m *RuleRepository) Update(ctx context.Context, tx interfaces.Tx, ruleID int64, rule *models.Rule) error {
+	ret := _m.Called(ctx, tx, ruleID, rule)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *models.Rule) error); ok {
-		r0 = rf(ctx, ruleID, rule)
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64, *models.Rule) error); ok {
+		r0 = rf(ctx, tx, ruleID, rule)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -260,15 +488,16 @@ type RuleRepository_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tx interfaces.Tx
 //   - ruleID int64
 //   - rule *models.Rule
-func (_e *RuleRepository_Expecter) Update(ctx interface{}, ruleID interface{}, rule interface{}) *RuleRepository_Update_Call {
-	return &RuleRepository_Update_Call{Call: _e.mock.On("Update", ctx, ruleID, rule)}
+func (_e *RuleRepository_Expecter) Update(ctx interface{}, tx interface{}, ruleID interface{}, rule interface{}) *RuleRepository_Update_Call {
+	return &RuleRepository_Update_Call{Call: _e.mock.On("Update", ctx, tx, ruleID, rule)}
 }
 
-func (_c *RuleRepository_Update_Call) Run(run func(ctx context.Context, ruleID int64, rule *models.Rule)) *RuleRepository_Update_Call {
+func (_c *RuleRepository_Update_Call) Run(run func(ctx context.Context, tx interfaces.Tx, ruleID int64, rule *models.Rule)) *RuleRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*models.Rule))
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64), args[3].(*models.Rule))
 	})
 	return _c
 }
@@ -278,7 +507,7 @@ func (_c *RuleRepository_Update_Call) Return(_a0 error) *RuleRepository_Update_C
 	return _c
 }
 
-func (_c *RuleRepository_Update_Call) RunAndReturn(run func(context.Context, int64, *models.Rule) error) *RuleRepository_Update_Call {
+func (_c *RuleRepository_Update_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64, *models.Rule) error) *RuleRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
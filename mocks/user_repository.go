@@ -374,6 +374,66 @@ func (_c *UserRepository_GetRole_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// GetRuleSubject provides a mock function with given fields: ctx, tx, userID
+func (_m *UserRepository) GetRuleSubject(ctx context.Context, tx interfaces.Tx, userID int64) (*models.RuleSubject, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleSubject")
+	}
+
+	var r0 *models.RuleSubject
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) (*models.RuleSubject, error)); ok {
+		return rf(ctx, tx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interfaces.Tx, int64) *models.RuleSubject); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RuleSubject)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interfaces.Tx, int64) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetRuleSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleSubject'
+type UserRepository_GetRuleSubject_Call struct {
+	*mock.Call
+}
+
+// GetRuleSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx interfaces.Tx
+//   - userID int64
+func (_e *UserRepository_Expecter) GetRuleSubject(ctx interface{}, tx interface{}, userID interface{}) *UserRepository_GetRuleSubject_Call {
+	return &UserRepository_GetRuleSubject_Call{Call: _e.mock.On("GetRuleSubject", ctx, tx, userID)}
+}
+
+func (_c *UserRepository_GetRuleSubject_Call) Run(run func(ctx context.Context, tx interfaces.Tx, userID int64)) *UserRepository_GetRuleSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interfaces.Tx), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetRuleSubject_Call) Return(_a0 *models.RuleSubject, _a1 error) *UserRepository_GetRuleSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetRuleSubject_Call) RunAndReturn(run func(context.Context, interfaces.Tx, int64) (*models.RuleSubject, error)) *UserRepository_GetRuleSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
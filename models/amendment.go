@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// RequestAmendment records a requester's change to a pending request: the amended fields before and
+// after, and the Status the re-run rules left the request in
+type RequestAmendment struct {
+	ID          int64                  `json:"id"`
+	RequestType string                 `json:"request_type"`
+	RequestID   int64                  `json:"request_id"`
+	EmployeeID  int64                  `json:"employee_id"`
+	Before      map[string]interface{} `json:"before"`
+	After       map[string]interface{} `json:"after"`
+	Status      string                 `json:"status"`
+	AmendedAt   time.Time              `json:"amended_at"`
+}
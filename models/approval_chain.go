@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// ApprovalChain sends a request matching Condition through Steps, one approver after another
+type ApprovalChain struct {
+	ID          int64                  `json:"id"`
+	Name        string                 `json:"name"`
+	RequestType string                 `json:"request_type"`
+	Condition   map[string]interface{} `json:"condition"`
+	Priority    int                    `json:"priority"`
+	Steps       []ApprovalChainStep    `json:"steps"`
+	Active      bool                   `json:"active"`
+	CreatedBy   int64                  `json:"created_by"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+// ApprovalChainStep names who decides one step: the requester's MANAGER, their manager's manager
+// (SKIP_MANAGER), a manager or admin of a DEPARTMENT, or anyone with a ROLE
+type ApprovalChainStep struct {
+	Approver   string `json:"approver"`
+	Department string `json:"department,omitempty"`
+	Role       string `json:"role,omitempty"`
+}
+
+// RequestApprovalStep is one step of the chain a request is going through.
+// AssignedTo is set when the step was resolved to a person; otherwise Department or Role decide.
+// OnBehalfOf is the delegator a delegate decided, or may decide, the step for.
+type RequestApprovalStep struct {
+	ID          int64      `json:"id"`
+	RequestType string     `json:"request_type"`
+	RequestID   int64      `json:"request_id"`
+	EmployeeID  int64      `json:"employee_id"`
+	ChainID     int64      `json:"chain_id"`
+	StepNo      int        `json:"step_no"`
+	Approver    string     `json:"approver"`
+	AssignedTo  *int64     `json:"assigned_to,omitempty"`
+	Department  string     `json:"department,omitempty"`
+	Role        string     `json:"role,omitempty"`
+	Status      string     `json:"status"`
+	DecidedBy   *int64     `json:"decided_by,omitempty"`
+	OnBehalfOf  *int64     `json:"on_behalf_of,omitempty"`
+	Comment     string     `json:"comment,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ChainDecision is what deciding a request's current step did. Chained is false when the request
+// is not on a chain; Final is true once the request is settled: a rejection, or the last approval.
+type ChainDecision struct {
+	Chained    bool
+	Final      bool
+	OnBehalfOf *int64
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// HistoricalRequest is a past leave, expense or discount request flattened for replay
+type HistoricalRequest struct {
+	ID                 int64
+	EmployeeID         int64
+	RequestType        string
+	GradeID            int64
+	Role               string
+	ManagerID          *int64
+	Department         string
+	FromDate           *time.Time
+	ToDate             *time.Time
+	LeaveType          string
+	Amount             float64
+	Category           string
+	DiscountPercentage float64
+	Status             string
+	CreatedAt          time.Time
+}
+
+type BacktestBreakdown struct {
+	RequestType     string `json:"request_type"`
+	GradeID         int64  `json:"grade_id"`
+	TotalRequests   int    `json:"total_requests"`
+	Changed         int    `json:"changed"`
+	ManualToAuto    int    `json:"manual_to_auto"`
+	AutoToManual    int    `json:"auto_to_manual"`
+	CurrentManual   int    `json:"current_manual"`
+	CandidateManual int    `json:"candidate_manual"`
+}
+
+type BacktestReport struct {
+	TotalRequests            int                 `json:"total_requests"`
+	Changed                  int                 `json:"changed"`
+	ManualToAuto             int                 `json:"manual_to_auto"`
+	AutoToManual             int                 `json:"auto_to_manual"`
+	CurrentManual            int                 `json:"current_manual"`
+	CandidateManual          int                 `json:"candidate_manual"`
+	WorkloadReduction        int                 `json:"workload_reduction"`
+	WorkloadReductionPercent float64             `json:"workload_reduction_percentage"`
+	Breakdown                []BacktestBreakdown `json:"breakdown"`
+}
@@ -0,0 +1,18 @@
+package models
+
+// BulkDecision reports a bulk approval or rejection, one result per distinct request ID in the
+// order given. Each request is decided on its own, so some may succeed while others fail.
+type BulkDecision struct {
+	Results   []BulkItemResult `json:"results"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+}
+
+// BulkItemResult is the outcome of one request of a bulk decision; Error says why it failed,
+// in the words the caller would have seen had they decided that request on its own
+type BulkItemResult struct {
+	RequestID int64  `json:"request_id"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+	Err       error  `json:"-"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+
+// DecisionTrace records how the rule engine decided a request
+type DecisionTrace struct {
+	RequestType   string                 `json:"request_type"`
+	Inputs        map[string]interface{} `json:"inputs"`
+	Rules         []RuleTrace            `json:"rules"`
+	RuleID        *int64                 `json:"rule_id"`
+	RuleVersionID *int64                 `json:"rule_version_id"`
+	RuleVersion   int                    `json:"rule_version,omitempty"`
+	DefaultAction string                 `json:"default_action"`
+	Action        string                 `json:"action"`
+	Outcome       string                 `json:"outcome"`
+}
+
+// RuleTrace is one rule the engine evaluated, in priority order
+type RuleTrace = ruleengine.RuleTrace
+
+// ClauseTrace is a single comparison evaluated against the request's inputs
+type ClauseTrace = ruleengine.ClauseTrace
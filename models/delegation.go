@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// Delegation lets Delegate decide Delegator's approvals from StartDate to EndDate, inclusive.
+// LeaveRequestID is set on delegations created when the delegator's leave was approved.
+type Delegation struct {
+	ID             int64      `json:"id"`
+	DelegatorID    int64      `json:"delegator_id"`
+	DelegateID     int64      `json:"delegate_id"`
+	StartDate      time.Time  `json:"start_date"`
+	EndDate        time.Time  `json:"end_date"`
+	Reason         string     `json:"reason,omitempty"`
+	LeaveRequestID *int64     `json:"leave_request_id,omitempty"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Approver is who decides a request: the acting user and the role they decide with.
+// A delegate decides with the role of the delegator in OnBehalfOf.
+type Approver struct {
+	ID         int64
+	Role       string
+	OnBehalfOf *int64
+}
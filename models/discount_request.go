@@ -8,7 +8,10 @@ type DiscountRequest struct {
 	DiscountPercentage float64
 	Reason             string
 	Status             string
+	ApprovalComment    string
 	RuleID             *int64
+	RuleVersionID      *int64
+	DecisionTrace      *DecisionTrace
 	ApprovedByID       *int64
 	CreatedAt          time.Time
 }
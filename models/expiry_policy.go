@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// ExpiryPolicy is the SLA of a request type, or of its requests filed by one grade when GradeID is
+// set: a reminder after ReminderDays, and Action after ExpiryDays. Days count from the day the
+// request reached its current approver, so every escalation restarts them; they are working days
+// unless CalendarDays is set.
+type ExpiryPolicy struct {
+	ID           int64      `json:"id,omitempty"`
+	RequestType  string     `json:"request_type"`
+	GradeID      *int64     `json:"grade_id,omitempty"`
+	Action       string     `json:"action"`
+	ReminderDays *int       `json:"reminder_days,omitempty"`
+	ExpiryDays   int        `json:"expiry_days"`
+	CalendarDays bool       `json:"calendar_days"`
+	UpdatedBy    *int64     `json:"updated_by,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// PendingRequest is a pending request as the expiry job sees it. It waits for ManagerID until it
+// is escalated; then for EscalatedTo, or for the admins when EscalatedTo is nil. ResubmittedAt is
+// when the requester last answered a question on it.
+type PendingRequest struct {
+	ID              int64
+	EmployeeID      int64
+	GradeID         int64
+	ManagerID       *int64
+	EscalationLevel int
+	EscalatedTo     *int64
+	EscalatedAt     *time.Time
+	ResubmittedAt   *time.Time
+	CreatedAt       time.Time
+}
+
+// RequestEscalation records a reminder, an escalation hop or an expiry decision on a request.
+// A nil ToApproverID on an escalation hands the request to the admins.
+type RequestEscalation struct {
+	ID             int64     `json:"id"`
+	RequestType    string    `json:"request_type"`
+	RequestID      int64     `json:"request_id"`
+	EmployeeID     int64     `json:"employee_id"`
+	Event          string    `json:"event"`
+	Level          int       `json:"level"`
+	FromApproverID *int64    `json:"from_approver_id,omitempty"`
+	ToApproverID   *int64    `json:"to_approver_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ChainEscalation is what escalating a request's current chain step did. Chained is false when
+// the request is not on a chain; Escalated is false when the step already waits for the admins.
+type ChainEscalation struct {
+	Chained   bool
+	Escalated bool
+	From      *int64
+	To        *int64
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// InfoRequest is a question an approver asked on a request, and the requester's answer once they
+// resubmitted it. A request waits in NEEDS_INFO while its latest question is unanswered.
+type InfoRequest struct {
+	ID          int64      `json:"id"`
+	RequestType string     `json:"request_type"`
+	RequestID   int64      `json:"request_id"`
+	EmployeeID  int64      `json:"employee_id"`
+	AskedBy     int64      `json:"asked_by"`
+	Question    string     `json:"question"`
+	AskedAt     time.Time  `json:"asked_at"`
+	Answer      *string    `json:"answer,omitempty"`
+	AnsweredAt  *time.Time `json:"answered_at,omitempty"`
+}
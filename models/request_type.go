@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// RequestType declares a request kind served by the generic /api/requests/{type} pipeline
+type RequestType struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Fields      []RequestField `json:"fields"`
+	Balance     *RequestCharge `json:"balance,omitempty"`
+	// payload fields rule conditions may reference, besides grade, role and weekday
+	Attributes []string       `json:"attributes,omitempty"`
+	Policy     ApprovalPolicy `json:"policy"`
+}
+
+// RequestField is one field of a request type's payload
+type RequestField struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required,omitempty"`
+	Allowed  []string `json:"allowed,omitempty"`
+}
+
+// RequestCharge names the balance a request type draws on and the payload field it is charged by
+type RequestCharge struct {
+	Kind  string `json:"kind"`
+	Field string `json:"field"`
+}
+
+// ApprovalPolicy says who may decide a request type's pending requests
+type ApprovalPolicy struct {
+	ApproverRoles   []string `json:"approver_roles,omitempty"`
+	CommentRequired bool     `json:"comment_required,omitempty"`
+}
+
+// GenericRequest is a request of a registered request type
+type GenericRequest struct {
+	ID              int64
+	RequestType     string
+	EmployeeID      int64
+	Payload         map[string]interface{}
+	Status          string
+	ApprovalComment string
+	ApprovedByID    *int64
+	RuleID          *int64
+	RuleVersionID   *int64
+	DecisionTrace   *DecisionTrace
+	CreatedAt       time.Time
+}
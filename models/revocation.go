@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// RequestRevocation records an approved request withdrawn by an approver or admin: who revoked it,
+// the status it had and why
+type RequestRevocation struct {
+	ID             int64     `json:"id"`
+	RequestType    string    `json:"request_type"`
+	RequestID      int64     `json:"request_id"`
+	EmployeeID     int64     `json:"employee_id"`
+	RevokedBy      int64     `json:"revoked_by"`
+	PreviousStatus string    `json:"previous_status"`
+	Reason         string    `json:"reason"`
+	RevokedAt      time.Time `json:"revoked_at"`
+}
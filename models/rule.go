@@ -1,10 +1,114 @@
 package models
 
+import "time"
+
 type Rule struct {
 	ID          int64                  `json:"id"`
+	Key         string                 `json:"key,omitempty"`
 	RequestType string                 `json:"request_type"`
 	Condition   map[string]interface{} `json:"condition"`
 	Action      string                 `json:"action"`
-	GradeID     int64                  `json:"grade_id"`
+	Priority    int                    `json:"priority"`
+	Reason      string                 `json:"reason,omitempty"`
 	Active      bool                   `json:"active"`
+
+	// who the rule applies to: TargetID is the user for USER and the manager for TEAM scope
+	Scope      string `json:"scope"`
+	GradeID    int64  `json:"grade_id,omitempty"`
+	TargetID   int64  `json:"target_id,omitempty"`
+	Department string `json:"department,omitempty"`
+
+	// version the rule was read at; EffectiveFrom also schedules a create or update
+	VersionID     int64      `json:"version_id,omitempty"`
+	Version       int        `json:"version,omitempty"`
+	EffectiveFrom *time.Time `json:"effective_from,omitempty"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty"`
+}
+
+// RuleVersion is an immutable snapshot of a rule, in force between EffectiveFrom and EffectiveTo
+type RuleVersion struct {
+	ID            int64                  `json:"id"`
+	RuleID        int64                  `json:"rule_id"`
+	Version       int                    `json:"version"`
+	RequestType   string                 `json:"request_type"`
+	Condition     map[string]interface{} `json:"condition"`
+	Action        string                 `json:"action"`
+	Scope         string                 `json:"scope"`
+	GradeID       int64                  `json:"grade_id,omitempty"`
+	TargetID      int64                  `json:"target_id,omitempty"`
+	Department    string                 `json:"department,omitempty"`
+	Priority      int                    `json:"priority"`
+	Reason        string                 `json:"reason,omitempty"`
+	Active        bool                   `json:"active"`
+	EffectiveFrom time.Time              `json:"effective_from"`
+	EffectiveTo   *time.Time             `json:"effective_to"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+// RuleSubject is what rule scopes are matched against for one requester
+type RuleSubject struct {
+	UserID     int64
+	GradeID    int64
+	ManagerID  *int64
+	Department string
+	Role       string
+}
+
+type RuleFieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type RuleVersionDiff struct {
+	RuleID      int64             `json:"rule_id"`
+	FromVersion int               `json:"from_version"`
+	ToVersion   int               `json:"to_version"`
+	Changes     []RuleFieldChange `json:"changes"`
+}
+
+type RuleFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// RuleAuditFinding lists why a stored rule does not satisfy the condition schema of its request type
+type RuleAuditFinding struct {
+	RuleID      int64            `json:"rule_id"`
+	Version     int              `json:"version"`
+	RequestType string           `json:"request_type"`
+	Scope       string           `json:"scope"`
+	GradeID     int64            `json:"grade_id,omitempty"`
+	Errors      []RuleFieldError `json:"errors"`
+}
+
+type RuleAuditReport struct {
+	Checked  int                `json:"checked"`
+	Invalid  int                `json:"invalid"`
+	Findings []RuleAuditFinding `json:"findings"`
+}
+
+// RuleAnalysisFinding is one gap or conflict in the rule set; OtherRuleID is the rule that shadows or overlaps RuleID
+type RuleAnalysisFinding struct {
+	Kind        string `json:"kind"`
+	RequestType string `json:"request_type"`
+	GradeID     int64  `json:"grade_id,omitempty"`
+	RuleID      int64  `json:"rule_id,omitempty"`
+	OtherRuleID int64  `json:"other_rule_id,omitempty"`
+	Message     string `json:"message"`
+}
+
+type RuleAnalysisReport struct {
+	Grades   int                   `json:"grades"`
+	Rules    int                   `json:"rules"`
+	Findings []RuleAnalysisFinding `json:"findings"`
+}
+
+// RuleCacheStats counts rule lookups served from memory since the instance started
+type RuleCacheStats struct {
+	Hits          int64   `json:"hits"`
+	Misses        int64   `json:"misses"`
+	HitRate       float64 `json:"hit_rate"`
+	Invalidations int64   `json:"invalidations"`
+	RequestTypes  int     `json:"request_types"`
 }
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// RuleBundleVersion is the bundle format this build reads and writes
+const RuleBundleVersion = 1
+
+// RuleBundle is the full rule set, exported from one environment and imported into another
+type RuleBundle struct {
+	Version    int          `json:"version"`
+	ExportedAt time.Time    `json:"exported_at"`
+	Rules      []BundleRule `json:"rules"`
+}
+
+// BundleRule is a rule without its environment-specific id and version; Key identifies it across environments
+type BundleRule struct {
+	Key         string                 `json:"key"`
+	RequestType string                 `json:"request_type"`
+	Scope       string                 `json:"scope"`
+	GradeID     int64                  `json:"grade_id,omitempty"`
+	TargetID    int64                  `json:"target_id,omitempty"`
+	Department  string                 `json:"department,omitempty"`
+	Priority    int                    `json:"priority"`
+	Condition   map[string]interface{} `json:"condition"`
+	Action      string                 `json:"action"`
+	Reason      string                 `json:"reason,omitempty"`
+	Active      bool                   `json:"active"`
+}
+
+type RuleImportChange struct {
+	Key     string            `json:"key"`
+	RuleID  int64             `json:"rule_id,omitempty"`
+	Changes []RuleFieldChange `json:"changes,omitempty"`
+}
+
+// RuleImportPlan lists what importing a bundle changes; an applied import is proposed for approval first
+type RuleImportPlan struct {
+	ProposalID int64              `json:"proposal_id,omitempty"`
+	Creates    []RuleImportChange `json:"creates"`
+	Updates    []RuleImportChange `json:"updates"`
+	Deletes    []RuleImportChange `json:"deletes"`
+	Unchanged  int                `json:"unchanged"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// RuleProposal is a rule change waiting for a second admin.
+// Before is the rule when the change was proposed; After is the proposed rule; an import carries its bundle and plan.
+type RuleProposal struct {
+	ID            int64           `json:"id"`
+	Action        string          `json:"action"`
+	RuleID        *int64          `json:"rule_id,omitempty"`
+	Before        *Rule           `json:"before,omitempty"`
+	After         *Rule           `json:"after,omitempty"`
+	Bundle        *RuleBundle     `json:"bundle,omitempty"`
+	Plan          *RuleImportPlan `json:"plan,omitempty"`
+	Status        string          `json:"status"`
+	ProposedBy    int64           `json:"proposed_by"`
+	ReviewedBy    *int64          `json:"reviewed_by,omitempty"`
+	ReviewComment string          `json:"review_comment,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	ReviewedAt    *time.Time      `json:"reviewed_at,omitempty"`
+}
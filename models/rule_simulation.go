@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// RuleSimulation describes a hypothetical request to run through the rule set.
+// Either UserID or GradeID identifies who is asking; UserID also checks balances.
+type RuleSimulation struct {
+	RequestType        string
+	UserID             int64
+	GradeID            int64
+	FromDate           time.Time
+	ToDate             time.Time
+	Days               int
+	LeaveType          string
+	Amount             float64
+	Category           string
+	DiscountPercentage float64
+}
@@ -1,6 +1,10 @@
 package apperrors
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+)
 
 // --- Leave-related errors ---
 var (
@@ -114,13 +118,107 @@ var (
 
 // --- Rule Service errors ---
 var (
-	ErrNoRuleFound           = errors.New("no rule found")
-	ErrRequestTypeRequired   = errors.New("request_type is required")
-	ErrActionRequired        = errors.New("action is required")
-	ErrGradeIDRequired       = errors.New("grade_id is required")
-	ErrConditionRequired     = errors.New("condition is required")
-	ErrInvalidConditionJSON  = errors.New("invalid condition JSON")
-	ErrRuleNotFoundForDelete = errors.New("rule not found")
+	ErrNoRuleFound            = errors.New("no rule found")
+	ErrRequestTypeRequired    = errors.New("request_type is required")
+	ErrActionRequired         = errors.New("action is required")
+	ErrGradeIDRequired        = errors.New("grade_id is required")
+	ErrConditionRequired      = ruleengine.ErrConditionRequired
+	ErrInvalidConditionJSON   = errors.New("invalid condition JSON")
+	ErrInvalidCondition       = ruleengine.ErrInvalidCondition
+	ErrInvalidPriority        = errors.New("priority must not be negative")
+	ErrInvalidAction          = errors.New("action must be AUTO_APPROVE, MANUAL or AUTO_REJECT")
+	ErrRejectReasonRequired   = errors.New("reason is required for AUTO_REJECT rules")
+	ErrEffectiveDateInPast    = errors.New("effective_from must not be in the past")
+	ErrRuleVersionNotFound    = errors.New("rule version not found")
+	ErrCandidateRulesRequired = errors.New("candidate rules are required")
+	ErrSimulationTarget       = errors.New("user_id or grade_id is required")
+	ErrUnknownRequestType     = errors.New("unknown request_type")
+	ErrRuleNotFoundForDelete  = errors.New("rule not found")
+	ErrInvalidDecisionTrace   = errors.New("invalid decision trace")
+	ErrInvalidScope           = errors.New("scope must be USER, TEAM, DEPARTMENT, GRADE or GLOBAL")
+	ErrScopeTargetRequired    = errors.New("target_id is required for USER and TEAM rules")
+	ErrDepartmentRequired     = errors.New("department is required for DEPARTMENT rules")
+	ErrDuplicateRuleKey       = errors.New("a rule with this key already exists")
+)
+
+// --- Rule bundle errors ---
+var (
+	ErrUnsupportedBundleVersion = errors.New("unsupported rule bundle version")
+	ErrUnsupportedBundleFormat  = errors.New("format must be json or yaml")
+	ErrBundleKeyRequired        = errors.New("key is required for every bundled rule")
+	ErrDuplicateBundleKey       = errors.New("rule keys must be unique within a bundle")
+)
+
+// --- Rule proposal errors ---
+var (
+	ErrProposalNotFound    = errors.New("rule proposal not found")
+	ErrProposalNotPending  = errors.New("rule proposal has already been reviewed")
+	ErrSelfApproval        = errors.New("a rule change must be approved by a different admin")
+	ErrProposalOutdated    = errors.New("the rule changed after this proposal was made; propose the change again")
+	ErrInvalidRuleProposal = errors.New("invalid rule proposal")
+)
+
+// --- Request type registry errors ---
+var (
+	ErrInvalidRequestTypeDefinition = errors.New("invalid request type definition")
+	ErrRequestTypeExists            = errors.New("request type is already registered")
+	ErrRequestNotFound              = errors.New("request not found")
+	ErrApproverRoleNotAllowed       = errors.New("this role cannot decide requests of this type")
+)
+
+// --- Approval chain errors ---
+var (
+	ErrChainNameRequired      = errors.New("name is required")
+	ErrChainStepsRequired     = errors.New("an approval chain needs at least one step")
+	ErrInvalidChainStep       = errors.New("invalid approval chain step")
+	ErrChainNotFound          = errors.New("approval chain not found")
+	ErrNotStepApprover        = errors.New("the current approval step is assigned to another approver")
+	ErrApproverAlreadyDecided = errors.New("an approver may only decide one step of a request")
+)
+
+// --- Delegation errors ---
+var (
+	ErrDelegatorNotApprover = errors.New("only managers and admins can delegate approvals")
+	ErrInvalidDelegate      = errors.New("delegate must be another manager or admin")
+	ErrInvalidDelegation    = errors.New("delegation must end on or after its start and not in the past")
+	ErrDelegationOverlap    = errors.New("a delegation already covers part of these dates")
+	ErrDelegationNotFound   = errors.New("delegation not found")
+)
+
+// --- Expiry policy errors ---
+var (
+	ErrExpiryPolicyType     = errors.New("expiry policies apply to LEAVE, EXPENSE and DISCOUNT requests")
+	ErrInvalidExpiryAction  = errors.New("expiry action must be ESCALATE, AUTO_APPROVE or AUTO_REJECT")
+	ErrInvalidExpiryDays    = errors.New("expiry days must be greater than zero")
+	ErrInvalidReminderDays  = errors.New("reminder days must be greater than zero and less than expiry days")
+	ErrExpiryPolicyNotFound = errors.New("expiry policy not found")
+	ErrExpiryPolicyGrade    = errors.New("grade_id must name an existing grade")
+)
+
+// --- Information request errors ---
+var (
+	ErrQuestionRequired       = errors.New("question is required")
+	ErrAnswerRequired         = errors.New("answer is required")
+	ErrRequestNotAwaitingInfo = errors.New("request is not waiting for more information")
+	ErrInfoRequestNotFound    = errors.New("no open question on this request")
+)
+
+// --- Amendment errors ---
+var (
+	ErrAmendmentUnchanged = errors.New("amendment changes nothing on the request")
+)
+
+// --- Revocation errors ---
+var (
+	ErrRevokeReasonRequired = errors.New("a reason is required to revoke a request")
+	ErrRequestNotApproved   = errors.New("only approved requests can be revoked")
+	ErrLeaveAlreadyStarted  = errors.New("leave that has already started cannot be revoked")
+)
+
+// --- Bulk decision errors ---
+var (
+	ErrBulkRequestIDsMissing = errors.New("request_ids must list at least one request")
+	ErrBulkTooManyRequests   = errors.New("at most 100 requests can be decided at once")
 )
 
 // --- Shared / Generic errors ---
@@ -0,0 +1,200 @@
+package ruleengine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// numberRange is the interval a numeric attribute must fall in; open ends exclude the bound
+type numberRange struct {
+	lo, hi         float64
+	loOpen, hiOpen bool
+}
+
+var unbounded = numberRange{lo: math.Inf(-1), hi: math.Inf(1)}
+
+func (r numberRange) intersect(o numberRange) numberRange {
+	if o.lo > r.lo || (o.lo == r.lo && o.loOpen) {
+		r.lo, r.loOpen = o.lo, o.loOpen
+	}
+	if o.hi < r.hi || (o.hi == r.hi && o.hiOpen) {
+		r.hi, r.hiOpen = o.hi, o.hiOpen
+	}
+	return r
+}
+
+func (r numberRange) empty() bool {
+	return r.lo > r.hi || (r.lo == r.hi && (r.loOpen || r.hiOpen))
+}
+
+// contains reports whether every value of o is also in r
+func (r numberRange) contains(o numberRange) bool {
+	if o.empty() {
+		return true
+	}
+	loOK := r.lo < o.lo || (r.lo == o.lo && (!r.loOpen || o.loOpen))
+	hiOK := r.hi > o.hi || (r.hi == o.hi && (!r.hiOpen || o.hiOpen))
+	return loOK && hiOK
+}
+
+// ConditionBounds summarises what a condition requires of each attribute it references.
+// Only conditions made of "all" groups and comparisons can be summarised exactly.
+type ConditionBounds struct {
+	numbers map[string]numberRange
+	// allowed values of string and boolean attributes, upper-cased since string facts compare case-insensitively
+	strings map[string][]string
+}
+
+// BoundsOf summarises a parsed condition; false when it uses any, not, != or a numeric "in" list,
+// whose matches cannot be described as one range per attribute
+func BoundsOf(cond Condition) (ConditionBounds, bool) {
+	b := ConditionBounds{numbers: map[string]numberRange{}, strings: map[string][]string{}}
+	if !b.add(cond) {
+		return ConditionBounds{}, false
+	}
+	return b, true
+}
+
+func (b ConditionBounds) add(cond Condition) bool {
+	switch c := cond.(type) {
+	case allCondition:
+		for _, child := range c {
+			if !b.add(child) {
+				return false
+			}
+		}
+		return true
+	case comparison:
+		return b.addComparison(c)
+	}
+	return false
+}
+
+func (b ConditionBounds) addComparison(c comparison) bool {
+	if c.kind == KindString || c.kind == KindBool {
+		var values []string
+		switch c.op {
+		case OpEqual:
+			values = []string{strings.ToUpper(fmt.Sprint(c.value))}
+		case OpIn:
+			for _, v := range c.value.([]interface{}) {
+				values = append(values, strings.ToUpper(fmt.Sprint(v)))
+			}
+		default:
+			return false
+		}
+		if existing, ok := b.strings[c.attr]; ok {
+			values = intersectStrings(existing, values)
+		}
+		b.strings[c.attr] = values
+		return true
+	}
+
+	r := unbounded
+	switch c.op {
+	case OpLess:
+		r.hi, r.hiOpen = c.value.(float64), true
+	case OpLessEqual:
+		r.hi = c.value.(float64)
+	case OpGreater:
+		r.lo, r.loOpen = c.value.(float64), true
+	case OpGreaterEqual:
+		r.lo = c.value.(float64)
+	case OpEqual:
+		r.lo, r.hi = c.value.(float64), c.value.(float64)
+	case OpBetween:
+		bounds := c.value.([]interface{})
+		r.lo, r.hi = bounds[0].(float64), bounds[1].(float64)
+	case OpIn:
+		items := c.value.([]interface{})
+		if len(items) != 1 {
+			return false
+		}
+		r.lo, r.hi = items[0].(float64), items[0].(float64)
+	default:
+		return false
+	}
+
+	b.numbers[c.attr] = b.numberRange(c.attr).intersect(r)
+	return true
+}
+
+func (b ConditionBounds) numberRange(attr string) numberRange {
+	if r, ok := b.numbers[attr]; ok {
+		return r
+	}
+	return unbounded
+}
+
+// Satisfiable reports whether any request can meet the bounds
+func (b ConditionBounds) Satisfiable() bool {
+	for _, r := range b.numbers {
+		if r.empty() {
+			return false
+		}
+	}
+	for _, values := range b.strings {
+		if len(values) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Covers reports whether every request meeting other also meets b
+func (b ConditionBounds) Covers(other ConditionBounds) bool {
+	for attr, r := range b.numbers {
+		if !r.contains(other.numberRange(attr)) {
+			return false
+		}
+	}
+	for attr, values := range b.strings {
+		otherValues, ok := other.strings[attr]
+		if !ok {
+			return false
+		}
+		for _, v := range otherValues {
+			if !contains(values, v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Overlaps reports whether some request meets both b and other
+func (b ConditionBounds) Overlaps(other ConditionBounds) bool {
+	merged := ConditionBounds{numbers: map[string]numberRange{}, strings: map[string][]string{}}
+	for _, side := range []ConditionBounds{b, other} {
+		for attr, r := range side.numbers {
+			merged.numbers[attr] = merged.numberRange(attr).intersect(r)
+		}
+		for attr, values := range side.strings {
+			if existing, ok := merged.strings[attr]; ok {
+				values = intersectStrings(existing, values)
+			}
+			merged.strings[attr] = values
+		}
+	}
+	return merged.Satisfiable()
+}
+
+// Max returns the largest value of a numeric attribute the bounds allow, false when it is not capped
+func (b ConditionBounds) Max(attr string) (float64, bool) {
+	r := b.numberRange(attr)
+	if math.IsInf(r.hi, 1) {
+		return 0, false
+	}
+	return r.hi, true
+}
+
+func intersectStrings(a, b []string) []string {
+	out := []string{}
+	for _, v := range a {
+		if contains(b, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,598 @@
+package ruleengine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrConditionRequired = errors.New("condition is required")
+	ErrInvalidCondition  = errors.New("invalid rule condition")
+)
+
+// Comparison operators supported in a condition clause
+const (
+	OpLess         = "<"
+	OpLessEqual    = "<="
+	OpGreater      = ">"
+	OpGreaterEqual = ">="
+	OpEqual        = "=="
+	OpNotEqual     = "!="
+	OpIn           = "in"
+	OpBetween      = "between"
+)
+
+// Group keys of a condition node
+const (
+	groupAll = "all"
+	groupAny = "any"
+	groupNot = "not"
+)
+
+// Kind is the type of value an attribute holds
+type Kind int
+
+const (
+	KindNumber Kind = iota
+	KindString
+	KindBool
+)
+
+// Attribute describes a fact conditions may compare against
+type Attribute struct {
+	Kind Kind
+	// Allowed lists the only values a string attribute may be compared with, matched case-insensitively
+	Allowed []string
+}
+
+// Schema lists what conditions may reference
+type Schema struct {
+	// Name appears in errors about attributes the schema does not make available
+	Name       string
+	Attributes map[string]Attribute
+	// Limits are single-key shorthands, such as {"max_days": 3}, for "attribute <= value"
+	Limits map[string]string
+	// Available, when set, narrows the attributes and limit keys conditions may use to those listed
+	Available []string
+	// NonNegative makes Validate reject negative numeric limits
+	NonNegative bool
+}
+
+func (s Schema) available(key string) bool {
+	return s.Available == nil || contains(s.Available, key)
+}
+
+// Facts holds the attribute values a condition is evaluated against
+type Facts map[string]interface{}
+
+// Condition is a parsed rule condition
+type Condition interface {
+	Evaluate(facts Facts) bool
+	// eval records each comparison it checks when trace is not nil
+	eval(facts Facts, trace *[]ClauseTrace) bool
+}
+
+// ClauseTrace is a single comparison evaluated against the facts
+type ClauseTrace struct {
+	Path     string      `json:"path"`
+	Attr     string      `json:"attr"`
+	Op       string      `json:"op"`
+	Expected interface{} `json:"expected"`
+	Actual   interface{} `json:"actual"`
+	Result   bool        `json:"result"`
+}
+
+// Explain evaluates a condition and returns the comparisons it checked,
+// in evaluation order; groups short-circuit exactly as in Evaluate
+func Explain(cond Condition, facts Facts) (bool, []ClauseTrace) {
+	trace := []ClauseTrace{}
+	result := cond.eval(facts, &trace)
+	return result, trace
+}
+
+// ConditionError describes why a rule condition could not be parsed
+type ConditionError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *ConditionError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+func (e *ConditionError) Unwrap() error {
+	return ErrInvalidCondition
+}
+
+// ConditionErrors lists every problem found in a condition, in document order
+type ConditionErrors []*ConditionError
+
+func (e ConditionErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e ConditionErrors) Unwrap() error {
+	return ErrInvalidCondition
+}
+
+type allCondition []Condition
+
+func (c allCondition) Evaluate(facts Facts) bool {
+	return c.eval(facts, nil)
+}
+
+func (c allCondition) eval(facts Facts, trace *[]ClauseTrace) bool {
+	for _, child := range c {
+		if !child.eval(facts, trace) {
+			return false
+		}
+	}
+	return true
+}
+
+type anyCondition []Condition
+
+func (c anyCondition) Evaluate(facts Facts) bool {
+	return c.eval(facts, nil)
+}
+
+func (c anyCondition) eval(facts Facts, trace *[]ClauseTrace) bool {
+	for _, child := range c {
+		if child.eval(facts, trace) {
+			return true
+		}
+	}
+	return false
+}
+
+type notCondition struct {
+	inner Condition
+}
+
+func (c notCondition) Evaluate(facts Facts) bool {
+	return c.eval(facts, nil)
+}
+
+func (c notCondition) eval(facts Facts, trace *[]ClauseTrace) bool {
+	return !c.inner.eval(facts, trace)
+}
+
+type comparison struct {
+	path  string
+	attr  string
+	kind  Kind
+	op    string
+	value interface{}
+}
+
+func (c comparison) Evaluate(facts Facts) bool {
+	return c.eval(facts, nil)
+}
+
+func (c comparison) eval(facts Facts, trace *[]ClauseTrace) bool {
+	result := c.compare(facts)
+	if trace != nil {
+		*trace = append(*trace, ClauseTrace{
+			Path:     c.path,
+			Attr:     c.attr,
+			Op:       c.op,
+			Expected: c.value,
+			Actual:   facts[c.attr],
+			Result:   result,
+		})
+	}
+	return result
+}
+
+// compare returns false when the fact is missing or has the wrong type,
+// so a rule never matches on data it cannot see
+func (c comparison) compare(facts Facts) bool {
+	fact, ok := facts[c.attr]
+	if !ok {
+		return false
+	}
+
+	switch c.op {
+	case OpIn:
+		for _, v := range c.value.([]interface{}) {
+			if valuesEqual(fact, v) {
+				return true
+			}
+		}
+		return false
+	case OpBetween:
+		n, ok := toNumber(fact)
+		if !ok {
+			return false
+		}
+		bounds := c.value.([]interface{})
+		return n >= bounds[0].(float64) && n <= bounds[1].(float64)
+	case OpEqual:
+		return valuesEqual(fact, c.value)
+	case OpNotEqual:
+		return !valuesEqual(fact, c.value)
+	}
+
+	n, ok := toNumber(fact)
+	if !ok {
+		return false
+	}
+	limit := c.value.(float64)
+
+	switch c.op {
+	case OpLess:
+		return n < limit
+	case OpLessEqual:
+		return n <= limit
+	case OpGreater:
+		return n > limit
+	case OpGreaterEqual:
+		return n >= limit
+	}
+	return false
+}
+
+type conditionParser struct {
+	schema Schema
+	// strict is set while validating a condition being authored
+	strict bool
+	errs   ConditionErrors
+}
+
+func (p *conditionParser) fail(field, message string) {
+	p.errs = append(p.errs, &ConditionError{Field: field, Message: message})
+}
+
+// Parse parses a JSON condition against a schema, failing on the first problem
+func Parse(schema Schema, raw map[string]interface{}) (Condition, error) {
+	p := &conditionParser{schema: schema}
+	cond, err := p.parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.errs) > 0 {
+		return nil, p.errs[0]
+	}
+	return cond, nil
+}
+
+// Validate checks a condition being authored: unknown keys and attributes, wrong value types and,
+// when the schema asks for it, negative limits are all reported, each with the path of the offending field.
+// The returned error is a ConditionErrors when the condition itself is invalid.
+func Validate(schema Schema, raw map[string]interface{}) error {
+	p := &conditionParser{schema: schema, strict: true}
+	if _, err := p.parse(raw); err != nil {
+		return err
+	}
+	if len(p.errs) > 0 {
+		return p.errs
+	}
+	return nil
+}
+
+func (p *conditionParser) parse(raw map[string]interface{}) (Condition, error) {
+	if len(raw) == 0 {
+		return nil, ErrConditionRequired
+	}
+
+	// normalise Go literals (ints, typed slices) into their JSON shapes
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, &ConditionError{Field: "condition", Message: "must be valid JSON"}
+	}
+	var node map[string]interface{}
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, &ConditionError{Field: "condition", Message: "must be valid JSON"}
+	}
+
+	return p.parseNode(node, "condition"), nil
+}
+
+// parseNode returns nil when the node is invalid; the reasons are recorded on the parser
+func (p *conditionParser) parseNode(node map[string]interface{}, path string) Condition {
+	if len(node) == 0 {
+		p.fail(path, "must not be empty")
+		return nil
+	}
+
+	if _, ok := node["attr"]; ok {
+		return p.parseComparison(node, path)
+	}
+
+	if !hasGroupKey(node) {
+		return p.parseLimits(node, path)
+	}
+
+	if len(node) != 1 {
+		p.fail(path, "must contain exactly one of all, any, not or attr")
+		return nil
+	}
+
+	for key, value := range node {
+		switch key {
+		case groupAll, groupAny:
+			children := p.parseGroup(value, path+"."+key)
+			if children == nil {
+				return nil
+			}
+			if key == groupAll {
+				return allCondition(children)
+			}
+			return anyCondition(children)
+		case groupNot:
+			child, ok := value.(map[string]interface{})
+			if !ok {
+				p.fail(path+".not", "must be an object")
+				return nil
+			}
+			inner := p.parseNode(child, path+".not")
+			if inner == nil {
+				return nil
+			}
+			return notCondition{inner: inner}
+		}
+	}
+
+	return nil
+}
+
+func (p *conditionParser) parseGroup(value interface{}, path string) []Condition {
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		p.fail(path, "must be a non-empty list")
+		return nil
+	}
+
+	valid := true
+	children := make([]Condition, 0, len(items))
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		child, ok := item.(map[string]interface{})
+		if !ok {
+			p.fail(itemPath, "must be an object")
+			valid = false
+			continue
+		}
+		parsed := p.parseNode(child, itemPath)
+		if parsed == nil {
+			valid = false
+			continue
+		}
+		children = append(children, parsed)
+	}
+
+	if !valid {
+		return nil
+	}
+	return children
+}
+
+func (p *conditionParser) parseComparison(node map[string]interface{}, path string) Condition {
+	valid := true
+	for _, key := range sortedKeys(node) {
+		if key != "attr" && key != "op" && key != "value" {
+			p.fail(path+"."+key, "unknown key")
+			valid = false
+		}
+	}
+
+	attr, ok := node["attr"].(string)
+	if !ok {
+		p.fail(path+".attr", "must be a string")
+		return nil
+	}
+	spec, ok := p.schema.Attributes[attr]
+	if !ok {
+		p.fail(path+".attr", fmt.Sprintf("unknown attribute %q", attr))
+		return nil
+	}
+	if !p.schema.available(attr) {
+		p.fail(path+".attr", fmt.Sprintf("attribute %q is not available for %s rules", attr, p.schema.Name))
+		return nil
+	}
+
+	op, ok := node["op"].(string)
+	if !ok {
+		p.fail(path+".op", "must be a string")
+		return nil
+	}
+
+	value, exists := node["value"]
+	if !exists {
+		p.fail(path+".value", "is required")
+		return nil
+	}
+	valuePath := path + ".value"
+
+	switch op {
+	case OpLess, OpLessEqual, OpGreater, OpGreaterEqual:
+		if spec.Kind != KindNumber {
+			p.fail(path+".op", fmt.Sprintf("%s is only valid for numeric attributes", op))
+			return nil
+		}
+		valid = p.checkNumber(value, valuePath) && valid
+	case OpEqual, OpNotEqual:
+		valid = p.checkScalar(spec, value, valuePath) && valid
+	case OpIn:
+		items, ok := value.([]interface{})
+		if !ok || len(items) == 0 {
+			p.fail(valuePath, "must be a non-empty list")
+			return nil
+		}
+		for i, item := range items {
+			valid = p.checkScalar(spec, item, fmt.Sprintf("%s[%d]", valuePath, i)) && valid
+		}
+	case OpBetween:
+		if spec.Kind != KindNumber {
+			p.fail(path+".op", "between is only valid for numeric attributes")
+			return nil
+		}
+		bounds, ok := value.([]interface{})
+		if !ok || len(bounds) != 2 {
+			p.fail(valuePath, "must be a [min, max] pair")
+			return nil
+		}
+		lo, okLo := bounds[0].(float64)
+		hi, okHi := bounds[1].(float64)
+		if !okLo || !okHi {
+			p.fail(valuePath, "bounds must be numbers")
+			return nil
+		}
+		if lo > hi {
+			p.fail(valuePath, "min must not exceed max")
+			return nil
+		}
+		valid = p.checkNumber(lo, valuePath+"[0]") && valid
+	default:
+		p.fail(path+".op", fmt.Sprintf("unsupported operator %q", op))
+		return nil
+	}
+
+	if !valid {
+		return nil
+	}
+	return comparison{path: path, attr: attr, kind: spec.Kind, op: op, value: value}
+}
+
+func (p *conditionParser) checkNumber(value interface{}, path string) bool {
+	n, ok := value.(float64)
+	if !ok {
+		p.fail(path, "must be a number")
+		return false
+	}
+	if p.strict && p.schema.NonNegative && n < 0 {
+		p.fail(path, "must not be negative")
+		return false
+	}
+	return true
+}
+
+func (p *conditionParser) checkScalar(spec Attribute, value interface{}, path string) bool {
+	switch spec.Kind {
+	case KindNumber:
+		return p.checkNumber(value, path)
+	case KindBool:
+		if _, ok := value.(bool); !ok {
+			p.fail(path, "must be true or false")
+			return false
+		}
+	case KindString:
+		s, ok := value.(string)
+		if !ok {
+			p.fail(path, "must be a string")
+			return false
+		}
+		if len(spec.Allowed) > 0 && !containsFold(spec.Allowed, s) {
+			p.fail(path, "must be one of "+strings.Join(spec.Allowed, ", "))
+			return false
+		}
+	}
+	return true
+}
+
+func hasGroupKey(node map[string]interface{}) bool {
+	for key := range node {
+		if key == groupAll || key == groupAny || key == groupNot {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLimits handles single-level shorthand conditions such as {"max_days": 3}
+func (p *conditionParser) parseLimits(node map[string]interface{}, path string) Condition {
+	valid := true
+	clauses := make(allCondition, 0, len(node))
+	for _, key := range sortedKeys(node) {
+		keyPath := path + "." + key
+		attr, ok := p.schema.Limits[key]
+		if !ok {
+			p.fail(keyPath, "unknown key")
+			valid = false
+			continue
+		}
+		if !p.schema.available(key) {
+			p.fail(keyPath, fmt.Sprintf("%s is not available for %s rules", key, p.schema.Name))
+			valid = false
+			continue
+		}
+		if !p.checkNumber(node[key], keyPath) {
+			valid = false
+			continue
+		}
+		clauses = append(clauses, comparison{path: keyPath, attr: attr, kind: KindNumber, op: OpLessEqual, value: node[key]})
+	}
+
+	if !valid {
+		return nil
+	}
+	if len(clauses) == 1 {
+		return clauses[0]
+	}
+	return clauses
+}
+
+func sortedKeys(node map[string]interface{}) []string {
+	keys := make([]string, 0, len(node))
+	for key := range node {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func valuesEqual(fact, value interface{}) bool {
+	if b, ok := value.(bool); ok {
+		f, ok := fact.(bool)
+		return ok && f == b
+	}
+	if s, ok := value.(string); ok {
+		f, ok := fact.(string)
+		return ok && strings.EqualFold(f, s)
+	}
+
+	n, ok := toNumber(fact)
+	v, okV := toNumber(value)
+	return ok && okV && n == v
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+// Package ruleengine evaluates approval rules against the facts of a request.
+// It has no database or HTTP dependencies: rules are compiled once against a Schema
+// and can then decide any number of requests, so the same policies can be evaluated
+// by other services or offline.
+package ruleengine
+
+// Rule is a condition and the action to take when it holds
+type Rule struct {
+	ID        int64                  `json:"id"`
+	VersionID int64                  `json:"version_id,omitempty"`
+	Version   int                    `json:"version,omitempty"`
+	Priority  int                    `json:"priority"`
+	Action    string                 `json:"action"`
+	Condition map[string]interface{} `json:"condition"`
+}
+
+// CompiledRule is a rule whose condition has been parsed and is ready to evaluate.
+// A rule whose condition does not parse is kept, records the error and never matches.
+type CompiledRule struct {
+	Rule
+	cond Condition
+	err  error
+}
+
+// Compile parses a rule's condition against the schema
+func Compile(schema Schema, rule Rule) *CompiledRule {
+	cond, err := Parse(schema, rule.Condition)
+	return &CompiledRule{Rule: rule, cond: cond, err: err}
+}
+
+// Err returns why the rule's condition could not be parsed, nil when it compiled
+func (r *CompiledRule) Err() error {
+	return r.err
+}
+
+// Matches reports whether the rule's condition holds for the facts
+func (r *CompiledRule) Matches(facts Facts) bool {
+	return r.err == nil && r.cond.Evaluate(facts)
+}
+
+// RuleTrace is one rule the engine evaluated
+type RuleTrace struct {
+	RuleID    int64         `json:"rule_id"`
+	VersionID int64         `json:"version_id,omitempty"`
+	Version   int           `json:"version,omitempty"`
+	Priority  int           `json:"priority"`
+	Action    string        `json:"action"`
+	Matched   bool          `json:"matched"`
+	Clauses   []ClauseTrace `json:"clauses"`
+	Error     string        `json:"error,omitempty"`
+}
+
+func (r *CompiledRule) explain(facts Facts) RuleTrace {
+	trace := RuleTrace{
+		RuleID:    r.ID,
+		VersionID: r.VersionID,
+		Version:   r.Version,
+		Priority:  r.Priority,
+		Action:    r.Action,
+		Clauses:   []ClauseTrace{},
+	}
+	if r.err != nil {
+		trace.Error = r.err.Error()
+		return trace
+	}
+	trace.Matched, trace.Clauses = Explain(r.cond, facts)
+	return trace
+}
+
+// RuleSet is an ordered list of compiled rules; the first one that matches decides
+type RuleSet []*CompiledRule
+
+// CompileRuleSet compiles rules, keeping their order
+func CompileRuleSet(schema Schema, rules []Rule) RuleSet {
+	set := make(RuleSet, len(rules))
+	for i, rule := range rules {
+		set[i] = Compile(schema, rule)
+	}
+	return set
+}
+
+// Decision is the outcome of evaluating a rule set
+type Decision struct {
+	// Action is the deciding rule's action, or the default action when no rule matched
+	Action string `json:"action"`
+	// Index is the position of the deciding rule in the set, -1 when the default action applied
+	Index int `json:"-"`
+	// Rule is the deciding rule, nil when the default action applied
+	Rule *Rule `json:"rule"`
+	// Trace lists every rule evaluated, in order, up to and including the deciding one
+	Trace []RuleTrace `json:"trace"`
+}
+
+// Match returns the position of the first rule that matches, -1 when none does
+func (s RuleSet) Match(facts Facts) int {
+	for i, rule := range s {
+		if rule.Matches(facts) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Decide walks the rules top-down and records how each was evaluated;
+// when no rule matches, defaultAction applies
+func (s RuleSet) Decide(facts Facts, defaultAction string) Decision {
+	decision := Decision{Action: defaultAction, Index: -1, Trace: []RuleTrace{}}
+	for i, rule := range s {
+		trace := rule.explain(facts)
+		decision.Trace = append(decision.Trace, trace)
+		if trace.Matched {
+			decision.Action = rule.Action
+			decision.Index = i
+			decision.Rule = &s[i].Rule
+			break
+		}
+	}
+	return decision
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+)
+
+// benchmarkRules builds n rules of the shape admins typically write; none of them match benchmarkFacts,
+// so every benchmark walks the whole set
+func benchmarkRules(n int) []ruleengine.Rule {
+	rules := make([]ruleengine.Rule, n)
+	for i := range rules {
+		rules[i] = ruleengine.Rule{
+			ID:       int64(i + 1),
+			Priority: i,
+			Action:   "AUTO_APPROVE",
+			Condition: map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"attr": "category", "op": "in", "value": []interface{}{"FOOD", "TRAVEL"}},
+					map[string]interface{}{"attr": "amount", "op": "between", "value": []interface{}{0, 1000 + i}},
+					map[string]interface{}{"attr": "grade", "op": ">=", "value": n - i},
+					map[string]interface{}{"not": map[string]interface{}{"attr": "weekday", "op": "==", "value": "FRIDAY"}},
+				},
+			},
+		}
+	}
+	return rules
+}
+
+var benchmarkFacts = ruleengine.Facts{"category": "travel", "amount": 1200.0, "grade": int64(1), "weekday": "MONDAY"}
+
+func BenchmarkCompileRuleSet(b *testing.B) {
+	rules := benchmarkRules(20)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ruleengine.CompileRuleSet(testSchema, rules)
+	}
+}
+
+func BenchmarkRuleSet_Match(b *testing.B) {
+	for _, n := range []int{1, 10, 100} {
+		set := ruleengine.CompileRuleSet(testSchema, benchmarkRules(n))
+
+		b.Run(fmt.Sprintf("rules=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				set.Match(benchmarkFacts)
+			}
+		})
+	}
+}
+
+func BenchmarkRuleSet_Decide(b *testing.B) {
+	for _, n := range []int{1, 10, 100} {
+		set := ruleengine.CompileRuleSet(testSchema, benchmarkRules(n))
+
+		b.Run(fmt.Sprintf("rules=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				set.Decide(benchmarkFacts, "MANUAL")
+			}
+		})
+	}
+}
+
+// compiling on every decision, for comparison with BenchmarkRuleSet_Decide
+func BenchmarkRuleSet_CompileAndDecide(b *testing.B) {
+	rules := benchmarkRules(10)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ruleengine.CompileRuleSet(testSchema, rules).Decide(benchmarkFacts, "MANUAL")
+	}
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBounds_ConditionBounds(t *testing.T) {
+	bounds := func(raw map[string]interface{}) ruleengine.ConditionBounds {
+		cond, err := ruleengine.Parse(testSchema, raw)
+		assert.NoError(t, err)
+		b, ok := ruleengine.BoundsOf(cond)
+		assert.True(t, ok)
+		return b
+	}
+
+	upTo3 := bounds(map[string]interface{}{"max_days": 3})
+	under3 := bounds(map[string]interface{}{"attr": "days", "op": "<", "value": 3})
+	sickUpTo2 := bounds(map[string]interface{}{"all": []interface{}{
+		map[string]interface{}{"attr": "days", "op": "between", "value": []interface{}{1, 2}},
+		map[string]interface{}{"attr": "leave_type", "op": "==", "value": "sick"},
+	}})
+	sickOrCasual := bounds(map[string]interface{}{"attr": "leave_type", "op": "in", "value": []interface{}{"SICK", "CASUAL"}})
+	over5 := bounds(map[string]interface{}{"attr": "days", "op": ">", "value": 5})
+
+	t.Run("Covers", func(t *testing.T) {
+		assert.True(t, upTo3.Covers(under3))
+		assert.False(t, under3.Covers(upTo3))
+		assert.True(t, upTo3.Covers(sickUpTo2))
+		assert.True(t, sickOrCasual.Covers(sickUpTo2))
+		assert.False(t, sickUpTo2.Covers(sickOrCasual))
+	})
+
+	t.Run("Overlaps", func(t *testing.T) {
+		assert.True(t, under3.Overlaps(sickOrCasual))
+		assert.False(t, upTo3.Overlaps(over5))
+		assert.False(t, bounds(map[string]interface{}{"attr": "days", "op": ">=", "value": 3}).Overlaps(under3))
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		capped, ok := upTo3.Max("days")
+		assert.True(t, ok)
+		assert.Equal(t, 3.0, capped)
+		_, ok = over5.Max("days")
+		assert.False(t, ok)
+	})
+
+	t.Run("Unsatisfiable", func(t *testing.T) {
+		assert.False(t, bounds(map[string]interface{}{"all": []interface{}{
+			map[string]interface{}{"attr": "days", "op": "<", "value": 2},
+			map[string]interface{}{"attr": "days", "op": ">", "value": 4},
+		}}).Satisfiable())
+	})
+
+	t.Run("Boolean Attributes", func(t *testing.T) {
+		weekday := bounds(map[string]interface{}{"attr": "weekend_submission", "op": "==", "value": false})
+		weekend := bounds(map[string]interface{}{"attr": "weekend_submission", "op": "==", "value": true})
+		either := bounds(map[string]interface{}{"attr": "weekend_submission", "op": "in", "value": []interface{}{true, false}})
+
+		assert.False(t, weekday.Overlaps(weekend))
+		assert.True(t, either.Covers(weekend))
+		assert.False(t, weekend.Covers(either))
+	})
+
+	t.Run("Not Summarised", func(t *testing.T) {
+		cond, err := ruleengine.Parse(testSchema, map[string]interface{}{"not": map[string]interface{}{"max_days": 3}})
+		assert.NoError(t, err)
+		_, ok := ruleengine.BoundsOf(cond)
+		assert.False(t, ok)
+	})
+}
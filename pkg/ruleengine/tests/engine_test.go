@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = ruleengine.Schema{
+	Attributes: map[string]ruleengine.Attribute{
+		"days":               {Kind: ruleengine.KindNumber},
+		"amount":             {Kind: ruleengine.KindNumber},
+		"grade":              {Kind: ruleengine.KindNumber},
+		"leave_type":         {Kind: ruleengine.KindString},
+		"category":           {Kind: ruleengine.KindString},
+		"weekday":            {Kind: ruleengine.KindString, Allowed: []string{"MONDAY", "FRIDAY"}},
+		"weekend_submission": {Kind: ruleengine.KindBool},
+	},
+	Limits: map[string]string{"max_days": "days", "max_amount": "amount"},
+}
+
+func TestEngine_Decide(t *testing.T) {
+	set := ruleengine.CompileRuleSet(testSchema, []ruleengine.Rule{
+		{ID: 1, Priority: 1, Action: "AUTO_REJECT", Condition: map[string]interface{}{"attr": "leave_type", "op": "==", "value": "unpaid"}},
+		{ID: 2, Priority: 2, Action: "AUTO_APPROVE", Condition: map[string]interface{}{"max_day": 3}},
+		{ID: 3, VersionID: 30, Version: 2, Priority: 3, Action: "AUTO_APPROVE", Condition: map[string]interface{}{
+			"all": []interface{}{
+				map[string]interface{}{"attr": "days", "op": "<=", "value": 3},
+				map[string]interface{}{"not": map[string]interface{}{"attr": "weekend_submission", "op": "==", "value": true}},
+			},
+		}},
+	})
+
+	t.Run("First Match Decides", func(t *testing.T) {
+		facts := ruleengine.Facts{"days": 2, "leave_type": "SICK", "weekend_submission": false}
+
+		decision := set.Decide(facts, "MANUAL")
+
+		assert.Equal(t, "AUTO_APPROVE", decision.Action)
+		assert.Equal(t, 2, decision.Index)
+		assert.Equal(t, int64(3), decision.Rule.ID)
+		assert.Equal(t, 2, set.Match(facts))
+
+		assert.Len(t, decision.Trace, 3)
+		assert.Equal(t, []ruleengine.ClauseTrace{
+			{Path: "condition", Attr: "leave_type", Op: "==", Expected: "unpaid", Actual: "SICK", Result: false},
+		}, decision.Trace[0].Clauses)
+		assert.Equal(t, "condition.max_day: unknown key", decision.Trace[1].Error)
+		assert.True(t, decision.Trace[2].Matched)
+		assert.Equal(t, int64(30), decision.Trace[2].VersionID)
+		assert.Len(t, decision.Trace[2].Clauses, 2)
+	})
+
+	t.Run("Stops At The Deciding Rule", func(t *testing.T) {
+		decision := set.Decide(ruleengine.Facts{"days": 1, "leave_type": "UNPAID"}, "MANUAL")
+
+		assert.Equal(t, "AUTO_REJECT", decision.Action)
+		assert.Equal(t, 0, decision.Index)
+		assert.Len(t, decision.Trace, 1)
+	})
+
+	t.Run("Default Action", func(t *testing.T) {
+		facts := ruleengine.Facts{"days": 5, "leave_type": "SICK", "weekend_submission": false}
+
+		decision := set.Decide(facts, "MANUAL")
+
+		assert.Equal(t, "MANUAL", decision.Action)
+		assert.Equal(t, -1, decision.Index)
+		assert.Nil(t, decision.Rule)
+		assert.Len(t, decision.Trace, 3)
+		assert.Equal(t, -1, set.Match(facts))
+	})
+
+	t.Run("Missing Fact Never Matches", func(t *testing.T) {
+		decision := set.Decide(ruleengine.Facts{"leave_type": "SICK"}, "MANUAL")
+		assert.Equal(t, "MANUAL", decision.Action)
+	})
+
+	t.Run("Compile Keeps The Error", func(t *testing.T) {
+		rule := ruleengine.Compile(testSchema, ruleengine.Rule{ID: 9, Condition: map[string]interface{}{"attr": "colour", "op": "==", "value": "red"}})
+
+		assert.ErrorIs(t, rule.Err(), ruleengine.ErrInvalidCondition)
+		assert.False(t, rule.Matches(ruleengine.Facts{"colour": "red"}))
+	})
+}
+
+func TestEngine_Validate(t *testing.T) {
+	leave := testSchema
+	leave.Name = "LEAVE"
+	leave.Available = []string{"days", "leave_type", "weekday", "max_days"}
+	leave.NonNegative = true
+
+	tests := []struct {
+		name      string
+		schema    ruleengine.Schema
+		condition map[string]interface{}
+		errs      ruleengine.ConditionErrors
+	}{
+		{
+			name:      "Valid",
+			schema:    leave,
+			condition: map[string]interface{}{"all": []interface{}{map[string]interface{}{"max_days": 3}, map[string]interface{}{"attr": "weekday", "op": "==", "value": "friday"}}},
+		},
+		{
+			name:   "Every Error Reported",
+			schema: leave,
+			condition: map[string]interface{}{"any": []interface{}{
+				map[string]interface{}{"attr": "amount", "op": "<", "value": 3},
+				map[string]interface{}{"max_amount": 3},
+				map[string]interface{}{"attr": "colour", "op": "==", "value": "red"},
+				map[string]interface{}{"attr": "days", "op": ">", "value": -1},
+			}},
+			errs: ruleengine.ConditionErrors{
+				{Field: "condition.any[0].attr", Message: `attribute "amount" is not available for LEAVE rules`},
+				{Field: "condition.any[1].max_amount", Message: "max_amount is not available for LEAVE rules"},
+				{Field: "condition.any[2].attr", Message: `unknown attribute "colour"`},
+				{Field: "condition.any[3].value", Message: "must not be negative"},
+			},
+		},
+		{
+			name:      "Negative Allowed Without NonNegative",
+			schema:    testSchema,
+			condition: map[string]interface{}{"attr": "amount", "op": ">", "value": -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ruleengine.Validate(tt.schema, tt.condition)
+			if tt.errs == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ruleengine.ErrInvalidCondition)
+			assert.Equal(t, tt.errs, err)
+		})
+	}
+
+	t.Run("Empty Condition", func(t *testing.T) {
+		assert.ErrorIs(t, ruleengine.Validate(leave, nil), ruleengine.ErrConditionRequired)
+	})
+}
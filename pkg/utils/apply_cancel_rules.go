@@ -1,107 +1,163 @@
 package utils
 
 import (
-	"context"
-	"fmt"
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
-
-	"github.com/jackc/pgx/v5"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
 )
 
 type DecisionResult struct {
-	Status  string
-	Message string
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Rule    *models.Rule          `json:"rule"`
+	Trace   *models.DecisionTrace `json:"trace,omitempty"`
+}
+
+// RuleID returns the id of the deciding rule, nil when the default action applied
+func (d DecisionResult) RuleID() *int64 {
+	if d.Rule == nil {
+		return nil
+	}
+	return &d.Rule.ID
+}
+
+// RuleVersionID returns the id of the deciding rule version, nil when the default action applied
+func (d DecisionResult) RuleVersionID() *int64 {
+	if d.Rule == nil || d.Rule.VersionID == 0 {
+		return nil
+	}
+	return &d.Rule.VersionID
+}
+
+// Evaluation is a decision together with the values it was computed from
+type Evaluation struct {
+	DecisionResult
+	Facts            Facts    `json:"facts"`
+	RemainingBalance *float64 `json:"remaining_balance,omitempty"`
+}
+
+// CompiledRule is a rule with its condition parsed once, so it can decide many requests
+type CompiledRule struct {
+	models.Rule
+	compiled *ruleengine.CompiledRule
+}
+
+// CompileRule parses a rule's condition; a condition that fails to parse never matches
+func CompileRule(rule models.Rule) CompiledRule {
+	return CompiledRule{Rule: rule, compiled: ruleengine.Compile(anySchema(), engineRule(rule))}
+}
+
+// CompileRules compiles rules, keeping their order
+func CompileRules(rules []models.Rule) []CompiledRule {
+	compiled := make([]CompiledRule, len(rules))
+	for i, rule := range rules {
+		compiled[i] = CompileRule(rule)
+	}
+	return compiled
+}
+
+func engineRule(rule models.Rule) ruleengine.Rule {
+	return ruleengine.Rule{
+		ID:        rule.ID,
+		VersionID: rule.VersionID,
+		Version:   rule.Version,
+		Priority:  rule.Priority,
+		Action:    rule.Action,
+		Condition: rule.Condition,
+	}
 }
 
+// MakeDecision compiles the rules and decides with them; see Decide
 func MakeDecision(
 	requestType string,
-	condition map[string]interface{},
-	value float64,
+	rules []models.Rule,
+	facts Facts,
+	defaultAction string,
 ) DecisionResult {
+	return Decide(requestType, CompileRules(rules), facts, defaultAction)
+}
 
-	decision := Decide(requestType, condition, value)
-	fmt.Println("DEBUG Decide returned:", decision)
+// Decide walks the rules top-down; the first rule whose condition holds decides.
+// When no rule matches, defaultAction applies. Every rule and clause evaluated is recorded in the trace.
+func Decide(
+	requestType string,
+	rules []CompiledRule,
+	facts Facts,
+	defaultAction string,
+) DecisionResult {
+	set := make(ruleengine.RuleSet, len(rules))
+	for i := range rules {
+		set[i] = rules[i].compiled
+	}
+	decision := set.Decide(facts, defaultAction)
+
+	var matched *models.Rule
+	if decision.Index >= 0 {
+		matched = &rules[decision.Index].Rule
+	}
 
-	if decision == constants.StatusAutoApprove {
+	result := decide(requestType, decision.Action, matched)
+	result.Trace = &models.DecisionTrace{
+		RequestType:   requestType,
+		Inputs:        facts,
+		Rules:         decision.Trace,
+		RuleID:        result.RuleID(),
+		RuleVersionID: result.RuleVersionID(),
+		DefaultAction: defaultAction,
+		Action:        decision.Action,
+		Outcome:       result.Status,
+	}
+	if matched != nil {
+		result.Trace.RuleVersion = matched.Version
+	}
+
+	return result
+}
+
+func decide(requestType, action string, matched *models.Rule) DecisionResult {
+	switch action {
+	case constants.ActionAutoApprove:
 		return DecisionResult{
 			Status:  constants.StatusAutoApproved,
 			Message: requestType + " approved by system",
+			Rule:    matched,
+		}
+	case constants.ActionAutoReject:
+		message := requestType + " rejected by system"
+		if matched != nil && matched.Reason != "" {
+			message = matched.Reason
+		}
+		return DecisionResult{
+			Status:  constants.StatusAutoRejected,
+			Message: message,
+			Rule:    matched,
 		}
 	}
 
 	return DecisionResult{
 		Status:  constants.StatusPending,
 		Message: requestType + " submitted for approval",
+		Rule:    matched,
 	}
 }
 
 func CanCancel(status string) error {
 	switch status {
-	case constants.StatusApproved, constants.StatusRejected, constants.StatusCancelled:
+	case constants.StatusApproved, constants.StatusRejected, constants.StatusCancelled, constants.StatusAutoRejected,
+		constants.StatusRevoked:
 		return apperrors.ErrRequestCannotCancel
 	default:
 		return nil
 	}
 }
-func FetchUserGrade(ctx context.Context, tx pgx.Tx, userID int64) (int64, error) {
-	var gradeID int64
-	err := tx.QueryRow(
-		ctx,
-		`SELECT grade_id FROM users WHERE id=$1`,
-		userID,
-	).Scan(&gradeID)
-
-	if err == pgx.ErrNoRows {
-		return 0, apperrors.ErrUserNotFound
-	}
-	return gradeID, err
-}
-func Decide(
-	requestType string,
-	rule map[string]interface{},
-	value float64,
-) string {
-
-	switch requestType {
-	case "LEAVE":
-		if EvaluateLeaveRule(rule, int(value)) {
-			return constants.StatusAutoApprove
-		}
-	case "EXPENSE":
-		if EvaluateExpenseRule(rule, value) {
-			return constants.StatusAutoApprove
-		}
-	case "DISCOUNT":
-		if EvaluateDiscountRule(rule, value) {
-			return constants.StatusAutoApprove
-		}
-	}
-
-	return "MANUAL"
-}
-
-func EvaluateLeaveRule(rule map[string]interface{}, days int) bool {
-	maxDays, ok := rule["max_days"].(float64)
-	fmt.Println("EvaluateLeaveRule ", ok)
-	if !ok {
-		return false
-	}
-	return days <= int(maxDays)
-}
 
-func EvaluateExpenseRule(rule map[string]interface{}, amount float64) bool {
-	maxAmount, ok := rule["max_amount"].(float64)
-	if !ok {
-		return false
-	}
-	return amount <= maxAmount
-}
-
-func EvaluateDiscountRule(rule map[string]interface{}, percent float64) bool {
-	maxPercent, ok := rule["max_percent"].(float64)
-	if !ok {
-		return false
+// CanRevoke allows revoking requests an approver or the rules approved
+func CanRevoke(status string) error {
+	switch status {
+	case constants.StatusApproved, constants.StatusAutoApproved:
+		return nil
+	default:
+		return apperrors.ErrRequestNotApproved
 	}
-	return percent <= maxPercent
 }
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"net/http"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+)
+
+// MaxBulkRequests is the most requests one bulk approval or rejection may decide
+const MaxBulkRequests = 100
+
+// RunBulk applies decide to each distinct request ID in turn and reports how each went.
+// decide runs its own transaction, so a failed request leaves the others decided.
+// Employees cannot approve anything, so they are turned away once rather than per request.
+func RunBulk(role string, requestIDs []int64, decide func(requestID int64) error) (*models.BulkDecision, error) {
+	if role == constants.RoleEmployee {
+		return nil, apperrors.ErrEmployeeCannotApprove
+	}
+	if len(requestIDs) == 0 {
+		return nil, apperrors.ErrBulkRequestIDsMissing
+	}
+	if len(requestIDs) > MaxBulkRequests {
+		return nil, apperrors.ErrBulkTooManyRequests
+	}
+
+	result := &models.BulkDecision{Results: make([]models.BulkItemResult, 0, len(requestIDs))}
+	seen := make(map[int64]bool, len(requestIDs))
+	for _, id := range requestIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		item := models.BulkItemResult{RequestID: id, Success: true}
+		if err := decide(id); err != nil {
+			item.Success = false
+			item.Err = err
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Results = append(result.Results, item)
+	}
+	return result, nil
+}
+
+// DescribeBulkFailures fills in Error for each failed request from the status the single-request
+// handler would answer with; anything it would answer with a server error is not spelled out
+func DescribeBulkFailures(result *models.BulkDecision, status func(error) int) {
+	for i := range result.Results {
+		err := result.Results[i].Err
+		if err == nil {
+			continue
+		}
+		if status(err) >= http.StatusInternalServerError {
+			err = apperrors.ErrInternalServer
+		}
+		result.Results[i].Error = err.Error()
+	}
+}
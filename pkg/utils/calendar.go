@@ -0,0 +1,62 @@
+package utils
+
+import "time"
+
+// Calendar answers which days are public holidays
+type Calendar struct {
+	holidays map[string]bool
+}
+
+// NewCalendar builds a calendar from holiday dates; only the calendar date of each is used
+func NewCalendar(holidays []time.Time) Calendar {
+	c := Calendar{holidays: make(map[string]bool, len(holidays))}
+	for _, h := range holidays {
+		c.holidays[h.Format("2006-01-02")] = true
+	}
+	return c
+}
+
+// IsHoliday reports whether t falls on a public holiday
+func (c Calendar) IsHoliday(t time.Time) bool {
+	return c.holidays[t.Format("2006-01-02")]
+}
+
+// DateOf returns midnight UTC on t's calendar date, the form leave dates are stored in
+func DateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// LeaveCalendarWindow returns the days whose holidays AddLeaveCalendarFacts looks at
+func LeaveCalendarWindow(submittedAt, from, to time.Time) (time.Time, time.Time) {
+	start := DateOf(submittedAt)
+	if before := from.AddDate(0, 0, -1); before.Before(start) {
+		start = before
+	}
+	return start, to.AddDate(0, 0, 1)
+}
+
+// AddLeaveCalendarFacts adds the attributes that depend on when a leave from..to was asked for:
+// working days of notice (those between the day of submission and the first day of leave), working days taken,
+// whether a holiday falls within or right next to the leave and whether it crosses into another month
+func AddLeaveCalendarFacts(facts Facts, cal Calendar, submittedAt, from, to time.Time) {
+	from, to = DateOf(from), DateOf(to)
+
+	notice := CountWorkingDays(DateOf(submittedAt).AddDate(0, 0, 1), from.AddDate(0, 0, -1), cal.IsHoliday)
+
+	touches := false
+	for d := from.AddDate(0, 0, -1); !d.After(to.AddDate(0, 0, 1)); d = d.AddDate(0, 0, 1) {
+		if cal.IsHoliday(d) {
+			touches = true
+			break
+		}
+	}
+
+	facts[AttrNoticeWorkingDays] = notice
+	facts[AttrWorkingDays] = CountWorkingDays(from, to, cal.IsHoliday)
+	facts[AttrTouchesHoliday] = touches
+	facts[AttrSpansMonthEnd] = from.Year() != to.Year() || from.Month() != to.Month()
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
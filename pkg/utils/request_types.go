@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+)
+
+// request types served by the generic pipeline, by name
+var requestTypes = map[string]models.RequestType{}
+
+// balances a request type may draw on
+var chargeableBalances = []string{"LEAVE", "EXPENSE", "DISCOUNT"}
+
+// attributes every request exposes to rule conditions, whatever its type
+var commonAttributes = []string{AttrGrade, AttrRole, AttrWeekday, AttrWeekendSubmission}
+
+var requestTypeName = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,29}$`)
+
+// PayloadError describes why a request payload does not match its request type
+type PayloadError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *PayloadError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+func (e *PayloadError) Unwrap() error {
+	return apperrors.ErrInvalidRequestPayload
+}
+
+// RegisterRequestType validates a request type definition and makes it available to the generic
+// request pipeline and to rule conditions. It must be called before requests are served.
+func RegisterRequestType(def models.RequestType) error {
+	def.Name = strings.ToUpper(strings.TrimSpace(def.Name))
+	if _, ok := conditionSchemas[def.Name]; ok {
+		return apperrors.ErrRequestTypeExists
+	}
+	if err := checkRequestType(&def); err != nil {
+		return err
+	}
+
+	schema := conditionSchema{attributes: append([]string{}, commonAttributes...)}
+	for _, attr := range def.Attributes {
+		field, _ := fieldOf(def, attr)
+		if _, ok := conditionAttributes[attr]; !ok {
+			conditionAttributes[attr] = ruleengine.Attribute{Kind: kindOfField(field), Allowed: field.Allowed}
+		}
+		schema.attributes = append(schema.attributes, attr)
+	}
+
+	conditionSchemas[def.Name] = schema
+	requestTypes[def.Name] = def
+	return nil
+}
+
+func checkRequestType(def *models.RequestType) error {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: %s", apperrors.ErrInvalidRequestTypeDefinition, fmt.Sprintf(format, args...))
+	}
+
+	if !requestTypeName.MatchString(def.Name) {
+		return invalid("name must be 2-30 upper-case letters, digits or underscores")
+	}
+	if len(def.Fields) == 0 {
+		return invalid("at least one field is required")
+	}
+
+	seen := map[string]bool{}
+	for _, field := range def.Fields {
+		if field.Name == "" || seen[field.Name] {
+			return invalid("field names must be present and unique")
+		}
+		seen[field.Name] = true
+		switch field.Type {
+		case constants.FieldNumber, constants.FieldString, constants.FieldDate:
+		default:
+			return invalid("field %s: type must be number, string or date", field.Name)
+		}
+	}
+
+	for _, attr := range def.Attributes {
+		field, ok := fieldOf(*def, attr)
+		if !ok {
+			return invalid("attribute %s is not a field", attr)
+		}
+		if slices.Contains(commonAttributes, attr) {
+			return invalid("attribute %s is always available", attr)
+		}
+		if field.Type == constants.FieldDate {
+			return invalid("attribute %s: date fields cannot be used in conditions", attr)
+		}
+		// an attribute shared with another request type must have the same kind
+		if spec, ok := conditionAttributes[attr]; ok && spec.Kind != kindOfField(field) {
+			return invalid("attribute %s is already declared with another type", attr)
+		}
+	}
+
+	if def.Balance != nil {
+		def.Balance.Kind = strings.ToUpper(def.Balance.Kind)
+		if !slices.Contains(chargeableBalances, def.Balance.Kind) {
+			return invalid("balance kind must be one of %s", strings.Join(chargeableBalances, ", "))
+		}
+		field, ok := fieldOf(*def, def.Balance.Field)
+		if !ok || field.Type != constants.FieldNumber || !field.Required {
+			return invalid("balance field must be a required number field")
+		}
+	}
+
+	if len(def.Policy.ApproverRoles) == 0 {
+		def.Policy.ApproverRoles = []string{constants.RoleManager, constants.RoleAdmin}
+	}
+	for _, role := range def.Policy.ApproverRoles {
+		if role != constants.RoleManager && role != constants.RoleAdmin {
+			return invalid("approver roles must be MANAGER or ADMIN")
+		}
+	}
+
+	return nil
+}
+
+func kindOfField(field models.RequestField) ruleengine.Kind {
+	if field.Type == constants.FieldString {
+		return ruleengine.KindString
+	}
+	return ruleengine.KindNumber
+}
+
+func fieldOf(def models.RequestType, name string) (models.RequestField, bool) {
+	for _, field := range def.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return models.RequestField{}, false
+}
+
+// LookupRequestType returns a registered request type; the name is case-insensitive
+func LookupRequestType(name string) (models.RequestType, bool) {
+	def, ok := requestTypes[strings.ToUpper(name)]
+	return def, ok
+}
+
+// RegisteredRequestTypes lists the request types served by the generic pipeline, sorted by name
+func RegisteredRequestTypes() []models.RequestType {
+	defs := make([]models.RequestType, 0, len(requestTypes))
+	for _, def := range requestTypes {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// ValidatePayload checks a payload against its request type and returns it with unknown fields dropped
+// and dates normalised to YYYY-MM-DD
+func ValidatePayload(def models.RequestType, payload map[string]interface{}) (map[string]interface{}, error) {
+	clean := map[string]interface{}{}
+	for _, field := range def.Fields {
+		value, ok := payload[field.Name]
+		if !ok || value == nil {
+			if field.Required {
+				return nil, &PayloadError{Field: field.Name, Message: "is required"}
+			}
+			continue
+		}
+
+		switch field.Type {
+		case constants.FieldNumber:
+			n, ok := toNumber(value)
+			if !ok || math.IsNaN(n) {
+				return nil, &PayloadError{Field: field.Name, Message: "must be a number"}
+			}
+			if n < 0 {
+				return nil, &PayloadError{Field: field.Name, Message: "must not be negative"}
+			}
+			value = n
+		case constants.FieldString:
+			s, ok := value.(string)
+			if !ok || (field.Required && strings.TrimSpace(s) == "") {
+				return nil, &PayloadError{Field: field.Name, Message: "must be a non-empty string"}
+			}
+			if len(field.Allowed) > 0 && !containsFold(field.Allowed, s) {
+				return nil, &PayloadError{Field: field.Name, Message: "must be one of " + strings.Join(field.Allowed, ", ")}
+			}
+		case constants.FieldDate:
+			s, _ := value.(string)
+			date, err := time.Parse("2006-01-02", s)
+			if err != nil {
+				return nil, &PayloadError{Field: field.Name, Message: "must be a date (YYYY-MM-DD)"}
+			}
+			value = date.Format("2006-01-02")
+		}
+		clean[field.Name] = value
+	}
+	return clean, nil
+}
+
+// RequestFacts builds the attributes a request of a registered type exposes to rule conditions
+func RequestFacts(def models.RequestType, payload map[string]interface{}, gradeID int64, role string, submittedAt time.Time) Facts {
+	facts := Facts{
+		AttrGrade:   gradeID,
+		AttrRole:    role,
+		AttrWeekday: weekdayOf(submittedAt),
+
+		AttrWeekendSubmission: isWeekend(submittedAt),
+	}
+	for _, attr := range def.Attributes {
+		if value, ok := payload[attr]; ok {
+			facts[attr] = value
+		}
+	}
+	return facts
+}
+
+// ChargeOf returns what a request draws from its type's balance, zero when the type has none
+func ChargeOf(def models.RequestType, payload map[string]interface{}) float64 {
+	if def.Balance == nil {
+		return 0
+	}
+	n, _ := toNumber(payload[def.Balance.Field])
+	return n
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
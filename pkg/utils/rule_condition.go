@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/ruleengine"
+)
+
+// Request attributes a rule condition can reference
+const (
+	AttrDays      = "days"
+	AttrAmount    = "amount"
+	AttrPercent   = "percent"
+	AttrCategory  = "category"
+	AttrLeaveType = "leave_type"
+	AttrGrade     = "grade"
+	AttrRole      = "role"
+	AttrWeekday   = "weekday"
+
+	// rolling aggregates over the requester's earlier requests
+	AttrMonthExpenseTotal    = "month_expense_total"
+	AttrLeaveCount30d        = "leave_count_30d"
+	AttrQuarterDiscountTotal = "quarter_discount_total"
+
+	// calendar attributes, computed from the submission time, the dates covered and the holiday calendar
+	AttrWeekendSubmission = "weekend_submission"
+	AttrNoticeWorkingDays = "notice_working_days"
+	AttrWorkingDays       = "working_days"
+	AttrTouchesHoliday    = "touches_holiday"
+	AttrSpansMonthEnd     = "spans_month_end"
+)
+
+var weekdays = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+// conditionAttributes is the catalogue of attributes any rule may reference
+var conditionAttributes = map[string]ruleengine.Attribute{
+	AttrDays:      {Kind: ruleengine.KindNumber},
+	AttrAmount:    {Kind: ruleengine.KindNumber},
+	AttrPercent:   {Kind: ruleengine.KindNumber},
+	AttrGrade:     {Kind: ruleengine.KindNumber},
+	AttrCategory:  {Kind: ruleengine.KindString},
+	AttrLeaveType: {Kind: ruleengine.KindString},
+	AttrRole:      {Kind: ruleengine.KindString, Allowed: []string{constants.RoleEmployee, constants.RoleManager, constants.RoleAdmin}},
+	AttrWeekday:   {Kind: ruleengine.KindString, Allowed: weekdays},
+
+	AttrMonthExpenseTotal:    {Kind: ruleengine.KindNumber},
+	AttrLeaveCount30d:        {Kind: ruleengine.KindNumber},
+	AttrQuarterDiscountTotal: {Kind: ruleengine.KindNumber},
+
+	AttrWeekendSubmission: {Kind: ruleengine.KindBool},
+	AttrNoticeWorkingDays: {Kind: ruleengine.KindNumber},
+	AttrWorkingDays:       {Kind: ruleengine.KindNumber},
+	AttrTouchesHoliday:    {Kind: ruleengine.KindBool},
+	AttrSpansMonthEnd:     {Kind: ruleengine.KindBool},
+}
+
+// legacy single-key conditions, kept so existing rules such as {"max_days": 3} still work
+var legacyConditionKeys = map[string]string{
+	"max_days":    AttrDays,
+	"max_amount":  AttrAmount,
+	"max_percent": AttrPercent,
+}
+
+// the condition language lives in pkg/ruleengine; these keep the names the rest of the app uses
+type (
+	Facts           = ruleengine.Facts
+	Condition       = ruleengine.Condition
+	ConditionError  = ruleengine.ConditionError
+	ConditionErrors = ruleengine.ConditionErrors
+)
+
+// conditionSchema lists what the rules of one request type may reference
+type conditionSchema struct {
+	attributes []string
+	legacyKeys []string
+}
+
+// conditionSchemas is the registry of condition schemas per request type;
+// an attribute is only allowed where the request exposes it as a fact
+var conditionSchemas = map[string]conditionSchema{
+	"LEAVE": {
+		attributes: []string{AttrDays, AttrLeaveType, AttrGrade, AttrRole, AttrWeekday, AttrLeaveCount30d,
+			AttrWeekendSubmission, AttrNoticeWorkingDays, AttrWorkingDays, AttrTouchesHoliday, AttrSpansMonthEnd},
+		legacyKeys: []string{"max_days"},
+	},
+	"EXPENSE": {
+		attributes: []string{AttrAmount, AttrCategory, AttrGrade, AttrRole, AttrWeekday, AttrMonthExpenseTotal, AttrWeekendSubmission},
+		legacyKeys: []string{"max_amount"},
+	},
+	"DISCOUNT": {
+		attributes: []string{AttrPercent, AttrGrade, AttrRole, AttrWeekday, AttrQuarterDiscountTotal, AttrWeekendSubmission},
+		legacyKeys: []string{"max_percent"},
+	},
+}
+
+// RequestTypes lists the request types rules can be written for, sorted
+func RequestTypes() []string {
+	types := make([]string, 0, len(conditionSchemas))
+	for requestType := range conditionSchemas {
+		types = append(types, requestType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// anySchema lets a condition reference any attribute; stored rules are parsed with it
+func anySchema() ruleengine.Schema {
+	return ruleengine.Schema{Attributes: conditionAttributes, Limits: legacyConditionKeys}
+}
+
+// schemaOf narrows the catalogue to what a request type exposes
+func schemaOf(requestType string, schema conditionSchema) ruleengine.Schema {
+	return ruleengine.Schema{
+		Name:        requestType,
+		Attributes:  conditionAttributes,
+		Limits:      legacyConditionKeys,
+		Available:   append(append([]string{}, schema.attributes...), schema.legacyKeys...),
+		NonNegative: true,
+	}
+}
+
+// ParseCondition parses and validates the JSON condition stored on a rule
+func ParseCondition(raw map[string]interface{}) (Condition, error) {
+	return ruleengine.Parse(anySchema(), raw)
+}
+
+// ValidateCondition checks a condition against the schema of its request type:
+// unknown keys, attributes the request does not expose, wrong value types and
+// negative limits are all reported, each with the path of the offending field.
+// The returned error is a ConditionErrors when the condition itself is invalid.
+func ValidateCondition(requestType string, raw map[string]interface{}) error {
+	schema, ok := conditionSchemas[strings.ToUpper(requestType)]
+	if !ok {
+		return apperrors.ErrUnknownRequestType
+	}
+	return ruleengine.Validate(schemaOf(strings.ToUpper(requestType), schema), raw)
+}
+
+// LeaveFacts builds the attributes a leave request exposes to rule conditions
+func LeaveFacts(days int, leaveType string, gradeID int64, role string, submittedAt time.Time) Facts {
+	return Facts{
+		AttrDays:      days,
+		AttrLeaveType: leaveType,
+		AttrGrade:     gradeID,
+		AttrRole:      role,
+		AttrWeekday:   weekdayOf(submittedAt),
+
+		AttrWeekendSubmission: isWeekend(submittedAt),
+	}
+}
+
+// ExpenseFacts builds the attributes an expense request exposes to rule conditions
+func ExpenseFacts(amount float64, category string, gradeID int64, role string, submittedAt time.Time) Facts {
+	return Facts{
+		AttrAmount:   amount,
+		AttrCategory: category,
+		AttrGrade:    gradeID,
+		AttrRole:     role,
+		AttrWeekday:  weekdayOf(submittedAt),
+
+		AttrWeekendSubmission: isWeekend(submittedAt),
+	}
+}
+
+// DiscountFacts builds the attributes a discount request exposes to rule conditions
+func DiscountFacts(percent float64, gradeID int64, role string, submittedAt time.Time) Facts {
+	return Facts{
+		AttrPercent: percent,
+		AttrGrade:   gradeID,
+		AttrRole:    role,
+		AttrWeekday: weekdayOf(submittedAt),
+
+		AttrWeekendSubmission: isWeekend(submittedAt),
+	}
+}
+
+func weekdayOf(t time.Time) string {
+	return strings.ToUpper(t.Weekday().String())
+}
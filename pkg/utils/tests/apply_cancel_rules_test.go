@@ -4,99 +4,240 @@ import (
 	"testing"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestApplyCancelRules_MakeDecision(t *testing.T) {
+	autoApprove := func(id int64, condition map[string]interface{}) models.Rule {
+		return models.Rule{ID: id, Action: constants.ActionAutoApprove, Condition: condition}
+	}
+	manual := func(id int64, condition map[string]interface{}) models.Rule {
+		return models.Rule{ID: id, Action: constants.ActionManual, Condition: condition}
+	}
+
 	tests := []struct {
-		name        string
-		requestType string
-		condition   map[string]interface{}
-		value       float64
-		expected    utils.DecisionResult
+		name           string
+		requestType    string
+		rules          []models.Rule
+		facts          utils.Facts
+		defaultAction  string
+		expectedStatus string
+		expectedMsg    string
+		expectedRuleID *int64
 	}{
 		{
-			name:        "Leave Auto Approved",
-			requestType: "LEAVE",
-			condition:   map[string]interface{}{"max_days": 5.0},
-			value:       3,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusAutoApproved,
-				Message: "LEAVE approved by system",
-			},
+			name:           "Leave Auto Approved",
+			requestType:    "LEAVE",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_days": 5.0})},
+			facts:          utils.Facts{utils.AttrDays: 3},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoApproved,
+			expectedMsg:    "LEAVE approved by system",
+			expectedRuleID: ptr(1),
 		},
 		{
-			name:        "Leave Manual Approval",
-			requestType: "LEAVE",
-			condition:   map[string]interface{}{"max_days": 5.0},
-			value:       6,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusPending,
-				Message: "LEAVE submitted for approval",
-			},
+			name:           "Leave Manual Approval",
+			requestType:    "LEAVE",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_days": 5.0})},
+			facts:          utils.Facts{utils.AttrDays: 6},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusPending,
+			expectedMsg:    "LEAVE submitted for approval",
+		},
+		{
+			name:           "Expense Auto Approved",
+			requestType:    "EXPENSE",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_amount": 100.0})},
+			facts:          utils.Facts{utils.AttrAmount: 50.0},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoApproved,
+			expectedMsg:    "EXPENSE approved by system",
+			expectedRuleID: ptr(1),
 		},
 		{
-			name:        "Expense Auto Approved",
+			name:           "Expense Manual Approval",
+			requestType:    "EXPENSE",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_amount": 100.0})},
+			facts:          utils.Facts{utils.AttrAmount: 150.0},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusPending,
+			expectedMsg:    "EXPENSE submitted for approval",
+		},
+		{
+			name:           "Discount Auto Approved",
+			requestType:    "DISCOUNT",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_percent": 20.0})},
+			facts:          utils.Facts{utils.AttrPercent: 15.0},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoApproved,
+			expectedMsg:    "DISCOUNT approved by system",
+			expectedRuleID: ptr(1),
+		},
+		{
+			name:        "Expression Auto Approved",
 			requestType: "EXPENSE",
-			condition:   map[string]interface{}{"max_amount": 100.0},
-			value:       50,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusAutoApproved,
-				Message: "EXPENSE approved by system",
+			rules: []models.Rule{autoApprove(1, map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"attr": "category", "op": "==", "value": "travel"},
+					map[string]interface{}{"attr": "amount", "op": "<", "value": 3000},
+				},
+			})},
+			facts:          utils.Facts{utils.AttrAmount: 2500.0, utils.AttrCategory: "Travel"},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoApproved,
+			expectedMsg:    "EXPENSE approved by system",
+			expectedRuleID: ptr(1),
+		},
+		{
+			name:        "Narrow Exception Wins Over Broad Default",
+			requestType: "EXPENSE",
+			rules: []models.Rule{
+				manual(7, map[string]interface{}{"attr": "category", "op": "==", "value": "alcohol"}),
+				autoApprove(3, map[string]interface{}{"max_amount": 5000.0}),
 			},
+			facts:          utils.Facts{utils.AttrAmount: 40.0, utils.AttrCategory: "alcohol"},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusPending,
+			expectedMsg:    "EXPENSE submitted for approval",
+			expectedRuleID: ptr(7),
 		},
 		{
-			name:        "Expense Manual Approval",
+			name:        "Falls Through To Next Rule",
 			requestType: "EXPENSE",
-			condition:   map[string]interface{}{"max_amount": 100.0},
-			value:       150,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusPending,
-				Message: "EXPENSE submitted for approval",
+			rules: []models.Rule{
+				manual(7, map[string]interface{}{"attr": "category", "op": "==", "value": "alcohol"}),
+				autoApprove(3, map[string]interface{}{"max_amount": 5000.0}),
 			},
+			facts:          utils.Facts{utils.AttrAmount: 40.0, utils.AttrCategory: "food"},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoApproved,
+			expectedMsg:    "EXPENSE approved by system",
+			expectedRuleID: ptr(3),
 		},
 		{
-			name:        "Discount Auto Approved",
+			name:        "Discount Auto Rejected With Reason",
 			requestType: "DISCOUNT",
-			condition:   map[string]interface{}{"max_percent": 20.0},
-			value:       15,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusAutoApproved,
-				Message: "DISCOUNT approved by system",
+			rules: []models.Rule{
+				{ID: 4, Action: constants.ActionAutoReject, Reason: "Discounts above 50% are not allowed",
+					Condition: map[string]interface{}{"attr": "percent", "op": ">", "value": 50}},
+				autoApprove(5, map[string]interface{}{"max_percent": 100.0}),
 			},
+			facts:          utils.Facts{utils.AttrPercent: 60.0},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoRejected,
+			expectedMsg:    "Discounts above 50% are not allowed",
+			expectedRuleID: ptr(4),
 		},
 		{
-			name:        "Malformed Condition",
-			requestType: "LEAVE",
-			condition:   map[string]interface{}{"max_days": "invalid"},
-			value:       3,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusPending,
-				Message: "LEAVE submitted for approval",
+			name:        "Auto Reject Without Reason",
+			requestType: "EXPENSE",
+			rules: []models.Rule{
+				{ID: 6, Action: constants.ActionAutoReject,
+					Condition: map[string]interface{}{"attr": "category", "op": "==", "value": "gambling"}},
 			},
+			facts:          utils.Facts{utils.AttrAmount: 10.0, utils.AttrCategory: "gambling"},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusAutoRejected,
+			expectedMsg:    "EXPENSE rejected by system",
+			expectedRuleID: ptr(6),
 		},
 		{
-			name:        "Unknown Request Type",
-			requestType: "UNKNOWN",
-			condition:   map[string]interface{}{"max_days": 5.0},
-			value:       3,
-			expected: utils.DecisionResult{
-				Status:  constants.StatusPending,
-				Message: "UNKNOWN submitted for approval",
-			},
+			name:           "No Match Uses Default Action",
+			requestType:    "LEAVE",
+			rules:          []models.Rule{manual(1, map[string]interface{}{"attr": "days", "op": ">", "value": 10})},
+			facts:          utils.Facts{utils.AttrDays: 2},
+			defaultAction:  constants.ActionAutoApprove,
+			expectedStatus: constants.StatusAutoApproved,
+			expectedMsg:    "LEAVE approved by system",
+		},
+		{
+			name:           "Malformed Condition",
+			requestType:    "LEAVE",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_days": "invalid"})},
+			facts:          utils.Facts{utils.AttrDays: 3},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusPending,
+			expectedMsg:    "LEAVE submitted for approval",
+		},
+		{
+			name:           "Missing Fact",
+			requestType:    "UNKNOWN",
+			rules:          []models.Rule{autoApprove(1, map[string]interface{}{"max_days": 5.0})},
+			facts:          utils.Facts{},
+			defaultAction:  constants.ActionManual,
+			expectedStatus: constants.StatusPending,
+			expectedMsg:    "UNKNOWN submitted for approval",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := utils.MakeDecision(tt.requestType, tt.condition, tt.value)
-			assert.Equal(t, tt.expected, result)
+			result := utils.MakeDecision(tt.requestType, tt.rules, tt.facts, tt.defaultAction)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			assert.Equal(t, tt.expectedMsg, result.Message)
+			assert.Equal(t, tt.expectedRuleID, result.RuleID())
 		})
 	}
 }
 
+func TestApplyCancelRules_MakeDecisionTrace(t *testing.T) {
+	rules := []models.Rule{
+		{ID: 1, VersionID: 11, Version: 2, Priority: 1, Action: constants.ActionAutoReject, Reason: "no sick leave on Fridays",
+			Condition: map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"attr": "leave_type", "op": "==", "value": "SICK"},
+					map[string]interface{}{"attr": "weekday", "op": "==", "value": "FRIDAY"},
+				},
+			}},
+		{ID: 2, Priority: 2, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_day": 3}},
+		{ID: 3, VersionID: 31, Version: 1, Priority: 3, Action: constants.ActionAutoApprove, Condition: map[string]interface{}{"max_days": 3}},
+	}
+	facts := utils.Facts{utils.AttrDays: 2, utils.AttrLeaveType: "CASUAL", utils.AttrWeekday: "FRIDAY"}
+
+	result := utils.MakeDecision("LEAVE", rules, facts, constants.ActionManual)
+	trace := result.Trace
+
+	assert.NotNil(t, trace)
+	assert.Equal(t, constants.StatusAutoApproved, trace.Outcome)
+	assert.Equal(t, constants.ActionAutoApprove, trace.Action)
+	assert.Equal(t, ptr(3), trace.RuleID)
+	assert.Equal(t, ptr(31), trace.RuleVersionID)
+	assert.Equal(t, 1, trace.RuleVersion)
+	assert.Equal(t, map[string]interface{}(facts), trace.Inputs)
+
+	assert.Len(t, trace.Rules, 3)
+
+	// the all-group stops at the first failing clause
+	assert.False(t, trace.Rules[0].Matched)
+	assert.Equal(t, []models.ClauseTrace{
+		{Path: "condition.all[0]", Attr: "leave_type", Op: "==", Expected: "SICK", Actual: "CASUAL", Result: false},
+	}, trace.Rules[0].Clauses)
+
+	assert.False(t, trace.Rules[1].Matched)
+	assert.Equal(t, "condition.max_day: unknown key", trace.Rules[1].Error)
+
+	assert.True(t, trace.Rules[2].Matched)
+	assert.Equal(t, []models.ClauseTrace{
+		{Path: "condition.max_days", Attr: "days", Op: "<=", Expected: 3.0, Actual: 2, Result: true},
+	}, trace.Rules[2].Clauses)
+
+	t.Run("Default Action", func(t *testing.T) {
+		result := utils.MakeDecision("LEAVE", nil, facts, constants.ActionManual)
+
+		assert.Nil(t, result.Trace.RuleID)
+		assert.Empty(t, result.Trace.Rules)
+		assert.Equal(t, constants.ActionManual, result.Trace.Action)
+		assert.Equal(t, constants.StatusPending, result.Trace.Outcome)
+	})
+}
+
+func ptr(id int64) *int64 {
+	return &id
+}
+
 func TestApplyCancelRules_CanCancel(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -123,6 +264,16 @@ func TestApplyCancelRules_CanCancel(t *testing.T) {
 			status:        constants.StatusCancelled,
 			expectedError: apperrors.ErrRequestCannotCancel,
 		},
+		{
+			name:          "Cannot Cancel Auto Rejected",
+			status:        constants.StatusAutoRejected,
+			expectedError: apperrors.ErrRequestCannotCancel,
+		},
+		{
+			name:          "Cannot Cancel Revoked",
+			status:        constants.StatusRevoked,
+			expectedError: apperrors.ErrRequestCannotCancel,
+		},
 	}
 
 	for _, tt := range tests {
@@ -137,22 +288,297 @@ func TestApplyCancelRules_CanCancel(t *testing.T) {
 	}
 }
 
-func TestApplyCancelRules_EvaluateRules(t *testing.T) {
-	t.Run("EvaluateLeaveRule", func(t *testing.T) {
-		assert.True(t, utils.EvaluateLeaveRule(map[string]interface{}{"max_days": 5.0}, 3))
-		assert.False(t, utils.EvaluateLeaveRule(map[string]interface{}{"max_days": 5.0}, 6))
-		assert.False(t, utils.EvaluateLeaveRule(map[string]interface{}{"min_days": 5.0}, 3)) // Missing max_days
-	})
+func TestApplyCancelRules_CanRevoke(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        string
+		expectedError error
+	}{
+		{name: "Can Revoke Approved", status: constants.StatusApproved},
+		{name: "Can Revoke Auto Approved", status: constants.StatusAutoApproved},
+		{name: "Cannot Revoke Pending", status: constants.StatusPending, expectedError: apperrors.ErrRequestNotApproved},
+		{name: "Cannot Revoke Rejected", status: constants.StatusRejected, expectedError: apperrors.ErrRequestNotApproved},
+		{name: "Cannot Revoke Revoked", status: constants.StatusRevoked, expectedError: apperrors.ErrRequestNotApproved},
+	}
 
-	t.Run("EvaluateExpenseRule", func(t *testing.T) {
-		assert.True(t, utils.EvaluateExpenseRule(map[string]interface{}{"max_amount": 100.0}, 50))
-		assert.False(t, utils.EvaluateExpenseRule(map[string]interface{}{"max_amount": 100.0}, 150))
-		assert.False(t, utils.EvaluateExpenseRule(map[string]interface{}{"min_amount": 100.0}, 50))
-	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := utils.CanRevoke(tt.status)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplyCancelRules_ParseCondition(t *testing.T) {
+	fridayTravel := map[string]interface{}{
+		"all": []interface{}{
+			map[string]interface{}{"attr": "category", "op": "==", "value": "travel"},
+			map[string]interface{}{"attr": "amount", "op": "<", "value": 3000},
+			map[string]interface{}{"not": map[string]interface{}{"attr": "weekday", "op": "==", "value": "FRIDAY"}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		condition map[string]interface{}
+		facts     utils.Facts
+		expected  bool
+	}{
+		{
+			name:      "Legacy Max Days",
+			condition: map[string]interface{}{"max_days": 5.0},
+			facts:     utils.Facts{utils.AttrDays: 3},
+			expected:  true,
+		},
+		{
+			name:      "Legacy Max Days Exceeded",
+			condition: map[string]interface{}{"max_days": 5.0},
+			facts:     utils.Facts{utils.AttrDays: 6},
+			expected:  false,
+		},
+		{
+			name:      "Travel Under Limit On Monday",
+			condition: fridayTravel,
+			facts:     utils.Facts{utils.AttrCategory: "travel", utils.AttrAmount: 1200.0, utils.AttrWeekday: "MONDAY"},
+			expected:  true,
+		},
+		{
+			name:      "Travel Under Limit On Friday",
+			condition: fridayTravel,
+			facts:     utils.Facts{utils.AttrCategory: "travel", utils.AttrAmount: 1200.0, utils.AttrWeekday: "FRIDAY"},
+			expected:  false,
+		},
+		{
+			name: "Any With In",
+			condition: map[string]interface{}{
+				"any": []interface{}{
+					map[string]interface{}{"attr": "leave_type", "op": "in", "value": []interface{}{"SICK", "CASUAL"}},
+					map[string]interface{}{"attr": "role", "op": "==", "value": "MANAGER"},
+				},
+			},
+			facts:    utils.Facts{utils.AttrLeaveType: "sick", utils.AttrRole: "EMPLOYEE"},
+			expected: true,
+		},
+		{
+			name:      "Between Inclusive",
+			condition: map[string]interface{}{"attr": "days", "op": "between", "value": []interface{}{1, 3}},
+			facts:     utils.Facts{utils.AttrDays: 3},
+			expected:  true,
+		},
+		{
+			name:      "Grade Comparison",
+			condition: map[string]interface{}{"attr": "grade", "op": "<=", "value": 2},
+			facts:     utils.Facts{utils.AttrGrade: int64(3)},
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, err := utils.ParseCondition(tt.condition)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, cond.Evaluate(tt.facts))
+		})
+	}
+}
+
+func TestApplyCancelRules_ParseConditionErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition map[string]interface{}
+		field     string
+	}{
+		{
+			name:      "Unknown Attribute",
+			condition: map[string]interface{}{"attr": "colour", "op": "==", "value": "red"},
+			field:     "condition.attr",
+		},
+		{
+			name:      "Unsupported Operator",
+			condition: map[string]interface{}{"attr": "days", "op": "~", "value": 3},
+			field:     "condition.op",
+		},
+		{
+			name:      "Ordering On String Attribute",
+			condition: map[string]interface{}{"attr": "category", "op": "<", "value": 3},
+			field:     "condition.op",
+		},
+		{
+			name: "Nested Wrong Value Type",
+			condition: map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"attr": "days", "op": "<=", "value": 3},
+					map[string]interface{}{"attr": "amount", "op": "<", "value": "lots"},
+				},
+			},
+			field: "condition.all[1].value",
+		},
+		{
+			name:      "Between Reversed",
+			condition: map[string]interface{}{"attr": "amount", "op": "between", "value": []interface{}{10, 1}},
+			field:     "condition.value",
+		},
+		{
+			name:      "Empty Group",
+			condition: map[string]interface{}{"any": []interface{}{}},
+			field:     "condition.any",
+		},
+		{
+			name:      "Unknown Weekday",
+			condition: map[string]interface{}{"attr": "weekday", "op": "==", "value": "FUNDAY"},
+			field:     "condition.value",
+		},
+		{
+			name:      "Unknown Key",
+			condition: map[string]interface{}{"max_day": 3},
+			field:     "condition.max_day",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := utils.ParseCondition(tt.condition)
+			assert.ErrorIs(t, err, apperrors.ErrInvalidCondition)
+
+			var condErr *utils.ConditionError
+			if assert.ErrorAs(t, err, &condErr) {
+				assert.Equal(t, tt.field, condErr.Field)
+			}
+		})
+	}
+}
+
+func TestApplyCancelRules_ValidateCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestType string
+		condition   map[string]interface{}
+		fields      []string
+	}{
+		{
+			name:        "Valid Leave Condition",
+			requestType: "LEAVE",
+			condition: map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"attr": "days", "op": "<=", "value": 3},
+					map[string]interface{}{"attr": "leave_type", "op": "in", "value": []interface{}{"SICK"}},
+				},
+			},
+		},
+		{
+			name:        "Valid Legacy Expense Condition",
+			requestType: "expense",
+			condition:   map[string]interface{}{"max_amount": 5000},
+		},
+		{
+			name:        "Misspelled Legacy Key",
+			requestType: "LEAVE",
+			condition:   map[string]interface{}{"max_day": 3},
+			fields:      []string{"condition.max_day"},
+		},
+		{
+			name:        "Legacy Key Of Another Type",
+			requestType: "LEAVE",
+			condition:   map[string]interface{}{"max_amount": 3},
+			fields:      []string{"condition.max_amount"},
+		},
+		{
+			name:        "Attribute Not Exposed By Request Type",
+			requestType: "DISCOUNT",
+			condition:   map[string]interface{}{"attr": "days", "op": "<", "value": 3},
+			fields:      []string{"condition.attr"},
+		},
+		{
+			name:        "Cumulative Attribute",
+			requestType: "EXPENSE",
+			condition:   map[string]interface{}{"attr": "month_expense_total", "op": "<", "value": 15000},
+		},
+		{
+			name:        "Cumulative Attribute Of Another Type",
+			requestType: "EXPENSE",
+			condition:   map[string]interface{}{"attr": "leave_count_30d", "op": "<", "value": 3},
+			fields:      []string{"condition.attr"},
+		},
+		{
+			name:        "Calendar Attributes",
+			requestType: "LEAVE",
+			condition: map[string]interface{}{
+				"all": []interface{}{
+					map[string]interface{}{"attr": "notice_working_days", "op": ">=", "value": 5},
+					map[string]interface{}{"attr": "touches_holiday", "op": "==", "value": false},
+					map[string]interface{}{"attr": "spans_month_end", "op": "!=", "value": true},
+				},
+			},
+		},
+		{
+			name:        "Calendar Attribute Of Another Type",
+			requestType: "EXPENSE",
+			condition:   map[string]interface{}{"attr": "touches_holiday", "op": "==", "value": true},
+			fields:      []string{"condition.attr"},
+		},
+		{
+			name:        "Boolean Attribute Misused",
+			requestType: "DISCOUNT",
+			condition: map[string]interface{}{
+				"any": []interface{}{
+					map[string]interface{}{"attr": "weekend_submission", "op": "==", "value": "yes"},
+					map[string]interface{}{"attr": "weekend_submission", "op": ">", "value": 0},
+				},
+			},
+			fields: []string{"condition.any[0].value", "condition.any[1].op"},
+		},
+		{
+			name:        "Negative Limit",
+			requestType: "EXPENSE",
+			condition:   map[string]interface{}{"attr": "amount", "op": "between", "value": []interface{}{-10, 100}},
+			fields:      []string{"condition.value[0]"},
+		},
+		{
+			name:        "Every Error Reported",
+			requestType: "LEAVE",
+			condition: map[string]interface{}{
+				"any": []interface{}{
+					map[string]interface{}{"attr": "days", "op": "<=", "value": "three"},
+					map[string]interface{}{"attr": "days", "op": ">", "value": -1, "unit": "weeks"},
+					map[string]interface{}{"max_days": -2},
+				},
+			},
+			fields: []string{
+				"condition.any[0].value",
+				"condition.any[1].unit",
+				"condition.any[1].value",
+				"condition.any[2].max_days",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := utils.ValidateCondition(tt.requestType, tt.condition)
+			if len(tt.fields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, apperrors.ErrInvalidCondition)
+
+			var condErrs utils.ConditionErrors
+			if assert.ErrorAs(t, err, &condErrs) {
+				fields := make([]string, 0, len(condErrs))
+				for _, e := range condErrs {
+					fields = append(fields, e.Field)
+				}
+				assert.Equal(t, tt.fields, fields)
+			}
+		})
+	}
 
-	t.Run("EvaluateDiscountRule", func(t *testing.T) {
-		assert.True(t, utils.EvaluateDiscountRule(map[string]interface{}{"max_percent": 20.0}, 10))
-		assert.False(t, utils.EvaluateDiscountRule(map[string]interface{}{"max_percent": 20.0}, 25))
-		assert.False(t, utils.EvaluateDiscountRule(map[string]interface{}{"min_percent": 20.0}, 10))
+	t.Run("Unknown Request Type", func(t *testing.T) {
+		err := utils.ValidateCondition("TRAVEL", map[string]interface{}{"max_days": 3})
+		assert.ErrorIs(t, err, apperrors.ErrUnknownRequestType)
 	})
 }
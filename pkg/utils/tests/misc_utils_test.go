@@ -1,10 +1,13 @@
 package tests
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 	"github.com/stretchr/testify/assert"
@@ -76,4 +79,141 @@ func TestMiscUtils_DateAndWorkingDays(t *testing.T) {
 		// 31 days: 4 weeks (20 working) + 3 days (Sun, Mon, Tue -> 2 working) = 22 working days
 		assert.Equal(t, 22, utils.CountWorkingDays(from, to, nil))
 	})
+
+	t.Run("ExpiryDeadline", func(t *testing.T) {
+		since := time.Date(2023, 1, 5, 10, 0, 0, 0, time.UTC) // Thursday
+		isHoliday := func(d time.Time) bool {
+			return d.Day() == 9 // Monday is holiday
+		}
+
+		// Thu, Fri, (weekend), (holiday), Tue
+		assert.Equal(t, time.Date(2023, 1, 10, 10, 0, 0, 0, time.UTC), utils.ExpiryDeadline(since, 3, false, isHoliday))
+		assert.Equal(t, time.Date(2023, 1, 7, 10, 0, 0, 0, time.UTC), utils.ExpiryDeadline(since, 3, true, isHoliday))
+
+		// the deadline is the moment CountWorkingDays reaches the days
+		deadline := utils.ExpiryDeadline(since, 7, false, nil)
+		assert.Equal(t, 7, utils.CountWorkingDays(since, deadline, nil))
+		assert.Equal(t, 6, utils.CountWorkingDays(since, deadline.Add(-time.Second), nil))
+	})
+}
+
+func TestMiscUtils_LeaveCalendarFacts(t *testing.T) {
+	submitted := time.Date(2026, 1, 9, 18, 0, 0, 0, time.UTC) // Friday evening
+	from := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)      // Monday
+	to := time.Date(2026, 1, 21, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Window", func(t *testing.T) {
+		start, end := utils.LeaveCalendarWindow(submitted, from, to)
+		assert.Equal(t, time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2026, 1, 22, 0, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("No Holidays", func(t *testing.T) {
+		facts := utils.Facts{}
+		utils.AddLeaveCalendarFacts(facts, utils.NewCalendar(nil), submitted, from, to)
+
+		// Mon 12 to Fri 16 lie between submission and the leave
+		assert.Equal(t, 5, facts[utils.AttrNoticeWorkingDays])
+		assert.Equal(t, 3, facts[utils.AttrWorkingDays])
+		assert.Equal(t, false, facts[utils.AttrTouchesHoliday])
+		assert.Equal(t, false, facts[utils.AttrSpansMonthEnd])
+	})
+
+	t.Run("Holidays", func(t *testing.T) {
+		facts := utils.Facts{}
+		cal := utils.NewCalendar([]time.Time{
+			time.Date(2026, 1, 14, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 22, 0, 0, 0, 0, time.UTC), // the day after the leave
+		})
+		utils.AddLeaveCalendarFacts(facts, cal, submitted, from, to)
+
+		assert.Equal(t, 4, facts[utils.AttrNoticeWorkingDays])
+		assert.Equal(t, 3, facts[utils.AttrWorkingDays])
+		assert.Equal(t, true, facts[utils.AttrTouchesHoliday])
+	})
+
+	t.Run("Same Day And Month End", func(t *testing.T) {
+		facts := utils.Facts{}
+		last := time.Date(2026, 1, 30, 9, 0, 0, 0, time.UTC)
+		utils.AddLeaveCalendarFacts(facts, utils.NewCalendar(nil), last, last, time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC))
+
+		assert.Equal(t, 0, facts[utils.AttrNoticeWorkingDays])
+		assert.Equal(t, 2, facts[utils.AttrWorkingDays])
+		assert.Equal(t, true, facts[utils.AttrSpansMonthEnd])
+	})
+
+	t.Run("Weekend Submission", func(t *testing.T) {
+		saturday := time.Date(2026, 1, 10, 11, 0, 0, 0, time.UTC)
+		assert.Equal(t, true, utils.LeaveFacts(1, "SICK", 1, "EMPLOYEE", saturday)[utils.AttrWeekendSubmission])
+		assert.Equal(t, false, utils.ExpenseFacts(10, "FOOD", 1, "EMPLOYEE", submitted)[utils.AttrWeekendSubmission])
+	})
+}
+
+func TestMiscUtils_UsageWindows(t *testing.T) {
+	at := time.Date(2026, 8, 17, 15, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), utils.MonthStart(at))
+	assert.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), utils.QuarterStart(at))
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), utils.QuarterStart(time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2026, 7, 18, 15, 30, 0, 0, time.UTC), utils.LeaveCountWindowStart(at))
+}
+
+func TestMiscUtils_RunBulk(t *testing.T) {
+	t.Run("Each Request On Its Own", func(t *testing.T) {
+		var decided []int64
+		result, err := utils.RunBulk(constants.RoleManager, []int64{1, 2, 1, 3}, func(requestID int64) error {
+			decided = append(decided, requestID)
+			if requestID == 2 {
+				return apperrors.ErrRequestNotPending
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		// the duplicate is decided once; the failure does not stop the rest
+		assert.Equal(t, []int64{1, 2, 3}, decided)
+		assert.Equal(t, 2, result.Succeeded)
+		assert.Equal(t, 1, result.Failed)
+		assert.True(t, result.Results[0].Success)
+		assert.False(t, result.Results[1].Success)
+		assert.ErrorIs(t, result.Results[1].Err, apperrors.ErrRequestNotPending)
+	})
+
+	t.Run("Employee Turned Away Once", func(t *testing.T) {
+		result, err := utils.RunBulk(constants.RoleEmployee, []int64{1, 2}, func(int64) error { return errors.New("not called") })
+		assert.ErrorIs(t, err, apperrors.ErrEmployeeCannotApprove)
+		assert.Nil(t, result)
+	})
+
+	t.Run("No Requests", func(t *testing.T) {
+		_, err := utils.RunBulk(constants.RoleManager, nil, func(int64) error { return nil })
+		assert.ErrorIs(t, err, apperrors.ErrBulkRequestIDsMissing)
+	})
+
+	t.Run("Too Many Requests", func(t *testing.T) {
+		ids := make([]int64, utils.MaxBulkRequests+1)
+		_, err := utils.RunBulk(constants.RoleManager, ids, func(int64) error { return errors.New("not called") })
+		assert.ErrorIs(t, err, apperrors.ErrBulkTooManyRequests)
+	})
+}
+
+func TestMiscUtils_DescribeBulkFailures(t *testing.T) {
+	status := func(err error) int {
+		if errors.Is(err, apperrors.ErrRequestNotPending) {
+			return http.StatusBadRequest
+		}
+		return http.StatusInternalServerError
+	}
+	result := &models.BulkDecision{Results: []models.BulkItemResult{
+		{RequestID: 1, Success: true},
+		{RequestID: 2, Err: apperrors.ErrRequestNotPending},
+		{RequestID: 3, Err: errors.New("pq: relation \"leave_requests\" does not exist")},
+	}}
+
+	utils.DescribeBulkFailures(result, status)
+
+	assert.Empty(t, result.Results[0].Error)
+	assert.Equal(t, apperrors.ErrRequestNotPending.Error(), result.Results[1].Error)
+	// internal failures are not spelled out to the caller
+	assert.Equal(t, apperrors.ErrInternalServer.Error(), result.Results[2].Error)
 }
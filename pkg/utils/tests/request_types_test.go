@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func assetType(name string) models.RequestType {
+	return models.RequestType{
+		Name: name,
+		Fields: []models.RequestField{
+			{Name: "asset_class", Type: constants.FieldString, Required: true, Allowed: []string{"LAPTOP", "PHONE"}},
+			{Name: "asset_cost", Type: constants.FieldNumber, Required: true},
+			{Name: "needed_by", Type: constants.FieldDate},
+		},
+		Balance:    &models.RequestCharge{Kind: "expense", Field: "asset_cost"},
+		Attributes: []string{"asset_class", "asset_cost"},
+	}
+}
+
+func TestRequestTypes_RegisterRequestType(t *testing.T) {
+	t.Run("Registers Schema And Defaults", func(t *testing.T) {
+		assert.NoError(t, utils.RegisterRequestType(assetType("asset_reg")))
+
+		def, ok := utils.LookupRequestType("ASSET_REG")
+		assert.True(t, ok)
+		assert.Equal(t, "ASSET_REG", def.Name)
+		assert.Equal(t, "EXPENSE", def.Balance.Kind)
+		assert.Equal(t, []string{constants.RoleManager, constants.RoleAdmin}, def.Policy.ApproverRoles)
+		assert.Contains(t, utils.RequestTypes(), "ASSET_REG")
+
+		// declared attributes, and the common ones, become available to its rules
+		assert.NoError(t, utils.ValidateCondition("ASSET_REG", map[string]interface{}{
+			"all": []interface{}{
+				map[string]interface{}{"attr": "asset_cost", "op": "<=", "value": 2000},
+				map[string]interface{}{"attr": "asset_class", "op": "==", "value": "laptop"},
+				map[string]interface{}{"attr": "grade", "op": ">=", "value": 2},
+			},
+		}))
+		assert.ErrorIs(t, utils.ValidateCondition("ASSET_REG", map[string]interface{}{
+			"attr": "asset_class", "op": "==", "value": "TABLET",
+		}), apperrors.ErrInvalidCondition)
+		assert.ErrorIs(t, utils.ValidateCondition("ASSET_REG", map[string]interface{}{
+			"attr": "days", "op": "<=", "value": 2,
+		}), apperrors.ErrInvalidCondition)
+		// other request types do not see the new attributes
+		assert.ErrorIs(t, utils.ValidateCondition("LEAVE", map[string]interface{}{
+			"attr": "asset_cost", "op": "<=", "value": 2,
+		}), apperrors.ErrInvalidCondition)
+	})
+
+	t.Run("Names Already Taken", func(t *testing.T) {
+		assert.NoError(t, utils.RegisterRequestType(assetType("ASSET_DUP")))
+		assert.ErrorIs(t, utils.RegisterRequestType(assetType("ASSET_DUP")), apperrors.ErrRequestTypeExists)
+		assert.ErrorIs(t, utils.RegisterRequestType(assetType("LEAVE")), apperrors.ErrRequestTypeExists)
+	})
+
+	tests := []struct {
+		name   string
+		mutate func(def *models.RequestType)
+	}{
+		{"Bad Name", func(def *models.RequestType) { def.Name = "a b" }},
+		{"No Fields", func(def *models.RequestType) { def.Fields = nil; def.Attributes = nil; def.Balance = nil }},
+		{"Duplicate Field", func(def *models.RequestType) { def.Fields = append(def.Fields, def.Fields[0]) }},
+		{"Unknown Field Type", func(def *models.RequestType) { def.Fields[2].Type = "bool" }},
+		{"Attribute Not A Field", func(def *models.RequestType) { def.Attributes = []string{"cost"} }},
+		{"Date Attribute", func(def *models.RequestType) { def.Attributes = []string{"needed_by"} }},
+		{"Common Attribute Redeclared", func(def *models.RequestType) {
+			def.Fields = append(def.Fields, models.RequestField{Name: "grade", Type: constants.FieldNumber})
+			def.Attributes = []string{"grade"}
+		}},
+		{"Attribute Kind Clash", func(def *models.RequestType) {
+			def.Fields = append(def.Fields, models.RequestField{Name: "amount", Type: constants.FieldString})
+			def.Attributes = []string{"amount"}
+		}},
+		{"Unknown Balance", func(def *models.RequestType) { def.Balance.Kind = "TRAVEL" }},
+		{"Optional Balance Field", func(def *models.RequestType) { def.Balance.Field = "needed_by" }},
+		{"Employee Approver", func(def *models.RequestType) { def.Policy.ApproverRoles = []string{constants.RoleEmployee} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := assetType("ASSET_BAD")
+			tt.mutate(&def)
+
+			err := utils.RegisterRequestType(def)
+
+			assert.ErrorIs(t, err, apperrors.ErrInvalidRequestTypeDefinition)
+			_, ok := utils.LookupRequestType("ASSET_BAD")
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestRequestTypes_ValidatePayload(t *testing.T) {
+	def := assetType("ASSET_PAYLOAD")
+
+	tests := []struct {
+		name          string
+		payload       map[string]interface{}
+		expected      map[string]interface{}
+		expectedField string
+	}{
+		{
+			name:     "Valid",
+			payload:  map[string]interface{}{"asset_class": "Laptop", "asset_cost": 1200, "needed_by": "2026-11-02", "extra": true},
+			expected: map[string]interface{}{"asset_class": "Laptop", "asset_cost": 1200.0, "needed_by": "2026-11-02"},
+		},
+		{
+			name:     "Optional Field Missing",
+			payload:  map[string]interface{}{"asset_class": "PHONE", "asset_cost": 300.0},
+			expected: map[string]interface{}{"asset_class": "PHONE", "asset_cost": 300.0},
+		},
+		{name: "Required Field Missing", payload: map[string]interface{}{"asset_class": "PHONE"}, expectedField: "asset_cost"},
+		{name: "Not A Number", payload: map[string]interface{}{"asset_class": "PHONE", "asset_cost": "300"}, expectedField: "asset_cost"},
+		{name: "Negative Number", payload: map[string]interface{}{"asset_class": "PHONE", "asset_cost": -1.0}, expectedField: "asset_cost"},
+		{name: "Value Not Allowed", payload: map[string]interface{}{"asset_class": "TABLET", "asset_cost": 1.0}, expectedField: "asset_class"},
+		{name: "Bad Date", payload: map[string]interface{}{"asset_class": "PHONE", "asset_cost": 1.0, "needed_by": "02/11/2026"}, expectedField: "needed_by"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clean, err := utils.ValidatePayload(def, tt.payload)
+
+			if tt.expectedField != "" {
+				assert.ErrorIs(t, err, apperrors.ErrInvalidRequestPayload)
+				var payloadErr *utils.PayloadError
+				assert.ErrorAs(t, err, &payloadErr)
+				assert.Equal(t, tt.expectedField, payloadErr.Field)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, clean)
+		})
+	}
+}
+
+func TestRequestTypes_RequestFacts(t *testing.T) {
+	def := assetType("ASSET_FACTS")
+	payload := map[string]interface{}{"asset_class": "PHONE", "asset_cost": 300.0, "needed_by": "2026-11-02"}
+	monday := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	facts := utils.RequestFacts(def, payload, 2, constants.RoleEmployee, monday)
+
+	assert.Equal(t, utils.Facts{
+		"asset_class":     "PHONE",
+		"asset_cost":      300.0,
+		utils.AttrGrade:   int64(2),
+		utils.AttrRole:    constants.RoleEmployee,
+		utils.AttrWeekday: "MONDAY",
+
+		utils.AttrWeekendSubmission: false,
+	}, facts)
+	assert.Equal(t, 300.0, utils.ChargeOf(def, payload))
+
+	def.Balance = nil
+	assert.Equal(t, 0.0, utils.ChargeOf(def, payload))
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
+)
+
+// leave requests counted by leave_count_30d are those filed in this many days before submission
+const LeaveCountWindowDays = 30
+
+// ApprovedStatuses are the outcomes counted towards expense and discount totals
+var ApprovedStatuses = []string{constants.StatusApproved, constants.StatusAutoApproved}
+
+// ActiveLeaveStatuses are the outcomes counted by leave_count_30d; cancelled and rejected leave is ignored
+var ActiveLeaveStatuses = []string{constants.StatusPending, constants.StatusNeedsInfo, constants.StatusApproved, constants.StatusAutoApproved}
+
+// MonthStart returns midnight on the first day of t's month
+func MonthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// QuarterStart returns midnight on the first day of t's calendar quarter
+func QuarterStart(t time.Time) time.Time {
+	month := time.Month((int(t.Month())-1)/3*3 + 1)
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+}
+
+// LeaveCountWindowStart returns the start of the rolling window counted by leave_count_30d
+func LeaveCountWindowStart(t time.Time) time.Time {
+	return t.AddDate(0, 0, -LeaveCountWindowDays)
+}
@@ -20,3 +20,18 @@ func CountWorkingDays(from, to time.Time, isHoliday func(time.Time) bool) int {
 
 	return days
 }
+
+// ExpiryDeadline returns when something waiting since since has waited the given number of days,
+// counting since's own day as the first, the way CountWorkingDays counts them.
+// Working days skip weekends and holidays; calendar days count every day.
+func ExpiryDeadline(since time.Time, days int, calendarDays bool, isHoliday func(time.Time) bool) time.Time {
+	for d, counted := since, 0; ; d = d.AddDate(0, 0, 1) {
+		if !calendarDays && (isWeekend(d) || (isHoliday != nil && isHoliday(d))) {
+			continue
+		}
+		counted++
+		if counted >= days {
+			return d
+		}
+	}
+}
@@ -6,22 +6,26 @@ import (
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 const (
 	aggQueryFetchAllLeaves = `
-		SELECT id, leave_type, from_date, to_date, status::TEXT, reason, approval_comment, created_at
+		SELECT id, leave_type, from_date, to_date, status::TEXT, reason, approval_comment, decision_trace, created_at
 		FROM leave_requests WHERE employee_id = $1
 	`
 	aggQueryFetchAllExpenses = `
-		SELECT id, amount, category, status::TEXT, reason, approval_comment, created_at
+		SELECT id, amount, category, status::TEXT, reason, approval_comment, decision_trace, created_at
 		FROM expense_requests WHERE employee_id = $1
 	`
 	aggQueryFetchAllDiscounts = `
-		SELECT id, discount_percentage, status::TEXT, reason, approval_comment, created_at
+		SELECT id, discount_percentage, status::TEXT, reason, approval_comment, decision_trace, created_at
 		FROM discount_requests WHERE employee_id = $1
 	`
+	aggQueryFetchLeave    = aggQueryFetchAllLeaves + ` AND id = $2`
+	aggQueryFetchExpense  = aggQueryFetchAllExpenses + ` AND id = $2`
+	aggQueryFetchDiscount = aggQueryFetchAllDiscounts + ` AND id = $2`
 )
 
 type aggregatedRepository struct {
@@ -98,7 +102,11 @@ func (r *aggregatedRepository) GetMyAllRequests(ctx context.Context, userID int6
 }
 
 func (r *aggregatedRepository) fetchAllLeaves(ctx context.Context, userID int64) ([]aggCombinedReq, error) {
-	rows, err := r.db.Query(ctx, aggQueryFetchAllLeaves, userID)
+	return r.fetchLeaves(ctx, aggQueryFetchAllLeaves, userID)
+}
+
+func (r *aggregatedRepository) fetchLeaves(ctx context.Context, query string, args ...interface{}) ([]aggCombinedReq, error) {
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, utils.MapPgError(err)
 	}
@@ -113,9 +121,10 @@ func (r *aggregatedRepository) fetchAllLeaves(ctx context.Context, userID int64)
 			status   string
 			reason   string
 			comment  *string
+			trace    []byte
 			created  time.Time
 		)
-		if err := rows.Scan(&id, &lType, &from, &to, &status, &reason, &comment, &created); err != nil {
+		if err := rows.Scan(&id, &lType, &from, &to, &status, &reason, &comment, &trace, &created); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		res = append(res, aggCombinedReq{
@@ -129,6 +138,7 @@ func (r *aggregatedRepository) fetchAllLeaves(ctx context.Context, userID int64)
 				"status":           status,
 				"reason":           reason,
 				"approval_comment": comment,
+				"decision_trace":   unmarshalDecisionTrace(trace),
 				"created_at":       created.Format(time.RFC3339),
 			},
 		})
@@ -137,7 +147,11 @@ func (r *aggregatedRepository) fetchAllLeaves(ctx context.Context, userID int64)
 }
 
 func (r *aggregatedRepository) fetchAllExpenses(ctx context.Context, userID int64) ([]aggCombinedReq, error) {
-	rows, err := r.db.Query(ctx, aggQueryFetchAllExpenses, userID)
+	return r.fetchExpenses(ctx, aggQueryFetchAllExpenses, userID)
+}
+
+func (r *aggregatedRepository) fetchExpenses(ctx context.Context, query string, args ...interface{}) ([]aggCombinedReq, error) {
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, utils.MapPgError(err)
 	}
@@ -152,9 +166,10 @@ func (r *aggregatedRepository) fetchAllExpenses(ctx context.Context, userID int6
 			status  string
 			reason  string
 			comment *string
+			trace   []byte
 			created time.Time
 		)
-		if err := rows.Scan(&id, &amount, &cat, &status, &reason, &comment, &created); err != nil {
+		if err := rows.Scan(&id, &amount, &cat, &status, &reason, &comment, &trace, &created); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		res = append(res, aggCombinedReq{
@@ -167,6 +182,7 @@ func (r *aggregatedRepository) fetchAllExpenses(ctx context.Context, userID int6
 				"status":           status,
 				"reason":           reason,
 				"approval_comment": comment,
+				"decision_trace":   unmarshalDecisionTrace(trace),
 				"created_at":       created.Format(time.RFC3339),
 			},
 		})
@@ -175,7 +191,11 @@ func (r *aggregatedRepository) fetchAllExpenses(ctx context.Context, userID int6
 }
 
 func (r *aggregatedRepository) fetchAllDiscounts(ctx context.Context, userID int64) ([]aggCombinedReq, error) {
-	rows, err := r.db.Query(ctx, aggQueryFetchAllDiscounts, userID)
+	return r.fetchDiscounts(ctx, aggQueryFetchAllDiscounts, userID)
+}
+
+func (r *aggregatedRepository) fetchDiscounts(ctx context.Context, query string, args ...interface{}) ([]aggCombinedReq, error) {
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, utils.MapPgError(err)
 	}
@@ -189,9 +209,10 @@ func (r *aggregatedRepository) fetchAllDiscounts(ctx context.Context, userID int
 			status  string
 			reason  string
 			comment *string
+			trace   []byte
 			created time.Time
 		)
-		if err := rows.Scan(&id, &percent, &status, &reason, &comment, &created); err != nil {
+		if err := rows.Scan(&id, &percent, &status, &reason, &comment, &trace, &created); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		res = append(res, aggCombinedReq{
@@ -203,6 +224,7 @@ func (r *aggregatedRepository) fetchAllDiscounts(ctx context.Context, userID int
 				"status":              status,
 				"reason":              reason,
 				"approval_comment":    comment,
+				"decision_trace":      unmarshalDecisionTrace(trace),
 				"created_at":          created.Format(time.RFC3339),
 			},
 		})
@@ -246,3 +268,36 @@ func (r *aggregatedRepository) GetMyDiscountRequests(ctx context.Context, userID
 	}
 	return data, nil
 }
+
+// GetMyRequest returns one of the user's requests together with its decision trace
+func (r *aggregatedRepository) GetMyRequest(ctx context.Context, userID int64, reqType string, requestID int64) (map[string]interface{}, error) {
+	var (
+		res []aggCombinedReq
+		err error
+	)
+
+	switch reqType {
+	case "LEAVE":
+		res, err = r.fetchLeaves(ctx, aggQueryFetchLeave, userID, requestID)
+		if err == nil && len(res) == 0 {
+			err = apperrors.ErrLeaveRequestNotFound
+		}
+	case "EXPENSE":
+		res, err = r.fetchExpenses(ctx, aggQueryFetchExpense, userID, requestID)
+		if err == nil && len(res) == 0 {
+			err = apperrors.ErrExpenseRequestNotFound
+		}
+	case "DISCOUNT":
+		res, err = r.fetchDiscounts(ctx, aggQueryFetchDiscount, userID, requestID)
+		if err == nil && len(res) == 0 {
+			err = apperrors.ErrDiscountRequestNotFound
+		}
+	default:
+		return nil, apperrors.ErrUnknownRequestType
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return res[0].data, nil
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+const (
+	amendmentQueryCreate = `INSERT INTO request_amendments
+		 (request_type, request_id, employee_id, before_values, after_values, status)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, amended_at`
+	amendmentQueryGetForRequest = `SELECT id, request_type, request_id, employee_id, before_values, after_values, status, amended_at
+		 FROM request_amendments
+		 WHERE request_type=$1 AND request_id=$2
+		 ORDER BY amended_at, id`
+)
+
+type amendmentRepository struct {
+	db interfaces.DB
+}
+
+// NewAmendmentRepository creates a new instance
+func NewAmendmentRepository(ctx context.Context, db interfaces.DB) interfaces.AmendmentRepository {
+	return &amendmentRepository{db: db}
+}
+
+// Create records an amendment of a request; ID and AmendedAt are filled in
+func (r *amendmentRepository) Create(ctx context.Context, tx interfaces.Tx, a *models.RequestAmendment) error {
+	before, err := json.Marshal(a.Before)
+	if err != nil {
+		return err
+	}
+	after, err := json.Marshal(a.After)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		amendmentQueryCreate,
+		a.RequestType,
+		a.RequestID,
+		a.EmployeeID,
+		before,
+		after,
+		a.Status,
+	).Scan(&a.ID, &a.AmendedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetForRequest returns the amendments of a request, oldest first
+func (r *amendmentRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestAmendment, error) {
+	rows, err := r.db.Query(ctx, amendmentQueryGetForRequest, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var amendments []models.RequestAmendment
+	for rows.Next() {
+		var a models.RequestAmendment
+		var before, after []byte
+		if err := rows.Scan(
+			&a.ID,
+			&a.RequestType,
+			&a.RequestID,
+			&a.EmployeeID,
+			&before,
+			&after,
+			&a.Status,
+			&a.AmendedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		_ = json.Unmarshal(before, &a.Before)
+		_ = json.Unmarshal(after, &a.After)
+		amendments = append(amendments, a)
+	}
+	return amendments, utils.MapPgError(rows.Err())
+}
@@ -0,0 +1,300 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	approvalChainColumns     = `id, name, request_type, condition, priority, steps, active, created_by, created_at`
+	approvalChainQueryCreate = `INSERT INTO approval_chains (name, request_type, condition, priority, steps, created_by)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, active, created_at`
+	approvalChainQueryGetAll = `SELECT ` + approvalChainColumns + `
+		 FROM approval_chains
+		 ORDER BY request_type, active DESC, priority, id`
+	approvalChainQueryGetActive = `SELECT ` + approvalChainColumns + `
+		 FROM approval_chains
+		 WHERE request_type=$1 AND active
+		 ORDER BY priority, id`
+	approvalChainQueryDeactivate = `UPDATE approval_chains SET active=FALSE WHERE id=$1 AND active`
+
+	approvalStepColumns = `id, request_type, request_id, employee_id, chain_id, step_no, approver, assigned_to,
+		        COALESCE(department, ''), COALESCE(role, ''), status, decided_by, on_behalf_of, COALESCE(comment, ''), decided_at, created_at`
+	approvalStepQueryCreate = `INSERT INTO request_approval_steps
+		 (request_type, request_id, employee_id, chain_id, step_no, approver, assigned_to, department, role)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9, ''))
+		 RETURNING id, status, created_at`
+	approvalStepQueryGetByRequest = `SELECT ` + approvalStepColumns + `
+		 FROM request_approval_steps
+		 WHERE request_type=$1 AND request_id=$2
+		 ORDER BY step_no`
+	// locks the steps while one of them is decided
+	approvalStepQueryGetForUpdate = approvalStepQueryGetByRequest + `
+		 FOR UPDATE`
+	approvalStepQueryDecide = `UPDATE request_approval_steps
+		 SET status=$2,
+		     decided_by=$3,
+		     on_behalf_of=$4,
+		     comment=NULLIF($5, ''),
+		     decided_at=NOW()
+		 WHERE id=$1`
+	approvalStepQuerySkipPending = `UPDATE request_approval_steps
+		 SET status='SKIPPED'
+		 WHERE request_type=$1 AND request_id=$2 AND status='PENDING'`
+	approvalStepQueryDelete   = `DELETE FROM request_approval_steps WHERE request_type=$1 AND request_id=$2`
+	approvalStepQueryReassign = `UPDATE request_approval_steps
+		 SET assigned_to=$2,
+		     department=NULL,
+		     role=NULLIF($3, '')
+		 WHERE id=$1 AND status='PENDING'`
+	// whether the current step (cs) of a chained request waits for the manager m, by name, by
+	// department or by role; the manager queues use it in place of the requester's manager
+	approvalStepMatchesManager = `(cs.assigned_to = m.id
+		       OR (cs.assigned_to IS NULL AND cs.department IS NOT NULL AND UPPER(cs.department) = UPPER(m.department))
+		       OR (cs.assigned_to IS NULL AND cs.department IS NULL AND cs.role = 'MANAGER'))`
+	// only the first pending step of a request is waiting for anyone, and only while the request
+	// is not waiting for an answer from its requester
+	approvalStepQueryGetPendingFor = `SELECT ` + approvalStepColumns + `
+		 FROM request_approval_steps s
+		 WHERE s.status='PENDING'
+		   AND s.employee_id <> $1
+		   AND NOT EXISTS (
+		       SELECT 1 FROM request_approval_steps p
+		       WHERE p.request_type=s.request_type AND p.request_id=s.request_id
+		         AND p.status='PENDING' AND p.step_no < s.step_no
+		   )
+		   AND NOT EXISTS (
+		       SELECT 1 FROM request_info_exchanges i
+		       WHERE i.request_type=s.request_type AND i.request_id=s.request_id
+		         AND i.answered_at IS NULL
+		   )
+		   AND (
+		       s.assigned_to=$1
+		       OR (s.assigned_to IS NULL AND s.department IS NOT NULL AND $2 IN ('MANAGER', 'ADMIN')
+		           AND UPPER(s.department)=(SELECT UPPER(department) FROM users WHERE id=$1))
+		       OR (s.assigned_to IS NULL AND s.department IS NULL AND s.role=$2)
+		   )
+		 ORDER BY s.created_at, s.id`
+)
+
+type approvalChainRepository struct {
+	db interfaces.DB
+}
+
+// NewApprovalChainRepository creates a new instance
+func NewApprovalChainRepository(ctx context.Context, db interfaces.DB) interfaces.ApprovalChainRepository {
+	return &approvalChainRepository{db: db}
+}
+
+// Create stores an active chain; ID, Active and CreatedAt are filled in
+func (r *approvalChainRepository) Create(ctx context.Context, chain *models.ApprovalChain) error {
+	condition, err := json.Marshal(chain.Condition)
+	if err != nil {
+		return apperrors.ErrInvalidConditionJSON
+	}
+	steps, err := json.Marshal(chain.Steps)
+	if err != nil {
+		return apperrors.ErrInvalidChainStep
+	}
+
+	err = r.db.QueryRow(
+		ctx,
+		approvalChainQueryCreate,
+		chain.Name,
+		chain.RequestType,
+		condition,
+		chain.Priority,
+		steps,
+		chain.CreatedBy,
+	).Scan(&chain.ID, &chain.Active, &chain.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetAll returns every chain, active ones first within a request type
+func (r *approvalChainRepository) GetAll(ctx context.Context) ([]models.ApprovalChain, error) {
+	rows, err := r.db.Query(ctx, approvalChainQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanApprovalChains(rows)
+}
+
+// GetActive returns the active chains of a request type by priority
+func (r *approvalChainRepository) GetActive(ctx context.Context, requestType string) ([]models.ApprovalChain, error) {
+	rows, err := r.db.Query(ctx, approvalChainQueryGetActive, requestType)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanApprovalChains(rows)
+}
+
+// Deactivate stops a chain from applying to new requests; requests already on it keep their steps
+func (r *approvalChainRepository) Deactivate(ctx context.Context, chainID int64) error {
+	tag, err := r.db.Exec(ctx, approvalChainQueryDeactivate, chainID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrChainNotFound
+	}
+	return nil
+}
+
+// CreateSteps stores the steps of a request's chain; their IDs, Status and CreatedAt are filled in
+func (r *approvalChainRepository) CreateSteps(ctx context.Context, tx interfaces.Tx, steps []models.RequestApprovalStep) error {
+	for i := range steps {
+		step := &steps[i]
+		err := tx.QueryRow(
+			ctx,
+			approvalStepQueryCreate,
+			step.RequestType,
+			step.RequestID,
+			step.EmployeeID,
+			step.ChainID,
+			step.StepNo,
+			step.Approver,
+			step.AssignedTo,
+			step.Department,
+			step.Role,
+		).Scan(&step.ID, &step.Status, &step.CreatedAt)
+		if err != nil {
+			return utils.MapPgError(err)
+		}
+	}
+	return nil
+}
+
+// GetSteps returns a request's steps in order and locks them until the transaction ends
+func (r *approvalChainRepository) GetSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	rows, err := tx.Query(ctx, approvalStepQueryGetForUpdate, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanApprovalSteps(rows)
+}
+
+// GetRequestSteps returns a request's steps in order
+func (r *approvalChainRepository) GetRequestSteps(ctx context.Context, requestType string, requestID int64) ([]models.RequestApprovalStep, error) {
+	rows, err := r.db.Query(ctx, approvalStepQueryGetByRequest, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanApprovalSteps(rows)
+}
+
+// GetPendingStepsFor returns the current steps the approver may decide, oldest first
+func (r *approvalChainRepository) GetPendingStepsFor(ctx context.Context, approverID int64, role string) ([]models.RequestApprovalStep, error) {
+	rows, err := r.db.Query(ctx, approvalStepQueryGetPendingFor, approverID, role)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanApprovalSteps(rows)
+}
+
+// DecideStep records an approver's decision on a step, and the delegator they decided it for
+func (r *approvalChainRepository) DecideStep(ctx context.Context, tx interfaces.Tx, stepID int64, status string, approverID int64, onBehalfOf *int64, comment string) error {
+	_, err := tx.Exec(ctx, approvalStepQueryDecide, stepID, status, approverID, onBehalfOf, comment)
+	return utils.MapPgError(err)
+}
+
+// SkipPendingSteps closes the steps still waiting once a request is rejected or withdrawn
+func (r *approvalChainRepository) SkipPendingSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	_, err := tx.Exec(ctx, approvalStepQuerySkipPending, requestType, requestID)
+	return utils.MapPgError(err)
+}
+
+// DeleteSteps removes every step of a request, decided or not
+func (r *approvalChainRepository) DeleteSteps(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) error {
+	_, err := tx.Exec(ctx, approvalStepQueryDelete, requestType, requestID)
+	return utils.MapPgError(err)
+}
+
+// ReassignStep hands a pending step to a person, or to everyone with a role when assignedTo is nil
+func (r *approvalChainRepository) ReassignStep(ctx context.Context, tx interfaces.Tx, stepID int64, assignedTo *int64, role string) error {
+	_, err := tx.Exec(ctx, approvalStepQueryReassign, stepID, assignedTo, role)
+	return utils.MapPgError(err)
+}
+
+func scanApprovalChains(rows pgx.Rows) ([]models.ApprovalChain, error) {
+	var chains []models.ApprovalChain
+
+	for rows.Next() {
+		var c models.ApprovalChain
+		var condition, steps []byte
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.Name,
+			&c.RequestType,
+			&condition,
+			&c.Priority,
+			&steps,
+			&c.Active,
+			&c.CreatedBy,
+			&c.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		if err := json.Unmarshal(condition, &c.Condition); err != nil {
+			return nil, apperrors.ErrInvalidConditionJSON
+		}
+		if err := json.Unmarshal(steps, &c.Steps); err != nil {
+			return nil, apperrors.ErrInvalidChainStep
+		}
+		chains = append(chains, c)
+	}
+
+	return chains, utils.MapPgError(rows.Err())
+}
+
+func scanApprovalSteps(rows pgx.Rows) ([]models.RequestApprovalStep, error) {
+	var steps []models.RequestApprovalStep
+
+	for rows.Next() {
+		var s models.RequestApprovalStep
+
+		if err := rows.Scan(
+			&s.ID,
+			&s.RequestType,
+			&s.RequestID,
+			&s.EmployeeID,
+			&s.ChainID,
+			&s.StepNo,
+			&s.Approver,
+			&s.AssignedTo,
+			&s.Department,
+			&s.Role,
+			&s.Status,
+			&s.DecidedBy,
+			&s.OnBehalfOf,
+			&s.Comment,
+			&s.DecidedAt,
+			&s.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		steps = append(steps, s)
+	}
+
+	return steps, utils.MapPgError(rows.Err())
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+const (
+	// grade, role, manager and department are the requester's current ones; their history is not kept
+	backtestQueryGetHistoricalRequests = `
+		SELECT 'LEAVE', lr.id, lr.employee_id, u.grade_id, u.role::TEXT, u.manager_id, COALESCE(u.department, ''), lr.from_date, lr.to_date,
+		       COALESCE(lr.leave_type::TEXT, ''), 0::FLOAT8, '', 0::FLOAT8, lr.status::TEXT, lr.created_at
+		FROM leave_requests lr
+		JOIN users u ON lr.employee_id = u.id
+
+		UNION ALL
+
+		SELECT 'EXPENSE', er.id, er.employee_id, u.grade_id, u.role::TEXT, u.manager_id, COALESCE(u.department, ''), NULL::DATE, NULL::DATE,
+		       '', er.amount::FLOAT8, COALESCE(er.category::TEXT, ''), 0::FLOAT8, er.status::TEXT, er.created_at
+		FROM expense_requests er
+		JOIN users u ON er.employee_id = u.id
+
+		UNION ALL
+
+		SELECT 'DISCOUNT', dr.id, dr.employee_id, u.grade_id, u.role::TEXT, u.manager_id, COALESCE(u.department, ''), NULL::DATE, NULL::DATE,
+		       '', 0::FLOAT8, '', dr.discount_percentage::FLOAT8, dr.status::TEXT, dr.created_at
+		FROM discount_requests dr
+		JOIN users u ON dr.employee_id = u.id
+
+		ORDER BY 15`
+)
+
+type backtestRepository struct {
+	db interfaces.DB
+}
+
+// NewBacktestRepository creates a new instance
+func NewBacktestRepository(ctx context.Context, db interfaces.DB) interfaces.BacktestRepository {
+	return &backtestRepository{db: db}
+}
+
+// GetHistoricalRequests returns every leave, expense and discount request, oldest first
+func (r *backtestRepository) GetHistoricalRequests(ctx context.Context) ([]models.HistoricalRequest, error) {
+	rows, err := r.db.Query(ctx, backtestQueryGetHistoricalRequests)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var requests []models.HistoricalRequest
+	for rows.Next() {
+		var req models.HistoricalRequest
+		if err := rows.Scan(
+			&req.RequestType,
+			&req.ID,
+			&req.EmployeeID,
+			&req.GradeID,
+			&req.Role,
+			&req.ManagerID,
+			&req.Department,
+			&req.FromDate,
+			&req.ToDate,
+			&req.LeaveType,
+			&req.Amount,
+			&req.Category,
+			&req.DiscountPercentage,
+			&req.Status,
+			&req.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		requests = append(requests, req)
+	}
+
+	return requests, utils.MapPgError(rows.Err())
+}
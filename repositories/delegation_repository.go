@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	delegationColumns     = `id, delegator_id, delegate_id, start_date, end_date, COALESCE(reason, ''), leave_request_id, revoked_at, created_at`
+	delegationQueryCreate = `INSERT INTO approval_delegations (delegator_id, delegate_id, start_date, end_date, reason, leave_request_id)
+		 VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)
+		 RETURNING id, created_at`
+	delegationQueryGetForUser = `SELECT ` + delegationColumns + `
+		 FROM approval_delegations
+		 WHERE delegator_id=$1 OR delegate_id=$1
+		 ORDER BY start_date DESC, id DESC`
+	delegationQueryHasOverlap = `SELECT 1
+		 FROM approval_delegations
+		 WHERE delegator_id=$1
+		   AND revoked_at IS NULL
+		   AND start_date <= $3
+		   AND end_date >= $2
+		 LIMIT 1`
+	delegationQueryRevoke = `UPDATE approval_delegations
+		 SET revoked_at=NOW()
+		 WHERE id=$1 AND delegator_id=$2 AND revoked_at IS NULL`
+	delegationQueryRevokeForLeave = `UPDATE approval_delegations
+		 SET revoked_at=NOW()
+		 WHERE leave_request_id=$1 AND revoked_at IS NULL`
+	// the approvers a delegate stands in for on a day
+	delegationQueryGetActiveDelegators = `SELECT u.id, u.grade_id, u.manager_id, COALESCE(u.department, ''), u.role
+		 FROM approval_delegations d
+		 JOIN users u ON u.id = d.delegator_id
+		 WHERE d.delegate_id=$1
+		   AND d.revoked_at IS NULL
+		   AND $2::date BETWEEN d.start_date AND d.end_date
+		 ORDER BY d.start_date, d.id`
+)
+
+type delegationRepository struct {
+	db interfaces.DB
+}
+
+// NewDelegationRepository creates a new instance
+func NewDelegationRepository(ctx context.Context, db interfaces.DB) interfaces.DelegationRepository {
+	return &delegationRepository{db: db}
+}
+
+// Create stores a delegation; ID and CreatedAt are filled in
+func (r *delegationRepository) Create(ctx context.Context, tx interfaces.Tx, d *models.Delegation) error {
+	err := tx.QueryRow(
+		ctx,
+		delegationQueryCreate,
+		d.DelegatorID,
+		d.DelegateID,
+		d.StartDate,
+		d.EndDate,
+		d.Reason,
+		d.LeaveRequestID,
+	).Scan(&d.ID, &d.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetForUser returns the delegations a user gave or received, latest first
+func (r *delegationRepository) GetForUser(ctx context.Context, userID int64) ([]models.Delegation, error) {
+	rows, err := r.db.Query(ctx, delegationQueryGetForUser, userID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var delegations []models.Delegation
+	for rows.Next() {
+		var d models.Delegation
+		if err := rows.Scan(
+			&d.ID,
+			&d.DelegatorID,
+			&d.DelegateID,
+			&d.StartDate,
+			&d.EndDate,
+			&d.Reason,
+			&d.LeaveRequestID,
+			&d.RevokedAt,
+			&d.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		delegations = append(delegations, d)
+	}
+
+	return delegations, utils.MapPgError(rows.Err())
+}
+
+// HasOverlap reports whether the delegator already has a live delegation on any of the dates
+func (r *delegationRepository) HasOverlap(ctx context.Context, tx interfaces.Tx, delegatorID int64, start, end time.Time) (bool, error) {
+	var dummy int
+
+	err := tx.QueryRow(ctx, delegationQueryHasOverlap, delegatorID, start, end).Scan(&dummy)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+	return true, nil
+}
+
+// Revoke ends one of the delegator's delegations
+func (r *delegationRepository) Revoke(ctx context.Context, delegationID, delegatorID int64) error {
+	tag, err := r.db.Exec(ctx, delegationQueryRevoke, delegationID, delegatorID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrDelegationNotFound
+	}
+	return nil
+}
+
+// RevokeForLeave ends the delegation created for a leave, if any
+func (r *delegationRepository) RevokeForLeave(ctx context.Context, tx interfaces.Tx, leaveRequestID int64) error {
+	_, err := tx.Exec(ctx, delegationQueryRevokeForLeave, leaveRequestID)
+	return utils.MapPgError(err)
+}
+
+// GetActiveDelegators returns the approvers who delegated to the delegate on the day
+func (r *delegationRepository) GetActiveDelegators(ctx context.Context, delegateID int64, on time.Time) ([]models.RuleSubject, error) {
+	rows, err := r.db.Query(ctx, delegationQueryGetActiveDelegators, delegateID, on)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var delegators []models.RuleSubject
+	for rows.Next() {
+		var s models.RuleSubject
+		if err := rows.Scan(&s.UserID, &s.GradeID, &s.ManagerID, &s.Department, &s.Role); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		delegators = append(delegators, s)
+	}
+
+	return delegators, utils.MapPgError(rows.Err())
+}
@@ -14,27 +14,63 @@ import (
 
 const (
 	discountQueryCreate = `INSERT INTO discount_requests
-		 (employee_id, discount_percentage, reason, status, rule_id)
-		 VALUES ($1, $2, $3, $4, $5)`
+		 (employee_id, discount_percentage, reason, status, rule_id, rule_version_id, approval_comment, decision_trace)
+		 VALUES ($1, $2, $3, $4, $5, $6, COALESCE(NULLIF($7, ''), 'Not Updated by manager'), $8)
+		 RETURNING id, created_at`
 	discountQueryGetByID = `SELECT id, employee_id, discount_percentage, reason, status, rule_id, approved_by_id, created_at
 		 FROM discount_requests WHERE id=$1`
+	discountQueryLockPending  = `SELECT 1 FROM discount_requests WHERE id=$1 AND status='PENDING' FOR UPDATE`
 	discountQueryUpdateStatus = `UPDATE discount_requests
 		 SET status=$1, approved_by_id=$2, approval_comment=$3
-		 WHERE id=$4`
+		 WHERE id=$4 AND status='PENDING'`
+	discountQuerySetOnBehalfOf        = `UPDATE discount_requests SET on_behalf_of=$1 WHERE id=$2`
 	discountQueryGetPendingForManager = `
-		SELECT dr.id, u.name, dr.discount_percentage, dr.reason, dr.created_at
+		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.created_at, u.grade_id, GREATEST(dr.created_at, dr.escalated_at, dr.resubmitted_at)
 		FROM discount_requests dr
 		JOIN users u ON dr.employee_id = u.id
-		WHERE dr.status='PENDING' AND u.manager_id=$1
+		JOIN users m ON m.id = $1
+		LEFT JOIN LATERAL (
+		     SELECT s.id, s.assigned_to, s.department, s.role
+		     FROM request_approval_steps s
+		     WHERE s.request_type='DISCOUNT' AND s.request_id=dr.id AND s.status='PENDING'
+		     ORDER BY s.step_no
+		     LIMIT 1
+		) cs ON TRUE
+		WHERE dr.status='PENDING'
+		  AND dr.employee_id <> $1
+		  AND CASE WHEN cs.id IS NULL
+		      THEN CASE WHEN dr.escalation_level = 0 THEN u.manager_id ELSE dr.escalated_to END = $1
+		      ELSE ` + approvalStepMatchesManager + `
+		  END
 	`
 	discountQueryGetPendingForAdmin = `
-		SELECT dr.id, u.name, dr.discount_percentage, dr.reason, dr.created_at
+		SELECT dr.id, dr.employee_id, u.name, dr.discount_percentage, dr.reason, dr.created_at, u.grade_id, GREATEST(dr.created_at, dr.escalated_at, dr.resubmitted_at)
 		FROM discount_requests dr
 		JOIN users u ON dr.employee_id = u.id
 		WHERE dr.status='PENDING'
 	`
 	discountQueryCancel             = `UPDATE discount_requests SET status='CANCELLED' WHERE id=$1`
-	discountQueryGetPendingRequests = "SELECT id, created_at FROM discount_requests WHERE status='PENDING'"
+	discountQueryGetPendingRequests = `SELECT r.id, r.employee_id, u.grade_id, u.manager_id, r.escalation_level, r.escalated_to, r.escalated_at, r.resubmitted_at, r.created_at
+		 FROM discount_requests r
+		 JOIN users u ON u.id = r.employee_id
+		 WHERE r.status='PENDING'`
+	discountQueryEscalate = `UPDATE discount_requests
+		 SET escalation_level=$1,
+		     escalated_to=$2,
+		     escalated_at=NOW()
+		 WHERE id=$3`
+	discountQueryPauseForInfo = `UPDATE discount_requests
+		 SET status='NEEDS_INFO',
+		     approval_comment=$1
+		 WHERE id=$2`
+	discountQueryRevoke = `UPDATE discount_requests
+		 SET status='REVOKED',
+		     approval_comment=$1
+		 WHERE id=$2 AND status IN ('APPROVED','AUTO_APPROVED')`
+	discountQueryResubmit = `UPDATE discount_requests
+		 SET status='PENDING',
+		     resubmitted_at=NOW()
+		 WHERE id=$1`
 )
 
 type discountRequestRepository struct {
@@ -46,11 +82,16 @@ func NewDiscountRequestRepository(ctx context.Context, db interfaces.DB) interfa
 }
 
 func (r *discountRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.DiscountRequest) error {
-	_, err := tx.Exec(
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
 		ctx,
 		discountQueryCreate,
-		req.EmployeeID, req.DiscountPercentage, req.Reason, req.Status, req.RuleID,
-	)
+		req.EmployeeID, req.DiscountPercentage, req.Reason, req.Status, req.RuleID, req.RuleVersionID, req.ApprovalComment, traceJSON,
+	).Scan(&req.ID, &req.CreatedAt)
 	return utils.MapPgError(err)
 }
 
@@ -71,12 +112,38 @@ func (r *discountRequestRepository) GetByID(ctx context.Context, tx interfaces.T
 	return reqObj, nil
 }
 
+// LockPending locks a request for the rest of tx and reports whether it is still pending
+func (r *discountRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	var found int
+	err := tx.QueryRow(ctx, discountQueryLockPending, requestID).Scan(&found)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+	return true, nil
+}
+
 func (r *discountRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
-	_, err := tx.Exec(
+	tag, err := tx.Exec(
 		ctx,
 		discountQueryUpdateStatus,
 		status, approverID, comment, requestID,
 	)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	// decided, cancelled or paused since it was read
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotPending
+	}
+	return nil
+}
+
+// SetOnBehalfOf records the delegator a delegate decided the request for
+func (r *discountRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID, delegatorID int64) error {
+	_, err := tx.Exec(ctx, discountQuerySetOnBehalfOf, delegatorID, requestID)
 	return utils.MapPgError(err)
 }
 
@@ -89,19 +156,24 @@ func (r *discountRequestRepository) GetPendingForManager(ctx context.Context, ma
 
 	var result []map[string]interface{}
 	for rows.Next() {
-		var id int64
+		var id, employeeID int64
 		var name, reason string
 		var percent float64
 		var createdAt interface{}
-		if err := rows.Scan(&id, &name, &percent, &reason, &createdAt); err != nil {
+		var gradeID int64
+		var waitingSince time.Time
+		if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &createdAt, &gradeID, &waitingSince); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		result = append(result, map[string]interface{}{
 			"id":                  id,
+			"employee_id":         employeeID,
 			"employee":            name,
 			"discount_percentage": percent,
 			"reason":              reason,
 			"created_at":          createdAt,
+			"grade_id":            gradeID,
+			"waiting_since":       waitingSince,
 		})
 	}
 	return result, nil
@@ -116,19 +188,24 @@ func (r *discountRequestRepository) GetPendingForAdmin(ctx context.Context) ([]m
 
 	var result []map[string]interface{}
 	for rows.Next() {
-		var id int64
+		var id, employeeID int64
 		var name, reason string
 		var percent float64
 		var createdAt interface{}
-		if err := rows.Scan(&id, &name, &percent, &reason, &createdAt); err != nil {
+		var gradeID int64
+		var waitingSince time.Time
+		if err := rows.Scan(&id, &employeeID, &name, &percent, &reason, &createdAt, &gradeID, &waitingSince); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 		result = append(result, map[string]interface{}{
 			"id":                  id,
+			"employee_id":         employeeID,
 			"employee":            name,
 			"discount_percentage": percent,
 			"reason":              reason,
 			"created_at":          createdAt,
+			"grade_id":            gradeID,
+			"waiting_since":       waitingSince,
 		})
 	}
 	return result, nil
@@ -139,29 +216,44 @@ func (r *discountRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx
 	return utils.MapPgError(err)
 }
 
-func (r *discountRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
+// Escalate hands a pending request to escalatedTo, or to the admins when it is nil
+func (r *discountRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	_, err := tx.Exec(ctx, discountQueryEscalate, level, escalatedTo, requestID)
+	return utils.MapPgError(err)
+}
+
+// GetPendingRequests returns every pending request with the approver it waits for
+func (r *discountRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
 	rows, err := r.db.Query(ctx, discountQueryGetPendingRequests)
 	if err != nil {
 		return nil, utils.MapPgError(err)
 	}
 	defer rows.Close()
 
-	var result []struct {
-		ID        int64
-		CreatedAt time.Time
+	return scanPendingRequests(rows)
+}
+
+// PauseForInfo puts a pending request in NEEDS_INFO with the approver's question as its comment
+func (r *discountRequestRepository) PauseForInfo(ctx context.Context, tx interfaces.Tx, requestID int64, question string) error {
+	_, err := tx.Exec(ctx, discountQueryPauseForInfo, question, requestID)
+	return utils.MapPgError(err)
+}
+
+// Revoke withdraws a request that is still approved, with the reason as its comment
+func (r *discountRequestRepository) Revoke(ctx context.Context, tx interfaces.Tx, requestID int64, reason string) error {
+	tag, err := tx.Exec(ctx, discountQueryRevoke, reason, requestID)
+	if err != nil {
+		return utils.MapPgError(err)
 	}
-	for rows.Next() {
-		var item struct {
-			ID        int64
-			CreatedAt time.Time
-		}
-		if err := rows.Scan(&item.ID, &item.CreatedAt); err != nil {
-			return nil, utils.MapPgError(err)
-		}
-		result = append(result, item)
+	// a concurrent revoke or cancel got there first
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotApproved
 	}
-	return result, nil
+	return nil
+}
+
+// Resubmit puts a request waiting for more information back in its approver's queue
+func (r *discountRequestRepository) Resubmit(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	_, err := tx.Exec(ctx, discountQueryResubmit, requestID)
+	return utils.MapPgError(err)
 }
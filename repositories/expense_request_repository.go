@@ -14,26 +14,72 @@ import (
 
 const (
 	expenseQueryCreate = `INSERT INTO expense_requests
-		 (employee_id, amount, category, reason, status, rule_id)
-		 VALUES ($1, $2, $3, $4, $5, $6)`
-	expenseQueryGetByID = `SELECT employee_id, status, amount
+		 (employee_id, amount, category, reason, status, rule_id, rule_version_id, approval_comment, decision_trace)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE(NULLIF($8, ''), 'Not Updated by manager'), $9)
+		 RETURNING id, created_at`
+	expenseQueryGetByID = `SELECT employee_id, status, amount, category, reason
 		 FROM expense_requests
 		 WHERE id=$1`
+	expenseQueryLockPending  = `SELECT 1 FROM expense_requests WHERE id=$1 AND status='PENDING' FOR UPDATE`
 	expenseQueryUpdateStatus = `UPDATE expense_requests
 		 SET status=$1,
 		     approved_by_id=$2,
 		     approval_comment=$3
-		 WHERE id=$4`
-	expenseQueryGetPendingForManager = `SELECT er.id, u.name, er.amount, er.category, er.reason, er.created_at 
+		 WHERE id=$4 AND status='PENDING'`
+	expenseQuerySetOnBehalfOf        = `UPDATE expense_requests SET on_behalf_of=$1 WHERE id=$2`
+	expenseQueryGetPendingForManager = `SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.created_at, u.grade_id, GREATEST(er.created_at, er.escalated_at, er.resubmitted_at)
 		 FROM expense_requests er
 		 JOIN users u ON er.employee_id = u.id
-		 WHERE er.status='PENDING' AND u.manager_id=$1`
-	expenseQueryGetPendingForAdmin = `SELECT er.id, u.name, er.amount, er.category, er.reason, er.created_at
+		 JOIN users m ON m.id = $1
+		 LEFT JOIN LATERAL (
+		      SELECT s.id, s.assigned_to, s.department, s.role
+		      FROM request_approval_steps s
+		      WHERE s.request_type='EXPENSE' AND s.request_id=er.id AND s.status='PENDING'
+		      ORDER BY s.step_no
+		      LIMIT 1
+		 ) cs ON TRUE
+		 WHERE er.status='PENDING'
+		   AND er.employee_id <> $1
+		   AND CASE WHEN cs.id IS NULL
+		       THEN CASE WHEN er.escalation_level = 0 THEN u.manager_id ELSE er.escalated_to END = $1
+		       ELSE ` + approvalStepMatchesManager + `
+		   END`
+	expenseQueryGetPendingForAdmin = `SELECT er.id, er.employee_id, u.name, er.amount, er.category, er.reason, er.created_at, u.grade_id, GREATEST(er.created_at, er.escalated_at, er.resubmitted_at)
 		 FROM expense_requests er
 		 JOIN users u ON er.employee_id = u.id
 		 WHERE er.status='PENDING'`
 	expenseQueryCancel             = `UPDATE expense_requests SET status='CANCELLED' WHERE id=$1`
-	expenseQueryGetPendingRequests = "SELECT id, created_at FROM expense_requests WHERE status='PENDING'"
+	expenseQueryGetPendingRequests = `SELECT r.id, r.employee_id, u.grade_id, u.manager_id, r.escalation_level, r.escalated_to, r.escalated_at, r.resubmitted_at, r.created_at
+		 FROM expense_requests r
+		 JOIN users u ON u.id = r.employee_id
+		 WHERE r.status='PENDING'`
+	expenseQueryEscalate = `UPDATE expense_requests
+		 SET escalation_level=$1,
+		     escalated_to=$2,
+		     escalated_at=NOW()
+		 WHERE id=$3`
+	expenseQueryPauseForInfo = `UPDATE expense_requests
+		 SET status='NEEDS_INFO',
+		     approval_comment=$1
+		 WHERE id=$2`
+	expenseQueryRevoke = `UPDATE expense_requests
+		 SET status='REVOKED',
+		     approval_comment=$1
+		 WHERE id=$2 AND status IN ('APPROVED','AUTO_APPROVED')`
+	expenseQueryResubmit = `UPDATE expense_requests
+		 SET status='PENDING',
+		     resubmitted_at=NOW()
+		 WHERE id=$1`
+	expenseQueryAmend = `UPDATE expense_requests
+		 SET amount=$1,
+		     category=$2,
+		     reason=$3,
+		     status=$4,
+		     rule_id=$5,
+		     rule_version_id=$6,
+		     approval_comment=COALESCE(NULLIF($7, ''), approval_comment),
+		     decision_trace=$8
+		 WHERE id=$9 AND status='PENDING'`
 )
 
 type expenseRequestRepository struct {
@@ -46,7 +92,12 @@ func NewExpenseRequestRepository(ctx context.Context, db interfaces.DB) interfac
 }
 
 func (r *expenseRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.ExpenseRequest) error {
-	_, err := tx.Exec(
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
 		ctx,
 		expenseQueryCreate,
 		req.EmployeeID,
@@ -55,7 +106,10 @@ func (r *expenseRequestRepository) Create(ctx context.Context, tx interfaces.Tx,
 		req.Reason,
 		req.Status,
 		req.RuleID,
-	)
+		req.RuleVersionID,
+		req.ApprovalComment,
+		traceJSON,
+	).Scan(&req.ID, &req.CreatedAt)
 
 	return utils.MapPgError(err)
 }
@@ -67,7 +121,7 @@ func (r *expenseRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx
 		ctx,
 		expenseQueryGetByID,
 		requestID,
-	).Scan(&req.EmployeeID, &req.Status, &req.Amount)
+	).Scan(&req.EmployeeID, &req.Status, &req.Amount, &req.Category, &req.Reason)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -80,13 +134,38 @@ func (r *expenseRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx
 	return &req, nil
 }
 
+// LockPending locks a request for the rest of tx and reports whether it is still pending
+func (r *expenseRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	var found int
+	err := tx.QueryRow(ctx, expenseQueryLockPending, requestID).Scan(&found)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+	return true, nil
+}
+
 func (r *expenseRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
-	_, err := tx.Exec(
+	tag, err := tx.Exec(
 		ctx,
 		expenseQueryUpdateStatus,
 		status, approverID, comment, requestID,
 	)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	// decided, cancelled or paused since it was read
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotPending
+	}
+	return nil
+}
 
+// SetOnBehalfOf records the delegator a delegate decided the request for
+func (r *expenseRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID, delegatorID int64) error {
+	_, err := tx.Exec(ctx, expenseQuerySetOnBehalfOf, delegatorID, requestID)
 	return utils.MapPgError(err)
 }
 
@@ -104,23 +183,28 @@ func (r *expenseRequestRepository) GetPendingForManager(ctx context.Context, man
 	var result []map[string]interface{}
 
 	for rows.Next() {
-		var id int64
+		var id, employeeID int64
 		var name, category string
 		var reason *string
 		var amount float64
 		var createdAt time.Time
 
-		if err := rows.Scan(&id, &name, &amount, &category, &reason, &createdAt); err != nil {
+		var gradeID int64
+		var waitingSince time.Time
+		if err := rows.Scan(&id, &employeeID, &name, &amount, &category, &reason, &createdAt, &gradeID, &waitingSince); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"employee":   name,
-			"amount":     amount,
-			"category":   category,
-			"reason":     reason,
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":            id,
+			"employee_id":   employeeID,
+			"employee":      name,
+			"amount":        amount,
+			"category":      category,
+			"reason":        reason,
+			"created_at":    createdAt.Format(time.RFC3339),
+			"grade_id":      gradeID,
+			"waiting_since": waitingSince,
 		})
 	}
 
@@ -140,23 +224,28 @@ func (r *expenseRequestRepository) GetPendingForAdmin(ctx context.Context) ([]ma
 	var result []map[string]interface{}
 
 	for rows.Next() {
-		var id int64
+		var id, employeeID int64
 		var name, category string
 		var reason *string
 		var amount float64
 		var createdAt time.Time
 
-		if err := rows.Scan(&id, &name, &amount, &category, &reason, &createdAt); err != nil {
+		var gradeID int64
+		var waitingSince time.Time
+		if err := rows.Scan(&id, &employeeID, &name, &amount, &category, &reason, &createdAt, &gradeID, &waitingSince); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"employee":   name,
-			"amount":     amount,
-			"category":   category,
-			"reason":     reason,
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":            id,
+			"employee_id":   employeeID,
+			"employee":      name,
+			"amount":        amount,
+			"category":      category,
+			"reason":        reason,
+			"created_at":    createdAt.Format(time.RFC3339),
+			"grade_id":      gradeID,
+			"waiting_since": waitingSince,
 		})
 	}
 
@@ -168,29 +257,74 @@ func (r *expenseRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx,
 	return utils.MapPgError(err)
 }
 
-func (r *expenseRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
+// Escalate hands a pending request to escalatedTo, or to the admins when it is nil
+func (r *expenseRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	_, err := tx.Exec(ctx, expenseQueryEscalate, level, escalatedTo, requestID)
+	return utils.MapPgError(err)
+}
+
+// GetPendingRequests returns every pending request with the approver it waits for
+func (r *expenseRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
 	rows, err := r.db.Query(ctx, expenseQueryGetPendingRequests)
 	if err != nil {
 		return nil, utils.MapPgError(err)
 	}
 	defer rows.Close()
 
-	var result []struct {
-		ID        int64
-		CreatedAt time.Time
+	return scanPendingRequests(rows)
+}
+
+// PauseForInfo puts a pending request in NEEDS_INFO with the approver's question as its comment
+func (r *expenseRequestRepository) PauseForInfo(ctx context.Context, tx interfaces.Tx, requestID int64, question string) error {
+	_, err := tx.Exec(ctx, expenseQueryPauseForInfo, question, requestID)
+	return utils.MapPgError(err)
+}
+
+// Revoke withdraws a request that is still approved, with the reason as its comment
+func (r *expenseRequestRepository) Revoke(ctx context.Context, tx interfaces.Tx, requestID int64, reason string) error {
+	tag, err := tx.Exec(ctx, expenseQueryRevoke, reason, requestID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	// a concurrent revoke or cancel got there first
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotApproved
+	}
+	return nil
+}
+
+// Resubmit puts a request waiting for more information back in its approver's queue
+func (r *expenseRequestRepository) Resubmit(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	_, err := tx.Exec(ctx, expenseQueryResubmit, requestID)
+	return utils.MapPgError(err)
+}
+
+// Amend replaces the amended fields of a request and the outcome of its re-run rules
+func (r *expenseRequestRepository) Amend(ctx context.Context, tx interfaces.Tx, req *models.ExpenseRequest) error {
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return err
 	}
-	for rows.Next() {
-		var item struct {
-			ID        int64
-			CreatedAt time.Time
-		}
-		if err := rows.Scan(&item.ID, &item.CreatedAt); err != nil {
-			return nil, utils.MapPgError(err)
-		}
-		result = append(result, item)
+
+	tag, err := tx.Exec(
+		ctx,
+		expenseQueryAmend,
+		req.Amount,
+		req.Category,
+		req.Reason,
+		req.Status,
+		req.RuleID,
+		req.RuleVersionID,
+		req.ApprovalComment,
+		traceJSON,
+		req.ID,
+	)
+	if err != nil {
+		return utils.MapPgError(err)
 	}
-	return result, nil
+	// decided, cancelled or paused since it was read
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotPending
+	}
+	return nil
 }
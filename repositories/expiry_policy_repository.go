@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	expiryPolicyQueryGetAll = `SELECT id, request_type, grade_id, action, reminder_days, expiry_days, calendar_days, updated_by, updated_at
+		 FROM expiry_policies
+		 ORDER BY request_type, grade_id NULLS FIRST`
+	expiryPolicyQueryUpsert = `INSERT INTO expiry_policies (request_type, grade_id, action, reminder_days, expiry_days, calendar_days, updated_by)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 ON CONFLICT (request_type, COALESCE(grade_id, 0)) DO UPDATE
+		 SET action=EXCLUDED.action,
+		     reminder_days=EXCLUDED.reminder_days,
+		     expiry_days=EXCLUDED.expiry_days,
+		     calendar_days=EXCLUDED.calendar_days,
+		     updated_by=EXCLUDED.updated_by,
+		     updated_at=NOW()
+		 RETURNING id, updated_at`
+	expiryPolicyQueryDelete = `DELETE FROM expiry_policies WHERE request_type=$1 AND grade_id IS NOT DISTINCT FROM $2`
+
+	escalationQueryCreate = `INSERT INTO request_escalations
+		 (request_type, request_id, employee_id, event, level, from_approver_id, to_approver_id)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING id, created_at`
+	escalationQueryGetForRequest = `SELECT id, request_type, request_id, employee_id, event, level, from_approver_id, to_approver_id, created_at
+		 FROM request_escalations
+		 WHERE request_type=$1 AND request_id=$2
+		 ORDER BY created_at, id`
+	escalationQueryHasEvent = `SELECT 1
+		 FROM request_escalations
+		 WHERE request_type=$1 AND request_id=$2 AND event=$3 AND level=$4
+		 LIMIT 1`
+)
+
+type expiryPolicyRepository struct {
+	db interfaces.DB
+}
+
+// NewExpiryPolicyRepository creates a new instance
+func NewExpiryPolicyRepository(ctx context.Context, db interfaces.DB) interfaces.ExpiryPolicyRepository {
+	return &expiryPolicyRepository{db: db}
+}
+
+// GetAll returns the stored policies, the type-wide one of each request type before its grades';
+// request types without one use the default
+func (r *expiryPolicyRepository) GetAll(ctx context.Context) ([]models.ExpiryPolicy, error) {
+	rows, err := r.db.Query(ctx, expiryPolicyQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var policies []models.ExpiryPolicy
+	for rows.Next() {
+		var p models.ExpiryPolicy
+		if err := rows.Scan(
+			&p.ID,
+			&p.RequestType,
+			&p.GradeID,
+			&p.Action,
+			&p.ReminderDays,
+			&p.ExpiryDays,
+			&p.CalendarDays,
+			&p.UpdatedBy,
+			&p.UpdatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		policies = append(policies, p)
+	}
+	return policies, utils.MapPgError(rows.Err())
+}
+
+// Upsert stores the policy of a request type or one of its grades, replacing any previous one;
+// ID and UpdatedAt are filled in
+func (r *expiryPolicyRepository) Upsert(ctx context.Context, p *models.ExpiryPolicy) error {
+	err := r.db.QueryRow(
+		ctx,
+		expiryPolicyQueryUpsert,
+		p.RequestType,
+		p.GradeID,
+		p.Action,
+		p.ReminderDays,
+		p.ExpiryDays,
+		p.CalendarDays,
+		p.UpdatedBy,
+	).Scan(&p.ID, &p.UpdatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// Delete removes the policy of a request type, or of one of its grades when gradeID is set
+func (r *expiryPolicyRepository) Delete(ctx context.Context, requestType string, gradeID *int64) error {
+	tag, err := r.db.Exec(ctx, expiryPolicyQueryDelete, requestType, gradeID)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrExpiryPolicyNotFound
+	}
+	return nil
+}
+
+type requestEscalationRepository struct {
+	db interfaces.DB
+}
+
+// NewRequestEscalationRepository creates a new instance
+func NewRequestEscalationRepository(ctx context.Context, db interfaces.DB) interfaces.RequestEscalationRepository {
+	return &requestEscalationRepository{db: db}
+}
+
+// Create records an event on a request; ID and CreatedAt are filled in
+func (r *requestEscalationRepository) Create(ctx context.Context, tx interfaces.Tx, e *models.RequestEscalation) error {
+	err := tx.QueryRow(
+		ctx,
+		escalationQueryCreate,
+		e.RequestType,
+		e.RequestID,
+		e.EmployeeID,
+		e.Event,
+		e.Level,
+		e.FromApproverID,
+		e.ToApproverID,
+	).Scan(&e.ID, &e.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetForRequest returns the events recorded on a request, oldest first
+func (r *requestEscalationRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestEscalation, error) {
+	rows, err := r.db.Query(ctx, escalationQueryGetForRequest, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var escalations []models.RequestEscalation
+	for rows.Next() {
+		var e models.RequestEscalation
+		if err := rows.Scan(
+			&e.ID,
+			&e.RequestType,
+			&e.RequestID,
+			&e.EmployeeID,
+			&e.Event,
+			&e.Level,
+			&e.FromApproverID,
+			&e.ToApproverID,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		escalations = append(escalations, e)
+	}
+	return escalations, utils.MapPgError(rows.Err())
+}
+
+// HasEvent reports whether an event was already recorded on a request at an escalation level
+func (r *requestEscalationRepository) HasEvent(ctx context.Context, requestType string, requestID int64, event string, level int) (bool, error) {
+	var dummy int
+
+	err := r.db.QueryRow(ctx, escalationQueryHasEvent, requestType, requestID, event, level).Scan(&dummy)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+	return true, nil
+}
+
+// scanPendingRequests reads the rows of the expiry job's pending request queries
+func scanPendingRequests(rows pgx.Rows) ([]models.PendingRequest, error) {
+	var result []models.PendingRequest
+	for rows.Next() {
+		var item models.PendingRequest
+		if err := rows.Scan(
+			&item.ID,
+			&item.EmployeeID,
+			&item.GradeID,
+			&item.ManagerID,
+			&item.EscalationLevel,
+			&item.EscalatedTo,
+			&item.EscalatedAt,
+			&item.ResubmittedAt,
+			&item.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		result = append(result, item)
+	}
+	return result, utils.MapPgError(rows.Err())
+}
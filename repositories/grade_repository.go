@@ -4,12 +4,15 @@ import (
 	"context"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 const (
 	gradeQueryGetLimits = `SELECT annual_leave_limit, annual_expense_limit, discount_limit_percent
 		 FROM grades WHERE id=$1`
+	gradeQueryGetAll = `SELECT id, name, annual_leave_limit, annual_expense_limit, discount_limit_percent
+		 FROM grades ORDER BY id`
 )
 
 type gradeRepository struct {
@@ -31,3 +34,29 @@ func (r *gradeRepository) GetLimits(ctx context.Context, tx interfaces.Tx, grade
 	err = utils.MapPgError(err)
 	return
 }
+
+// GetAll returns every grade with its annual limits
+func (r *gradeRepository) GetAll(ctx context.Context) ([]models.Grade, error) {
+	rows, err := r.db.Query(ctx, gradeQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var grades []models.Grade
+	for rows.Next() {
+		var grade models.Grade
+		if err := rows.Scan(
+			&grade.ID,
+			&grade.Name,
+			&grade.AnnualLeaveLimit,
+			&grade.AnnualExpenseLimit,
+			&grade.DiscountLimitPercent,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		grades = append(grades, grade)
+	}
+
+	return grades, utils.MapPgError(rows.Err())
+}
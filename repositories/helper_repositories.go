@@ -2,23 +2,25 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
 )
 
 const (
-	helperQueryGetMyLeaves = `SELECT id, leave_type, from_date, to_date, status, reason, approval_comment, created_at
+	helperQueryGetMyLeaves = `SELECT id, leave_type, from_date, to_date, status, reason, approval_comment, decision_trace, created_at
 		 FROM leave_requests
 		 WHERE employee_id = $1
 		 ORDER BY created_at DESC`
-	helperQueryGetMyExpenses = `SELECT id, amount, category, status, reason, approval_comment, created_at
+	helperQueryGetMyExpenses = `SELECT id, amount, category, status, reason, approval_comment, decision_trace, created_at
 		 FROM expense_requests
 		 WHERE employee_id = $1
 		 ORDER BY created_at DESC`
-	helperQueryGetMyDiscounts = `SELECT id, discount_percentage, status, reason, approval_comment, created_at
+	helperQueryGetMyDiscounts = `SELECT id, discount_percentage, status, reason, approval_comment, decision_trace, created_at
 		 FROM discount_requests
 		 WHERE employee_id = $1
 		 ORDER BY created_at DESC`
@@ -63,7 +65,10 @@ const (
 		COUNT(*) FILTER (WHERE status_text='AUTO_APPROVED')
 	FROM (SELECT status::text AS status_text FROM discount_requests) d
 	`
-	helperQueryIsHoliday = `SELECT COUNT(*) FROM holidays WHERE holiday_date=$1`
+	helperQueryIsHoliday       = `SELECT COUNT(*) FROM holidays WHERE holiday_date=$1`
+	helperQueryGetHolidayDates = `SELECT holiday_date FROM holidays
+		 WHERE holiday_date BETWEEN $1 AND $2
+		 ORDER BY holiday_date`
 )
 
 type myRequestsRepository struct {
@@ -97,6 +102,7 @@ func (r *myRequestsRepository) GetMyLeaveRequests(ctx context.Context, userID in
 			status    string
 			reason    string
 			comment   *string
+			trace     []byte
 			createdAt time.Time
 		)
 
@@ -108,19 +114,21 @@ func (r *myRequestsRepository) GetMyLeaveRequests(ctx context.Context, userID in
 			&status,
 			&reason,
 			&comment,
+			&trace,
 			&createdAt,
 		); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 
 		response := map[string]interface{}{
-			"id":         id,
-			"leave_type": leaveType,
-			"from_date":  fromDate.Format("2006-01-02"),
-			"to_date":    toDate.Format("2006-01-02"),
-			"status":     status,
-			"reason":     reason,
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":             id,
+			"leave_type":     leaveType,
+			"from_date":      fromDate.Format("2006-01-02"),
+			"to_date":        toDate.Format("2006-01-02"),
+			"status":         status,
+			"reason":         reason,
+			"decision_trace": unmarshalDecisionTrace(trace),
+			"created_at":     createdAt.Format(time.RFC3339),
 		}
 
 		if comment != nil {
@@ -160,6 +168,7 @@ func (r *myRequestsRepository) GetMyExpenseRequests(ctx context.Context, userID
 			status    string
 			reason    string
 			comment   *string
+			trace     []byte
 			createdAt time.Time
 		)
 
@@ -170,6 +179,7 @@ func (r *myRequestsRepository) GetMyExpenseRequests(ctx context.Context, userID
 			&status,
 			&reason,
 			&comment,
+			&trace,
 			&createdAt,
 		); err != nil {
 			return nil, utils.MapPgError(err)
@@ -182,6 +192,7 @@ func (r *myRequestsRepository) GetMyExpenseRequests(ctx context.Context, userID
 			"status":           status,
 			"reason":           reason,
 			"approval_comment": comment,
+			"decision_trace":   unmarshalDecisionTrace(trace),
 			"created_at":       createdAt.Format(time.RFC3339),
 		})
 	}
@@ -209,6 +220,7 @@ func (r *myRequestsRepository) GetMyDiscountRequests(ctx context.Context, userID
 			status    string
 			reason    string
 			comment   *string
+			trace     []byte
 			createdAt time.Time
 		)
 
@@ -218,6 +230,7 @@ func (r *myRequestsRepository) GetMyDiscountRequests(ctx context.Context, userID
 			&status,
 			&reason,
 			&comment,
+			&trace,
 			&createdAt,
 		); err != nil {
 			return nil, utils.MapPgError(err)
@@ -229,6 +242,7 @@ func (r *myRequestsRepository) GetMyDiscountRequests(ctx context.Context, userID
 			"status":              status,
 			"reason":              reason,
 			"approval_comment":    comment,
+			"decision_trace":      unmarshalDecisionTrace(trace),
 			"created_at":          createdAt.Format(time.RFC3339),
 		})
 	}
@@ -242,6 +256,37 @@ func (r *myRequestsRepository) GetMyAllRequests(ctx context.Context, userID int6
 	return nil, nil, nil, 0, nil
 }
 
+func (r *myRequestsRepository) GetMyRequest(ctx context.Context, userID int64, reqType string, requestID int64) (map[string]interface{}, error) {
+	// single requests are served by AggregatedRepository as well
+	return NewAggregatedRepository(ctx, r.db).GetMyRequest(ctx, userID, reqType, requestID)
+}
+
+// marshalDecisionTrace encodes a trace for a JSONB column; a missing trace is stored as NULL
+func marshalDecisionTrace(trace *models.DecisionTrace) ([]byte, error) {
+	if trace == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(trace)
+	if err != nil {
+		return nil, apperrors.ErrInvalidDecisionTrace
+	}
+	return data, nil
+}
+
+// unmarshalDecisionTrace decodes a stored trace; requests decided before traces were recorded have none
+func unmarshalDecisionTrace(data []byte) *models.DecisionTrace {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var trace models.DecisionTrace
+	if err := json.Unmarshal(data, &trace); err != nil {
+		return nil
+	}
+	return &trace
+}
+
 // HolidayRepository implementation is below
 
 type holidayRepository struct {
@@ -378,6 +423,31 @@ func (r *holidayRepository) IsHoliday(ctx context.Context, date time.Time) (bool
 	return count > 0, utils.MapPgError(err)
 }
 
+// GetHolidayDates returns the holidays between from and to, both inclusive
+func (r *holidayRepository) GetHolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error) {
+	rows, err := r.db.Query(
+		ctx,
+		helperQueryGetHolidayDates,
+		from.Format("2006-01-02"),
+		to.Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var dates []time.Time
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		dates = append(dates, date)
+	}
+
+	return dates, utils.MapPgError(rows.Err())
+}
+
 func NewHolidayRepository(ctx context.Context, db interfaces.DB) interfaces.HolidayRepository {
 	return &holidayRepository{db: db}
 }
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	infoRequestColumns     = `id, request_type, request_id, employee_id, asked_by, question, asked_at, answer, answered_at`
+	infoRequestQueryCreate = `INSERT INTO request_info_exchanges
+		 (request_type, request_id, employee_id, asked_by, question)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING id, asked_at`
+	infoRequestQueryGetOpen = `SELECT ` + infoRequestColumns + `
+		 FROM request_info_exchanges
+		 WHERE request_type=$1 AND request_id=$2 AND answered_at IS NULL
+		 FOR UPDATE`
+	infoRequestQueryAnswer = `UPDATE request_info_exchanges
+		 SET answer=$2,
+		     answered_at=NOW()
+		 WHERE id=$1`
+	infoRequestQueryGetForRequest = `SELECT ` + infoRequestColumns + `
+		 FROM request_info_exchanges
+		 WHERE request_type=$1 AND request_id=$2
+		 ORDER BY asked_at, id`
+)
+
+type infoRequestRepository struct {
+	db interfaces.DB
+}
+
+// NewInfoRequestRepository creates a new instance
+func NewInfoRequestRepository(ctx context.Context, db interfaces.DB) interfaces.InfoRequestRepository {
+	return &infoRequestRepository{db: db}
+}
+
+// Create records a question asked on a request; ID and AskedAt are filled in
+func (r *infoRequestRepository) Create(ctx context.Context, tx interfaces.Tx, info *models.InfoRequest) error {
+	err := tx.QueryRow(
+		ctx,
+		infoRequestQueryCreate,
+		info.RequestType,
+		info.RequestID,
+		info.EmployeeID,
+		info.AskedBy,
+		info.Question,
+	).Scan(&info.ID, &info.AskedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetOpen returns the unanswered question of a request, locked until the transaction ends
+func (r *infoRequestRepository) GetOpen(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (*models.InfoRequest, error) {
+	info, err := scanInfoRequest(tx.QueryRow(ctx, infoRequestQueryGetOpen, requestType, requestID))
+	if err == pgx.ErrNoRows {
+		return nil, apperrors.ErrInfoRequestNotFound
+	}
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	return info, nil
+}
+
+// Answer records the requester's answer to a question
+func (r *infoRequestRepository) Answer(ctx context.Context, tx interfaces.Tx, infoID int64, answer string) error {
+	_, err := tx.Exec(ctx, infoRequestQueryAnswer, infoID, answer)
+	return utils.MapPgError(err)
+}
+
+// GetForRequest returns the questions asked on a request and their answers, oldest first
+func (r *infoRequestRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.InfoRequest, error) {
+	rows, err := r.db.Query(ctx, infoRequestQueryGetForRequest, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var infos []models.InfoRequest
+	for rows.Next() {
+		info, err := scanInfoRequest(rows)
+		if err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		infos = append(infos, *info)
+	}
+	return infos, utils.MapPgError(rows.Err())
+}
+
+func scanInfoRequest(row pgx.Row) (*models.InfoRequest, error) {
+	var info models.InfoRequest
+	err := row.Scan(
+		&info.ID,
+		&info.RequestType,
+		&info.RequestID,
+		&info.EmployeeID,
+		&info.AskedBy,
+		&info.Question,
+		&info.AskedAt,
+		&info.Answer,
+		&info.AnsweredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
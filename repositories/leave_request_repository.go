@@ -15,34 +15,81 @@ import (
 
 const (
 	leaveQueryCreate = `INSERT INTO leave_requests
-		 (employee_id, from_date, to_date, reason, leave_type, status, rule_id)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7)`
-	leaveQueryGetByID = `SELECT employee_id, status, from_date, to_date
+		 (employee_id, from_date, to_date, reason, leave_type, status, rule_id, rule_version_id, approval_comment, decision_trace, delegate_id)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE(NULLIF($9, ''), 'Not Updated by manager'), $10, $11)
+		 RETURNING id, created_at`
+	leaveQueryGetByID = `SELECT employee_id, status, from_date, to_date, delegate_id, leave_type, reason
 		 FROM leave_requests
 		 WHERE id=$1`
+	leaveQueryLockPending  = `SELECT 1 FROM leave_requests WHERE id=$1 AND status='PENDING' FOR UPDATE`
 	leaveQueryUpdateStatus = `UPDATE leave_requests
 		 SET status=$1,
 		     approved_by_id=$2,
 		     approval_comment=$3
-		 WHERE id=$4`
-	leaveQueryGetPendingForManager = `SELECT lr.id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.created_at 
+		 WHERE id=$4 AND status='PENDING'`
+	leaveQuerySetOnBehalfOf        = `UPDATE leave_requests SET on_behalf_of=$1 WHERE id=$2`
+	leaveQueryGetPendingForManager = `SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.created_at, u.grade_id, GREATEST(lr.created_at, lr.escalated_at, lr.resubmitted_at)
 		 FROM leave_requests lr
 		 JOIN users u ON lr.employee_id = u.id
+		 JOIN users m ON m.id = $1
+		 LEFT JOIN LATERAL (
+		      SELECT s.id, s.assigned_to, s.department, s.role
+		      FROM request_approval_steps s
+		      WHERE s.request_type='LEAVE' AND s.request_id=lr.id AND s.status='PENDING'
+		      ORDER BY s.step_no
+		      LIMIT 1
+		 ) cs ON TRUE
 		 WHERE lr.status='PENDING'
-		   AND u.manager_id=$1`
-	leaveQueryGetPendingForAdmin = `SELECT lr.id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.created_at
+		   AND lr.employee_id <> $1
+		   AND CASE WHEN cs.id IS NULL
+		       THEN CASE WHEN lr.escalation_level = 0 THEN u.manager_id ELSE lr.escalated_to END = $1
+		       ELSE ` + approvalStepMatchesManager + `
+		   END`
+	leaveQueryGetPendingForAdmin = `SELECT lr.id, lr.employee_id, u.name, lr.from_date, lr.to_date, lr.leave_type, lr.reason, lr.created_at, u.grade_id, GREATEST(lr.created_at, lr.escalated_at, lr.resubmitted_at)
 		 FROM leave_requests lr
 		 JOIN users u ON lr.employee_id = u.id
 		 WHERE lr.status='PENDING'`
 	leaveQueryCheckOverlap = `SELECT 1
 		 FROM leave_requests
 		 WHERE employee_id = $1
-		   AND status IN ('PENDING', 'APPROVED', 'AUTO_APPROVED') 
+		   AND status IN ('PENDING', 'NEEDS_INFO', 'APPROVED', 'AUTO_APPROVED')
 		   AND from_date <= $2
 		   AND to_date >= $3
+		   AND id <> $4
 		 LIMIT 1`
 	leaveQueryCancel             = `UPDATE leave_requests SET status='CANCELLED' WHERE id=$1`
-	leaveQueryGetPendingRequests = "SELECT id, created_at FROM leave_requests WHERE status='PENDING'"
+	leaveQueryGetPendingRequests = `SELECT r.id, r.employee_id, u.grade_id, u.manager_id, r.escalation_level, r.escalated_to, r.escalated_at, r.resubmitted_at, r.created_at
+		 FROM leave_requests r
+		 JOIN users u ON u.id = r.employee_id
+		 WHERE r.status='PENDING'`
+	leaveQueryEscalate = `UPDATE leave_requests
+		 SET escalation_level=$1,
+		     escalated_to=$2,
+		     escalated_at=NOW()
+		 WHERE id=$3`
+	leaveQueryPauseForInfo = `UPDATE leave_requests
+		 SET status='NEEDS_INFO',
+		     approval_comment=$1
+		 WHERE id=$2`
+	leaveQueryRevoke = `UPDATE leave_requests
+		 SET status='REVOKED',
+		     approval_comment=$1
+		 WHERE id=$2 AND status IN ('APPROVED','AUTO_APPROVED')`
+	leaveQueryResubmit = `UPDATE leave_requests
+		 SET status='PENDING',
+		     resubmitted_at=NOW()
+		 WHERE id=$1`
+	leaveQueryAmend = `UPDATE leave_requests
+		 SET from_date=$1,
+		     to_date=$2,
+		     leave_type=$3,
+		     reason=$4,
+		     status=$5,
+		     rule_id=$6,
+		     rule_version_id=$7,
+		     approval_comment=COALESCE(NULLIF($8, ''), approval_comment),
+		     decision_trace=$9
+		 WHERE id=$10 AND status='PENDING'`
 )
 
 type leaveRequestRepository struct {
@@ -54,7 +101,12 @@ func NewLeaveRequestRepository(ctx context.Context, db interfaces.DB) interfaces
 }
 
 func (r *leaveRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.LeaveRequest) error {
-	_, err := tx.Exec(
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
 		ctx,
 		leaveQueryCreate,
 		req.EmployeeID,
@@ -64,7 +116,11 @@ func (r *leaveRequestRepository) Create(ctx context.Context, tx interfaces.Tx, r
 		req.LeaveType,
 		req.Status,
 		req.RuleID,
-	)
+		req.RuleVersionID,
+		req.ApprovalComment,
+		traceJSON,
+		req.DelegateID,
+	).Scan(&req.ID, &req.CreatedAt)
 
 	return utils.MapPgError(err)
 }
@@ -76,7 +132,7 @@ func (r *leaveRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx,
 		ctx,
 		leaveQueryGetByID,
 		requestID,
-	).Scan(&req.EmployeeID, &req.Status, &req.FromDate, &req.ToDate)
+	).Scan(&req.EmployeeID, &req.Status, &req.FromDate, &req.ToDate, &req.DelegateID, &req.LeaveType, &req.Reason)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -89,13 +145,38 @@ func (r *leaveRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx,
 	return &req, nil
 }
 
+// LockPending locks a request for the rest of tx and reports whether it is still pending
+func (r *leaveRequestRepository) LockPending(ctx context.Context, tx interfaces.Tx, requestID int64) (bool, error) {
+	var found int
+	err := tx.QueryRow(ctx, leaveQueryLockPending, requestID).Scan(&found)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, utils.MapPgError(err)
+	}
+	return true, nil
+}
+
 func (r *leaveRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
-	_, err := tx.Exec(
+	tag, err := tx.Exec(
 		ctx,
 		leaveQueryUpdateStatus,
 		status, approverID, comment, requestID,
 	)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	// decided, cancelled or paused since it was read
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotPending
+	}
+	return nil
+}
 
+// SetOnBehalfOf records the delegator a delegate decided the request for
+func (r *leaveRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID, delegatorID int64) error {
+	_, err := tx.Exec(ctx, leaveQuerySetOnBehalfOf, delegatorID, requestID)
 	return utils.MapPgError(err)
 }
 
@@ -113,22 +194,27 @@ func (r *leaveRequestRepository) GetPendingForManager(ctx context.Context, manag
 	var result []map[string]interface{}
 
 	for rows.Next() {
-		var id int64
+		var id, employeeID int64
 		var name, leaveType, reason string
 		var fromDate, toDate, createdAt time.Time
 
-		if err := rows.Scan(&id, &name, &fromDate, &toDate, &leaveType, &reason, &createdAt); err != nil {
+		var gradeID int64
+		var waitingSince time.Time
+		if err := rows.Scan(&id, &employeeID, &name, &fromDate, &toDate, &leaveType, &reason, &createdAt, &gradeID, &waitingSince); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"employee":   name,
-			"from_date":  fromDate.Format("2006-01-02"),
-			"to_date":    toDate.Format("2006-01-02"),
-			"leave_type": leaveType,
-			"reason":     reason,
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":            id,
+			"employee_id":   employeeID,
+			"employee":      name,
+			"from_date":     fromDate.Format("2006-01-02"),
+			"to_date":       toDate.Format("2006-01-02"),
+			"leave_type":    leaveType,
+			"reason":        reason,
+			"created_at":    createdAt.Format(time.RFC3339),
+			"grade_id":      gradeID,
+			"waiting_since": waitingSince,
 		})
 	}
 
@@ -148,29 +234,36 @@ func (r *leaveRequestRepository) GetPendingForAdmin(ctx context.Context) ([]map[
 	var result []map[string]interface{}
 
 	for rows.Next() {
-		var id int64
+		var id, employeeID int64
 		var name, leaveType, reason string
 		var fromDate, toDate, createdAt time.Time
 
-		if err := rows.Scan(&id, &name, &fromDate, &toDate, &leaveType, &reason, &createdAt); err != nil {
+		var gradeID int64
+		var waitingSince time.Time
+		if err := rows.Scan(&id, &employeeID, &name, &fromDate, &toDate, &leaveType, &reason, &createdAt, &gradeID, &waitingSince); err != nil {
 			return nil, utils.MapPgError(err)
 		}
 
 		result = append(result, map[string]interface{}{
-			"id":         id,
-			"employee":   name,
-			"from_date":  fromDate.Format("2006-01-02"),
-			"to_date":    toDate.Format("2006-01-02"),
-			"leave_type": leaveType,
-			"reason":     reason,
-			"created_at": createdAt.Format(time.RFC3339),
+			"id":            id,
+			"employee_id":   employeeID,
+			"employee":      name,
+			"from_date":     fromDate.Format("2006-01-02"),
+			"to_date":       toDate.Format("2006-01-02"),
+			"leave_type":    leaveType,
+			"reason":        reason,
+			"created_at":    createdAt.Format(time.RFC3339),
+			"grade_id":      gradeID,
+			"waiting_since": waitingSince,
 		})
 	}
 
 	return result, nil
 }
 
-func (r *leaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, fromDate, toDate time.Time) (bool, error) {
+// CheckOverlap reports whether the user has another active leave overlapping the dates; excludeID
+// leaves out the request being amended, 0 when applying
+func (r *leaveRequestRepository) CheckOverlap(ctx context.Context, userID int64, fromDate, toDate time.Time, excludeID int64) (bool, error) {
 	var dummy int
 
 	err := r.db.QueryRow(
@@ -179,6 +272,7 @@ func (r *leaveRequestRepository) CheckOverlap(ctx context.Context, userID int64,
 		userID,
 		toDate,
 		fromDate,
+		excludeID,
 	).Scan(&dummy)
 
 	// pgx NO ROWS = no overlap
@@ -200,29 +294,75 @@ func (r *leaveRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx, r
 	return utils.MapPgError(err)
 }
 
-func (r *leaveRequestRepository) GetPendingRequests(ctx context.Context) ([]struct {
-	ID        int64
-	CreatedAt time.Time
-}, error) {
+// Escalate hands a pending request to escalatedTo, or to the admins when it is nil
+func (r *leaveRequestRepository) Escalate(ctx context.Context, tx interfaces.Tx, requestID int64, level int, escalatedTo *int64) error {
+	_, err := tx.Exec(ctx, leaveQueryEscalate, level, escalatedTo, requestID)
+	return utils.MapPgError(err)
+}
+
+// GetPendingRequests returns every pending request with the approver it waits for
+func (r *leaveRequestRepository) GetPendingRequests(ctx context.Context) ([]models.PendingRequest, error) {
 	rows, err := r.db.Query(ctx, leaveQueryGetPendingRequests)
 	if err != nil {
 		return nil, utils.MapPgError(err)
 	}
 	defer rows.Close()
 
-	var result []struct {
-		ID        int64
-		CreatedAt time.Time
+	return scanPendingRequests(rows)
+}
+
+// PauseForInfo puts a pending request in NEEDS_INFO with the approver's question as its comment
+func (r *leaveRequestRepository) PauseForInfo(ctx context.Context, tx interfaces.Tx, requestID int64, question string) error {
+	_, err := tx.Exec(ctx, leaveQueryPauseForInfo, question, requestID)
+	return utils.MapPgError(err)
+}
+
+// Revoke withdraws a request that is still approved, with the reason as its comment
+func (r *leaveRequestRepository) Revoke(ctx context.Context, tx interfaces.Tx, requestID int64, reason string) error {
+	tag, err := tx.Exec(ctx, leaveQueryRevoke, reason, requestID)
+	if err != nil {
+		return utils.MapPgError(err)
 	}
-	for rows.Next() {
-		var item struct {
-			ID        int64
-			CreatedAt time.Time
-		}
-		if err := rows.Scan(&item.ID, &item.CreatedAt); err != nil {
-			return nil, utils.MapPgError(err)
-		}
-		result = append(result, item)
+	// a concurrent revoke or cancel got there first
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotApproved
 	}
-	return result, nil
+	return nil
+}
+
+// Resubmit puts a request waiting for more information back in its approver's queue
+func (r *leaveRequestRepository) Resubmit(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	_, err := tx.Exec(ctx, leaveQueryResubmit, requestID)
+	return utils.MapPgError(err)
+}
+
+// Amend replaces the amended fields of a request and the outcome of its re-run rules
+func (r *leaveRequestRepository) Amend(ctx context.Context, tx interfaces.Tx, req *models.LeaveRequest) error {
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		leaveQueryAmend,
+		req.FromDate,
+		req.ToDate,
+		req.LeaveType,
+		req.Reason,
+		req.Status,
+		req.RuleID,
+		req.RuleVersionID,
+		req.ApprovalComment,
+		traceJSON,
+		req.ID,
+	)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	// decided, cancelled or paused since it was read
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotPending
+	}
+	return nil
 }
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// the query that finds who filed a request, per request type
+var ownerQueries = map[string]string{
+	"LEAVE":    `SELECT employee_id FROM leave_requests WHERE id=$1`,
+	"EXPENSE":  `SELECT employee_id FROM expense_requests WHERE id=$1`,
+	"DISCOUNT": `SELECT employee_id FROM discount_requests WHERE id=$1`,
+}
+
+type requestOwnerRepository struct {
+	db interfaces.DB
+}
+
+// NewRequestOwnerRepository creates a new instance
+func NewRequestOwnerRepository(ctx context.Context, db interfaces.DB) interfaces.RequestOwnerRepository {
+	return &requestOwnerRepository{db: db}
+}
+
+// GetOwner returns the employee who filed a LEAVE, EXPENSE or DISCOUNT request
+func (r *requestOwnerRepository) GetOwner(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (int64, error) {
+	query, ok := ownerQueries[requestType]
+	if !ok {
+		return 0, apperrors.ErrRequestNotFound
+	}
+
+	var employeeID int64
+	err := tx.QueryRow(ctx, query, requestID).Scan(&employeeID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, apperrors.ErrRequestNotFound
+		}
+		return 0, utils.MapPgError(err)
+	}
+	return employeeID, nil
+}
@@ -0,0 +1,218 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	requestTypeQueryGetAll = `SELECT name, definition FROM request_types ORDER BY name`
+
+	genericRequestQueryCreate = `INSERT INTO generic_requests
+		 (request_type, employee_id, payload, status, rule_id, rule_version_id, approval_comment, decision_trace)
+		 VALUES ($1, $2, $3, $4, $5, $6, COALESCE(NULLIF($7, ''), 'Not Updated by manager'), $8)
+		 RETURNING id, created_at`
+	genericRequestQueryGetByID = `SELECT employee_id, status, payload
+		 FROM generic_requests
+		 WHERE id=$1 AND request_type=$2`
+	genericRequestQueryUpdateStatus = `UPDATE generic_requests
+		 SET status=$1,
+		     approved_by_id=$2,
+		     approval_comment=$3
+		 WHERE id=$4 AND status='PENDING'`
+	genericRequestQuerySetOnBehalfOf        = `UPDATE generic_requests SET on_behalf_of=$1 WHERE id=$2`
+	genericRequestQueryGetPendingForManager = `SELECT gr.id, gr.employee_id, u.name, gr.payload, gr.created_at
+		 FROM generic_requests gr
+		 JOIN users u ON gr.employee_id = u.id
+		 JOIN users m ON m.id = $2
+		 LEFT JOIN LATERAL (
+		      SELECT s.id, s.assigned_to, s.department, s.role
+		      FROM request_approval_steps s
+		      WHERE s.request_type=gr.request_type AND s.request_id=gr.id AND s.status='PENDING'
+		      ORDER BY s.step_no
+		      LIMIT 1
+		 ) cs ON TRUE
+		 WHERE gr.request_type=$1 AND gr.status='PENDING'
+		   AND gr.employee_id <> $2
+		   AND CASE WHEN cs.id IS NULL THEN u.manager_id = $2 ELSE ` + approvalStepMatchesManager + ` END
+		 ORDER BY gr.created_at`
+	genericRequestQueryGetPendingForAdmin = `SELECT gr.id, gr.employee_id, u.name, gr.payload, gr.created_at
+		 FROM generic_requests gr
+		 JOIN users u ON gr.employee_id = u.id
+		 WHERE gr.request_type=$1 AND gr.status='PENDING'
+		 ORDER BY gr.created_at`
+	genericRequestQueryCancel = `UPDATE generic_requests SET status='CANCELLED' WHERE id=$1`
+)
+
+type requestTypeRepository struct {
+	db interfaces.DB
+}
+
+// NewRequestTypeRepository creates a new instance
+func NewRequestTypeRepository(ctx context.Context, db interfaces.DB) interfaces.RequestTypeRepository {
+	return &requestTypeRepository{db: db}
+}
+
+// GetAll returns the stored request type definitions; they are validated when registered
+func (r *requestTypeRepository) GetAll(ctx context.Context) ([]models.RequestType, error) {
+	rows, err := r.db.Query(ctx, requestTypeQueryGetAll)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var defs []models.RequestType
+	for rows.Next() {
+		var name string
+		var data []byte
+		if err := rows.Scan(&name, &data); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		var def models.RequestType
+		if err := json.Unmarshal(data, &def); err != nil {
+			return nil, apperrors.ErrInvalidRequestTypeDefinition
+		}
+		def.Name = name
+		defs = append(defs, def)
+	}
+
+	return defs, utils.MapPgError(rows.Err())
+}
+
+type genericRequestRepository struct {
+	db interfaces.DB
+}
+
+// NewGenericRequestRepository creates a new instance
+func NewGenericRequestRepository(ctx context.Context, db interfaces.DB) interfaces.GenericRequestRepository {
+	return &genericRequestRepository{db: db}
+}
+
+// Create stores a request; ID and CreatedAt are filled in
+func (r *genericRequestRepository) Create(ctx context.Context, tx interfaces.Tx, req *models.GenericRequest) error {
+	payload, err := json.Marshal(req.Payload)
+	if err != nil {
+		return apperrors.ErrInvalidRequestPayload
+	}
+	traceJSON, err := marshalDecisionTrace(req.DecisionTrace)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		genericRequestQueryCreate,
+		req.RequestType,
+		req.EmployeeID,
+		payload,
+		req.Status,
+		req.RuleID,
+		req.RuleVersionID,
+		req.ApprovalComment,
+		traceJSON,
+	).Scan(&req.ID, &req.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+func (r *genericRequestRepository) GetByID(ctx context.Context, tx interfaces.Tx, requestType string, requestID int64) (*models.GenericRequest, error) {
+	var req models.GenericRequest
+	var payload []byte
+
+	err := tx.QueryRow(
+		ctx,
+		genericRequestQueryGetByID,
+		requestID,
+		requestType,
+	).Scan(&req.EmployeeID, &req.Status, &payload)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrRequestNotFound
+		}
+		return nil, utils.MapPgError(err)
+	}
+
+	if err := json.Unmarshal(payload, &req.Payload); err != nil {
+		return nil, apperrors.ErrInvalidRequestPayload
+	}
+
+	req.ID = requestID
+	req.RequestType = requestType
+	return &req, nil
+}
+
+func (r *genericRequestRepository) UpdateStatus(ctx context.Context, tx interfaces.Tx, requestID int64, status string, approverID int64, comment string) error {
+	tag, err := tx.Exec(
+		ctx,
+		genericRequestQueryUpdateStatus,
+		status, approverID, comment, requestID,
+	)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+	// decided, cancelled or paused since it was read
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrRequestNotPending
+	}
+	return nil
+}
+
+// SetOnBehalfOf records the delegator a delegate decided the request for
+func (r *genericRequestRepository) SetOnBehalfOf(ctx context.Context, tx interfaces.Tx, requestID, delegatorID int64) error {
+	_, err := tx.Exec(ctx, genericRequestQuerySetOnBehalfOf, delegatorID, requestID)
+	return utils.MapPgError(err)
+}
+
+func (r *genericRequestRepository) GetPendingForManager(ctx context.Context, requestType string, managerID int64) ([]map[string]interface{}, error) {
+	return r.getPending(ctx, genericRequestQueryGetPendingForManager, requestType, managerID)
+}
+
+func (r *genericRequestRepository) GetPendingForAdmin(ctx context.Context, requestType string) ([]map[string]interface{}, error) {
+	return r.getPending(ctx, genericRequestQueryGetPendingForAdmin, requestType)
+}
+
+func (r *genericRequestRepository) getPending(ctx context.Context, query string, args ...any) ([]map[string]interface{}, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var result []map[string]interface{}
+
+	for rows.Next() {
+		var id, employeeID int64
+		var name string
+		var payload map[string]interface{}
+		var createdAt time.Time
+
+		if err := rows.Scan(&id, &employeeID, &name, &payload, &createdAt); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":          id,
+			"employee_id": employeeID,
+			"employee":    name,
+			"payload":     payload,
+			"created_at":  createdAt.Format(time.RFC3339),
+		})
+	}
+
+	return result, utils.MapPgError(rows.Err())
+}
+
+func (r *genericRequestRepository) Cancel(ctx context.Context, tx interfaces.Tx, requestID int64) error {
+	_, err := tx.Exec(ctx, genericRequestQueryCancel, requestID)
+	return utils.MapPgError(err)
+}
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+const (
+	revocationQueryCreate = `INSERT INTO request_revocations
+		 (request_type, request_id, employee_id, revoked_by, previous_status, reason)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, revoked_at`
+	revocationQueryGetForRequest = `SELECT id, request_type, request_id, employee_id, revoked_by, previous_status, reason, revoked_at
+		 FROM request_revocations
+		 WHERE request_type=$1 AND request_id=$2
+		 ORDER BY revoked_at, id`
+)
+
+type revocationRepository struct {
+	db interfaces.DB
+}
+
+// NewRevocationRepository creates a new instance
+func NewRevocationRepository(ctx context.Context, db interfaces.DB) interfaces.RevocationRepository {
+	return &revocationRepository{db: db}
+}
+
+// Create records the revocation of a request; ID and RevokedAt are filled in
+func (r *revocationRepository) Create(ctx context.Context, tx interfaces.Tx, v *models.RequestRevocation) error {
+	err := tx.QueryRow(
+		ctx,
+		revocationQueryCreate,
+		v.RequestType,
+		v.RequestID,
+		v.EmployeeID,
+		v.RevokedBy,
+		v.PreviousStatus,
+		v.Reason,
+	).Scan(&v.ID, &v.RevokedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetForRequest returns the revocation of a request, if it was revoked
+func (r *revocationRepository) GetForRequest(ctx context.Context, requestType string, requestID int64) ([]models.RequestRevocation, error) {
+	rows, err := r.db.Query(ctx, revocationQueryGetForRequest, requestType, requestID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var revocations []models.RequestRevocation
+	for rows.Next() {
+		var v models.RequestRevocation
+		if err := rows.Scan(
+			&v.ID,
+			&v.RequestType,
+			&v.RequestID,
+			&v.EmployeeID,
+			&v.RevokedBy,
+			&v.PreviousStatus,
+			&v.Reason,
+			&v.RevokedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+		revocations = append(revocations, v)
+	}
+	return revocations, utils.MapPgError(rows.Err())
+}
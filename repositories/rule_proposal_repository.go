@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/models"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	ruleProposalColumns = `id, action, rule_id, before, after, bundle, plan, status, proposed_by,
+		        reviewed_by, COALESCE(review_comment, ''), created_at, reviewed_at`
+	ruleProposalQueryCreate = `INSERT INTO rule_proposals (action, rule_id, before, after, bundle, plan, proposed_by)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING id, status, created_at`
+	// locks the proposal until it is reviewed
+	ruleProposalQueryGetByID = `SELECT ` + ruleProposalColumns + `
+		 FROM rule_proposals
+		 WHERE id=$1
+		 FOR UPDATE`
+	ruleProposalQueryGetAll = `SELECT ` + ruleProposalColumns + `
+		 FROM rule_proposals
+		 WHERE ($1 = '' OR status = $1)
+		 ORDER BY created_at DESC, id DESC`
+	ruleProposalQueryReview = `UPDATE rule_proposals
+		 SET status=$2,
+		     rule_id=$3,
+		     after=$4,
+		     reviewed_by=$5,
+		     review_comment=NULLIF($6, ''),
+		     reviewed_at=NOW()
+		 WHERE id=$1
+		 RETURNING reviewed_at`
+)
+
+type ruleProposalRepository struct {
+	db interfaces.DB
+}
+
+// NewRuleProposalRepository creates a new instance
+func NewRuleProposalRepository(ctx context.Context, db interfaces.DB) interfaces.RuleProposalRepository {
+	return &ruleProposalRepository{db: db}
+}
+
+// Create stores a pending proposal; ID, Status and CreatedAt are filled in
+func (r *ruleProposalRepository) Create(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal) error {
+	before, after, bundle, plan, err := marshalProposal(proposal)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		ruleProposalQueryCreate,
+		proposal.Action,
+		proposal.RuleID,
+		before,
+		after,
+		bundle,
+		plan,
+		proposal.ProposedBy,
+	).Scan(&proposal.ID, &proposal.Status, &proposal.CreatedAt)
+
+	return utils.MapPgError(err)
+}
+
+// GetByID returns a proposal and locks it for review
+func (r *ruleProposalRepository) GetByID(ctx context.Context, tx interfaces.Tx, proposalID int64) (*models.RuleProposal, error) {
+	rows, err := tx.Query(ctx, ruleProposalQueryGetByID, proposalID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	proposals, err := scanRuleProposals(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proposals) == 0 {
+		return nil, apperrors.ErrProposalNotFound
+	}
+
+	return &proposals[0], nil
+}
+
+// GetAll returns proposals with the given status, or all of them when status is empty, newest first
+func (r *ruleProposalRepository) GetAll(ctx context.Context, status string) ([]models.RuleProposal, error) {
+	rows, err := r.db.Query(ctx, ruleProposalQueryGetAll, status)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	return scanRuleProposals(rows)
+}
+
+// Review records the decision on a proposal together with the rule it produced
+func (r *ruleProposalRepository) Review(ctx context.Context, tx interfaces.Tx, proposal *models.RuleProposal) error {
+	_, after, _, _, err := marshalProposal(proposal)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		ruleProposalQueryReview,
+		proposal.ID,
+		proposal.Status,
+		proposal.RuleID,
+		after,
+		proposal.ReviewedBy,
+		proposal.ReviewComment,
+	).Scan(&proposal.ReviewedAt)
+
+	return utils.MapPgError(err)
+}
+
+func marshalProposal(proposal *models.RuleProposal) (before, after, bundle, plan []byte, err error) {
+	if before, err = marshalProposalPart(proposal.Before, proposal.Before != nil); err != nil {
+		return
+	}
+	if after, err = marshalProposalPart(proposal.After, proposal.After != nil); err != nil {
+		return
+	}
+	if bundle, err = marshalProposalPart(proposal.Bundle, proposal.Bundle != nil); err != nil {
+		return
+	}
+	plan, err = marshalProposalPart(proposal.Plan, proposal.Plan != nil)
+	return
+}
+
+// absent parts are stored as NULL
+func marshalProposalPart(part interface{}, present bool) ([]byte, error) {
+	if !present {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(part)
+	if err != nil {
+		return nil, apperrors.ErrInvalidRuleProposal
+	}
+	return data, nil
+}
+
+func scanRuleProposals(rows pgx.Rows) ([]models.RuleProposal, error) {
+	var proposals []models.RuleProposal
+
+	for rows.Next() {
+		var p models.RuleProposal
+		var before, after, bundle, plan []byte
+
+		if err := rows.Scan(
+			&p.ID,
+			&p.Action,
+			&p.RuleID,
+			&before,
+			&after,
+			&bundle,
+			&plan,
+			&p.Status,
+			&p.ProposedBy,
+			&p.ReviewedBy,
+			&p.ReviewComment,
+			&p.CreatedAt,
+			&p.ReviewedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		if len(before) > 0 {
+			_ = json.Unmarshal(before, &p.Before)
+		}
+		if len(after) > 0 {
+			_ = json.Unmarshal(after, &p.After)
+		}
+		if len(bundle) > 0 {
+			_ = json.Unmarshal(bundle, &p.Bundle)
+		}
+		if len(plan) > 0 {
+			_ = json.Unmarshal(plan, &p.Plan)
+		}
+		proposals = append(proposals, p)
+	}
+
+	return proposals, utils.MapPgError(rows.Err())
+}
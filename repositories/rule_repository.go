@@ -3,36 +3,94 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/constants"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/models"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/apperrors"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+
+	"github.com/jackc/pgx/v5"
 )
 
 const (
-	ruleQueryGetByTypeAndGrade = `SELECT id, condition, action 
-		 FROM rules 
-		 WHERE request_type=$1 AND grade_id=$2 AND active=true
-		 LIMIT 1`
-	ruleQueryCreate = `INSERT INTO rules (request_type, condition, action, grade_id, active)
-		 VALUES ($1, $2, $3, $4, $5)
-		 ON CONFLICT (request_type, grade_id)
-		 DO UPDATE SET
-		 	condition  = EXCLUDED.condition,
-		 	action     = EXCLUDED.action,
-		 	active     = EXCLUDED.active,
-		 	updated_at = NOW()`
-	ruleQueryGetAll = `SELECT id, request_type, condition, action, grade_id, active
-		 FROM rules`
+	// versions of the active rules of a request type in force now or scheduled to be; callers pick the ones in force
+	ruleQueryGetByType = `SELECT v.rule_id, r.key, v.request_type, v.condition, v.action, v.scope, COALESCE(v.grade_id, 0),
+		        COALESCE(v.target_id, 0), COALESCE(v.department, ''), v.priority,
+		        COALESCE(v.reason, ''), v.active, v.id, v.version, v.effective_from, v.effective_to
+		 FROM rule_versions v
+		 JOIN rules r ON r.id = v.rule_id
+		 WHERE v.request_type=$1 AND v.active=true
+		   AND (v.effective_to IS NULL OR v.effective_to > NOW())
+		 ORDER BY v.priority, v.rule_id, v.effective_from`
+	// delivered to every listening instance when the transaction commits
+	ruleQueryNotifyChange = `SELECT pg_notify($1, $2)`
+	// latest version of every rule, including changes scheduled for later
+	ruleQueryGetAll = `SELECT v.rule_id, r.key, v.request_type, v.condition, v.action, v.scope, COALESCE(v.grade_id, 0),
+		        COALESCE(v.target_id, 0), COALESCE(v.department, ''), v.priority,
+		        COALESCE(v.reason, ''), v.active, v.id, v.version, v.effective_from, v.effective_to
+		 FROM rules r
+		 JOIN rule_versions v ON v.rule_id = r.id
+		  AND v.version = (SELECT MAX(version) FROM rule_versions WHERE rule_id = r.id)
+		 WHERE r.deleted_at IS NULL
+		 ORDER BY v.request_type, v.scope, v.grade_id, v.target_id, v.department, v.priority, v.rule_id`
+	ruleQueryGetAllForUpdate = ruleQueryGetAll + `
+		 FOR UPDATE OF r`
+	ruleQueryGetByID = `SELECT v.rule_id, r.key, v.request_type, v.condition, v.action, v.scope, COALESCE(v.grade_id, 0),
+		        COALESCE(v.target_id, 0), COALESCE(v.department, ''), v.priority,
+		        COALESCE(v.reason, ''), v.active, v.id, v.version, v.effective_from, v.effective_to
+		 FROM rules r
+		 JOIN rule_versions v ON v.rule_id = r.id
+		  AND v.version = (SELECT MAX(version) FROM rule_versions WHERE rule_id = r.id)
+		 WHERE r.id=$1 AND r.deleted_at IS NULL`
+	ruleQueryLock   = `SELECT id FROM rules WHERE id=$1 AND deleted_at IS NULL FOR UPDATE`
+	ruleQueryCreate = `WITH next AS (SELECT nextval(pg_get_serial_sequence('rules', 'id')) AS id)
+		 INSERT INTO rules (id, key, request_type, condition, action, grade_id, priority, reason, active, scope, target_id, department)
+		 SELECT next.id, COALESCE(NULLIF($11, ''), 'rule-' || next.id),
+		        $1, $2, $3, NULLIF($4, 0), $5, NULLIF($6, ''), $7, $8, NULLIF($9, 0), NULLIF($10, '')
+		 FROM next
+		 RETURNING id, key`
 	ruleQueryUpdate = `UPDATE rules
 		 SET request_type=$1,
 		     condition=$2,
 		     action=$3,
-		     grade_id=$4,
-		     active=$5
-		 WHERE id=$6`
-	ruleQueryDelete = `DELETE FROM rules WHERE id=$1`
+		     grade_id=NULLIF($4, 0),
+		     priority=$5,
+		     reason=NULLIF($6, ''),
+		     active=$7,
+		     scope=$8,
+		     target_id=NULLIF($9, 0),
+		     department=NULLIF($10, ''),
+		     key=COALESCE(NULLIF($11, ''), key),
+		     updated_at=NOW()
+		 WHERE id=$12`
+	ruleQueryDelete = `UPDATE rules SET deleted_at=NOW(), active=false WHERE id=$1 AND deleted_at IS NULL`
+
+	ruleVersionQueryNext   = `SELECT COALESCE(MAX(version), 0) + 1 FROM rule_versions WHERE rule_id=$1`
+	ruleVersionQueryInsert = `INSERT INTO rule_versions
+		 (rule_id, version, request_type, condition, action, grade_id, priority, reason, active, effective_from,
+		  scope, target_id, department)
+		 VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0), $7, NULLIF($8, ''), $9, $10, $11, NULLIF($12, 0), NULLIF($13, ''))
+		 RETURNING id`
+	// ends the version in force at $2
+	ruleVersionQueryClose = `UPDATE rule_versions
+		 SET effective_to=$2
+		 WHERE rule_id=$1
+		   AND effective_from < $2
+		   AND (effective_to IS NULL OR effective_to > $2)`
+	// versions scheduled at or after $2 are superseded and never take effect
+	ruleVersionQueryCancelScheduled = `UPDATE rule_versions
+		 SET effective_to=effective_from
+		 WHERE rule_id=$1
+		   AND effective_from >= $2
+		   AND (effective_to IS NULL OR effective_to > effective_from)`
+	ruleVersionQueryGetByRule = `SELECT id, rule_id, version, request_type, condition, action, scope, COALESCE(grade_id, 0),
+		        COALESCE(target_id, 0), COALESCE(department, ''), priority,
+		        COALESCE(reason, ''), active, effective_from, effective_to, created_at
+		 FROM rule_versions
+		 WHERE rule_id=$1
+		 ORDER BY version`
 )
 
 type ruleRepository struct {
@@ -44,45 +102,73 @@ func NewRuleRepository(ctx context.Context, db interfaces.DB) interfaces.RuleRep
 	return &ruleRepository{db: db}
 }
 
-func (r *ruleRepository) GetByTypeAndGrade(ctx context.Context, requestType string, gradeID int64) (*models.Rule, error) {
-	var rule models.Rule
-	var conditionJSON []byte
+// GetByType returns the versions of a request type's active rules that are in force or scheduled,
+// by priority within the request type
+func (r *ruleRepository) GetByType(ctx context.Context, requestType string) ([]models.Rule, error) {
+	rows, err := r.db.Query(ctx, ruleQueryGetByType, requestType)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
 
-	err := r.db.QueryRow(
-		ctx,
-		ruleQueryGetByTypeAndGrade,
-		requestType, gradeID,
-	).Scan(&rule.ID, &conditionJSON, &rule.Action)
+	return scanRules(rows)
+}
 
+// NotifyChange tells every instance that the rules of a request type changed, all of them when it is empty.
+// The notification is only sent if tx commits.
+func (r *ruleRepository) NotifyChange(ctx context.Context, tx interfaces.Tx, requestType string) error {
+	_, err := tx.Exec(ctx, ruleQueryNotifyChange, constants.RuleChangeChannel, requestType)
+	return utils.MapPgError(err)
+}
+
+// GetByID returns the latest version of a rule
+func (r *ruleRepository) GetByID(ctx context.Context, tx interfaces.Tx, ruleID int64) (*models.Rule, error) {
+	rows, err := tx.Query(ctx, ruleQueryGetByID, ruleID)
 	if err != nil {
-		return nil, apperrors.ErrNoRuleFound
+		return nil, utils.MapPgError(err)
 	}
+	defer rows.Close()
 
-	err = json.Unmarshal(conditionJSON, &rule.Condition)
+	rules, err := scanRules(rows)
 	if err != nil {
-		return nil, apperrors.ErrDatabase // Or a specific JSON error
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return nil, apperrors.ErrNoRuleFound
 	}
 
-	return &rule, nil
+	return &rules[0], nil
 }
 
-func (r *ruleRepository) Create(ctx context.Context, rule *models.Rule) error {
+// Create stores a rule and its first version; rule.ID, Key, VersionID and Version are filled in.
+// A rule created without a key is keyed "rule-<id>".
+func (r *ruleRepository) Create(ctx context.Context, tx interfaces.Tx, rule *models.Rule) error {
 	conditionJSON, err := json.Marshal(rule.Condition)
 	if err != nil {
 		return apperrors.ErrInvalidConditionJSON
 	}
 
-	_, err = r.db.Exec(
+	err = tx.QueryRow(
 		ctx,
 		ruleQueryCreate,
 		rule.RequestType,
 		conditionJSON,
 		rule.Action,
 		rule.GradeID,
+		rule.Priority,
+		rule.Reason,
 		rule.Active,
-	)
+		rule.Scope,
+		rule.TargetID,
+		rule.Department,
+		rule.Key,
+	).Scan(&rule.ID, &rule.Key)
+	if err != nil {
+		return utils.MapPgError(err)
+	}
 
-	return utils.MapPgError(err)
+	return insertRuleVersion(ctx, tx, rule.ID, rule, conditionJSON)
 }
 
 func (r *ruleRepository) GetAll(ctx context.Context) ([]models.Rule, error) {
@@ -95,44 +181,80 @@ func (r *ruleRepository) GetAll(ctx context.Context) ([]models.Rule, error) {
 	}
 	defer rows.Close()
 
-	var rules []models.Rule
-
-	for rows.Next() {
-		var rule models.Rule
-		var conditionJSON []byte
-
-		if err := rows.Scan(
-			&rule.ID,
-			&rule.RequestType,
-			&conditionJSON,
-			&rule.Action,
-			&rule.GradeID,
-			&rule.Active,
-		); err != nil {
-			return nil, utils.MapPgError(err)
-		}
+	return scanRules(rows)
+}
 
-		_ = json.Unmarshal(conditionJSON, &rule.Condition)
-		rules = append(rules, rule)
+// GetAllForUpdate is GetAll read through tx, locking the rules until it ends
+func (r *ruleRepository) GetAllForUpdate(ctx context.Context, tx interfaces.Tx) ([]models.Rule, error) {
+	rows, err := tx.Query(ctx, ruleQueryGetAllForUpdate)
+	if err != nil {
+		return nil, utils.MapPgError(err)
 	}
+	defer rows.Close()
 
-	return rules, nil
+	return scanRules(rows)
 }
 
-func (r *ruleRepository) Update(ctx context.Context, ruleID int64, rule *models.Rule) error {
+// Update appends a version taking effect at rule.EffectiveFrom (now when unset).
+// The version in force at that moment is closed, and later scheduled versions are superseded.
+func (r *ruleRepository) Update(ctx context.Context, tx interfaces.Tx, ruleID int64, rule *models.Rule) error {
 	conditionJSON, err := json.Marshal(rule.Condition)
 	if err != nil {
 		return apperrors.ErrInvalidConditionJSON
 	}
 
-	cmd, err := r.db.Exec(
+	var lockedID int64
+	err = tx.QueryRow(ctx, ruleQueryLock, ruleID).Scan(&lockedID)
+	if err == pgx.ErrNoRows {
+		return apperrors.ErrNoRuleFound
+	}
+	if err != nil {
+		return utils.MapPgError(err)
+	}
+
+	effectiveFrom := time.Now()
+	if rule.EffectiveFrom != nil {
+		effectiveFrom = *rule.EffectiveFrom
+	}
+	rule.EffectiveFrom = &effectiveFrom
+
+	if _, err := tx.Exec(ctx, ruleVersionQueryClose, ruleID, effectiveFrom); err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if _, err := tx.Exec(ctx, ruleVersionQueryCancelScheduled, ruleID, effectiveFrom); err != nil {
+		return utils.MapPgError(err)
+	}
+
+	if err := insertRuleVersion(ctx, tx, ruleID, rule, conditionJSON); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
 		ctx,
 		ruleQueryUpdate,
 		rule.RequestType,
 		conditionJSON,
 		rule.Action,
 		rule.GradeID,
+		rule.Priority,
+		rule.Reason,
 		rule.Active,
+		rule.Scope,
+		rule.TargetID,
+		rule.Department,
+		rule.Key,
+		ruleID,
+	)
+
+	return utils.MapPgError(err)
+}
+
+// Delete retires a rule: its current version ends now and scheduled ones never start
+func (r *ruleRepository) Delete(ctx context.Context, tx interfaces.Tx, ruleID int64) error {
+	cmd, err := tx.Exec(
+		ctx,
+		ruleQueryDelete,
 		ruleID,
 	)
 
@@ -141,26 +263,127 @@ func (r *ruleRepository) Update(ctx context.Context, ruleID int64, rule *models.
 	}
 
 	if cmd.RowsAffected() == 0 {
-		return apperrors.ErrNoRuleFound
+		return apperrors.ErrRuleNotFoundForDelete
 	}
 
-	return nil
+	now := time.Now()
+	if _, err := tx.Exec(ctx, ruleVersionQueryClose, ruleID, now); err != nil {
+		return utils.MapPgError(err)
+	}
+
+	_, err = tx.Exec(ctx, ruleVersionQueryCancelScheduled, ruleID, now)
+	return utils.MapPgError(err)
 }
 
-func (r *ruleRepository) Delete(ctx context.Context, ruleID int64) error {
-	cmd, err := r.db.Exec(
+// GetVersions returns every version of a rule, oldest first
+func (r *ruleRepository) GetVersions(ctx context.Context, ruleID int64) ([]models.RuleVersion, error) {
+	rows, err := r.db.Query(ctx, ruleVersionQueryGetByRule, ruleID)
+	if err != nil {
+		return nil, utils.MapPgError(err)
+	}
+	defer rows.Close()
+
+	var versions []models.RuleVersion
+	for rows.Next() {
+		var v models.RuleVersion
+		var conditionJSON []byte
+
+		if err := rows.Scan(
+			&v.ID,
+			&v.RuleID,
+			&v.Version,
+			&v.RequestType,
+			&conditionJSON,
+			&v.Action,
+			&v.Scope,
+			&v.GradeID,
+			&v.TargetID,
+			&v.Department,
+			&v.Priority,
+			&v.Reason,
+			&v.Active,
+			&v.EffectiveFrom,
+			&v.EffectiveTo,
+			&v.CreatedAt,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		_ = json.Unmarshal(conditionJSON, &v.Condition)
+		versions = append(versions, v)
+	}
+
+	return versions, utils.MapPgError(rows.Err())
+}
+
+func insertRuleVersion(ctx context.Context, tx interfaces.Tx, ruleID int64, rule *models.Rule, conditionJSON []byte) error {
+	var version int
+	if err := tx.QueryRow(ctx, ruleVersionQueryNext, ruleID).Scan(&version); err != nil {
+		return utils.MapPgError(err)
+	}
+
+	effectiveFrom := time.Now()
+	if rule.EffectiveFrom != nil {
+		effectiveFrom = *rule.EffectiveFrom
+	}
+
+	err := tx.QueryRow(
 		ctx,
-		ruleQueryDelete,
+		ruleVersionQueryInsert,
 		ruleID,
-	)
-
+		version,
+		rule.RequestType,
+		conditionJSON,
+		rule.Action,
+		rule.GradeID,
+		rule.Priority,
+		rule.Reason,
+		rule.Active,
+		effectiveFrom,
+		rule.Scope,
+		rule.TargetID,
+		rule.Department,
+	).Scan(&rule.VersionID)
 	if err != nil {
 		return utils.MapPgError(err)
 	}
 
-	if cmd.RowsAffected() == 0 {
-		return apperrors.ErrRuleNotFoundForDelete
+	rule.Version = version
+	rule.EffectiveFrom = &effectiveFrom
+	return nil
+}
+
+func scanRules(rows pgx.Rows) ([]models.Rule, error) {
+	var rules []models.Rule
+
+	for rows.Next() {
+		var rule models.Rule
+		var conditionJSON []byte
+
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.Key,
+			&rule.RequestType,
+			&conditionJSON,
+			&rule.Action,
+			&rule.Scope,
+			&rule.GradeID,
+			&rule.TargetID,
+			&rule.Department,
+			&rule.Priority,
+			&rule.Reason,
+			&rule.Active,
+			&rule.VersionID,
+			&rule.Version,
+			&rule.EffectiveFrom,
+			&rule.EffectiveTo,
+		); err != nil {
+			return nil, utils.MapPgError(err)
+		}
+
+		_ = json.Unmarshal(conditionJSON, &rule.Condition)
+		rules = append(rules, rule)
 	}
 
-	return nil
+	return rules, utils.MapPgError(rows.Err())
 }
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
+	"github.com/ankita-advitot/rule_based_approval_engine/pkg/utils"
+)
+
+// the statuses counted are passed in from utils.ApprovedStatuses and utils.ActiveLeaveStatuses,
+// the same ones backtests replay history with
+const (
+	usageQueryApprovedExpenseTotal = `SELECT COALESCE(SUM(amount), 0)::FLOAT8
+		 FROM expense_requests
+		 WHERE employee_id=$1
+		   AND status::text = ANY($3)
+		   AND created_at >= $2`
+	usageQueryLeaveRequestCount = `SELECT COUNT(*)
+		 FROM leave_requests
+		 WHERE employee_id=$1
+		   AND status::text = ANY($4)
+		   AND created_at >= $2
+		   AND id <> $3`
+	usageQueryApprovedDiscountTotal = `SELECT COALESCE(SUM(discount_percentage), 0)::FLOAT8
+		 FROM discount_requests
+		 WHERE employee_id=$1
+		   AND status::text = ANY($3)
+		   AND created_at >= $2`
+)
+
+type usageRepository struct {
+	db interfaces.DB
+}
+
+// NewUsageRepository creates a new instance
+func NewUsageRepository(ctx context.Context, db interfaces.DB) interfaces.UsageRepository {
+	return &usageRepository{db: db}
+}
+
+// GetApprovedExpenseTotal sums the user's approved expenses filed since the given time
+func (r *usageRepository) GetApprovedExpenseTotal(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time) (float64, error) {
+	var total float64
+	err := tx.QueryRow(ctx, usageQueryApprovedExpenseTotal, userID, since, utils.ApprovedStatuses).Scan(&total)
+	return total, utils.MapPgError(err)
+}
+
+// GetLeaveRequestCount counts the user's pending and approved leave requests filed since the given time,
+// but for excludeID, the request being amended
+func (r *usageRepository) GetLeaveRequestCount(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time, excludeID int64) (int, error) {
+	var count int
+	err := tx.QueryRow(ctx, usageQueryLeaveRequestCount, userID, since, excludeID, utils.ActiveLeaveStatuses).Scan(&count)
+	return count, utils.MapPgError(err)
+}
+
+// GetApprovedDiscountTotal sums the user's approved discount percentages filed since the given time
+func (r *usageRepository) GetApprovedDiscountTotal(ctx context.Context, tx interfaces.Tx, userID int64, since time.Time) (float64, error) {
+	var total float64
+	err := tx.QueryRow(ctx, usageQueryApprovedDiscountTotal, userID, since, utils.ApprovedStatuses).Scan(&total)
+	return total, utils.MapPgError(err)
+}
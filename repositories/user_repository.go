@@ -22,6 +22,7 @@ const (
 	userQueryCheckEmailExists = `SELECT COUNT(*) FROM users WHERE email=$1`
 	userQueryGetRole          = `SELECT role FROM users WHERE id=$1`
 	userQueryGetGrade         = `SELECT grade_id FROM users WHERE id=$1`
+	userQueryGetRuleSubject   = `SELECT id, grade_id, manager_id, COALESCE(department, ''), role FROM users WHERE id=$1`
 )
 
 type userRepository struct {
@@ -163,3 +164,29 @@ func (r *userRepository) GetGrade(ctx context.Context, tx interfaces.Tx, userID
 
 	return gradeID, nil
 }
+
+// GetRuleSubject returns what rule scopes are matched against for a user
+func (r *userRepository) GetRuleSubject(ctx context.Context, tx interfaces.Tx, userID int64) (*models.RuleSubject, error) {
+	var subject models.RuleSubject
+
+	err := tx.QueryRow(
+		ctx,
+		userQueryGetRuleSubject,
+		userID,
+	).Scan(
+		&subject.UserID,
+		&subject.GradeID,
+		&subject.ManagerID,
+		&subject.Department,
+		&subject.Role,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrUserNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+
+	return &subject, nil
+}
@@ -3,13 +3,20 @@ package routes
 import (
 	"context"
 
+	"github.com/ankita-advitot/rule_based_approval_engine/app/amendments"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/approval_chains"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/auth"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/auto_reject"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/delegations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/domain_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/expense_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/holidays"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/info_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/leave_service"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/my_requests"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/reports"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/requests"
+	"github.com/ankita-advitot/rule_based_approval_engine/app/revocations"
 	"github.com/ankita-advitot/rule_based_approval_engine/app/rules"
 	"github.com/ankita-advitot/rule_based_approval_engine/interfaces"
 	"github.com/ankita-advitot/rule_based_approval_engine/pkg/middleware"
@@ -32,6 +39,17 @@ func Register(
 	autoRejectService interfaces.AutoRejectService,
 	discountService interfaces.DiscountService,
 	discountApprovalService interfaces.DiscountApprovalService,
+	ruleSimulationService interfaces.RuleSimulationService,
+	ruleBacktestService interfaces.RuleBacktestService,
+	ruleAnalyzerService interfaces.RuleAnalyzerService,
+	requestService interfaces.RequestService,
+	requestApprovalService interfaces.RequestApprovalService,
+	approvalChainService interfaces.ApprovalChainService,
+	delegationService interfaces.DelegationService,
+	expiryPolicyService interfaces.ExpiryPolicyService,
+	infoRequestService interfaces.InfoRequestService,
+	amendmentService interfaces.AmendmentService,
+	revocationService interfaces.RevocationService,
 ) {
 	// Initialize handlers
 	authHandler := auth.NewAuthHandler(ctx, authService)
@@ -40,12 +58,23 @@ func Register(
 	expenseHandler := expense_service.NewExpenseHandler(ctx, expenseService)
 	expenseApprovalHandler := expense_service.NewExpenseApprovalHandler(ctx, expenseApprovalService)
 	ruleHandler := rules.NewRuleHandler(ctx, ruleService)
+	ruleSimulationHandler := rules.NewRuleSimulationHandler(ctx, ruleSimulationService)
+	ruleBacktestHandler := rules.NewRuleBacktestHandler(ctx, ruleBacktestService)
+	ruleAnalyzerHandler := rules.NewRuleAnalyzerHandler(ctx, ruleAnalyzerService)
 	myRequestsHandler := my_requests.NewMyRequestsHandler(ctx, myRequestsService)
 	holidayHandler := holidays.NewHolidayHandler(ctx, holidayService)
 	reportHandler := reports.NewReportHandler(ctx, reportService)
 	balanceHandler := domain_service.NewBalanceHandler(ctx, balanceService)
 	discountHandler := domain_service.NewDiscountHandler(ctx, discountService)
 	discountApprovalHandler := domain_service.NewDiscountApprovalHandler(ctx, discountApprovalService)
+	requestHandler := requests.NewRequestHandler(ctx, requestService)
+	requestApprovalHandler := requests.NewRequestApprovalHandler(ctx, requestApprovalService)
+	approvalChainHandler := approval_chains.NewApprovalChainHandler(ctx, approvalChainService)
+	delegationHandler := delegations.NewDelegationHandler(ctx, delegationService)
+	expiryPolicyHandler := auto_reject.NewExpiryPolicyHandler(ctx, expiryPolicyService)
+	infoRequestHandler := info_requests.NewInfoRequestHandler(ctx, infoRequestService)
+	amendmentHandler := amendments.NewAmendmentHandler(ctx, amendmentService)
+	revocationHandler := revocations.NewRevocationHandler(ctx, revocationService)
 
 	// Public routes
 	public := router.Group("/api")
@@ -64,6 +93,12 @@ func Register(
 		protected.GET("/leave/pending", leaveApprovalHandler.GetPendingLeaves)
 		protected.POST("/leave/approve/:id", leaveApprovalHandler.ApproveLeave)
 		protected.POST("/leave/reject/:id", leaveApprovalHandler.RejectLeave)
+		protected.POST("/leave/bulk-approve", leaveApprovalHandler.BulkApproveLeaves)
+		protected.POST("/leave/bulk-reject", leaveApprovalHandler.BulkRejectLeaves)
+		protected.POST("/leave/request-info/:id", leaveApprovalHandler.RequestLeaveInfo)
+		protected.POST("/leave/revoke/:id", leaveApprovalHandler.RevokeLeave)
+		protected.POST("/leave/resubmit/:id", leaveHandler.ResubmitLeave)
+		protected.PUT("/leave/:id", leaveHandler.AmendLeave)
 
 		// Expense routes
 		protected.POST("/expense/apply", expenseHandler.ApplyExpense)
@@ -71,16 +106,35 @@ func Register(
 		protected.GET("/expense/pending", expenseApprovalHandler.GetPendingExpenses)
 		protected.POST("/expense/approve/:id", expenseApprovalHandler.ApproveExpense)
 		protected.POST("/expense/reject/:id", expenseApprovalHandler.RejectExpense)
+		protected.POST("/expense/bulk-approve", expenseApprovalHandler.BulkApproveExpenses)
+		protected.POST("/expense/bulk-reject", expenseApprovalHandler.BulkRejectExpenses)
+		protected.POST("/expense/request-info/:id", expenseApprovalHandler.RequestExpenseInfo)
+		protected.POST("/expense/revoke/:id", expenseApprovalHandler.RevokeExpense)
+		protected.POST("/expense/resubmit/:id", expenseHandler.ResubmitExpense)
+		protected.PUT("/expense/:id", expenseHandler.AmendExpense)
 
 		// Rule routes
 		protected.POST("/rules", ruleHandler.CreateRule)
+		protected.POST("/rules/simulate", ruleSimulationHandler.Simulate)
+		protected.POST("/rules/backtest", ruleBacktestHandler.Backtest)
 		protected.GET("/rules", ruleHandler.GetRules)
+		protected.GET("/rules/audit", ruleHandler.AuditRules)
+		protected.GET("/rules/analysis", ruleAnalyzerHandler.Analyze)
+		protected.GET("/rules/cache/stats", ruleHandler.GetRuleCacheStats)
+		protected.GET("/rules/export", ruleHandler.ExportRules)
+		protected.POST("/rules/import", ruleHandler.ImportRules)
+		protected.GET("/rules/proposals", ruleHandler.GetRuleProposals)
+		protected.POST("/rules/proposals/:id/approve", ruleHandler.ApproveRuleProposal)
+		protected.POST("/rules/proposals/:id/reject", ruleHandler.RejectRuleProposal)
 		protected.PUT("/rules/:id", ruleHandler.UpdateRule)
 		protected.DELETE("/rules/:id", ruleHandler.DeleteRule)
+		protected.GET("/rules/:id/versions", ruleHandler.GetRuleVersions)
+		protected.GET("/rules/:id/versions/diff", ruleHandler.DiffRuleVersions)
 
 		// My Requests routes
 		protected.GET("/my-requests", myRequestsHandler.GetMyRequests)
 		protected.GET("/my-requests/all", myRequestsHandler.GetMyAllRequests)
+		protected.GET("/my-requests/:type/:id", myRequestsHandler.GetMyRequest)
 
 		// Holiday routes
 		protected.POST("/holidays", holidayHandler.AddHoliday)
@@ -99,5 +153,47 @@ func Register(
 		protected.GET("/discount/pending", discountApprovalHandler.GetPendingDiscounts)
 		protected.POST("/discount/approve/:id", discountApprovalHandler.ApproveDiscount)
 		protected.POST("/discount/reject/:id", discountApprovalHandler.RejectDiscount)
+		protected.POST("/discount/bulk-approve", discountApprovalHandler.BulkApproveDiscounts)
+		protected.POST("/discount/bulk-reject", discountApprovalHandler.BulkRejectDiscounts)
+		protected.POST("/discount/request-info/:id", discountApprovalHandler.RequestDiscountInfo)
+		protected.POST("/discount/revoke/:id", discountApprovalHandler.RevokeDiscount)
+		protected.POST("/discount/resubmit/:id", discountHandler.ResubmitDiscount)
+
+		// Registered request type routes
+		protected.GET("/request-types", requestHandler.GetRequestTypes)
+		protected.POST("/requests/:type/apply", requestHandler.Apply)
+		protected.POST("/requests/:type/cancel/:id", requestHandler.Cancel)
+		protected.GET("/requests/:type/pending", requestApprovalHandler.GetPending)
+		protected.POST("/requests/:type/approve/:id", requestApprovalHandler.Approve)
+		protected.POST("/requests/:type/reject/:id", requestApprovalHandler.Reject)
+		protected.POST("/requests/:type/bulk-approve", requestApprovalHandler.BulkApprove)
+		protected.POST("/requests/:type/bulk-reject", requestApprovalHandler.BulkReject)
+
+		// Approval chain routes
+		protected.POST("/approval-chains", approvalChainHandler.CreateChain)
+		protected.GET("/approval-chains", approvalChainHandler.GetChains)
+		protected.DELETE("/approval-chains/:id", approvalChainHandler.DeleteChain)
+		protected.GET("/approval-steps/pending", approvalChainHandler.GetPendingSteps)
+		protected.GET("/approval-steps/:type/:id", approvalChainHandler.GetRequestSteps)
+
+		// Delegation routes
+		protected.POST("/delegations", delegationHandler.CreateDelegation)
+		protected.GET("/delegations", delegationHandler.GetDelegations)
+		protected.DELETE("/delegations/:id", delegationHandler.RevokeDelegation)
+
+		// Expiry policy routes
+		protected.GET("/expiry-policies", expiryPolicyHandler.GetPolicies)
+		protected.PUT("/expiry-policies/:type", expiryPolicyHandler.SetPolicy)
+		protected.DELETE("/expiry-policies/:type", expiryPolicyHandler.DeletePolicy)
+		protected.GET("/escalations/:type/:id", expiryPolicyHandler.GetEscalations)
+
+		// Information request routes
+		protected.GET("/info-requests/:type/:id", infoRequestHandler.GetInfoRequests)
+
+		// Amendment routes
+		protected.GET("/amendments/:type/:id", amendmentHandler.GetAmendments)
+
+		// Revocation routes
+		protected.GET("/revocations/:type/:id", revocationHandler.GetRevocations)
 	}
 }